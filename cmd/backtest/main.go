@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/backtest"
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/datapi/gmgn"
+	"github.com/fachebot/sol-grid-bot/internal/datapi/jupag"
+	"github.com/fachebot/sol-grid-bot/internal/datapi/okxweb3"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+)
+
+var configFile = flag.String("f", "etc/config.yaml", "the config file")
+var optionsFile = flag.String("b", "etc/backtest.yaml", "the backtest options file")
+
+func loadOhlcs(ctx context.Context, c *config.Config, options *backtest.Options) ([]charts.Ohlc, error) {
+	if options.CandlesFile != "" {
+		return backtest.LoadOhlcsFromFile(options.CandlesFile)
+	}
+
+	var fetcher backtest.CandleFetcher
+	switch c.Datapi {
+	case "okx":
+		fetcher = okxweb3.NewClient(c.Sock5Proxy)
+	case "jupag":
+		fetcher = jupag.NewClient(c.Sock5Proxy)
+	default:
+		fetcher = gmgn.NewClient(c.Sock5Proxy)
+	}
+	return backtest.FetchOhlcs(ctx, fetcher, options.Token, options.Period, time.Now(), options.Limit)
+}
+
+func main() {
+	flag.Parse()
+	logger.SetLogFile(filepath.Join("logs", "gridbot.log"))
+
+	// 读取配置文件
+	c, err := config.LoadFromFile(*configFile)
+	if err != nil {
+		logger.Fatalf("读取配置文件失败, %s", err)
+	}
+
+	options, err := backtest.LoadOptionsFromFile(*optionsFile)
+	if err != nil {
+		logger.Fatalf("读取回测配置失败, %s", err)
+	}
+
+	// 加载K线数据
+	ctx := context.Background()
+	ohlcs, err := loadOhlcs(ctx, c, options)
+	if err != nil {
+		logger.Fatalf("加载K线数据失败, %s", err)
+	}
+
+	// 运行回测
+	b, err := backtest.NewBacktest(ctx, c, options)
+	if err != nil {
+		logger.Fatalf("创建回测失败, %s", err)
+	}
+	defer b.Close()
+
+	report, err := b.Run(ctx, ohlcs)
+	if err != nil {
+		logger.Fatalf("运行回测失败, %s", err)
+	}
+
+	fmt.Println(report.String())
+}
@@ -1430,8 +1430,47 @@ func (ui *MainUI) startProgram() {
 		return
 	}
 
-	// 启动程序
-	if err := ui.processManager.Start(ui.exePath, ui.deployDir); err != nil {
+	// 已通过环境变量设置主密码时直接启动
+	if os.Getenv(masterPasswordEnv) != "" {
+		ui.launchProgram("")
+		return
+	}
+
+	ui.showMasterPasswordDialog()
+}
+
+// showMasterPasswordDialog 输入主密码, 启动程序时通过环境变量传递给子进程
+func (ui *MainUI) showMasterPasswordDialog() {
+	passwordEntry := widget.NewPasswordEntry()
+	passwordEntry.SetPlaceHolder("用于加密钱包私钥")
+	confirmEntry := widget.NewPasswordEntry()
+	confirmEntry.SetPlaceHolder("再次输入主密码")
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("主密码", passwordEntry),
+		widget.NewFormItem("确认主密码", confirmEntry),
+	}
+	d := dialog.NewForm("输入主密码", "启动", "取消", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		if passwordEntry.Text == "" {
+			dialog.ShowError(fmt.Errorf("主密码不能为空"), ui.window)
+			return
+		}
+		if passwordEntry.Text != confirmEntry.Text {
+			dialog.ShowError(fmt.Errorf("两次输入的主密码不一致"), ui.window)
+			return
+		}
+		ui.launchProgram(passwordEntry.Text)
+	}, ui.window)
+	d.Resize(fyne.NewSize(420, 220))
+	d.Show()
+}
+
+// launchProgram 启动程序, masterPassword 为空时使用启动器自身的环境变量
+func (ui *MainUI) launchProgram(masterPassword string) {
+	if err := ui.processManager.Start(ui.exePath, ui.deployDir, masterPassword); err != nil {
 		dialog.ShowError(fmt.Errorf("启动失败: %w", err), ui.window)
 		return
 	}
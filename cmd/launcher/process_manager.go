@@ -16,6 +16,9 @@ import (
 	"time"
 )
 
+// 主密码环境变量, 程序在没有终端时从此变量读取主密码
+const masterPasswordEnv = "GRIDBOT_MASTER_PASSWORD"
+
 // ProcessManager 进程管理器
 type ProcessManager struct {
 	cmd        *exec.Cmd
@@ -48,8 +51,8 @@ func (pm *ProcessManager) SetLogCallback(callback func(level, message string)) {
 	pm.onLogLine = callback
 }
 
-// Start 启动程序并捕获日志
-func (pm *ProcessManager) Start(exePath, workDir string) error {
+// Start 启动程序并捕获日志, masterPassword 不为空时通过环境变量传递给程序
+func (pm *ProcessManager) Start(exePath, workDir, masterPassword string) error {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
@@ -81,6 +84,9 @@ func (pm *ProcessManager) Start(exePath, workDir string) error {
 	pm.cmd = exec.CommandContext(pm.ctx, exePath)
 	pm.cmd.Dir = absWorkDir
 	pm.cmd.Env = os.Environ()
+	if masterPassword != "" {
+		pm.cmd.Env = append(pm.cmd.Env, masterPasswordEnv+"="+masterPassword)
+	}
 
 	// 创建管道捕获stdout和stderr
 	stdoutPipe, err := pm.cmd.StdoutPipe()
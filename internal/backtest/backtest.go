@@ -0,0 +1,298 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/engine"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/job"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+)
+
+const backtestUserId = 1
+
+type nopKlineManager struct{}
+
+func (nopKlineManager) Start()                                 {}
+func (nopKlineManager) Stop()                                  {}
+func (nopKlineManager) Subscribe(assets []string) error        { return nil }
+func (nopKlineManager) Unsubscribe(assets []string) error      { return nil }
+func (nopKlineManager) GetOhlcsChan() <-chan charts.TokenOhlcs { return nil }
+
+// Backtest 使用内存数据库和模拟交易回放K线
+type Backtest struct {
+	options  *Options
+	svcCtx   *svc.ServiceContext
+	executor *Executor
+	keeper   *job.OrderKeeper
+	recorder *notificationRecorder
+	record   *ent.Strategy
+	strategy engine.Strategy
+}
+
+func NewBacktest(ctx context.Context, c *config.Config, options *Options) (*Backtest, error) {
+	// 创建内存数据库
+	dsn := fmt.Sprintf("file:backtest-%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Schema.Create(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	// 创建模拟执行器
+	account := solana.NewWallet().PublicKey().String()
+	executor := NewExecutor(options.Token, options.Decimals, account, options.SlippageBps, options.InitialBalance)
+	recorder := &notificationRecorder{executor: executor}
+	botApi, err := newBotApi(recorder)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	tokenMetaCache := cache.NewTokenMetaCache(nil)
+	tokenMetaCache.SetTokenMeta(options.Token, cache.TokenMeta{
+		Name:     options.Symbol,
+		Symbol:   options.Symbol,
+		Decimals: options.Decimals,
+	})
+
+	strategyEngine := engine.NewStrategyEngine(nopKlineManager{})
+	svcCtx := &svc.ServiceContext{
+		Config:         c,
+		Engine:         strategyEngine,
+		DbClient:       client,
+		BotApi:         botApi,
+		BotUserInfo:    &botApi.Self,
+		MessageCache:   cache.NewMessageCache(),
+		TokenMetaCache: tokenMetaCache,
+		GridModel:      model.NewGridModel(client.Grid),
+		OrderModel:     model.NewOrderModel(client.Order),
+		SettingsModel:  model.NewSettingsModel(client.Settings),
+		StrategyModel:  model.NewStrategyModel(client.Strategy),
+		WalletModel:    model.NewWalletModel(client.Wallet),
+	}
+
+	b := &Backtest{
+		options:  options,
+		svcCtx:   svcCtx,
+		executor: executor,
+		keeper:   job.NewOrderKeeperWithExecutor(svcCtx, executor),
+		recorder: recorder,
+	}
+	if err = b.initStrategy(ctx, account); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (b *Backtest) Close() {
+	if err := b.svcCtx.DbClient.Close(); err != nil {
+		logger.Errorf("[Backtest] 关闭数据库失败, %v", err)
+	}
+}
+
+func (b *Backtest) initStrategy(ctx context.Context, account string) error {
+	_, err := b.svcCtx.WalletModel.Save(ctx, ent.Wallet{
+		UserId:  backtestUserId,
+		Account: account,
+	})
+	if err != nil {
+		return err
+	}
+
+	c := b.options.Grid
+	args := ent.Strategy{
+		GUID:                   uuid.NewString(),
+		UserId:                 backtestUserId,
+		Account:                account,
+		Token:                  b.options.Token,
+		Symbol:                 b.options.Symbol,
+		Type:                   entstrategy.Type(c.Type),
+		MartinFactor:           c.MartinFactor,
+		TakeProfitRatio:        c.TakeProfitRatio,
+		UpperPriceBound:        c.UpperPriceBound,
+		LowerPriceBound:        c.LowerPriceBound,
+		GridMode:               entstrategy.GridMode(c.GridMode),
+		GridCount:              c.GridCount,
+		InitialOrderSize:       c.OrderSize,
+		LastKlineVolume:        &c.LastKlineVolume,
+		FiveKlineVolume:        &c.FiveKlineVolume,
+		UpperBoundExit:         &c.UpperBoundExit,
+		StopLossExit:           &c.StopLossExit,
+		TakeProfitExit:         &c.TakeProfitExit,
+		GlobalTakeProfitRatio:  &c.GlobalTakeProfitRatio,
+		DropOn:                 c.DropOn,
+		CandlesToCheck:         c.CandlesToCheck,
+		DropThreshold:          &c.DropThreshold,
+		TrailingOn:             c.TrailingOn,
+		TrailingCandles:        c.TrailingCandles,
+		TrailingMaxShifts:      c.TrailingMaxShifts,
+		BuyConditions:          c.BuyConditions,
+		DcaInterval:            c.DcaInterval,
+		DcaDropRatio:           &c.DcaDropRatio,
+		DcaMaxOrders:           c.DcaMaxOrders,
+		DcaSellInterval:        c.DcaSellInterval,
+		DcaSellProfitRatio:     &c.DcaSellProfitRatio,
+		EnableAutoBuy:          true,
+		EnableAutoSell:         true,
+		EnableAutoExit:         c.EnableAutoExit,
+		EnablePushNotification: true,
+		Status:                 entstrategy.StatusActive,
+	}
+	if c.MaxGridLimit > 0 {
+		args.MaxGridLimit = &c.MaxGridLimit
+	}
+	if c.GridStep.GreaterThan(decimal.Zero) {
+		args.GridStep = &c.GridStep
+	}
+
+	record, err := b.svcCtx.StrategyModel.Save(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	if c.DynamicStopLoss {
+		if err = b.svcCtx.StrategyModel.UpdateDynamicStopLoss(ctx, record.ID, true); err != nil {
+			return err
+		}
+		record.DynamicStopLoss = true
+	}
+
+	b.record = record
+	b.strategy = strategy.NewStrategyWithExecutor(b.svcCtx, b.executor, record)
+	return b.svcCtx.Engine.StartStrategy([]engine.Strategy{b.strategy})
+}
+
+func (b *Backtest) Run(ctx context.Context, ohlcs []charts.Ohlc) (*Report, error) {
+	if len(ohlcs) == 0 {
+		return nil, errors.New("no ohlc data")
+	}
+
+	report := &Report{
+		Token:          b.options.Token,
+		Symbol:         b.options.Symbol,
+		StartTime:      ohlcs[0].Time,
+		InitialBalance: b.options.InitialBalance,
+	}
+
+	peak := b.options.InitialBalance
+	for idx := range ohlcs {
+		latest := ohlcs[idx]
+		b.executor.SetLatestOhlc(latest)
+		report.EndTime = latest.Time
+		report.Candles = idx + 1
+
+		// 执行策略
+		window := ohlcs[max(0, idx+1-b.options.Window) : idx+1]
+		if err := b.strategy.OnTick(ctx, window); err != nil {
+			logger.Debugf("[Backtest] 策略执行失败, time: %s, %v", latest.Time, err)
+		}
+
+		// 确认订单
+		b.keeper.Poll()
+
+		// 计算回撤
+		equity := b.executor.Balance(solanautil.USDC).Add(b.executor.Balance(b.options.Token).Mul(latest.Close))
+		if equity.GreaterThan(peak) {
+			peak = equity
+		}
+		drawdown := peak.Sub(equity)
+		if drawdown.GreaterThan(report.MaxDrawdown) {
+			report.MaxDrawdown = drawdown
+			report.MaxDrawdownRatio = drawdown.Div(peak)
+		}
+
+		// 策略是否退出
+		record, err := b.svcCtx.StrategyModel.FindByGUID(ctx, b.record.GUID)
+		if err != nil {
+			return nil, err
+		}
+		if record.Status != entstrategy.StatusActive {
+			exitTime := latest.Time
+			report.ExitTime = &exitTime
+			break
+		}
+	}
+
+	if err := b.summarize(ctx, report, ohlcs[report.Candles-1].Close); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (b *Backtest) summarize(ctx context.Context, report *Report, latestPrice decimal.Decimal) error {
+	// 成交记录
+	orders, _, err := b.svcCtx.OrderModel.FindOrdersByStrategyId(ctx, b.record.GUID, 0, math.MaxInt32)
+	if err != nil {
+		return err
+	}
+
+	for idx := len(orders) - 1; idx >= 0; idx-- {
+		ord := orders[idx]
+		if ord.Status != order.StatusClosed {
+			continue
+		}
+
+		fillTime, _ := b.executor.FillTime(ord.TxHash)
+		report.Fills = append(report.Fills, Fill{
+			Time:       fillTime,
+			Type:       ord.Type,
+			GridNumber: ord.GridNumber,
+			Price:      ord.FinalPrice,
+			InAmount:   ord.InAmount,
+			OutAmount:  ord.OutAmount,
+			Profit:     ord.Profit,
+			Reason:     ord.Reason,
+		})
+
+		if ord.Profit != nil {
+			report.RealizedProfit = report.RealizedProfit.Add(*ord.Profit)
+		}
+		if report.ExitTime != nil && ord.Type == order.TypeSell && ord.GridId == nil {
+			report.ExitReason = ord.Reason
+		}
+	}
+	if report.ExitTime != nil && report.ExitReason == "" {
+		report.ExitReason = "策略已停止(无持仓)"
+	}
+
+	// 未实现盈亏
+	gridRecords, err := b.svcCtx.GridModel.FindByStrategyId(ctx, b.record.GUID)
+	if err != nil {
+		return err
+	}
+	for _, item := range gridRecords {
+		if item.Status != grid.StatusBought {
+			continue
+		}
+		report.UnrealizedProfit = report.UnrealizedProfit.Add(item.Quantity.Mul(latestPrice).Sub(item.Amount))
+	}
+
+	report.FinalEquity = b.executor.Balance(solanautil.USDC).Add(b.executor.Balance(b.options.Token).Mul(latestPrice))
+	report.Notifications = b.recorder.notifications
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package backtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestBacktestRun(t *testing.T) {
+	ctx := context.Background()
+	options := &Options{
+		Token:          "token",
+		InitialBalance: decimal.NewFromInt(1000),
+		Grid: GridSettings{
+			OrderSize:       decimal.NewFromInt(100),
+			GridMode:        "arithmetic",
+			GridCount:       4,
+			LowerPriceBound: decimal.NewFromInt(1),
+			UpperPriceBound: decimal.NewFromInt(2),
+			StopLossExit:    decimal.NewFromInt(60),
+		},
+	}
+	if err := options.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewBacktest(ctx, &config.Config{}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	// 网格价格 1, 1.25, 1.5, 1.75, 2, 无滑点时以收盘价成交
+	prices := []string{"1.9", "1.7", "1.45", "1.8", "1.45", "1.2", "0.9", "0.8"}
+	ohlcs := make([]charts.Ohlc, 0, len(prices))
+	for idx, item := range prices {
+		price := decimal.RequireFromString(item)
+		ohlcs = append(ohlcs, charts.Ohlc{Open: price, High: price, Low: price, Close: price, Time: time.Unix(1700000000+int64(idx)*60, 0)})
+	}
+
+	report, err := b.Run(ctx, ohlcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 成交记录
+	expectedFills := []struct {
+		typ        order.Type
+		gridNumber int // -1 表示清仓订单
+		outAmount  string
+	}{
+		{typ: order.TypeBuy, gridNumber: 3, outAmount: "58.823529"},
+		{typ: order.TypeBuy, gridNumber: 2, outAmount: "68.965517"},
+		{typ: order.TypeSell, gridNumber: 2, outAmount: "124.13793"},
+		{typ: order.TypeBuy, gridNumber: 2, outAmount: "68.965517"},
+		{typ: order.TypeBuy, gridNumber: 1, outAmount: "83.333333"},
+		{typ: order.TypeSell, gridNumber: -1, outAmount: "190.010141"},
+	}
+	if len(report.Fills) != len(expectedFills) {
+		t.Fatalf("成交记录 %d 条, 期望 %d 条", len(report.Fills), len(expectedFills))
+	}
+	for idx, expected := range expectedFills {
+		fill := report.Fills[idx]
+		gridNumber := -1
+		if fill.GridNumber != nil {
+			gridNumber = *fill.GridNumber
+		}
+		if fill.Type != expected.typ || gridNumber != expected.gridNumber || !fill.OutAmount.Equal(decimal.RequireFromString(expected.outAmount)) {
+			t.Errorf("成交记录[%d] = %s #%d %s, 期望 %s #%d %s", idx, fill.Type, gridNumber, fill.OutAmount, expected.typ, expected.gridNumber, expected.outAmount)
+		}
+	}
+
+	// 止盈 24.13793U, 清仓 190.010141 - 300 = -109.989859U
+	if !report.RealizedProfit.Equal(decimal.RequireFromString("-85.851929")) {
+		t.Errorf("RealizedProfit = %s, 期望 -85.851929", report.RealizedProfit)
+	}
+	if !report.UnrealizedProfit.IsZero() {
+		t.Errorf("UnrealizedProfit = %s, 期望 0", report.UnrealizedProfit)
+	}
+	if !report.FinalEquity.Equal(decimal.RequireFromString("914.148071")) {
+		t.Errorf("FinalEquity = %s, 期望 914.148071", report.FinalEquity)
+	}
+
+	// 亏损达到预设金额后清仓退出, 不再处理后续K线
+	if report.ExitReason != "亏损达到预设金额" {
+		t.Errorf("ExitReason = %q, 期望 %q", report.ExitReason, "亏损达到预设金额")
+	}
+	if report.ExitTime == nil || !report.ExitTime.Equal(ohlcs[6].Time) || report.Candles != 7 {
+		t.Errorf("ExitTime = %v, Candles = %d, 期望 %s, 7", report.ExitTime, report.Candles, ohlcs[6].Time)
+	}
+
+	// 权益峰值 1030.0202822 出现在止盈后, 清仓后权益 914.148071
+	if !report.MaxDrawdown.Equal(decimal.RequireFromString("115.8722112")) {
+		t.Errorf("MaxDrawdown = %s, 期望 115.8722112", report.MaxDrawdown)
+	}
+	expectedRatio := decimal.RequireFromString("115.8722112").Div(decimal.RequireFromString("1030.0202822"))
+	if !report.MaxDrawdownRatio.Equal(expectedRatio) {
+		t.Errorf("MaxDrawdownRatio = %s, 期望 %s", report.MaxDrawdownRatio, expectedRatio)
+	}
+}
+
+func TestBacktestRunDcaStopLoss(t *testing.T) {
+	ctx := context.Background()
+	options := &Options{
+		Token:          "token",
+		InitialBalance: decimal.NewFromInt(1000),
+		Grid: GridSettings{
+			Type:         "dca",
+			OrderSize:    decimal.NewFromInt(100),
+			DcaInterval:  1,
+			StopLossExit: decimal.NewFromInt(30),
+		},
+	}
+	if err := options.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewBacktest(ctx, &config.Config{}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	prices := []string{"1", "0.9", "0.8", "0.7"}
+	ohlcs := make([]charts.Ohlc, 0, len(prices))
+	for idx, item := range prices {
+		price := decimal.RequireFromString(item)
+		ohlcs = append(ohlcs, charts.Ohlc{Open: price, High: price, Low: price, Close: price, Time: time.Unix(1700000000+int64(idx)*60, 0)})
+	}
+
+	report, err := b.Run(ctx, ohlcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 每分钟定投买入, 价格 0.8 时浮亏 211.111111 * 0.8 - 200 = -31.111111U, 达到止损金额后清仓
+	if len(report.Fills) != 3 || report.Fills[2].Type != order.TypeSell || report.Fills[2].GridNumber != nil {
+		t.Fatalf("成交记录 = %+v, 期望两笔买入和一笔清仓", report.Fills)
+	}
+	if !report.RealizedProfit.Equal(decimal.RequireFromString("-31.111112")) {
+		t.Errorf("RealizedProfit = %s, 期望 -31.111112", report.RealizedProfit)
+	}
+	if report.ExitReason != "亏损达到预设金额" {
+		t.Errorf("ExitReason = %q, 期望 %q", report.ExitReason, "亏损达到预设金额")
+	}
+	if report.ExitTime == nil || !report.ExitTime.Equal(ohlcs[2].Time) {
+		t.Errorf("ExitTime = %v, 期望 %s", report.ExitTime, ohlcs[2].Time)
+	}
+}
@@ -0,0 +1,154 @@
+package backtest
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+
+	"github.com/shopspring/decimal"
+)
+
+type CandleFetcher interface {
+	FetchTokenCandles(ctx context.Context, token string, to time.Time, period string, limit int) ([]charts.Ohlc, error)
+}
+
+// LoadOhlcsFromFile 从CSV或JSON文件读取K线数据
+// CSV格式: time,open,high,low,close,volume, 时间为Unix秒或RFC3339
+func LoadOhlcsFromFile(filename string) ([]charts.Ohlc, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ohlcs []charts.Ohlc
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		if err = json.NewDecoder(f).Decode(&ohlcs); err != nil {
+			return nil, fmt.Errorf("failed to parse ohlc data: %w", err)
+		}
+	case ".csv":
+		ohlcs, err = readOhlcsCSV(f)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported file format: %s", filename)
+	}
+
+	sortOhlcs(ohlcs)
+	return ohlcs, nil
+}
+
+// FetchOhlcs 分页拉取最近 limit 根K线
+func FetchOhlcs(ctx context.Context, fetcher CandleFetcher, token, period string, to time.Time, limit int) ([]charts.Ohlc, error) {
+	const pageSize = 300
+
+	seen := make(map[int64]struct{})
+	result := make([]charts.Ohlc, 0, limit)
+	for len(result) < limit {
+		ohlcs, err := fetcher.FetchTokenCandles(ctx, token, to, period, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		count := 0
+		for _, item := range ohlcs {
+			if _, ok := seen[item.Time.Unix()]; ok {
+				continue
+			}
+			seen[item.Time.Unix()] = struct{}{}
+			result = append(result, item)
+			count++
+		}
+		if count == 0 {
+			break
+		}
+
+		sortOhlcs(result)
+		to = result[0].Time
+	}
+
+	if len(result) > limit {
+		result = result[len(result)-limit:]
+	}
+	return result, nil
+}
+
+func sortOhlcs(ohlcs []charts.Ohlc) {
+	sort.Slice(ohlcs, func(i, j int) bool {
+		return ohlcs[i].Time.Before(ohlcs[j].Time)
+	})
+}
+
+func readOhlcsCSV(r io.Reader) ([]charts.Ohlc, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	ohlcs := make([]charts.Ohlc, 0, len(records))
+	for idx, record := range records {
+		if len(record) < 6 {
+			return nil, fmt.Errorf("line %d: expected 6 columns, got %d", idx+1, len(record))
+		}
+
+		// 跳过表头
+		if idx == 0 && strings.EqualFold(record[0], "time") {
+			continue
+		}
+
+		t, err := parseTime(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", idx+1, err)
+		}
+
+		values := make([]decimal.Decimal, 5)
+		for i := range values {
+			values[i], err = decimal.NewFromString(record[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", idx+1, err)
+			}
+		}
+
+		ohlcs = append(ohlcs, charts.Ohlc{
+			Time:   t,
+			Open:   values[0],
+			High:   values[1],
+			Low:    values[2],
+			Close:  values[3],
+			Volume: values[4],
+		})
+	}
+
+	return ohlcs, nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n > 1e12 {
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.New("invalid time: " + s)
+	}
+	return t, nil
+}
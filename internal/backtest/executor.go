@@ -0,0 +1,180 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+// Executor 模拟链上交互, 以当前K线收盘价加滑点成交
+type Executor struct {
+	token       string
+	decimals    uint8
+	account     string
+	slippageBps int
+	latest      charts.Ohlc
+	sequence    int
+	balances    map[string]decimal.Decimal
+	changes     map[string]map[string]solanautil.TokenBalanceChange
+	fillTimes   map[string]time.Time
+}
+
+func NewExecutor(token string, decimals uint8, account string, slippageBps int, initialBalance decimal.Decimal) *Executor {
+	return &Executor{
+		token:       token,
+		decimals:    decimals,
+		account:     account,
+		slippageBps: slippageBps,
+		balances:    map[string]decimal.Decimal{solanautil.USDC: initialBalance},
+		changes:     make(map[string]map[string]solanautil.TokenBalanceChange),
+		fillTimes:   make(map[string]time.Time),
+	}
+}
+
+func (e *Executor) SetLatestOhlc(ohlc charts.Ohlc) {
+	e.latest = ohlc
+}
+
+func (e *Executor) Balance(token string) decimal.Decimal {
+	return e.balances[token]
+}
+
+func (e *Executor) FillTime(hash string) (time.Time, bool) {
+	t, ok := e.fillTimes[hash]
+	return t, ok
+}
+
+func (e *Executor) Quote(ctx context.Context, userId int64, account string, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	price := e.latest.Close
+	if price.LessThanOrEqual(decimal.Zero) {
+		return nil, errors.New("invalid price")
+	}
+
+	slippage := decimal.NewFromInt(int64(e.slippageBps)).Div(decimal.NewFromInt(10000))
+	switch {
+	case inputToken == solanautil.USDC && outputToken == e.token:
+		uiInAmount := solanautil.ParseUnits(amount, solanautil.USDCDecimals)
+		uiOutAmount := uiInAmount.Div(price.Mul(decimal.NewFromInt(1).Add(slippage))).Truncate(int32(e.decimals))
+		return e.newSwapTransaction(inputToken, outputToken, uiInAmount, uiOutAmount, e.decimals), nil
+	case inputToken == e.token && outputToken == solanautil.USDC:
+		uiInAmount := solanautil.ParseUnits(amount, e.decimals)
+		uiOutAmount := uiInAmount.Mul(price.Mul(decimal.NewFromInt(1).Sub(slippage))).Truncate(solanautil.USDCDecimals)
+		return e.newSwapTransaction(inputToken, outputToken, uiInAmount, uiOutAmount, solanautil.USDCDecimals), nil
+	default:
+		return nil, fmt.Errorf("unsupported pair: %s -> %s", inputToken, outputToken)
+	}
+}
+
+func (e *Executor) GetTokenBalance(ctx context.Context, tokenAddress, ownerAddress string) (*big.Int, uint8, error) {
+	decimals := e.decimals
+	if tokenAddress == solanautil.USDC {
+		decimals = solanautil.USDCDecimals
+	}
+	return solanautil.FormatUnits(e.balances[tokenAddress].Truncate(int32(decimals)), decimals), decimals, nil
+}
+
+func (e *Executor) GetTokenBalanceChanges(ctx context.Context, hash, ownerAddress string) (map[string]solanautil.TokenBalanceChange, error) {
+	changes, ok := e.changes[hash]
+	if !ok {
+		return nil, solanautil.ErrTxNotFound
+	}
+	return changes, nil
+}
+
+func (e *Executor) GetTransactionFee(ctx context.Context, hash, ownerAddress string) (solanautil.TransactionFee, error) {
+	return solanautil.TransactionFee{}, nil
+}
+
+func (e *Executor) GetMintRisk(ctx context.Context, tokenAddress string) (solanautil.MintRisk, error) {
+	// 回测不检查代币权限
+	return solanautil.MintRisk{}, nil
+}
+
+func (e *Executor) GetConfirmedSignatures(ctx context.Context, hashes []string) (map[string]bool, error) {
+	confirmed := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		if _, ok := e.changes[hash]; ok {
+			confirmed[hash] = true
+		}
+	}
+	return confirmed, nil
+}
+
+func (e *Executor) newSwapTransaction(inputToken, outputToken string, uiInAmount, uiOutAmount decimal.Decimal, outDecimals uint8) *SwapTransaction {
+	return &SwapTransaction{
+		executor:    e,
+		inputToken:  inputToken,
+		outputToken: outputToken,
+		uiInAmount:  uiInAmount,
+		uiOutAmount: uiOutAmount,
+		outAmount:   solanautil.FormatUnits(uiOutAmount, outDecimals),
+	}
+}
+
+func (e *Executor) execute(tx *SwapTransaction) (string, error) {
+	inBalance := e.balances[tx.inputToken]
+	if inBalance.LessThan(tx.uiInAmount) {
+		return "", ErrInsufficientBalance
+	}
+	outBalance := e.balances[tx.outputToken]
+
+	e.sequence++
+	hash := fmt.Sprintf("backtest-%d", e.sequence)
+
+	e.balances[tx.inputToken] = inBalance.Sub(tx.uiInAmount)
+	e.balances[tx.outputToken] = outBalance.Add(tx.uiOutAmount)
+	e.changes[hash] = map[string]solanautil.TokenBalanceChange{
+		tx.inputToken: {
+			Pre:    inBalance,
+			Post:   e.balances[tx.inputToken],
+			Change: tx.uiInAmount.Neg(),
+		},
+		tx.outputToken: {
+			Pre:    outBalance,
+			Post:   e.balances[tx.outputToken],
+			Change: tx.uiOutAmount,
+		},
+	}
+	e.fillTimes[hash] = e.latest.Time
+
+	return hash, nil
+}
+
+type SwapTransaction struct {
+	executor    *Executor
+	inputToken  string
+	outputToken string
+	uiInAmount  decimal.Decimal
+	uiOutAmount decimal.Decimal
+	outAmount   *big.Int
+}
+
+func (tx *SwapTransaction) Aggregator() string {
+	return "backtest"
+}
+
+func (tx *SwapTransaction) Signer() string {
+	return tx.executor.account
+}
+
+func (tx *SwapTransaction) OutAmount() *big.Int {
+	return tx.outAmount
+}
+
+func (tx *SwapTransaction) SlippageBps() int {
+	return tx.executor.slippageBps
+}
+
+func (tx *SwapTransaction) Swap(ctx context.Context) (string, error) {
+	return tx.executor.execute(tx)
+}
@@ -0,0 +1,77 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestExecutorSwap(t *testing.T) {
+	const token = "token"
+	ctx := context.Background()
+	e := NewExecutor(token, 6, "account", 100, decimal.NewFromInt(100))
+	e.SetLatestOhlc(charts.Ohlc{Close: decimal.NewFromInt(2), Time: time.Unix(1700000000, 0)})
+
+	// 以收盘价加 1% 滑点买入
+	tx, err := e.Quote(ctx, 0, "account", solanautil.USDC, token, big.NewInt(10_100_000), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.OutAmount().Cmp(big.NewInt(5_000_000)) != 0 {
+		t.Errorf("买入 OutAmount() = %s, 期望 5000000", tx.OutAmount())
+	}
+	hash, err := tx.Swap(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.Balance(solanautil.USDC).Equal(decimal.RequireFromString("89.9")) || !e.Balance(token).Equal(decimal.NewFromInt(5)) {
+		t.Errorf("买入后余额 USDC: %s, token: %s, 期望 89.9, 5", e.Balance(solanautil.USDC), e.Balance(token))
+	}
+
+	changes, err := e.GetTokenBalanceChanges(ctx, hash, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changes[token].Change.Equal(decimal.NewFromInt(5)) || !changes[solanautil.USDC].Change.Equal(decimal.RequireFromString("-10.1")) {
+		t.Errorf("买入余额变化 = %+v", changes)
+	}
+	if fillTime, ok := e.FillTime(hash); !ok || !fillTime.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("FillTime() = %s, %v", fillTime, ok)
+	}
+
+	// 以收盘价减 1% 滑点卖出
+	tx, err = e.Quote(ctx, 0, "account", token, solanautil.USDC, big.NewInt(5_000_000), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.OutAmount().Cmp(big.NewInt(9_900_000)) != 0 {
+		t.Errorf("卖出 OutAmount() = %s, 期望 9900000", tx.OutAmount())
+	}
+	if _, err = tx.Swap(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !e.Balance(solanautil.USDC).Equal(decimal.RequireFromString("99.8")) || !e.Balance(token).IsZero() {
+		t.Errorf("卖出后余额 USDC: %s, token: %s, 期望 99.8, 0", e.Balance(solanautil.USDC), e.Balance(token))
+	}
+
+	// 余额不足
+	tx, err = e.Quote(ctx, 0, "account", solanautil.USDC, token, big.NewInt(200_000_000), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tx.Swap(ctx); !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Swap() 返回 %v, 期望 %v", err, ErrInsufficientBalance)
+	}
+
+	// 不支持的交易对
+	if _, err = e.Quote(ctx, 0, "account", solanautil.WSOL, token, big.NewInt(1), false); err == nil {
+		t.Error("Quote() 不支持的交易对应该返回错误")
+	}
+}
@@ -0,0 +1,154 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/fachebot/sol-grid-bot/internal/strategy"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+type GridSettings struct {
+	Type                  string          `yaml:"Type"`
+	OrderSize             decimal.Decimal `yaml:"OrderSize"`
+	MartinFactor          float64         `yaml:"MartinFactor"`
+	MaxGridLimit          int             `yaml:"MaxGridLimit"`
+	GridMode              string          `yaml:"GridMode"`
+	GridCount             int             `yaml:"GridCount"`
+	GridStep              decimal.Decimal `yaml:"GridStep"`
+	UpperPriceBound       decimal.Decimal `yaml:"UpperPriceBound"`
+	LowerPriceBound       decimal.Decimal `yaml:"LowerPriceBound"`
+	TakeProfitRatio       decimal.Decimal `yaml:"TakeProfitRatio"`
+	UpperBoundExit        decimal.Decimal `yaml:"UpperBoundExit"`
+	StopLossExit          decimal.Decimal `yaml:"StopLossExit"`
+	TakeProfitExit        decimal.Decimal `yaml:"TakeProfitExit"`
+	GlobalTakeProfitRatio decimal.Decimal `yaml:"GlobalTakeProfitRatio"`
+	LastKlineVolume       decimal.Decimal `yaml:"LastKlineVolume"`
+	FiveKlineVolume       decimal.Decimal `yaml:"FiveKlineVolume"`
+	EnableAutoExit        bool            `yaml:"EnableAutoExit"`
+	DynamicStopLoss       bool            `yaml:"DynamicStopLoss"`
+	DropOn                bool            `yaml:"DropOn"`
+	CandlesToCheck        int             `yaml:"CandlesToCheck"`
+	DropThreshold         decimal.Decimal `yaml:"DropThreshold"`
+	TrailingOn            bool            `yaml:"TrailingOn"`
+	TrailingCandles       int             `yaml:"TrailingCandles"`
+	TrailingMaxShifts     int             `yaml:"TrailingMaxShifts"`
+	BuyConditions         string          `yaml:"BuyConditions"`
+	DcaInterval           int             `yaml:"DcaInterval"`
+	DcaDropRatio          decimal.Decimal `yaml:"DcaDropRatio"`
+	DcaMaxOrders          int             `yaml:"DcaMaxOrders"`
+	DcaSellInterval       int             `yaml:"DcaSellInterval"`
+	DcaSellProfitRatio    decimal.Decimal `yaml:"DcaSellProfitRatio"`
+}
+
+type Options struct {
+	Token          string          `yaml:"Token"`
+	Symbol         string          `yaml:"Symbol"`
+	Decimals       uint8           `yaml:"Decimals"`
+	CandlesFile    string          `yaml:"CandlesFile"`
+	Period         string          `yaml:"Period"`
+	Limit          int             `yaml:"Limit"`
+	Window         int             `yaml:"Window"`
+	InitialBalance decimal.Decimal `yaml:"InitialBalance"`
+	SlippageBps    int             `yaml:"SlippageBps"`
+	Grid           GridSettings    `yaml:"Grid"`
+}
+
+func (c *Options) Validate() error {
+	if c.Token == "" {
+		return errors.New("Token 不能为空")
+	}
+	if c.Symbol == "" {
+		c.Symbol = c.Token[:min(len(c.Token), 8)]
+	}
+	if c.Decimals == 0 {
+		c.Decimals = 6
+	}
+	if c.Period == "" {
+		c.Period = "1m"
+	}
+	if c.Limit <= 0 {
+		c.Limit = 1440
+	}
+	if c.Window <= 0 {
+		c.Window = 329
+	}
+	if c.InitialBalance.LessThanOrEqual(decimal.Zero) {
+		c.InitialBalance = decimal.NewFromInt(1000)
+	}
+	if c.SlippageBps < 0 {
+		c.SlippageBps = 0
+	}
+
+	if c.Grid.OrderSize.LessThanOrEqual(decimal.Zero) {
+		return errors.New("Grid.OrderSize 必须大于0")
+	}
+	if c.Grid.MartinFactor < 1 {
+		c.Grid.MartinFactor = 1
+	}
+	if c.Grid.BuyConditions != "" {
+		conditions, err := strategy.ParseBuyConditions(c.Grid.BuyConditions)
+		if err != nil {
+			return fmt.Errorf("Grid.BuyConditions 格式错误: %w", err)
+		}
+		c.Grid.BuyConditions = strategy.FormatBuyConditions(conditions)
+	}
+
+	if c.Grid.Type == "" {
+		c.Grid.Type = "grid"
+	}
+	switch c.Grid.Type {
+	case "grid":
+	case "dca":
+		if c.Grid.DcaInterval <= 0 && c.Grid.DcaDropRatio.LessThanOrEqual(decimal.Zero) {
+			return errors.New("Grid.DcaInterval 和 Grid.DcaDropRatio 至少设置一项")
+		}
+		return nil
+	default:
+		return errors.New("Grid.Type 枚举值范围: grid/dca")
+	}
+
+	if c.Grid.GridMode == "" {
+		c.Grid.GridMode = "geometric"
+	}
+	switch c.Grid.GridMode {
+	case "geometric":
+		if c.Grid.TakeProfitRatio.LessThanOrEqual(decimal.Zero) {
+			return errors.New("Grid.TakeProfitRatio 必须大于0")
+		}
+	case "arithmetic":
+		if c.Grid.GridCount <= 0 && c.Grid.GridStep.LessThanOrEqual(decimal.Zero) {
+			return errors.New("Grid.GridCount 和 Grid.GridStep 至少设置一项")
+		}
+	default:
+		return errors.New("Grid.GridMode 枚举值范围: geometric/arithmetic")
+	}
+	if c.Grid.LowerPriceBound.LessThanOrEqual(decimal.Zero) ||
+		c.Grid.UpperPriceBound.LessThanOrEqual(c.Grid.LowerPriceBound) {
+		return errors.New("Grid 价格区间设置错误")
+	}
+
+	return nil
+}
+
+func LoadOptionsFromFile(filename string) (*Options, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Options
+	err = yaml.Unmarshal(data, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
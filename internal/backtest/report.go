@@ -0,0 +1,74 @@
+package backtest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	"github.com/fachebot/sol-grid-bot/internal/utils/format"
+
+	"github.com/shopspring/decimal"
+)
+
+type Fill struct {
+	Time       time.Time
+	Type       order.Type
+	GridNumber *int
+	Price      decimal.Decimal
+	InAmount   decimal.Decimal
+	OutAmount  decimal.Decimal
+	Profit     *decimal.Decimal
+	Reason     string
+}
+
+type Report struct {
+	Token            string
+	Symbol           string
+	StartTime        time.Time
+	EndTime          time.Time
+	Candles          int
+	InitialBalance   decimal.Decimal
+	FinalEquity      decimal.Decimal
+	RealizedProfit   decimal.Decimal
+	UnrealizedProfit decimal.Decimal
+	MaxDrawdown      decimal.Decimal
+	MaxDrawdownRatio decimal.Decimal
+	ExitReason       string
+	ExitTime         *time.Time
+	Fills            []Fill
+	Notifications    []Notification
+}
+
+func (r *Report) String() string {
+	var sb strings.Builder
+
+	const layout = "2006-01-02 15:04"
+	fmt.Fprintf(&sb, "回测结果 %s (%s)\n", r.Symbol, r.Token)
+	fmt.Fprintf(&sb, "时间范围: %s ~ %s, K线数量: %d\n", r.StartTime.Format(layout), r.EndTime.Format(layout), r.Candles)
+	fmt.Fprintf(&sb, "初始资金: %sU, 最终权益: %sU\n", r.InitialBalance, r.FinalEquity.Truncate(4))
+	fmt.Fprintf(&sb, "已实现盈亏: %sU, 未实现盈亏: %sU\n", r.RealizedProfit.Truncate(4), r.UnrealizedProfit.Truncate(4))
+	fmt.Fprintf(&sb, "最大回撤: %sU (%s%%)\n", r.MaxDrawdown.Truncate(4), r.MaxDrawdownRatio.Mul(decimal.NewFromInt(100)).Truncate(2))
+	if r.ExitTime != nil {
+		fmt.Fprintf(&sb, "退出原因: %s (%s)\n", r.ExitReason, r.ExitTime.Format(layout))
+	} else {
+		sb.WriteString("退出原因: 未触发\n")
+	}
+
+	fmt.Fprintf(&sb, "\n成交记录 (%d):\n", len(r.Fills))
+	for _, item := range r.Fills {
+		grid := "-"
+		if item.GridNumber != nil {
+			grid = fmt.Sprintf("#%d", *item.GridNumber)
+		}
+		profit := "-"
+		if item.Profit != nil {
+			profit = item.Profit.Truncate(4).String() + "U"
+		}
+		fmt.Fprintf(&sb, "%s %-4s %-4s 价格: %s, 投入: %s, 获得: %s, 盈亏: %s %s\n",
+			item.Time.Format(layout), item.Type, grid, format.Price(item.Price, 5),
+			item.InAmount.Truncate(4), item.OutAmount.Truncate(4), profit, item.Reason)
+	}
+
+	return sb.String()
+}
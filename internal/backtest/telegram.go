@@ -0,0 +1,58 @@
+package backtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type Notification struct {
+	Time time.Time
+	Text string
+}
+
+// notificationRecorder 拦截电报请求并记录通知内容, 不访问网络
+type notificationRecorder struct {
+	executor      *Executor
+	messageId     int
+	notifications []Notification
+}
+
+func newBotApi(recorder *notificationRecorder) (*tgbotapi.BotAPI, error) {
+	return tgbotapi.NewBotAPIWithClient("backtest", tgbotapi.APIEndpoint, recorder)
+}
+
+func (r *notificationRecorder) Do(req *http.Request) (*http.Response, error) {
+	var result string
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/getMe"):
+		result = `{"id":1,"is_bot":true,"first_name":"backtest","username":"backtest"}`
+	case strings.HasSuffix(req.URL.Path, "/sendMessage"):
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+
+		r.messageId++
+		r.notifications = append(r.notifications, Notification{
+			Time: r.executor.latest.Time,
+			Text: req.PostForm.Get("text"),
+		})
+		result = fmt.Sprintf(`{"message_id":%d,"date":%d,"chat":{"id":%s}}`,
+			r.messageId, time.Now().Unix(), req.PostForm.Get("chat_id"))
+	default:
+		result = "true"
+	}
+
+	body := fmt.Sprintf(`{"ok":true,"result":%s}`, result)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+		Header:     make(http.Header),
+		Request:    req,
+	}, nil
+}
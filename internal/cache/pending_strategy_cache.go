@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/shopspring/decimal"
+)
+
+// PendingStrategy 等待用户确认的策略
+type PendingStrategy struct {
+	Strategy ent.Strategy
+	Method   string
+	Price    decimal.Decimal
+	Atr      decimal.Decimal
+}
+
+type PendingStrategyCache struct {
+	cache *gocache.Cache
+}
+
+func NewPendingStrategyCache() *PendingStrategyCache {
+	return &PendingStrategyCache{cache: gocache.New(30*time.Minute, 10*time.Minute)}
+}
+
+func (c *PendingStrategyCache) Get(guid string) (PendingStrategy, bool) {
+	value, ok := c.cache.Get(guid)
+	if !ok {
+		return PendingStrategy{}, false
+	}
+	return value.(PendingStrategy), true
+}
+
+func (c *PendingStrategyCache) Set(guid string, pending PendingStrategy) {
+	c.cache.Set(guid, pending, gocache.DefaultExpiration)
+}
+
+func (c *PendingStrategyCache) Delete(guid string) {
+	c.cache.Delete(guid)
+}
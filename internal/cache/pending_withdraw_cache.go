@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"math/big"
+	"strconv"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// PendingWithdraw 正在填写的提现请求
+type PendingWithdraw struct {
+	Account     string
+	AddressId   int
+	AddressName string
+	Destination string
+	Token       string
+	Symbol      string
+	Decimals    uint8
+	Amount      *big.Int
+}
+
+type PendingWithdrawCache struct {
+	cache *gocache.Cache
+}
+
+func NewPendingWithdrawCache() *PendingWithdrawCache {
+	return &PendingWithdrawCache{cache: gocache.New(10*time.Minute, 5*time.Minute)}
+}
+
+func (c *PendingWithdrawCache) Get(userId int64) (PendingWithdraw, bool) {
+	value, ok := c.cache.Get(strconv.FormatInt(userId, 10))
+	if !ok {
+		return PendingWithdraw{}, false
+	}
+	return value.(PendingWithdraw), true
+}
+
+func (c *PendingWithdrawCache) Set(userId int64, pending PendingWithdraw) {
+	c.cache.Set(strconv.FormatInt(userId, 10), pending, gocache.DefaultExpiration)
+}
+
+func (c *PendingWithdrawCache) Delete(userId int64) {
+	c.cache.Delete(strconv.FormatInt(userId, 10))
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const solPriceTTL = time.Minute
+
+// PriceFetcher 获取最新价格(USD)
+type PriceFetcher func(ctx context.Context) (decimal.Decimal, error)
+
+type SolPriceCache struct {
+	fetcher   PriceFetcher
+	mutex     sync.Mutex
+	price     decimal.Decimal
+	updatedAt time.Time
+}
+
+func NewSolPriceCache(fetcher PriceFetcher) *SolPriceCache {
+	return &SolPriceCache{fetcher: fetcher}
+}
+
+// GetPrice 获取 SOL 价格, 刷新失败时返回上次的价格
+func (c *SolPriceCache) GetPrice(ctx context.Context) (decimal.Decimal, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.price.IsZero() && time.Since(c.updatedAt) < solPriceTTL {
+		return c.price, nil
+	}
+
+	price, err := c.fetcher(ctx)
+	if err != nil {
+		if !c.price.IsZero() {
+			return c.price, nil
+		}
+		return decimal.Zero, err
+	}
+
+	c.price = price
+	c.updatedAt = time.Now()
+	return price, nil
+}
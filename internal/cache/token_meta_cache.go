@@ -33,6 +33,10 @@ func (c *TokenMetaCache) GetTokenMeta(ctx context.Context, tokenAddress string)
 	return meta, nil
 }
 
+func (c *TokenMetaCache) SetTokenMeta(tokenAddress string, meta TokenMeta) {
+	c.tokenMetaMap.Store(tokenAddress, meta)
+}
+
 func (c *TokenMetaCache) loadTokenMeta(ctx context.Context, tokenAddress string) (TokenMeta, error) {
 	val, ok := c.tokenMetaMap.Load(tokenAddress)
 	if ok {
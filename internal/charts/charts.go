@@ -24,11 +24,62 @@ type TokenOhlcs struct {
 	Ohlcs []Ohlc
 }
 
-func CalculateRSI(ohlcs []Ohlc) []float64 {
-	prices := lo.Map(ohlcs, func(ohlc Ohlc, idx int) float64 {
+func closePrices(ohlcs []Ohlc) []float64 {
+	return lo.Map(ohlcs, func(ohlc Ohlc, idx int) float64 {
 		return ohlc.Close.InexactFloat64()
 	})
-	return talib.Rsi(prices, 14)
+}
+
+func CalculateRSI(ohlcs []Ohlc, period int) []float64 {
+	return talib.Rsi(closePrices(ohlcs), period)
+}
+
+func CalculateSMA(ohlcs []Ohlc, period int) []float64 {
+	return talib.Sma(closePrices(ohlcs), period)
+}
+
+func CalculateEMA(ohlcs []Ohlc, period int) []float64 {
+	return talib.Ema(closePrices(ohlcs), period)
+}
+
+// CalculateBollingerBands 返回布林带的上轨、中轨、下轨
+func CalculateBollingerBands(ohlcs []Ohlc, period int, nbDev float64) ([]float64, []float64, []float64) {
+	return talib.BBands(closePrices(ohlcs), period, nbDev, nbDev, talib.SMA)
+}
+
+// CalculateMACD 返回 MACD 线、信号线和柱状图
+func CalculateMACD(ohlcs []Ohlc, fastPeriod, slowPeriod, signalPeriod int) ([]float64, []float64, []float64) {
+	return talib.Macd(closePrices(ohlcs), fastPeriod, slowPeriod, signalPeriod)
+}
+
+// CalculateVWAP 计算从第一根K线开始累计的成交量加权平均价
+func CalculateVWAP(ohlcs []Ohlc) []float64 {
+	vwap := make([]float64, len(ohlcs))
+	var totalAmount, totalVolume float64
+	for idx, ohlc := range ohlcs {
+		typicalPrice := ohlc.High.Add(ohlc.Low).Add(ohlc.Close).Div(decimal.NewFromInt(3)).InexactFloat64()
+		volume := ohlc.Volume.InexactFloat64()
+		totalAmount += typicalPrice * volume
+		totalVolume += volume
+		if totalVolume > 0 {
+			vwap[idx] = totalAmount / totalVolume
+		} else {
+			vwap[idx] = typicalPrice
+		}
+	}
+	return vwap
+}
+
+func CalculateATR(ohlcs []Ohlc, period int) []float64 {
+	highs := make([]float64, 0, len(ohlcs))
+	lows := make([]float64, 0, len(ohlcs))
+	closes := make([]float64, 0, len(ohlcs))
+	for _, ohlc := range ohlcs {
+		highs = append(highs, ohlc.High.InexactFloat64())
+		lows = append(lows, ohlc.Low.InexactFloat64())
+		closes = append(closes, ohlc.Close.InexactFloat64())
+	}
+	return talib.Atr(highs, lows, closes, period)
 }
 
 func FillMissingOhlc(tokenOhlcs []Ohlc, to time.Time, interval time.Duration) []Ohlc {
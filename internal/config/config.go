@@ -12,11 +12,50 @@ import (
 
 type Solana struct {
 	RpcUrl        string `yaml:"RpcUrl"`
+	WsUrl         string `yaml:"WsUrl"` // RPC WebSocket地址, 为空时只使用轮询确认订单
 	MaxRetries    uint   `yaml:"MaxRetries"`
 	SlippageBps   int    `yaml:"SlippageBps"`
 	MaxLamports   int64  `yaml:"MaxLamports"`
 	PriorityLevel string `yaml:"PriorityLevel"`
 	DexAggregator string `yaml:"DexAggregator"`
+	QuoteTimeout  int    `yaml:"QuoteTimeout"` // 聚合器并行报价超时(毫秒)
+
+	AggregatorPriority []string `yaml:"AggregatorPriority"` // 聚合器故障切换优先级
+	FailoverThreshold  int      `yaml:"FailoverThreshold"`  // 聚合器连续失败次数达到阈值后进入冷却
+	FailoverCooldown   int      `yaml:"FailoverCooldown"`   // 聚合器冷却时间(秒)
+}
+
+type Jito struct {
+	Url             string `yaml:"Url"`             // Jito Block Engine地址
+	TipLamports     int64  `yaml:"TipLamports"`     // 默认小费(lamports)
+	ExitTipLamports int64  `yaml:"ExitTipLamports"` // 默认清仓交易小费(lamports)
+}
+
+type Sellability struct {
+	Enable            bool            `yaml:"Enable"`            // 首次买入前检查代币是否可以卖出
+	MaxRoundTripLoss  decimal.Decimal `yaml:"MaxRoundTripLoss"`  // 往返报价最大损耗(%), 超过时拒绝买入
+	WarnRoundTripLoss decimal.Decimal `yaml:"WarnRoundTripLoss"` // 往返报价损耗(%)超过时发出警告
+}
+
+type GasMonitor struct {
+	Enable        bool            `yaml:"Enable"`        // 监控有运行策略的钱包 SOL 余额
+	MinBalance    decimal.Decimal `yaml:"MinBalance"`    // SOL 余额低于此值时提醒
+	AutoTopUp     bool            `yaml:"AutoTopUp"`     // 余额不足时自动使用 USDC 兑换 SOL
+	TopUpAmount   decimal.Decimal `yaml:"TopUpAmount"`   // 每次兑换的 USDC 数量
+	CheckInterval int             `yaml:"CheckInterval"` // 检查间隔(秒)
+}
+
+type Withdraw struct {
+	AddressCooldown int `yaml:"AddressCooldown"` // 新添加的提现地址冷却时间(分钟), 0 表示不限制
+}
+
+type TokenAccount struct {
+	AutoClose bool `yaml:"AutoClose"` // 策略清仓后自动关闭空代币账户回收租金
+}
+
+type PaperTrading struct {
+	Enable      bool `yaml:"Enable"`
+	SlippageBps int  `yaml:"SlippageBps"`
 }
 
 type Jupiter struct {
@@ -51,6 +90,7 @@ func (c *TelegramBot) IsWhiteListUser(userId int64) bool {
 
 type DefaultGridSettings struct {
 	OrderSize             decimal.Decimal `yaml:"OrderSize"`
+	MartinFactor          float64         `yaml:"MartinFactor"`
 	MaxGridLimit          int             `yaml:"MaxGridLimit"`
 	StopLossExit          decimal.Decimal `yaml:"StopLossExit"`
 	TakeProfitExit        decimal.Decimal `yaml:"TakeProfitExit"`
@@ -62,6 +102,9 @@ type DefaultGridSettings struct {
 	DropOn                bool            `yaml:"DropOn"`
 	CandlesToCheck        int             `yaml:"CandlesToCheck"`
 	DropThreshold         decimal.Decimal `yaml:"DropThreshold"`
+	TrailingOn            bool            `yaml:"TrailingOn"`
+	TrailingCandles       int             `yaml:"TrailingCandles"`
+	TrailingMaxShifts     int             `yaml:"TrailingMaxShifts"`
 }
 
 func (c *DefaultGridSettings) Validate() error {
@@ -84,6 +127,9 @@ func (c *DefaultGridSettings) Validate() error {
 	if c.TakeProfitRatio.LessThanOrEqual(decimal.Zero) {
 		c.TakeProfitRatio = decimal.NewFromFloat(3.5)
 	}
+	if c.MartinFactor < 1 {
+		c.MartinFactor = 1
+	}
 
 	if c.GlobalTakeProfitRatio.LessThan(decimal.Zero) {
 		return errors.New("GlobalTakeProfitRatio 不能小于0")
@@ -96,11 +142,19 @@ func (c *DefaultGridSettings) Validate() error {
 		c.DropThreshold = decimal.Zero
 	}
 
+	if c.TrailingCandles < 0 {
+		c.TrailingCandles = 0
+	}
+	if c.TrailingMaxShifts < 0 {
+		c.TrailingMaxShifts = 0
+	}
+
 	return nil
 }
 
 type QuickStartSettings struct {
 	OrderSize             decimal.Decimal `yaml:"OrderSize"`
+	MartinFactor          float64         `yaml:"MartinFactor"`
 	MaxGridLimit          int             `yaml:"MaxGridLimit"`
 	StopLossExit          decimal.Decimal `yaml:"StopLossExit"`
 	TakeProfitExit        decimal.Decimal `yaml:"TakeProfitExit"`
@@ -114,6 +168,119 @@ type QuickStartSettings struct {
 	DropOn                bool            `yaml:"DropOn"`
 	CandlesToCheck        int             `yaml:"CandlesToCheck"`
 	DropThreshold         decimal.Decimal `yaml:"DropThreshold"`
+	TrailingOn            bool            `yaml:"TrailingOn"`
+	TrailingCandles       int             `yaml:"TrailingCandles"`
+	TrailingMaxShifts     int             `yaml:"TrailingMaxShifts"`
+}
+
+type DefaultDcaSettings struct {
+	OrderSize       decimal.Decimal `yaml:"OrderSize"`
+	Interval        int             `yaml:"Interval"`
+	DropRatio       decimal.Decimal `yaml:"DropRatio"`
+	MaxOrders       int             `yaml:"MaxOrders"`
+	SellInterval    int             `yaml:"SellInterval"`
+	SellProfitRatio decimal.Decimal `yaml:"SellProfitRatio"`
+}
+
+func (c *DefaultDcaSettings) Validate() error {
+	if c.OrderSize.LessThanOrEqual(decimal.Zero) {
+		c.OrderSize = decimal.NewFromInt(20)
+	}
+	if c.Interval < 0 {
+		c.Interval = 0
+	}
+	if c.DropRatio.LessThan(decimal.Zero) {
+		c.DropRatio = decimal.Zero
+	}
+	if c.Interval == 0 && c.DropRatio.IsZero() {
+		c.Interval = 1440
+	}
+	if c.MaxOrders < 0 {
+		c.MaxOrders = 0
+	}
+	if c.SellInterval < 0 {
+		c.SellInterval = 0
+	}
+	if c.SellProfitRatio.LessThan(decimal.Zero) {
+		return errors.New("SellProfitRatio 不能小于0")
+	}
+
+	return nil
+}
+
+type AutoRange struct {
+	Enable                  bool            `yaml:"Enable"`
+	Method                  string          `yaml:"Method"`
+	Period                  string          `yaml:"Period"`
+	Candles                 int             `yaml:"Candles"`
+	AtrPeriod               int             `yaml:"AtrPeriod"`
+	AtrMultiplier           decimal.Decimal `yaml:"AtrMultiplier"`
+	LowerPercentile         decimal.Decimal `yaml:"LowerPercentile"`
+	UpperPercentile         decimal.Decimal `yaml:"UpperPercentile"`
+	LowerPercent            decimal.Decimal `yaml:"LowerPercent"`
+	UpperPercent            decimal.Decimal `yaml:"UpperPercent"`
+	TakeProfitAtrMultiplier decimal.Decimal `yaml:"TakeProfitAtrMultiplier"`
+	MinTakeProfitRatio      decimal.Decimal `yaml:"MinTakeProfitRatio"`
+	MaxTakeProfitRatio      decimal.Decimal `yaml:"MaxTakeProfitRatio"`
+}
+
+func (c *AutoRange) Validate() error {
+	if c.Method == "" {
+		c.Method = "atr"
+	}
+	if c.Method != "atr" && c.Method != "percentile" && c.Method != "percent" {
+		return errors.New("Method配置枚举值范围: atr/percentile/percent")
+	}
+	if c.Period == "" {
+		c.Period = "5m"
+	}
+	if c.Candles <= 0 {
+		c.Candles = 288
+	}
+	if c.AtrPeriod <= 0 {
+		c.AtrPeriod = 14
+	}
+	if c.Candles <= c.AtrPeriod {
+		return errors.New("Candles 必须大于 AtrPeriod")
+	}
+	if c.AtrMultiplier.LessThanOrEqual(decimal.Zero) {
+		c.AtrMultiplier = decimal.NewFromInt(6)
+	}
+
+	if c.LowerPercentile.IsZero() && c.UpperPercentile.IsZero() {
+		c.LowerPercentile = decimal.NewFromInt(5)
+		c.UpperPercentile = decimal.NewFromInt(95)
+	}
+	if c.LowerPercentile.LessThan(decimal.Zero) ||
+		c.UpperPercentile.GreaterThan(decimal.NewFromInt(100)) ||
+		c.LowerPercentile.GreaterThanOrEqual(c.UpperPercentile) {
+		return errors.New("LowerPercentile/UpperPercentile 配置范围: 0 <= LowerPercentile < UpperPercentile <= 100")
+	}
+
+	if c.LowerPercent.LessThanOrEqual(decimal.Zero) {
+		c.LowerPercent = decimal.NewFromInt(30)
+	}
+	if c.UpperPercent.LessThanOrEqual(decimal.Zero) {
+		c.UpperPercent = decimal.NewFromInt(30)
+	}
+	if c.LowerPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return errors.New("LowerPercent 必须小于100")
+	}
+
+	if c.TakeProfitAtrMultiplier.LessThan(decimal.Zero) {
+		c.TakeProfitAtrMultiplier = decimal.Zero
+	}
+	if c.MinTakeProfitRatio.LessThanOrEqual(decimal.Zero) {
+		c.MinTakeProfitRatio = decimal.NewFromInt(1)
+	}
+	if c.MaxTakeProfitRatio.LessThanOrEqual(decimal.Zero) {
+		c.MaxTakeProfitRatio = decimal.NewFromInt(10)
+	}
+	if c.MinTakeProfitRatio.GreaterThan(c.MaxTakeProfitRatio) {
+		return errors.New("MinTakeProfitRatio 不能大于 MaxTakeProfitRatio")
+	}
+
+	return nil
 }
 
 type TokenRequirements struct {
@@ -131,12 +298,20 @@ type Top100AvgCostFilter struct {
 type Config struct {
 	Solana              Solana              `yaml:"Solana"`
 	Jupiter             Jupiter             `yaml:"Jupiter"`
+	Jito                Jito                `yaml:"Jito"`
+	PaperTrading        PaperTrading        `yaml:"PaperTrading"`
+	Sellability         Sellability         `yaml:"Sellability"`
+	GasMonitor          GasMonitor          `yaml:"GasMonitor"`
+	Withdraw            Withdraw            `yaml:"Withdraw"`
+	TokenAccount        TokenAccount        `yaml:"TokenAccount"`
 	Datapi              string              `yaml:"Datapi"`
 	OkxWeb3             OkxWeb3             `yaml:"OkxWeb3"`
 	Sock5Proxy          Sock5Proxy          `yaml:"Sock5Proxy"`
 	TelegramBot         TelegramBot         `yaml:"TelegramBot"`
 	DefaultGridSettings DefaultGridSettings `yaml:"DefaultGridSettings"`
 	QuickStartSettings  QuickStartSettings  `yaml:"QuickStartSettings"`
+	DefaultDcaSettings  DefaultDcaSettings  `yaml:"DefaultDcaSettings"`
+	AutoRange           AutoRange           `yaml:"AutoRange"`
 	TokenRequirements   TokenRequirements   `yaml:"TokenRequirements"`
 }
 
@@ -156,6 +331,79 @@ func LoadFromFile(filename string) (*Config, error) {
 		return nil, fmt.Errorf("DefaultGridSettings配置错误: %w", err)
 	}
 
+	if c.QuickStartSettings.MartinFactor < 1 {
+		c.QuickStartSettings.MartinFactor = 1
+	}
+
+	if err = c.DefaultDcaSettings.Validate(); err != nil {
+		return nil, fmt.Errorf("DefaultDcaSettings配置错误: %w", err)
+	}
+
+	if err = c.AutoRange.Validate(); err != nil {
+		return nil, fmt.Errorf("AutoRange配置错误: %w", err)
+	}
+
+	if c.Solana.QuoteTimeout <= 0 {
+		c.Solana.QuoteTimeout = 3000
+	}
+
+	if len(c.Solana.AggregatorPriority) == 0 {
+		c.Solana.AggregatorPriority = []string{"jup", "okx", "relay"}
+	}
+	for _, item := range c.Solana.AggregatorPriority {
+		if item != "jup" && item != "okx" && item != "relay" {
+			return nil, errors.New("Solana.AggregatorPriority配置枚举值范围: jup/okx/relay")
+		}
+	}
+
+	if c.Solana.FailoverThreshold <= 0 {
+		c.Solana.FailoverThreshold = 3
+	}
+
+	if c.Solana.FailoverCooldown <= 0 {
+		c.Solana.FailoverCooldown = 60
+	}
+
+	if c.Jito.Url == "" {
+		c.Jito.Url = "https://mainnet.block-engine.jito.wtf"
+	}
+
+	if c.Jito.TipLamports <= 0 {
+		c.Jito.TipLamports = 100000
+	}
+
+	if c.Jito.ExitTipLamports <= 0 {
+		c.Jito.ExitTipLamports = c.Jito.TipLamports
+	}
+
+	if c.PaperTrading.SlippageBps < 0 || c.PaperTrading.SlippageBps >= 10000 {
+		return nil, errors.New("PaperTrading.SlippageBps配置范围: 0-9999")
+	}
+
+	if c.Sellability.MaxRoundTripLoss.LessThanOrEqual(decimal.Zero) {
+		c.Sellability.MaxRoundTripLoss = decimal.NewFromInt(10)
+	}
+
+	if c.Sellability.WarnRoundTripLoss.LessThanOrEqual(decimal.Zero) || c.Sellability.WarnRoundTripLoss.GreaterThan(c.Sellability.MaxRoundTripLoss) {
+		c.Sellability.WarnRoundTripLoss = c.Sellability.MaxRoundTripLoss.Div(decimal.NewFromInt(2))
+	}
+
+	if c.GasMonitor.MinBalance.LessThanOrEqual(decimal.Zero) {
+		c.GasMonitor.MinBalance = decimal.NewFromFloat(0.02)
+	}
+
+	if c.GasMonitor.TopUpAmount.LessThanOrEqual(decimal.Zero) {
+		c.GasMonitor.TopUpAmount = decimal.NewFromInt(5)
+	}
+
+	if c.GasMonitor.CheckInterval <= 0 {
+		c.GasMonitor.CheckInterval = 60
+	}
+
+	if c.Withdraw.AddressCooldown < 0 {
+		c.Withdraw.AddressCooldown = 0
+	}
+
 	if c.Datapi != "gmgn" && c.Datapi != "jupag" && c.Datapi != "okx" {
 		return nil, errors.New("Datapi配置枚举值范围: gmgn/jupag/okx")
 	}
@@ -10,11 +10,11 @@ import (
 	"strconv"
 
 	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/carlmjohnson/requests"
 	"github.com/gagliardetto/solana-go"
-	"github.com/gagliardetto/solana-go/rpc"
 )
 
 const (
@@ -145,7 +145,7 @@ func (client *JupiterClient) Swap(ctx context.Context, userWalletAddress string,
 	return &response, nil
 }
 
-func (client *JupiterClient) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, wallet *solana.Wallet, swapResponse *SwapResponse, maxRetries uint) (string, error) {
+func (client *JupiterClient) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, wallet *solana.Wallet, swapResponse *SwapResponse, priorityLevel PriorityLevel, maxLamports int64, maxRetries uint, jitoTip uint64, simulation *txsender.Simulation) (string, error) {
 	latestBlockhash, err := svcCtx.SolanaRpc.GetLatestBlockhash(ctx, "")
 	if err != nil {
 		return "", fmt.Errorf("could not get latest blockhash: %w", err)
@@ -157,20 +157,36 @@ func (client *JupiterClient) SendSwapTransaction(ctx context.Context, svcCtx *sv
 	}
 	signedTx.Message.RecentBlockhash = latestBlockhash.Value.Blockhash
 
+	// 设置优先费
+	tableAddresses := make([]string, 0, len(signedTx.Message.AddressTableLookups))
+	for _, lookup := range signedTx.Message.AddressTableLookups {
+		tableAddresses = append(tableAddresses, lookup.AccountKey.String())
+	}
+	tables, err := svcCtx.LookuptableCache.GetAddressLookupTables(ctx, tableAddresses)
+	if err != nil {
+		return "", err
+	}
+	_, err = solanautil.SetPriorityFee(ctx, svcCtx.SolanaRpc, &signedTx, tables, string(priorityLevel), uint64(maxLamports))
+	if err != nil {
+		return "", fmt.Errorf("could not estimate priority fee: %w", err)
+	}
+
 	tx, hash, err := solanautil.SignTransaction(wallet, &signedTx)
 	if err != nil {
 		return "", fmt.Errorf("could not sign swap transaction: %w", err)
 	}
 
-	sig, err := svcCtx.SolanaRpc.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{
-		MaxRetries:          &maxRetries,
-		MinContextSlot:      &latestBlockhash.Context.Slot,
-		SkipPreflight:       true,
-		PreflightCommitment: rpc.CommitmentProcessed,
+	hash, err = svcCtx.TxSender.Send(ctx, tx, txsender.SendOptions{
+		MinContextSlot:       latestBlockhash.Context.Slot,
+		LastValidBlockHeight: latestBlockhash.Value.LastValidBlockHeight,
+		MaxRetries:           maxRetries,
+		Wallet:               wallet,
+		JitoTip:              jitoTip,
+		Simulation:           simulation,
 	})
 	if err != nil {
 		return hash, fmt.Errorf("could not send transaction: %w", err)
 	}
 
-	return sig.String(), nil
+	return hash, nil
 }
@@ -17,10 +17,11 @@ import (
 
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/gagliardetto/solana-go"
-	"github.com/gagliardetto/solana-go/rpc"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
@@ -140,15 +141,19 @@ func (client *Client) Quote(ctx context.Context, chainIndex string, userWalletAd
 	return &swapInstruction, nil
 }
 
-func (client *Client) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, wallet *solana.Wallet, swapInstruction *SwapInstruction, priorityLevel settings.PriorityLevel, maxRetries uint) (string, error) {
+func (client *Client) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, wallet *solana.Wallet, swapInstruction *SwapInstruction, priorityLevel settings.PriorityLevel, maxLamports int64, maxRetries uint, jitoTip uint64, simulation *txsender.Simulation) (string, error) {
 	latestBlockhash, err := svcCtx.SolanaRpc.GetLatestBlockhash(ctx, "")
 	if err != nil {
 		return "", fmt.Errorf("could not get latest blockhash: %w", err)
 	}
 
-	// 创建交易指令
-	instructions := []solana.Instruction{}
+	// 创建交易指令, 优先费在创建交易后根据可写账户估算
+	instructions := []solana.Instruction{computebudget.NewSetComputeUnitPriceInstruction(0).Build()}
 	for _, instrData := range swapInstruction.InstructionLists {
+		if isComputeUnitPriceInstruction(instrData) {
+			continue
+		}
+
 		var accounts []*solana.AccountMeta
 		for _, key := range instrData.Accounts {
 			accounts = append(accounts, &solana.AccountMeta{
@@ -188,6 +193,12 @@ func (client *Client) SendSwapTransaction(ctx context.Context, svcCtx *svc.Servi
 		return "", fmt.Errorf("could not deserialize swap transaction: %w", err)
 	}
 
+	// 设置优先费
+	_, err = solanautil.SetPriorityFee(ctx, svcCtx.SolanaRpc, tx, tables, string(priorityLevel), uint64(maxLamports))
+	if err != nil {
+		return "", fmt.Errorf("could not estimate priority fee: %w", err)
+	}
+
 	// 签名交易
 	signedTx, hash, err := solanautil.SignTransaction(wallet, tx)
 	if err != nil {
@@ -195,17 +206,32 @@ func (client *Client) SendSwapTransaction(ctx context.Context, svcCtx *svc.Servi
 	}
 
 	// 发送交易
-	sig, err := svcCtx.SolanaRpc.SendTransactionWithOpts(ctx, signedTx, rpc.TransactionOpts{
-		MaxRetries:          &maxRetries,
-		MinContextSlot:      &latestBlockhash.Context.Slot,
-		SkipPreflight:       true,
-		PreflightCommitment: rpc.CommitmentProcessed,
+	hash, err = svcCtx.TxSender.Send(ctx, signedTx, txsender.SendOptions{
+		MinContextSlot:       latestBlockhash.Context.Slot,
+		LastValidBlockHeight: latestBlockhash.Value.LastValidBlockHeight,
+		MaxRetries:           maxRetries,
+		Wallet:               wallet,
+		JitoTip:              jitoTip,
+		Simulation:           simulation,
 	})
 	if err != nil {
 		return hash, fmt.Errorf("could not send transaction: %w", err)
 	}
 
-	return sig.String(), nil
+	return hash, nil
+}
+
+// isComputeUnitPriceInstruction 是否为 SetComputeUnitPrice 指令
+func isComputeUnitPriceInstruction(instrData Instruction) bool {
+	if instrData.ProgramId != solana.ComputeBudget.String() {
+		return false
+	}
+
+	data, err := base64.StdEncoding.DecodeString(instrData.Data)
+	if err != nil || len(data) == 0 {
+		return false
+	}
+	return data[0] == byte(computebudget.Instruction_SetComputeUnitPrice)
 }
 
 func (client *Client) sign(method, path, body string) (string, string) {
@@ -11,13 +11,13 @@ import (
 
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/carlmjohnson/requests"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gagliardetto/solana-go"
 	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
-	"github.com/gagliardetto/solana-go/rpc"
 )
 
 const (
@@ -119,7 +119,7 @@ func (client *RelaylinkClient) Quote(ctx context.Context, chainId int64, user, i
 	return &response, nil
 }
 
-func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, wallet *solana.Wallet, swapResponse *QuoteResponse, priorityLevel settings.PriorityLevel, maxRetries uint) (string, error) {
+func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *svc.ServiceContext, wallet *solana.Wallet, swapResponse *QuoteResponse, priorityLevel settings.PriorityLevel, maxLamports int64, maxRetries uint, jitoTip uint64, simulation *txsender.Simulation) (string, error) {
 
 	latestBlockhash, err := svcCtx.SolanaRpc.GetLatestBlockhash(ctx, "")
 	if err != nil {
@@ -132,14 +132,8 @@ func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *
 		return "", errors.New("instructions not found")
 	}
 
-	// 获取优先费
-	priorityFee, err := getPriorityFeeByLevel(ctx, priorityLevel)
-	if err != nil {
-		return "", err
-	}
-	setComputeUnitPriceIx := computebudget.NewSetComputeUnitPriceInstruction(
-		priorityFee,
-	).Build()
+	// 优先费在创建交易后根据可写账户估算
+	setComputeUnitPriceIx := computebudget.NewSetComputeUnitPriceInstruction(0).Build()
 
 	// 创建交易指令
 	instructions := []solana.Instruction{setComputeUnitPriceIx}
@@ -185,6 +179,12 @@ func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *
 		return "", fmt.Errorf("could not deserialize swap transaction: %w", err)
 	}
 
+	// 设置优先费
+	_, err = solanautil.SetPriorityFee(ctx, svcCtx.SolanaRpc, tx, tables, string(priorityLevel), uint64(maxLamports))
+	if err != nil {
+		return "", fmt.Errorf("could not estimate priority fee: %w", err)
+	}
+
 	// 签名交易
 	signedTx, hash, err := solanautil.SignTransaction(wallet, tx)
 	if err != nil {
@@ -192,15 +192,17 @@ func (client *RelaylinkClient) SendSwapTransaction(ctx context.Context, svcCtx *
 	}
 
 	// 发送交易
-	sig, err := svcCtx.SolanaRpc.SendTransactionWithOpts(ctx, signedTx, rpc.TransactionOpts{
-		MaxRetries:          &maxRetries,
-		MinContextSlot:      &latestBlockhash.Context.Slot,
-		SkipPreflight:       true,
-		PreflightCommitment: rpc.CommitmentProcessed,
+	hash, err = svcCtx.TxSender.Send(ctx, signedTx, txsender.SendOptions{
+		MinContextSlot:       latestBlockhash.Context.Slot,
+		LastValidBlockHeight: latestBlockhash.Value.LastValidBlockHeight,
+		MaxRetries:           maxRetries,
+		Wallet:               wallet,
+		JitoTip:              jitoTip,
+		Simulation:           simulation,
 	})
 	if err != nil {
 		return hash, fmt.Errorf("could not send transaction: %w", err)
 	}
 
-	return sig.String(), nil
+	return hash, nil
 }
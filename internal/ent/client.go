@@ -19,6 +19,8 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/ent/wallet"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawaddress"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
 )
 
 // Client is the client that holds all ent builders.
@@ -36,6 +38,10 @@ type Client struct {
 	Strategy *StrategyClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// WithdrawAddress is the client for interacting with the WithdrawAddress builders.
+	WithdrawAddress *WithdrawAddressClient
+	// Withdrawal is the client for interacting with the Withdrawal builders.
+	Withdrawal *WithdrawalClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -52,6 +58,8 @@ func (c *Client) init() {
 	c.Settings = NewSettingsClient(c.config)
 	c.Strategy = NewStrategyClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
+	c.WithdrawAddress = NewWithdrawAddressClient(c.config)
+	c.Withdrawal = NewWithdrawalClient(c.config)
 }
 
 type (
@@ -142,13 +150,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Grid:     NewGridClient(cfg),
-		Order:    NewOrderClient(cfg),
-		Settings: NewSettingsClient(cfg),
-		Strategy: NewStrategyClient(cfg),
-		Wallet:   NewWalletClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Grid:            NewGridClient(cfg),
+		Order:           NewOrderClient(cfg),
+		Settings:        NewSettingsClient(cfg),
+		Strategy:        NewStrategyClient(cfg),
+		Wallet:          NewWalletClient(cfg),
+		WithdrawAddress: NewWithdrawAddressClient(cfg),
+		Withdrawal:      NewWithdrawalClient(cfg),
 	}, nil
 }
 
@@ -166,13 +176,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Grid:     NewGridClient(cfg),
-		Order:    NewOrderClient(cfg),
-		Settings: NewSettingsClient(cfg),
-		Strategy: NewStrategyClient(cfg),
-		Wallet:   NewWalletClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Grid:            NewGridClient(cfg),
+		Order:           NewOrderClient(cfg),
+		Settings:        NewSettingsClient(cfg),
+		Strategy:        NewStrategyClient(cfg),
+		Wallet:          NewWalletClient(cfg),
+		WithdrawAddress: NewWithdrawAddressClient(cfg),
+		Withdrawal:      NewWithdrawalClient(cfg),
 	}, nil
 }
 
@@ -201,21 +213,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Grid.Use(hooks...)
-	c.Order.Use(hooks...)
-	c.Settings.Use(hooks...)
-	c.Strategy.Use(hooks...)
-	c.Wallet.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Grid, c.Order, c.Settings, c.Strategy, c.Wallet, c.WithdrawAddress,
+		c.Withdrawal,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Grid.Intercept(interceptors...)
-	c.Order.Intercept(interceptors...)
-	c.Settings.Intercept(interceptors...)
-	c.Strategy.Intercept(interceptors...)
-	c.Wallet.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Grid, c.Order, c.Settings, c.Strategy, c.Wallet, c.WithdrawAddress,
+		c.Withdrawal,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -231,6 +245,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Strategy.mutate(ctx, m)
 	case *WalletMutation:
 		return c.Wallet.mutate(ctx, m)
+	case *WithdrawAddressMutation:
+		return c.WithdrawAddress.mutate(ctx, m)
+	case *WithdrawalMutation:
+		return c.Withdrawal.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -901,12 +919,279 @@ func (c *WalletClient) mutate(ctx context.Context, m *WalletMutation) (Value, er
 	}
 }
 
+// WithdrawAddressClient is a client for the WithdrawAddress schema.
+type WithdrawAddressClient struct {
+	config
+}
+
+// NewWithdrawAddressClient returns a client for the WithdrawAddress from the given config.
+func NewWithdrawAddressClient(c config) *WithdrawAddressClient {
+	return &WithdrawAddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withdrawaddress.Hooks(f(g(h())))`.
+func (c *WithdrawAddressClient) Use(hooks ...Hook) {
+	c.hooks.WithdrawAddress = append(c.hooks.WithdrawAddress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `withdrawaddress.Intercept(f(g(h())))`.
+func (c *WithdrawAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WithdrawAddress = append(c.inters.WithdrawAddress, interceptors...)
+}
+
+// Create returns a builder for creating a WithdrawAddress entity.
+func (c *WithdrawAddressClient) Create() *WithdrawAddressCreate {
+	mutation := newWithdrawAddressMutation(c.config, OpCreate)
+	return &WithdrawAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithdrawAddress entities.
+func (c *WithdrawAddressClient) CreateBulk(builders ...*WithdrawAddressCreate) *WithdrawAddressCreateBulk {
+	return &WithdrawAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WithdrawAddressClient) MapCreateBulk(slice any, setFunc func(*WithdrawAddressCreate, int)) *WithdrawAddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WithdrawAddressCreateBulk{err: fmt.Errorf("calling to WithdrawAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WithdrawAddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WithdrawAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithdrawAddress.
+func (c *WithdrawAddressClient) Update() *WithdrawAddressUpdate {
+	mutation := newWithdrawAddressMutation(c.config, OpUpdate)
+	return &WithdrawAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithdrawAddressClient) UpdateOne(wa *WithdrawAddress) *WithdrawAddressUpdateOne {
+	mutation := newWithdrawAddressMutation(c.config, OpUpdateOne, withWithdrawAddress(wa))
+	return &WithdrawAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithdrawAddressClient) UpdateOneID(id int) *WithdrawAddressUpdateOne {
+	mutation := newWithdrawAddressMutation(c.config, OpUpdateOne, withWithdrawAddressID(id))
+	return &WithdrawAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithdrawAddress.
+func (c *WithdrawAddressClient) Delete() *WithdrawAddressDelete {
+	mutation := newWithdrawAddressMutation(c.config, OpDelete)
+	return &WithdrawAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithdrawAddressClient) DeleteOne(wa *WithdrawAddress) *WithdrawAddressDeleteOne {
+	return c.DeleteOneID(wa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WithdrawAddressClient) DeleteOneID(id int) *WithdrawAddressDeleteOne {
+	builder := c.Delete().Where(withdrawaddress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithdrawAddressDeleteOne{builder}
+}
+
+// Query returns a query builder for WithdrawAddress.
+func (c *WithdrawAddressClient) Query() *WithdrawAddressQuery {
+	return &WithdrawAddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWithdrawAddress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WithdrawAddress entity by its id.
+func (c *WithdrawAddressClient) Get(ctx context.Context, id int) (*WithdrawAddress, error) {
+	return c.Query().Where(withdrawaddress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithdrawAddressClient) GetX(ctx context.Context, id int) *WithdrawAddress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithdrawAddressClient) Hooks() []Hook {
+	return c.hooks.WithdrawAddress
+}
+
+// Interceptors returns the client interceptors.
+func (c *WithdrawAddressClient) Interceptors() []Interceptor {
+	return c.inters.WithdrawAddress
+}
+
+func (c *WithdrawAddressClient) mutate(ctx context.Context, m *WithdrawAddressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WithdrawAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WithdrawAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WithdrawAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WithdrawAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WithdrawAddress mutation op: %q", m.Op())
+	}
+}
+
+// WithdrawalClient is a client for the Withdrawal schema.
+type WithdrawalClient struct {
+	config
+}
+
+// NewWithdrawalClient returns a client for the Withdrawal from the given config.
+func NewWithdrawalClient(c config) *WithdrawalClient {
+	return &WithdrawalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withdrawal.Hooks(f(g(h())))`.
+func (c *WithdrawalClient) Use(hooks ...Hook) {
+	c.hooks.Withdrawal = append(c.hooks.Withdrawal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `withdrawal.Intercept(f(g(h())))`.
+func (c *WithdrawalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Withdrawal = append(c.inters.Withdrawal, interceptors...)
+}
+
+// Create returns a builder for creating a Withdrawal entity.
+func (c *WithdrawalClient) Create() *WithdrawalCreate {
+	mutation := newWithdrawalMutation(c.config, OpCreate)
+	return &WithdrawalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Withdrawal entities.
+func (c *WithdrawalClient) CreateBulk(builders ...*WithdrawalCreate) *WithdrawalCreateBulk {
+	return &WithdrawalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WithdrawalClient) MapCreateBulk(slice any, setFunc func(*WithdrawalCreate, int)) *WithdrawalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WithdrawalCreateBulk{err: fmt.Errorf("calling to WithdrawalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WithdrawalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WithdrawalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Withdrawal.
+func (c *WithdrawalClient) Update() *WithdrawalUpdate {
+	mutation := newWithdrawalMutation(c.config, OpUpdate)
+	return &WithdrawalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithdrawalClient) UpdateOne(w *Withdrawal) *WithdrawalUpdateOne {
+	mutation := newWithdrawalMutation(c.config, OpUpdateOne, withWithdrawal(w))
+	return &WithdrawalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithdrawalClient) UpdateOneID(id int) *WithdrawalUpdateOne {
+	mutation := newWithdrawalMutation(c.config, OpUpdateOne, withWithdrawalID(id))
+	return &WithdrawalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Withdrawal.
+func (c *WithdrawalClient) Delete() *WithdrawalDelete {
+	mutation := newWithdrawalMutation(c.config, OpDelete)
+	return &WithdrawalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithdrawalClient) DeleteOne(w *Withdrawal) *WithdrawalDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WithdrawalClient) DeleteOneID(id int) *WithdrawalDeleteOne {
+	builder := c.Delete().Where(withdrawal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithdrawalDeleteOne{builder}
+}
+
+// Query returns a query builder for Withdrawal.
+func (c *WithdrawalClient) Query() *WithdrawalQuery {
+	return &WithdrawalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWithdrawal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Withdrawal entity by its id.
+func (c *WithdrawalClient) Get(ctx context.Context, id int) (*Withdrawal, error) {
+	return c.Query().Where(withdrawal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithdrawalClient) GetX(ctx context.Context, id int) *Withdrawal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithdrawalClient) Hooks() []Hook {
+	return c.hooks.Withdrawal
+}
+
+// Interceptors returns the client interceptors.
+func (c *WithdrawalClient) Interceptors() []Interceptor {
+	return c.inters.Withdrawal
+}
+
+func (c *WithdrawalClient) mutate(ctx context.Context, m *WithdrawalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WithdrawalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WithdrawalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WithdrawalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WithdrawalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Withdrawal mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Grid, Order, Settings, Strategy, Wallet []ent.Hook
+		Grid, Order, Settings, Strategy, Wallet, WithdrawAddress, Withdrawal []ent.Hook
 	}
 	inters struct {
-		Grid, Order, Settings, Strategy, Wallet []ent.Interceptor
+		Grid, Order, Settings, Strategy, Wallet, WithdrawAddress,
+		Withdrawal []ent.Interceptor
 	}
 )
@@ -17,6 +17,8 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/ent/wallet"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawaddress"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +79,13 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			grid.Table:     grid.ValidColumn,
-			order.Table:    order.ValidColumn,
-			settings.Table: settings.ValidColumn,
-			strategy.Table: strategy.ValidColumn,
-			wallet.Table:   wallet.ValidColumn,
+			grid.Table:            grid.ValidColumn,
+			order.Table:           order.ValidColumn,
+			settings.Table:        settings.ValidColumn,
+			strategy.Table:        strategy.ValidColumn,
+			wallet.Table:          wallet.ValidColumn,
+			withdrawaddress.Table: withdrawaddress.ValidColumn,
+			withdrawal.Table:      withdrawal.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -64,6 +64,14 @@ var (
 		{Name: "tx_hash", Type: field.TypeString, Size: 100},
 		{Name: "reason", Type: field.TypeString, Size: 500},
 		{Name: "profit", Type: field.TypeString, Nullable: true},
+		{Name: "paper", Type: field.TypeBool, Nullable: true},
+		{Name: "aggregator", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "base_fee", Type: field.TypeInt64, Nullable: true},
+		{Name: "priority_fee", Type: field.TypeInt64, Nullable: true},
+		{Name: "compute_units", Type: field.TypeInt64, Nullable: true},
+		{Name: "jito_tip", Type: field.TypeInt64, Nullable: true},
+		{Name: "rent_fee", Type: field.TypeInt64, Nullable: true},
+		{Name: "fee_usd", Type: field.TypeString, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
@@ -95,7 +103,13 @@ var (
 		{Name: "exit_slippage_bps", Type: field.TypeInt, Nullable: true},
 		{Name: "max_lamports", Type: field.TypeInt64},
 		{Name: "priority_level", Type: field.TypeEnum, Enums: []string{"medium", "high", "veryHigh"}},
-		{Name: "dex_aggregator", Type: field.TypeEnum, Enums: []string{"jup", "okx", "relay"}},
+		{Name: "dex_aggregator", Type: field.TypeEnum, Enums: []string{"jup", "okx", "relay", "auto"}},
+		{Name: "aggregator_priority", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "tx_sender", Type: field.TypeEnum, Enums: []string{"rpc", "jito"}, Default: "rpc"},
+		{Name: "jito_tip", Type: field.TypeInt64, Nullable: true},
+		{Name: "exit_jito_tip", Type: field.TypeInt64, Nullable: true},
+		{Name: "simulate_tx", Type: field.TypeBool, Default: false},
+		{Name: "skip_exit_simulation", Type: field.TypeBool, Default: true},
 	}
 	// SettingsTable holds the schema information for the "settings" table.
 	SettingsTable = &schema.Table{
@@ -117,13 +131,18 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "guid", Type: field.TypeString, Size: 50},
 		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "account", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "token", Type: field.TypeString, Size: 50},
 		{Name: "symbol", Type: field.TypeString, Size: 32},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"grid", "dca"}, Default: "grid"},
 		{Name: "martin_factor", Type: field.TypeFloat64},
 		{Name: "max_grid_limit", Type: field.TypeInt, Nullable: true},
 		{Name: "take_profit_ratio", Type: field.TypeString},
 		{Name: "upper_price_bound", Type: field.TypeString},
 		{Name: "lower_price_bound", Type: field.TypeString},
+		{Name: "grid_mode", Type: field.TypeEnum, Enums: []string{"geometric", "arithmetic"}, Default: "geometric"},
+		{Name: "grid_count", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "grid_step", Type: field.TypeString, Nullable: true},
 		{Name: "initial_order_size", Type: field.TypeString},
 		{Name: "last_kline_volume", Type: field.TypeString, Nullable: true},
 		{Name: "five_kline_volume", Type: field.TypeString, Nullable: true},
@@ -133,9 +152,23 @@ var (
 		{Name: "take_profit_exit", Type: field.TypeString, Nullable: true},
 		{Name: "global_take_profit_ratio", Type: field.TypeString, Nullable: true},
 		{Name: "dynamic_stop_loss", Type: field.TypeBool, Nullable: true},
+		{Name: "paper_trading", Type: field.TypeBool, Nullable: true},
 		{Name: "drop_on", Type: field.TypeBool, Nullable: true},
 		{Name: "candles_to_check", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "drop_threshold", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_on", Type: field.TypeBool, Nullable: true},
+		{Name: "trailing_candles", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "trailing_max_shifts", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "trailing_shifts", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "buy_conditions", Type: field.TypeString, Nullable: true},
+		{Name: "dca_interval", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "dca_drop_ratio", Type: field.TypeString, Nullable: true},
+		{Name: "dca_max_orders", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "dca_sell_interval", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "dca_sell_profit_ratio", Type: field.TypeString, Nullable: true},
+		{Name: "dca_last_buy_time", Type: field.TypeTime, Nullable: true},
+		{Name: "dca_last_buy_price", Type: field.TypeString, Nullable: true},
+		{Name: "dca_last_sell_time", Type: field.TypeTime, Nullable: true},
 		{Name: "enable_auto_buy", Type: field.TypeBool},
 		{Name: "enable_auto_sell", Type: field.TypeBool},
 		{Name: "enable_auto_exit", Type: field.TypeBool},
@@ -164,7 +197,7 @@ var (
 			{
 				Name:    "strategy_user_id_token",
 				Unique:  true,
-				Columns: []*schema.Column{StrategiesColumns[4], StrategiesColumns[5]},
+				Columns: []*schema.Column{StrategiesColumns[4], StrategiesColumns[6]},
 			},
 		},
 	}
@@ -177,6 +210,8 @@ var (
 		{Name: "account", Type: field.TypeString, Size: 50},
 		{Name: "password", Type: field.TypeString, Size: 100},
 		{Name: "private_key", Type: field.TypeString, Size: 200},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "is_default", Type: field.TypeBool, Nullable: true},
 	}
 	// WalletsTable holds the schema information for the "wallets" table.
 	WalletsTable = &schema.Table{
@@ -186,7 +221,7 @@ var (
 		Indexes: []*schema.Index{
 			{
 				Name:    "wallet_user_id",
-				Unique:  true,
+				Unique:  false,
 				Columns: []*schema.Column{WalletsColumns[3]},
 			},
 			{
@@ -196,6 +231,64 @@ var (
 			},
 		},
 	}
+	// WithdrawAddressesColumns holds the columns for the "withdraw_addresses" table.
+	WithdrawAddressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "address", Type: field.TypeString, Size: 50},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 64},
+	}
+	// WithdrawAddressesTable holds the schema information for the "withdraw_addresses" table.
+	WithdrawAddressesTable = &schema.Table{
+		Name:       "withdraw_addresses",
+		Columns:    WithdrawAddressesColumns,
+		PrimaryKey: []*schema.Column{WithdrawAddressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "withdrawaddress_user_id_address",
+				Unique:  true,
+				Columns: []*schema.Column{WithdrawAddressesColumns[3], WithdrawAddressesColumns[4]},
+			},
+		},
+	}
+	// WithdrawalsColumns holds the columns for the "withdrawals" table.
+	WithdrawalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "account", Type: field.TypeString, Size: 50},
+		{Name: "token", Type: field.TypeString, Size: 50},
+		{Name: "symbol", Type: field.TypeString, Size: 32},
+		{Name: "destination", Type: field.TypeString, Size: 50},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "closed", "rejected"}},
+		{Name: "tx_hash", Type: field.TypeString, Size: 100},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "base_fee", Type: field.TypeInt64, Nullable: true},
+		{Name: "priority_fee", Type: field.TypeInt64, Nullable: true},
+		{Name: "rent_fee", Type: field.TypeInt64, Nullable: true},
+	}
+	// WithdrawalsTable holds the schema information for the "withdrawals" table.
+	WithdrawalsTable = &schema.Table{
+		Name:       "withdrawals",
+		Columns:    WithdrawalsColumns,
+		PrimaryKey: []*schema.Column{WithdrawalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "withdrawal_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WithdrawalsColumns[3]},
+			},
+			{
+				Name:    "withdrawal_tx_hash",
+				Unique:  false,
+				Columns: []*schema.Column{WithdrawalsColumns[10]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		GridsTable,
@@ -203,6 +296,8 @@ var (
 		SettingsTable,
 		StrategiesTable,
 		WalletsTable,
+		WithdrawAddressesTable,
+		WithdrawalsTable,
 	}
 )
 
@@ -17,6 +17,8 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/ent/wallet"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawaddress"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
 	"github.com/shopspring/decimal"
 )
 
@@ -29,11 +31,13 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeGrid     = "Grid"
-	TypeOrder    = "Order"
-	TypeSettings = "Settings"
-	TypeStrategy = "Strategy"
-	TypeWallet   = "Wallet"
+	TypeGrid            = "Grid"
+	TypeOrder           = "Order"
+	TypeSettings        = "Settings"
+	TypeStrategy        = "Strategy"
+	TypeWallet          = "Wallet"
+	TypeWithdrawAddress = "WithdrawAddress"
+	TypeWithdrawal      = "Withdrawal"
 )
 
 // GridMutation represents an operation that mutates the Grid nodes in the graph.
@@ -1049,32 +1053,45 @@ func (m *GridMutation) ResetEdge(name string) error {
 // OrderMutation represents an operation that mutates the Order nodes in the graph.
 type OrderMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	account       *string
-	token         *string
-	symbol        *string
-	gridId        *string
-	gridNumber    *int
-	addgridNumber *int
-	gridBuyCost   *decimal.Decimal
-	strategyId    *string
-	_type         *order.Type
-	price         *decimal.Decimal
-	finalPrice    *decimal.Decimal
-	inAmount      *decimal.Decimal
-	outAmount     *decimal.Decimal
-	status        *order.Status
-	txHash        *string
-	reason        *string
-	profit        *decimal.Decimal
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Order, error)
-	predicates    []predicate.Order
+	op              Op
+	typ             string
+	id              *int
+	create_time     *time.Time
+	update_time     *time.Time
+	account         *string
+	token           *string
+	symbol          *string
+	gridId          *string
+	gridNumber      *int
+	addgridNumber   *int
+	gridBuyCost     *decimal.Decimal
+	strategyId      *string
+	_type           *order.Type
+	price           *decimal.Decimal
+	finalPrice      *decimal.Decimal
+	inAmount        *decimal.Decimal
+	outAmount       *decimal.Decimal
+	status          *order.Status
+	txHash          *string
+	reason          *string
+	profit          *decimal.Decimal
+	paper           *bool
+	aggregator      *string
+	baseFee         *int64
+	addbaseFee      *int64
+	priorityFee     *int64
+	addpriorityFee  *int64
+	computeUnits    *int64
+	addcomputeUnits *int64
+	jitoTip         *int64
+	addjitoTip      *int64
+	rentFee         *int64
+	addrentFee      *int64
+	feeUsd          *decimal.Decimal
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Order, error)
+	predicates      []predicate.Order
 }
 
 var _ ent.Mutation = (*OrderMutation)(nil)
@@ -1896,924 +1913,1673 @@ func (m *OrderMutation) ResetProfit() {
 	delete(m.clearedFields, order.FieldProfit)
 }
 
-// Where appends a list predicates to the OrderMutation builder.
-func (m *OrderMutation) Where(ps ...predicate.Order) {
-	m.predicates = append(m.predicates, ps...)
+// SetPaper sets the "paper" field.
+func (m *OrderMutation) SetPaper(b bool) {
+	m.paper = &b
 }
 
-// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Order, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Paper returns the value of the "paper" field in the mutation.
+func (m *OrderMutation) Paper() (r bool, exists bool) {
+	v := m.paper
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *OrderMutation) Op() Op {
-	return m.op
+// OldPaper returns the old "paper" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPaper(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaper is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaper requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaper: %w", err)
+	}
+	return oldValue.Paper, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *OrderMutation) SetOp(op Op) {
-	m.op = op
+// ClearPaper clears the value of the "paper" field.
+func (m *OrderMutation) ClearPaper() {
+	m.paper = nil
+	m.clearedFields[order.FieldPaper] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Order).
-func (m *OrderMutation) Type() string {
-	return m.typ
+// PaperCleared returns if the "paper" field was cleared in this mutation.
+func (m *OrderMutation) PaperCleared() bool {
+	_, ok := m.clearedFields[order.FieldPaper]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *OrderMutation) Fields() []string {
-	fields := make([]string, 0, 18)
-	if m.create_time != nil {
-		fields = append(fields, order.FieldCreateTime)
+// ResetPaper resets all changes to the "paper" field.
+func (m *OrderMutation) ResetPaper() {
+	m.paper = nil
+	delete(m.clearedFields, order.FieldPaper)
+}
+
+// SetAggregator sets the "aggregator" field.
+func (m *OrderMutation) SetAggregator(s string) {
+	m.aggregator = &s
+}
+
+// Aggregator returns the value of the "aggregator" field in the mutation.
+func (m *OrderMutation) Aggregator() (r string, exists bool) {
+	v := m.aggregator
+	if v == nil {
+		return
 	}
-	if m.update_time != nil {
-		fields = append(fields, order.FieldUpdateTime)
+	return *v, true
+}
+
+// OldAggregator returns the old "aggregator" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldAggregator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregator is only allowed on UpdateOne operations")
 	}
-	if m.account != nil {
-		fields = append(fields, order.FieldAccount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregator requires an ID field in the mutation")
 	}
-	if m.token != nil {
-		fields = append(fields, order.FieldToken)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregator: %w", err)
 	}
-	if m.symbol != nil {
-		fields = append(fields, order.FieldSymbol)
+	return oldValue.Aggregator, nil
+}
+
+// ClearAggregator clears the value of the "aggregator" field.
+func (m *OrderMutation) ClearAggregator() {
+	m.aggregator = nil
+	m.clearedFields[order.FieldAggregator] = struct{}{}
+}
+
+// AggregatorCleared returns if the "aggregator" field was cleared in this mutation.
+func (m *OrderMutation) AggregatorCleared() bool {
+	_, ok := m.clearedFields[order.FieldAggregator]
+	return ok
+}
+
+// ResetAggregator resets all changes to the "aggregator" field.
+func (m *OrderMutation) ResetAggregator() {
+	m.aggregator = nil
+	delete(m.clearedFields, order.FieldAggregator)
+}
+
+// SetBaseFee sets the "baseFee" field.
+func (m *OrderMutation) SetBaseFee(i int64) {
+	m.baseFee = &i
+	m.addbaseFee = nil
+}
+
+// BaseFee returns the value of the "baseFee" field in the mutation.
+func (m *OrderMutation) BaseFee() (r int64, exists bool) {
+	v := m.baseFee
+	if v == nil {
+		return
 	}
-	if m.gridId != nil {
-		fields = append(fields, order.FieldGridId)
+	return *v, true
+}
+
+// OldBaseFee returns the old "baseFee" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldBaseFee(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseFee is only allowed on UpdateOne operations")
 	}
-	if m.gridNumber != nil {
-		fields = append(fields, order.FieldGridNumber)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseFee requires an ID field in the mutation")
 	}
-	if m.gridBuyCost != nil {
-		fields = append(fields, order.FieldGridBuyCost)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseFee: %w", err)
 	}
-	if m.strategyId != nil {
-		fields = append(fields, order.FieldStrategyId)
+	return oldValue.BaseFee, nil
+}
+
+// AddBaseFee adds i to the "baseFee" field.
+func (m *OrderMutation) AddBaseFee(i int64) {
+	if m.addbaseFee != nil {
+		*m.addbaseFee += i
+	} else {
+		m.addbaseFee = &i
 	}
-	if m._type != nil {
-		fields = append(fields, order.FieldType)
+}
+
+// AddedBaseFee returns the value that was added to the "baseFee" field in this mutation.
+func (m *OrderMutation) AddedBaseFee() (r int64, exists bool) {
+	v := m.addbaseFee
+	if v == nil {
+		return
 	}
-	if m.price != nil {
-		fields = append(fields, order.FieldPrice)
+	return *v, true
+}
+
+// ClearBaseFee clears the value of the "baseFee" field.
+func (m *OrderMutation) ClearBaseFee() {
+	m.baseFee = nil
+	m.addbaseFee = nil
+	m.clearedFields[order.FieldBaseFee] = struct{}{}
+}
+
+// BaseFeeCleared returns if the "baseFee" field was cleared in this mutation.
+func (m *OrderMutation) BaseFeeCleared() bool {
+	_, ok := m.clearedFields[order.FieldBaseFee]
+	return ok
+}
+
+// ResetBaseFee resets all changes to the "baseFee" field.
+func (m *OrderMutation) ResetBaseFee() {
+	m.baseFee = nil
+	m.addbaseFee = nil
+	delete(m.clearedFields, order.FieldBaseFee)
+}
+
+// SetPriorityFee sets the "priorityFee" field.
+func (m *OrderMutation) SetPriorityFee(i int64) {
+	m.priorityFee = &i
+	m.addpriorityFee = nil
+}
+
+// PriorityFee returns the value of the "priorityFee" field in the mutation.
+func (m *OrderMutation) PriorityFee() (r int64, exists bool) {
+	v := m.priorityFee
+	if v == nil {
+		return
 	}
-	if m.finalPrice != nil {
-		fields = append(fields, order.FieldFinalPrice)
+	return *v, true
+}
+
+// OldPriorityFee returns the old "priorityFee" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPriorityFee(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriorityFee is only allowed on UpdateOne operations")
 	}
-	if m.inAmount != nil {
-		fields = append(fields, order.FieldInAmount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriorityFee requires an ID field in the mutation")
 	}
-	if m.outAmount != nil {
-		fields = append(fields, order.FieldOutAmount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriorityFee: %w", err)
 	}
-	if m.status != nil {
-		fields = append(fields, order.FieldStatus)
+	return oldValue.PriorityFee, nil
+}
+
+// AddPriorityFee adds i to the "priorityFee" field.
+func (m *OrderMutation) AddPriorityFee(i int64) {
+	if m.addpriorityFee != nil {
+		*m.addpriorityFee += i
+	} else {
+		m.addpriorityFee = &i
 	}
-	if m.txHash != nil {
-		fields = append(fields, order.FieldTxHash)
+}
+
+// AddedPriorityFee returns the value that was added to the "priorityFee" field in this mutation.
+func (m *OrderMutation) AddedPriorityFee() (r int64, exists bool) {
+	v := m.addpriorityFee
+	if v == nil {
+		return
 	}
-	if m.reason != nil {
-		fields = append(fields, order.FieldReason)
+	return *v, true
+}
+
+// ClearPriorityFee clears the value of the "priorityFee" field.
+func (m *OrderMutation) ClearPriorityFee() {
+	m.priorityFee = nil
+	m.addpriorityFee = nil
+	m.clearedFields[order.FieldPriorityFee] = struct{}{}
+}
+
+// PriorityFeeCleared returns if the "priorityFee" field was cleared in this mutation.
+func (m *OrderMutation) PriorityFeeCleared() bool {
+	_, ok := m.clearedFields[order.FieldPriorityFee]
+	return ok
+}
+
+// ResetPriorityFee resets all changes to the "priorityFee" field.
+func (m *OrderMutation) ResetPriorityFee() {
+	m.priorityFee = nil
+	m.addpriorityFee = nil
+	delete(m.clearedFields, order.FieldPriorityFee)
+}
+
+// SetComputeUnits sets the "computeUnits" field.
+func (m *OrderMutation) SetComputeUnits(i int64) {
+	m.computeUnits = &i
+	m.addcomputeUnits = nil
+}
+
+// ComputeUnits returns the value of the "computeUnits" field in the mutation.
+func (m *OrderMutation) ComputeUnits() (r int64, exists bool) {
+	v := m.computeUnits
+	if v == nil {
+		return
 	}
-	if m.profit != nil {
-		fields = append(fields, order.FieldProfit)
+	return *v, true
+}
+
+// OldComputeUnits returns the old "computeUnits" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldComputeUnits(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComputeUnits is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComputeUnits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComputeUnits: %w", err)
+	}
+	return oldValue.ComputeUnits, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *OrderMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case order.FieldCreateTime:
-		return m.CreateTime()
-	case order.FieldUpdateTime:
-		return m.UpdateTime()
-	case order.FieldAccount:
-		return m.Account()
-	case order.FieldToken:
-		return m.Token()
-	case order.FieldSymbol:
-		return m.Symbol()
-	case order.FieldGridId:
-		return m.GridId()
-	case order.FieldGridNumber:
-		return m.GridNumber()
-	case order.FieldGridBuyCost:
-		return m.GridBuyCost()
-	case order.FieldStrategyId:
-		return m.StrategyId()
-	case order.FieldType:
-		return m.GetType()
-	case order.FieldPrice:
-		return m.Price()
-	case order.FieldFinalPrice:
-		return m.FinalPrice()
-	case order.FieldInAmount:
-		return m.InAmount()
-	case order.FieldOutAmount:
-		return m.OutAmount()
-	case order.FieldStatus:
-		return m.Status()
-	case order.FieldTxHash:
-		return m.TxHash()
-	case order.FieldReason:
-		return m.Reason()
-	case order.FieldProfit:
-		return m.Profit()
+// AddComputeUnits adds i to the "computeUnits" field.
+func (m *OrderMutation) AddComputeUnits(i int64) {
+	if m.addcomputeUnits != nil {
+		*m.addcomputeUnits += i
+	} else {
+		m.addcomputeUnits = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case order.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case order.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case order.FieldAccount:
-		return m.OldAccount(ctx)
-	case order.FieldToken:
-		return m.OldToken(ctx)
-	case order.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case order.FieldGridId:
-		return m.OldGridId(ctx)
-	case order.FieldGridNumber:
-		return m.OldGridNumber(ctx)
-	case order.FieldGridBuyCost:
-		return m.OldGridBuyCost(ctx)
-	case order.FieldStrategyId:
-		return m.OldStrategyId(ctx)
-	case order.FieldType:
-		return m.OldType(ctx)
-	case order.FieldPrice:
-		return m.OldPrice(ctx)
-	case order.FieldFinalPrice:
-		return m.OldFinalPrice(ctx)
-	case order.FieldInAmount:
-		return m.OldInAmount(ctx)
-	case order.FieldOutAmount:
-		return m.OldOutAmount(ctx)
-	case order.FieldStatus:
-		return m.OldStatus(ctx)
-	case order.FieldTxHash:
-		return m.OldTxHash(ctx)
-	case order.FieldReason:
-		return m.OldReason(ctx)
-	case order.FieldProfit:
-		return m.OldProfit(ctx)
+// AddedComputeUnits returns the value that was added to the "computeUnits" field in this mutation.
+func (m *OrderMutation) AddedComputeUnits() (r int64, exists bool) {
+	v := m.addcomputeUnits
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Order field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OrderMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case order.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case order.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case order.FieldAccount:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccount(v)
-		return nil
-	case order.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case order.FieldSymbol:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSymbol(v)
-		return nil
-	case order.FieldGridId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGridId(v)
-		return nil
-	case order.FieldGridNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGridNumber(v)
-		return nil
-	case order.FieldGridBuyCost:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGridBuyCost(v)
-		return nil
-	case order.FieldStrategyId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStrategyId(v)
-		return nil
-	case order.FieldType:
-		v, ok := value.(order.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case order.FieldPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrice(v)
-		return nil
-	case order.FieldFinalPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFinalPrice(v)
-		return nil
-	case order.FieldInAmount:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInAmount(v)
-		return nil
-	case order.FieldOutAmount:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutAmount(v)
-		return nil
-	case order.FieldStatus:
-		v, ok := value.(order.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case order.FieldTxHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTxHash(v)
-		return nil
-	case order.FieldReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReason(v)
-		return nil
-	case order.FieldProfit:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProfit(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Order field %s", name)
+// ClearComputeUnits clears the value of the "computeUnits" field.
+func (m *OrderMutation) ClearComputeUnits() {
+	m.computeUnits = nil
+	m.addcomputeUnits = nil
+	m.clearedFields[order.FieldComputeUnits] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *OrderMutation) AddedFields() []string {
-	var fields []string
-	if m.addgridNumber != nil {
-		fields = append(fields, order.FieldGridNumber)
-	}
-	return fields
+// ComputeUnitsCleared returns if the "computeUnits" field was cleared in this mutation.
+func (m *OrderMutation) ComputeUnitsCleared() bool {
+	_, ok := m.clearedFields[order.FieldComputeUnits]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case order.FieldGridNumber:
-		return m.AddedGridNumber()
-	}
-	return nil, false
+// ResetComputeUnits resets all changes to the "computeUnits" field.
+func (m *OrderMutation) ResetComputeUnits() {
+	m.computeUnits = nil
+	m.addcomputeUnits = nil
+	delete(m.clearedFields, order.FieldComputeUnits)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OrderMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case order.FieldGridNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGridNumber(v)
-		return nil
+// SetJitoTip sets the "jitoTip" field.
+func (m *OrderMutation) SetJitoTip(i int64) {
+	m.jitoTip = &i
+	m.addjitoTip = nil
+}
+
+// JitoTip returns the value of the "jitoTip" field in the mutation.
+func (m *OrderMutation) JitoTip() (r int64, exists bool) {
+	v := m.jitoTip
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Order numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *OrderMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(order.FieldGridId) {
-		fields = append(fields, order.FieldGridId)
+// OldJitoTip returns the old "jitoTip" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldJitoTip(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJitoTip is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(order.FieldGridNumber) {
-		fields = append(fields, order.FieldGridNumber)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJitoTip requires an ID field in the mutation")
 	}
-	if m.FieldCleared(order.FieldGridBuyCost) {
-		fields = append(fields, order.FieldGridBuyCost)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJitoTip: %w", err)
 	}
-	if m.FieldCleared(order.FieldProfit) {
-		fields = append(fields, order.FieldProfit)
+	return oldValue.JitoTip, nil
+}
+
+// AddJitoTip adds i to the "jitoTip" field.
+func (m *OrderMutation) AddJitoTip(i int64) {
+	if m.addjitoTip != nil {
+		*m.addjitoTip += i
+	} else {
+		m.addjitoTip = &i
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *OrderMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// AddedJitoTip returns the value that was added to the "jitoTip" field in this mutation.
+func (m *OrderMutation) AddedJitoTip() (r int64, exists bool) {
+	v := m.addjitoTip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearJitoTip clears the value of the "jitoTip" field.
+func (m *OrderMutation) ClearJitoTip() {
+	m.jitoTip = nil
+	m.addjitoTip = nil
+	m.clearedFields[order.FieldJitoTip] = struct{}{}
+}
+
+// JitoTipCleared returns if the "jitoTip" field was cleared in this mutation.
+func (m *OrderMutation) JitoTipCleared() bool {
+	_, ok := m.clearedFields[order.FieldJitoTip]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *OrderMutation) ClearField(name string) error {
-	switch name {
-	case order.FieldGridId:
-		m.ClearGridId()
-		return nil
-	case order.FieldGridNumber:
-		m.ClearGridNumber()
-		return nil
-	case order.FieldGridBuyCost:
-		m.ClearGridBuyCost()
-		return nil
-	case order.FieldProfit:
-		m.ClearProfit()
-		return nil
+// ResetJitoTip resets all changes to the "jitoTip" field.
+func (m *OrderMutation) ResetJitoTip() {
+	m.jitoTip = nil
+	m.addjitoTip = nil
+	delete(m.clearedFields, order.FieldJitoTip)
+}
+
+// SetRentFee sets the "rentFee" field.
+func (m *OrderMutation) SetRentFee(i int64) {
+	m.rentFee = &i
+	m.addrentFee = nil
+}
+
+// RentFee returns the value of the "rentFee" field in the mutation.
+func (m *OrderMutation) RentFee() (r int64, exists bool) {
+	v := m.rentFee
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Order nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *OrderMutation) ResetField(name string) error {
-	switch name {
-	case order.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case order.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case order.FieldAccount:
-		m.ResetAccount()
-		return nil
-	case order.FieldToken:
-		m.ResetToken()
-		return nil
-	case order.FieldSymbol:
-		m.ResetSymbol()
-		return nil
-	case order.FieldGridId:
-		m.ResetGridId()
-		return nil
-	case order.FieldGridNumber:
-		m.ResetGridNumber()
-		return nil
-	case order.FieldGridBuyCost:
-		m.ResetGridBuyCost()
-		return nil
-	case order.FieldStrategyId:
-		m.ResetStrategyId()
-		return nil
-	case order.FieldType:
-		m.ResetType()
-		return nil
-	case order.FieldPrice:
-		m.ResetPrice()
-		return nil
-	case order.FieldFinalPrice:
-		m.ResetFinalPrice()
-		return nil
-	case order.FieldInAmount:
-		m.ResetInAmount()
-		return nil
-	case order.FieldOutAmount:
-		m.ResetOutAmount()
-		return nil
-	case order.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case order.FieldTxHash:
-		m.ResetTxHash()
-		return nil
-	case order.FieldReason:
-		m.ResetReason()
-		return nil
-	case order.FieldProfit:
-		m.ResetProfit()
-		return nil
+// OldRentFee returns the old "rentFee" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRentFee(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRentFee is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Order field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *OrderMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *OrderMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *OrderMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Order unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *OrderMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Order edge %s", name)
-}
-
-// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
-type SettingsMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *int
-	create_time        *time.Time
-	update_time        *time.Time
-	userId             *int64
-	adduserId          *int64
-	maxRetries         *int64
-	addmaxRetries      *int64
-	slippageBps        *int
-	addslippageBps     *int
-	sellSlippageBps    *int
-	addsellSlippageBps *int
-	exitSlippageBps    *int
-	addexitSlippageBps *int
-	maxLamports        *int64
-	addmaxLamports     *int64
-	priorityLevel      *settings.PriorityLevel
-	dexAggregator      *settings.DexAggregator
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*Settings, error)
-	predicates         []predicate.Settings
-}
-
-var _ ent.Mutation = (*SettingsMutation)(nil)
-
-// settingsOption allows management of the mutation configuration using functional options.
-type settingsOption func(*SettingsMutation)
-
-// newSettingsMutation creates new mutation for the Settings entity.
-func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
-	m := &SettingsMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSettings,
-		clearedFields: make(map[string]struct{}),
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRentFee requires an ID field in the mutation")
 	}
-	for _, opt := range opts {
-		opt(m)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRentFee: %w", err)
 	}
-	return m
+	return oldValue.RentFee, nil
 }
 
-// withSettingsID sets the ID field of the mutation.
-func withSettingsID(id int) settingsOption {
-	return func(m *SettingsMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Settings
-		)
-		m.oldValue = func(ctx context.Context) (*Settings, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Settings.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddRentFee adds i to the "rentFee" field.
+func (m *OrderMutation) AddRentFee(i int64) {
+	if m.addrentFee != nil {
+		*m.addrentFee += i
+	} else {
+		m.addrentFee = &i
 	}
 }
 
-// withSettings sets the old Settings of the mutation.
-func withSettings(node *Settings) settingsOption {
-	return func(m *SettingsMutation) {
-		m.oldValue = func(context.Context) (*Settings, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedRentFee returns the value that was added to the "rentFee" field in this mutation.
+func (m *OrderMutation) AddedRentFee() (r int64, exists bool) {
+	v := m.addrentFee
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingsMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SettingsMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearRentFee clears the value of the "rentFee" field.
+func (m *OrderMutation) ClearRentFee() {
+	m.rentFee = nil
+	m.addrentFee = nil
+	m.clearedFields[order.FieldRentFee] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SettingsMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// RentFeeCleared returns if the "rentFee" field was cleared in this mutation.
+func (m *OrderMutation) RentFeeCleared() bool {
+	_, ok := m.clearedFields[order.FieldRentFee]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetRentFee resets all changes to the "rentFee" field.
+func (m *OrderMutation) ResetRentFee() {
+	m.rentFee = nil
+	m.addrentFee = nil
+	delete(m.clearedFields, order.FieldRentFee)
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *SettingsMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetFeeUsd sets the "feeUsd" field.
+func (m *OrderMutation) SetFeeUsd(d decimal.Decimal) {
+	m.feeUsd = &d
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SettingsMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// FeeUsd returns the value of the "feeUsd" field in the mutation.
+func (m *OrderMutation) FeeUsd() (r decimal.Decimal, exists bool) {
+	v := m.feeUsd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldFeeUsd returns the old "feeUsd" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *OrderMutation) OldFeeUsd(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeeUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldFeeUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeeUsd: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.FeeUsd, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *SettingsMutation) ResetCreateTime() {
-	m.create_time = nil
+// ClearFeeUsd clears the value of the "feeUsd" field.
+func (m *OrderMutation) ClearFeeUsd() {
+	m.feeUsd = nil
+	m.clearedFields[order.FieldFeeUsd] = struct{}{}
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *SettingsMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// FeeUsdCleared returns if the "feeUsd" field was cleared in this mutation.
+func (m *OrderMutation) FeeUsdCleared() bool {
+	_, ok := m.clearedFields[order.FieldFeeUsd]
+	return ok
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SettingsMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetFeeUsd resets all changes to the "feeUsd" field.
+func (m *OrderMutation) ResetFeeUsd() {
+	m.feeUsd = nil
+	delete(m.clearedFields, order.FieldFeeUsd)
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+// Where appends a list predicates to the OrderMutation builder.
+func (m *OrderMutation) Where(ps ...predicate.Order) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Order, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.UpdateTime, nil
+	m.Where(p...)
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SettingsMutation) ResetUpdateTime() {
-	m.update_time = nil
+// Op returns the operation name.
+func (m *OrderMutation) Op() Op {
+	return m.op
 }
 
-// SetUserId sets the "userId" field.
-func (m *SettingsMutation) SetUserId(i int64) {
-	m.userId = &i
-	m.adduserId = nil
+// SetOp allows setting the mutation operation.
+func (m *OrderMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// UserId returns the value of the "userId" field in the mutation.
-func (m *SettingsMutation) UserId() (r int64, exists bool) {
-	v := m.userId
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Order).
+func (m *OrderMutation) Type() string {
+	return m.typ
 }
 
-// OldUserId returns the old "userId" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUserId(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.create_time != nil {
+		fields = append(fields, order.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserId requires an ID field in the mutation")
+	if m.update_time != nil {
+		fields = append(fields, order.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	if m.account != nil {
+		fields = append(fields, order.FieldAccount)
 	}
-	return oldValue.UserId, nil
-}
-
-// AddUserId adds i to the "userId" field.
-func (m *SettingsMutation) AddUserId(i int64) {
-	if m.adduserId != nil {
-		*m.adduserId += i
-	} else {
-		m.adduserId = &i
+	if m.token != nil {
+		fields = append(fields, order.FieldToken)
 	}
-}
-
-// AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *SettingsMutation) AddedUserId() (r int64, exists bool) {
-	v := m.adduserId
-	if v == nil {
-		return
+	if m.symbol != nil {
+		fields = append(fields, order.FieldSymbol)
 	}
-	return *v, true
-}
-
-// ResetUserId resets all changes to the "userId" field.
-func (m *SettingsMutation) ResetUserId() {
-	m.userId = nil
-	m.adduserId = nil
-}
-
-// SetMaxRetries sets the "maxRetries" field.
-func (m *SettingsMutation) SetMaxRetries(i int64) {
-	m.maxRetries = &i
-	m.addmaxRetries = nil
-}
-
-// MaxRetries returns the value of the "maxRetries" field in the mutation.
-func (m *SettingsMutation) MaxRetries() (r int64, exists bool) {
-	v := m.maxRetries
-	if v == nil {
-		return
+	if m.gridId != nil {
+		fields = append(fields, order.FieldGridId)
 	}
-	return *v, true
-}
-
-// OldMaxRetries returns the old "maxRetries" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldMaxRetries(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxRetries is only allowed on UpdateOne operations")
+	if m.gridNumber != nil {
+		fields = append(fields, order.FieldGridNumber)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxRetries requires an ID field in the mutation")
+	if m.gridBuyCost != nil {
+		fields = append(fields, order.FieldGridBuyCost)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxRetries: %w", err)
+	if m.strategyId != nil {
+		fields = append(fields, order.FieldStrategyId)
 	}
-	return oldValue.MaxRetries, nil
-}
-
-// AddMaxRetries adds i to the "maxRetries" field.
-func (m *SettingsMutation) AddMaxRetries(i int64) {
-	if m.addmaxRetries != nil {
-		*m.addmaxRetries += i
-	} else {
-		m.addmaxRetries = &i
+	if m._type != nil {
+		fields = append(fields, order.FieldType)
 	}
-}
-
-// AddedMaxRetries returns the value that was added to the "maxRetries" field in this mutation.
-func (m *SettingsMutation) AddedMaxRetries() (r int64, exists bool) {
-	v := m.addmaxRetries
-	if v == nil {
-		return
+	if m.price != nil {
+		fields = append(fields, order.FieldPrice)
 	}
-	return *v, true
-}
-
-// ResetMaxRetries resets all changes to the "maxRetries" field.
-func (m *SettingsMutation) ResetMaxRetries() {
-	m.maxRetries = nil
-	m.addmaxRetries = nil
-}
-
-// SetSlippageBps sets the "slippageBps" field.
-func (m *SettingsMutation) SetSlippageBps(i int) {
-	m.slippageBps = &i
-	m.addslippageBps = nil
-}
-
-// SlippageBps returns the value of the "slippageBps" field in the mutation.
-func (m *SettingsMutation) SlippageBps() (r int, exists bool) {
-	v := m.slippageBps
-	if v == nil {
-		return
+	if m.finalPrice != nil {
+		fields = append(fields, order.FieldFinalPrice)
 	}
-	return *v, true
-}
-
-// OldSlippageBps returns the old "slippageBps" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldSlippageBps(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlippageBps is only allowed on UpdateOne operations")
+	if m.inAmount != nil {
+		fields = append(fields, order.FieldInAmount)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlippageBps requires an ID field in the mutation")
+	if m.outAmount != nil {
+		fields = append(fields, order.FieldOutAmount)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlippageBps: %w", err)
+	if m.status != nil {
+		fields = append(fields, order.FieldStatus)
 	}
-	return oldValue.SlippageBps, nil
-}
-
-// AddSlippageBps adds i to the "slippageBps" field.
-func (m *SettingsMutation) AddSlippageBps(i int) {
-	if m.addslippageBps != nil {
-		*m.addslippageBps += i
-	} else {
-		m.addslippageBps = &i
+	if m.txHash != nil {
+		fields = append(fields, order.FieldTxHash)
 	}
-}
-
-// AddedSlippageBps returns the value that was added to the "slippageBps" field in this mutation.
-func (m *SettingsMutation) AddedSlippageBps() (r int, exists bool) {
-	v := m.addslippageBps
-	if v == nil {
-		return
+	if m.reason != nil {
+		fields = append(fields, order.FieldReason)
 	}
-	return *v, true
-}
-
-// ResetSlippageBps resets all changes to the "slippageBps" field.
-func (m *SettingsMutation) ResetSlippageBps() {
-	m.slippageBps = nil
-	m.addslippageBps = nil
-}
-
-// SetSellSlippageBps sets the "sellSlippageBps" field.
-func (m *SettingsMutation) SetSellSlippageBps(i int) {
-	m.sellSlippageBps = &i
-	m.addsellSlippageBps = nil
-}
-
-// SellSlippageBps returns the value of the "sellSlippageBps" field in the mutation.
-func (m *SettingsMutation) SellSlippageBps() (r int, exists bool) {
-	v := m.sellSlippageBps
-	if v == nil {
-		return
+	if m.profit != nil {
+		fields = append(fields, order.FieldProfit)
 	}
-	return *v, true
-}
-
-// OldSellSlippageBps returns the old "sellSlippageBps" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldSellSlippageBps(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellSlippageBps is only allowed on UpdateOne operations")
+	if m.paper != nil {
+		fields = append(fields, order.FieldPaper)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellSlippageBps requires an ID field in the mutation")
+	if m.aggregator != nil {
+		fields = append(fields, order.FieldAggregator)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellSlippageBps: %w", err)
+	if m.baseFee != nil {
+		fields = append(fields, order.FieldBaseFee)
 	}
-	return oldValue.SellSlippageBps, nil
-}
-
-// AddSellSlippageBps adds i to the "sellSlippageBps" field.
-func (m *SettingsMutation) AddSellSlippageBps(i int) {
-	if m.addsellSlippageBps != nil {
-		*m.addsellSlippageBps += i
-	} else {
-		m.addsellSlippageBps = &i
+	if m.priorityFee != nil {
+		fields = append(fields, order.FieldPriorityFee)
+	}
+	if m.computeUnits != nil {
+		fields = append(fields, order.FieldComputeUnits)
+	}
+	if m.jitoTip != nil {
+		fields = append(fields, order.FieldJitoTip)
 	}
+	if m.rentFee != nil {
+		fields = append(fields, order.FieldRentFee)
+	}
+	if m.feeUsd != nil {
+		fields = append(fields, order.FieldFeeUsd)
+	}
+	return fields
 }
 
-// AddedSellSlippageBps returns the value that was added to the "sellSlippageBps" field in this mutation.
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldCreateTime:
+		return m.CreateTime()
+	case order.FieldUpdateTime:
+		return m.UpdateTime()
+	case order.FieldAccount:
+		return m.Account()
+	case order.FieldToken:
+		return m.Token()
+	case order.FieldSymbol:
+		return m.Symbol()
+	case order.FieldGridId:
+		return m.GridId()
+	case order.FieldGridNumber:
+		return m.GridNumber()
+	case order.FieldGridBuyCost:
+		return m.GridBuyCost()
+	case order.FieldStrategyId:
+		return m.StrategyId()
+	case order.FieldType:
+		return m.GetType()
+	case order.FieldPrice:
+		return m.Price()
+	case order.FieldFinalPrice:
+		return m.FinalPrice()
+	case order.FieldInAmount:
+		return m.InAmount()
+	case order.FieldOutAmount:
+		return m.OutAmount()
+	case order.FieldStatus:
+		return m.Status()
+	case order.FieldTxHash:
+		return m.TxHash()
+	case order.FieldReason:
+		return m.Reason()
+	case order.FieldProfit:
+		return m.Profit()
+	case order.FieldPaper:
+		return m.Paper()
+	case order.FieldAggregator:
+		return m.Aggregator()
+	case order.FieldBaseFee:
+		return m.BaseFee()
+	case order.FieldPriorityFee:
+		return m.PriorityFee()
+	case order.FieldComputeUnits:
+		return m.ComputeUnits()
+	case order.FieldJitoTip:
+		return m.JitoTip()
+	case order.FieldRentFee:
+		return m.RentFee()
+	case order.FieldFeeUsd:
+		return m.FeeUsd()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case order.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case order.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case order.FieldAccount:
+		return m.OldAccount(ctx)
+	case order.FieldToken:
+		return m.OldToken(ctx)
+	case order.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case order.FieldGridId:
+		return m.OldGridId(ctx)
+	case order.FieldGridNumber:
+		return m.OldGridNumber(ctx)
+	case order.FieldGridBuyCost:
+		return m.OldGridBuyCost(ctx)
+	case order.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case order.FieldType:
+		return m.OldType(ctx)
+	case order.FieldPrice:
+		return m.OldPrice(ctx)
+	case order.FieldFinalPrice:
+		return m.OldFinalPrice(ctx)
+	case order.FieldInAmount:
+		return m.OldInAmount(ctx)
+	case order.FieldOutAmount:
+		return m.OldOutAmount(ctx)
+	case order.FieldStatus:
+		return m.OldStatus(ctx)
+	case order.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case order.FieldReason:
+		return m.OldReason(ctx)
+	case order.FieldProfit:
+		return m.OldProfit(ctx)
+	case order.FieldPaper:
+		return m.OldPaper(ctx)
+	case order.FieldAggregator:
+		return m.OldAggregator(ctx)
+	case order.FieldBaseFee:
+		return m.OldBaseFee(ctx)
+	case order.FieldPriorityFee:
+		return m.OldPriorityFee(ctx)
+	case order.FieldComputeUnits:
+		return m.OldComputeUnits(ctx)
+	case order.FieldJitoTip:
+		return m.OldJitoTip(ctx)
+	case order.FieldRentFee:
+		return m.OldRentFee(ctx)
+	case order.FieldFeeUsd:
+		return m.OldFeeUsd(ctx)
+	}
+	return nil, fmt.Errorf("unknown Order field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case order.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case order.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case order.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case order.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case order.FieldGridId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridId(v)
+		return nil
+	case order.FieldGridNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridNumber(v)
+		return nil
+	case order.FieldGridBuyCost:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridBuyCost(v)
+		return nil
+	case order.FieldStrategyId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyId(v)
+		return nil
+	case order.FieldType:
+		v, ok := value.(order.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case order.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case order.FieldFinalPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinalPrice(v)
+		return nil
+	case order.FieldInAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInAmount(v)
+		return nil
+	case order.FieldOutAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutAmount(v)
+		return nil
+	case order.FieldStatus:
+		v, ok := value.(order.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case order.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case order.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case order.FieldProfit:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfit(v)
+		return nil
+	case order.FieldPaper:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaper(v)
+		return nil
+	case order.FieldAggregator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregator(v)
+		return nil
+	case order.FieldBaseFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseFee(v)
+		return nil
+	case order.FieldPriorityFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorityFee(v)
+		return nil
+	case order.FieldComputeUnits:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComputeUnits(v)
+		return nil
+	case order.FieldJitoTip:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJitoTip(v)
+		return nil
+	case order.FieldRentFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRentFee(v)
+		return nil
+	case order.FieldFeeUsd:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderMutation) AddedFields() []string {
+	var fields []string
+	if m.addgridNumber != nil {
+		fields = append(fields, order.FieldGridNumber)
+	}
+	if m.addbaseFee != nil {
+		fields = append(fields, order.FieldBaseFee)
+	}
+	if m.addpriorityFee != nil {
+		fields = append(fields, order.FieldPriorityFee)
+	}
+	if m.addcomputeUnits != nil {
+		fields = append(fields, order.FieldComputeUnits)
+	}
+	if m.addjitoTip != nil {
+		fields = append(fields, order.FieldJitoTip)
+	}
+	if m.addrentFee != nil {
+		fields = append(fields, order.FieldRentFee)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldGridNumber:
+		return m.AddedGridNumber()
+	case order.FieldBaseFee:
+		return m.AddedBaseFee()
+	case order.FieldPriorityFee:
+		return m.AddedPriorityFee()
+	case order.FieldComputeUnits:
+		return m.AddedComputeUnits()
+	case order.FieldJitoTip:
+		return m.AddedJitoTip()
+	case order.FieldRentFee:
+		return m.AddedRentFee()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldGridNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridNumber(v)
+		return nil
+	case order.FieldBaseFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBaseFee(v)
+		return nil
+	case order.FieldPriorityFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriorityFee(v)
+		return nil
+	case order.FieldComputeUnits:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddComputeUnits(v)
+		return nil
+	case order.FieldJitoTip:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJitoTip(v)
+		return nil
+	case order.FieldRentFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRentFee(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(order.FieldGridId) {
+		fields = append(fields, order.FieldGridId)
+	}
+	if m.FieldCleared(order.FieldGridNumber) {
+		fields = append(fields, order.FieldGridNumber)
+	}
+	if m.FieldCleared(order.FieldGridBuyCost) {
+		fields = append(fields, order.FieldGridBuyCost)
+	}
+	if m.FieldCleared(order.FieldProfit) {
+		fields = append(fields, order.FieldProfit)
+	}
+	if m.FieldCleared(order.FieldPaper) {
+		fields = append(fields, order.FieldPaper)
+	}
+	if m.FieldCleared(order.FieldAggregator) {
+		fields = append(fields, order.FieldAggregator)
+	}
+	if m.FieldCleared(order.FieldBaseFee) {
+		fields = append(fields, order.FieldBaseFee)
+	}
+	if m.FieldCleared(order.FieldPriorityFee) {
+		fields = append(fields, order.FieldPriorityFee)
+	}
+	if m.FieldCleared(order.FieldComputeUnits) {
+		fields = append(fields, order.FieldComputeUnits)
+	}
+	if m.FieldCleared(order.FieldJitoTip) {
+		fields = append(fields, order.FieldJitoTip)
+	}
+	if m.FieldCleared(order.FieldRentFee) {
+		fields = append(fields, order.FieldRentFee)
+	}
+	if m.FieldCleared(order.FieldFeeUsd) {
+		fields = append(fields, order.FieldFeeUsd)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderMutation) ClearField(name string) error {
+	switch name {
+	case order.FieldGridId:
+		m.ClearGridId()
+		return nil
+	case order.FieldGridNumber:
+		m.ClearGridNumber()
+		return nil
+	case order.FieldGridBuyCost:
+		m.ClearGridBuyCost()
+		return nil
+	case order.FieldProfit:
+		m.ClearProfit()
+		return nil
+	case order.FieldPaper:
+		m.ClearPaper()
+		return nil
+	case order.FieldAggregator:
+		m.ClearAggregator()
+		return nil
+	case order.FieldBaseFee:
+		m.ClearBaseFee()
+		return nil
+	case order.FieldPriorityFee:
+		m.ClearPriorityFee()
+		return nil
+	case order.FieldComputeUnits:
+		m.ClearComputeUnits()
+		return nil
+	case order.FieldJitoTip:
+		m.ClearJitoTip()
+		return nil
+	case order.FieldRentFee:
+		m.ClearRentFee()
+		return nil
+	case order.FieldFeeUsd:
+		m.ClearFeeUsd()
+		return nil
+	}
+	return fmt.Errorf("unknown Order nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderMutation) ResetField(name string) error {
+	switch name {
+	case order.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case order.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case order.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case order.FieldToken:
+		m.ResetToken()
+		return nil
+	case order.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case order.FieldGridId:
+		m.ResetGridId()
+		return nil
+	case order.FieldGridNumber:
+		m.ResetGridNumber()
+		return nil
+	case order.FieldGridBuyCost:
+		m.ResetGridBuyCost()
+		return nil
+	case order.FieldStrategyId:
+		m.ResetStrategyId()
+		return nil
+	case order.FieldType:
+		m.ResetType()
+		return nil
+	case order.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case order.FieldFinalPrice:
+		m.ResetFinalPrice()
+		return nil
+	case order.FieldInAmount:
+		m.ResetInAmount()
+		return nil
+	case order.FieldOutAmount:
+		m.ResetOutAmount()
+		return nil
+	case order.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case order.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case order.FieldReason:
+		m.ResetReason()
+		return nil
+	case order.FieldProfit:
+		m.ResetProfit()
+		return nil
+	case order.FieldPaper:
+		m.ResetPaper()
+		return nil
+	case order.FieldAggregator:
+		m.ResetAggregator()
+		return nil
+	case order.FieldBaseFee:
+		m.ResetBaseFee()
+		return nil
+	case order.FieldPriorityFee:
+		m.ResetPriorityFee()
+		return nil
+	case order.FieldComputeUnits:
+		m.ResetComputeUnits()
+		return nil
+	case order.FieldJitoTip:
+		m.ResetJitoTip()
+		return nil
+	case order.FieldRentFee:
+		m.ResetRentFee()
+		return nil
+	case order.FieldFeeUsd:
+		m.ResetFeeUsd()
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Order unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Order edge %s", name)
+}
+
+// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
+type SettingsMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	userId             *int64
+	adduserId          *int64
+	maxRetries         *int64
+	addmaxRetries      *int64
+	slippageBps        *int
+	addslippageBps     *int
+	sellSlippageBps    *int
+	addsellSlippageBps *int
+	exitSlippageBps    *int
+	addexitSlippageBps *int
+	maxLamports        *int64
+	addmaxLamports     *int64
+	priorityLevel      *settings.PriorityLevel
+	dexAggregator      *settings.DexAggregator
+	aggregatorPriority *string
+	txSender           *settings.TxSender
+	jitoTip            *int64
+	addjitoTip         *int64
+	exitJitoTip        *int64
+	addexitJitoTip     *int64
+	simulateTx         *bool
+	skipExitSimulation *bool
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Settings, error)
+	predicates         []predicate.Settings
+}
+
+var _ ent.Mutation = (*SettingsMutation)(nil)
+
+// settingsOption allows management of the mutation configuration using functional options.
+type settingsOption func(*SettingsMutation)
+
+// newSettingsMutation creates new mutation for the Settings entity.
+func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
+	m := &SettingsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingsID sets the ID field of the mutation.
+func withSettingsID(id int) settingsOption {
+	return func(m *SettingsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settings
+		)
+		m.oldValue = func(ctx context.Context) (*Settings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettings sets the old Settings of the mutation.
+func withSettings(node *Settings) settingsOption {
+	return func(m *SettingsMutation) {
+		m.oldValue = func(context.Context) (*Settings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SettingsMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SettingsMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SettingsMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SettingsMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SettingsMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SettingsMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *SettingsMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *SettingsMutation) UserId() (r int64, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUserId(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *SettingsMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *SettingsMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *SettingsMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetMaxRetries sets the "maxRetries" field.
+func (m *SettingsMutation) SetMaxRetries(i int64) {
+	m.maxRetries = &i
+	m.addmaxRetries = nil
+}
+
+// MaxRetries returns the value of the "maxRetries" field in the mutation.
+func (m *SettingsMutation) MaxRetries() (r int64, exists bool) {
+	v := m.maxRetries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRetries returns the old "maxRetries" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldMaxRetries(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRetries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRetries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRetries: %w", err)
+	}
+	return oldValue.MaxRetries, nil
+}
+
+// AddMaxRetries adds i to the "maxRetries" field.
+func (m *SettingsMutation) AddMaxRetries(i int64) {
+	if m.addmaxRetries != nil {
+		*m.addmaxRetries += i
+	} else {
+		m.addmaxRetries = &i
+	}
+}
+
+// AddedMaxRetries returns the value that was added to the "maxRetries" field in this mutation.
+func (m *SettingsMutation) AddedMaxRetries() (r int64, exists bool) {
+	v := m.addmaxRetries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxRetries resets all changes to the "maxRetries" field.
+func (m *SettingsMutation) ResetMaxRetries() {
+	m.maxRetries = nil
+	m.addmaxRetries = nil
+}
+
+// SetSlippageBps sets the "slippageBps" field.
+func (m *SettingsMutation) SetSlippageBps(i int) {
+	m.slippageBps = &i
+	m.addslippageBps = nil
+}
+
+// SlippageBps returns the value of the "slippageBps" field in the mutation.
+func (m *SettingsMutation) SlippageBps() (r int, exists bool) {
+	v := m.slippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlippageBps returns the old "slippageBps" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldSlippageBps(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlippageBps: %w", err)
+	}
+	return oldValue.SlippageBps, nil
+}
+
+// AddSlippageBps adds i to the "slippageBps" field.
+func (m *SettingsMutation) AddSlippageBps(i int) {
+	if m.addslippageBps != nil {
+		*m.addslippageBps += i
+	} else {
+		m.addslippageBps = &i
+	}
+}
+
+// AddedSlippageBps returns the value that was added to the "slippageBps" field in this mutation.
+func (m *SettingsMutation) AddedSlippageBps() (r int, exists bool) {
+	v := m.addslippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSlippageBps resets all changes to the "slippageBps" field.
+func (m *SettingsMutation) ResetSlippageBps() {
+	m.slippageBps = nil
+	m.addslippageBps = nil
+}
+
+// SetSellSlippageBps sets the "sellSlippageBps" field.
+func (m *SettingsMutation) SetSellSlippageBps(i int) {
+	m.sellSlippageBps = &i
+	m.addsellSlippageBps = nil
+}
+
+// SellSlippageBps returns the value of the "sellSlippageBps" field in the mutation.
+func (m *SettingsMutation) SellSlippageBps() (r int, exists bool) {
+	v := m.sellSlippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellSlippageBps returns the old "sellSlippageBps" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldSellSlippageBps(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellSlippageBps: %w", err)
+	}
+	return oldValue.SellSlippageBps, nil
+}
+
+// AddSellSlippageBps adds i to the "sellSlippageBps" field.
+func (m *SettingsMutation) AddSellSlippageBps(i int) {
+	if m.addsellSlippageBps != nil {
+		*m.addsellSlippageBps += i
+	} else {
+		m.addsellSlippageBps = &i
+	}
+}
+
+// AddedSellSlippageBps returns the value that was added to the "sellSlippageBps" field in this mutation.
 func (m *SettingsMutation) AddedSellSlippageBps() (r int, exists bool) {
 	v := m.addsellSlippageBps
 	if v == nil {
@@ -2822,233 +3588,3734 @@ func (m *SettingsMutation) AddedSellSlippageBps() (r int, exists bool) {
 	return *v, true
 }
 
-// ClearSellSlippageBps clears the value of the "sellSlippageBps" field.
-func (m *SettingsMutation) ClearSellSlippageBps() {
-	m.sellSlippageBps = nil
-	m.addsellSlippageBps = nil
-	m.clearedFields[settings.FieldSellSlippageBps] = struct{}{}
+// ClearSellSlippageBps clears the value of the "sellSlippageBps" field.
+func (m *SettingsMutation) ClearSellSlippageBps() {
+	m.sellSlippageBps = nil
+	m.addsellSlippageBps = nil
+	m.clearedFields[settings.FieldSellSlippageBps] = struct{}{}
+}
+
+// SellSlippageBpsCleared returns if the "sellSlippageBps" field was cleared in this mutation.
+func (m *SettingsMutation) SellSlippageBpsCleared() bool {
+	_, ok := m.clearedFields[settings.FieldSellSlippageBps]
+	return ok
+}
+
+// ResetSellSlippageBps resets all changes to the "sellSlippageBps" field.
+func (m *SettingsMutation) ResetSellSlippageBps() {
+	m.sellSlippageBps = nil
+	m.addsellSlippageBps = nil
+	delete(m.clearedFields, settings.FieldSellSlippageBps)
+}
+
+// SetExitSlippageBps sets the "exitSlippageBps" field.
+func (m *SettingsMutation) SetExitSlippageBps(i int) {
+	m.exitSlippageBps = &i
+	m.addexitSlippageBps = nil
+}
+
+// ExitSlippageBps returns the value of the "exitSlippageBps" field in the mutation.
+func (m *SettingsMutation) ExitSlippageBps() (r int, exists bool) {
+	v := m.exitSlippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitSlippageBps returns the old "exitSlippageBps" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldExitSlippageBps(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitSlippageBps: %w", err)
+	}
+	return oldValue.ExitSlippageBps, nil
+}
+
+// AddExitSlippageBps adds i to the "exitSlippageBps" field.
+func (m *SettingsMutation) AddExitSlippageBps(i int) {
+	if m.addexitSlippageBps != nil {
+		*m.addexitSlippageBps += i
+	} else {
+		m.addexitSlippageBps = &i
+	}
+}
+
+// AddedExitSlippageBps returns the value that was added to the "exitSlippageBps" field in this mutation.
+func (m *SettingsMutation) AddedExitSlippageBps() (r int, exists bool) {
+	v := m.addexitSlippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExitSlippageBps clears the value of the "exitSlippageBps" field.
+func (m *SettingsMutation) ClearExitSlippageBps() {
+	m.exitSlippageBps = nil
+	m.addexitSlippageBps = nil
+	m.clearedFields[settings.FieldExitSlippageBps] = struct{}{}
+}
+
+// ExitSlippageBpsCleared returns if the "exitSlippageBps" field was cleared in this mutation.
+func (m *SettingsMutation) ExitSlippageBpsCleared() bool {
+	_, ok := m.clearedFields[settings.FieldExitSlippageBps]
+	return ok
+}
+
+// ResetExitSlippageBps resets all changes to the "exitSlippageBps" field.
+func (m *SettingsMutation) ResetExitSlippageBps() {
+	m.exitSlippageBps = nil
+	m.addexitSlippageBps = nil
+	delete(m.clearedFields, settings.FieldExitSlippageBps)
+}
+
+// SetMaxLamports sets the "maxLamports" field.
+func (m *SettingsMutation) SetMaxLamports(i int64) {
+	m.maxLamports = &i
+	m.addmaxLamports = nil
+}
+
+// MaxLamports returns the value of the "maxLamports" field in the mutation.
+func (m *SettingsMutation) MaxLamports() (r int64, exists bool) {
+	v := m.maxLamports
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLamports returns the old "maxLamports" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldMaxLamports(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLamports is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLamports requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLamports: %w", err)
+	}
+	return oldValue.MaxLamports, nil
+}
+
+// AddMaxLamports adds i to the "maxLamports" field.
+func (m *SettingsMutation) AddMaxLamports(i int64) {
+	if m.addmaxLamports != nil {
+		*m.addmaxLamports += i
+	} else {
+		m.addmaxLamports = &i
+	}
+}
+
+// AddedMaxLamports returns the value that was added to the "maxLamports" field in this mutation.
+func (m *SettingsMutation) AddedMaxLamports() (r int64, exists bool) {
+	v := m.addmaxLamports
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxLamports resets all changes to the "maxLamports" field.
+func (m *SettingsMutation) ResetMaxLamports() {
+	m.maxLamports = nil
+	m.addmaxLamports = nil
+}
+
+// SetPriorityLevel sets the "priorityLevel" field.
+func (m *SettingsMutation) SetPriorityLevel(sl settings.PriorityLevel) {
+	m.priorityLevel = &sl
+}
+
+// PriorityLevel returns the value of the "priorityLevel" field in the mutation.
+func (m *SettingsMutation) PriorityLevel() (r settings.PriorityLevel, exists bool) {
+	v := m.priorityLevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriorityLevel returns the old "priorityLevel" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldPriorityLevel(ctx context.Context) (v settings.PriorityLevel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriorityLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriorityLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriorityLevel: %w", err)
+	}
+	return oldValue.PriorityLevel, nil
+}
+
+// ResetPriorityLevel resets all changes to the "priorityLevel" field.
+func (m *SettingsMutation) ResetPriorityLevel() {
+	m.priorityLevel = nil
+}
+
+// SetDexAggregator sets the "dexAggregator" field.
+func (m *SettingsMutation) SetDexAggregator(sa settings.DexAggregator) {
+	m.dexAggregator = &sa
+}
+
+// DexAggregator returns the value of the "dexAggregator" field in the mutation.
+func (m *SettingsMutation) DexAggregator() (r settings.DexAggregator, exists bool) {
+	v := m.dexAggregator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDexAggregator returns the old "dexAggregator" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldDexAggregator(ctx context.Context) (v settings.DexAggregator, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDexAggregator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDexAggregator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDexAggregator: %w", err)
+	}
+	return oldValue.DexAggregator, nil
+}
+
+// ResetDexAggregator resets all changes to the "dexAggregator" field.
+func (m *SettingsMutation) ResetDexAggregator() {
+	m.dexAggregator = nil
+}
+
+// SetAggregatorPriority sets the "aggregatorPriority" field.
+func (m *SettingsMutation) SetAggregatorPriority(s string) {
+	m.aggregatorPriority = &s
+}
+
+// AggregatorPriority returns the value of the "aggregatorPriority" field in the mutation.
+func (m *SettingsMutation) AggregatorPriority() (r string, exists bool) {
+	v := m.aggregatorPriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregatorPriority returns the old "aggregatorPriority" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldAggregatorPriority(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregatorPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregatorPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregatorPriority: %w", err)
+	}
+	return oldValue.AggregatorPriority, nil
+}
+
+// ClearAggregatorPriority clears the value of the "aggregatorPriority" field.
+func (m *SettingsMutation) ClearAggregatorPriority() {
+	m.aggregatorPriority = nil
+	m.clearedFields[settings.FieldAggregatorPriority] = struct{}{}
+}
+
+// AggregatorPriorityCleared returns if the "aggregatorPriority" field was cleared in this mutation.
+func (m *SettingsMutation) AggregatorPriorityCleared() bool {
+	_, ok := m.clearedFields[settings.FieldAggregatorPriority]
+	return ok
+}
+
+// ResetAggregatorPriority resets all changes to the "aggregatorPriority" field.
+func (m *SettingsMutation) ResetAggregatorPriority() {
+	m.aggregatorPriority = nil
+	delete(m.clearedFields, settings.FieldAggregatorPriority)
+}
+
+// SetTxSender sets the "txSender" field.
+func (m *SettingsMutation) SetTxSender(ss settings.TxSender) {
+	m.txSender = &ss
+}
+
+// TxSender returns the value of the "txSender" field in the mutation.
+func (m *SettingsMutation) TxSender() (r settings.TxSender, exists bool) {
+	v := m.txSender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxSender returns the old "txSender" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldTxSender(ctx context.Context) (v settings.TxSender, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxSender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxSender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxSender: %w", err)
+	}
+	return oldValue.TxSender, nil
+}
+
+// ResetTxSender resets all changes to the "txSender" field.
+func (m *SettingsMutation) ResetTxSender() {
+	m.txSender = nil
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (m *SettingsMutation) SetJitoTip(i int64) {
+	m.jitoTip = &i
+	m.addjitoTip = nil
+}
+
+// JitoTip returns the value of the "jitoTip" field in the mutation.
+func (m *SettingsMutation) JitoTip() (r int64, exists bool) {
+	v := m.jitoTip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJitoTip returns the old "jitoTip" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldJitoTip(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJitoTip is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJitoTip requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJitoTip: %w", err)
+	}
+	return oldValue.JitoTip, nil
+}
+
+// AddJitoTip adds i to the "jitoTip" field.
+func (m *SettingsMutation) AddJitoTip(i int64) {
+	if m.addjitoTip != nil {
+		*m.addjitoTip += i
+	} else {
+		m.addjitoTip = &i
+	}
+}
+
+// AddedJitoTip returns the value that was added to the "jitoTip" field in this mutation.
+func (m *SettingsMutation) AddedJitoTip() (r int64, exists bool) {
+	v := m.addjitoTip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearJitoTip clears the value of the "jitoTip" field.
+func (m *SettingsMutation) ClearJitoTip() {
+	m.jitoTip = nil
+	m.addjitoTip = nil
+	m.clearedFields[settings.FieldJitoTip] = struct{}{}
+}
+
+// JitoTipCleared returns if the "jitoTip" field was cleared in this mutation.
+func (m *SettingsMutation) JitoTipCleared() bool {
+	_, ok := m.clearedFields[settings.FieldJitoTip]
+	return ok
+}
+
+// ResetJitoTip resets all changes to the "jitoTip" field.
+func (m *SettingsMutation) ResetJitoTip() {
+	m.jitoTip = nil
+	m.addjitoTip = nil
+	delete(m.clearedFields, settings.FieldJitoTip)
+}
+
+// SetExitJitoTip sets the "exitJitoTip" field.
+func (m *SettingsMutation) SetExitJitoTip(i int64) {
+	m.exitJitoTip = &i
+	m.addexitJitoTip = nil
+}
+
+// ExitJitoTip returns the value of the "exitJitoTip" field in the mutation.
+func (m *SettingsMutation) ExitJitoTip() (r int64, exists bool) {
+	v := m.exitJitoTip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitJitoTip returns the old "exitJitoTip" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldExitJitoTip(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitJitoTip is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitJitoTip requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitJitoTip: %w", err)
+	}
+	return oldValue.ExitJitoTip, nil
+}
+
+// AddExitJitoTip adds i to the "exitJitoTip" field.
+func (m *SettingsMutation) AddExitJitoTip(i int64) {
+	if m.addexitJitoTip != nil {
+		*m.addexitJitoTip += i
+	} else {
+		m.addexitJitoTip = &i
+	}
+}
+
+// AddedExitJitoTip returns the value that was added to the "exitJitoTip" field in this mutation.
+func (m *SettingsMutation) AddedExitJitoTip() (r int64, exists bool) {
+	v := m.addexitJitoTip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExitJitoTip clears the value of the "exitJitoTip" field.
+func (m *SettingsMutation) ClearExitJitoTip() {
+	m.exitJitoTip = nil
+	m.addexitJitoTip = nil
+	m.clearedFields[settings.FieldExitJitoTip] = struct{}{}
+}
+
+// ExitJitoTipCleared returns if the "exitJitoTip" field was cleared in this mutation.
+func (m *SettingsMutation) ExitJitoTipCleared() bool {
+	_, ok := m.clearedFields[settings.FieldExitJitoTip]
+	return ok
+}
+
+// ResetExitJitoTip resets all changes to the "exitJitoTip" field.
+func (m *SettingsMutation) ResetExitJitoTip() {
+	m.exitJitoTip = nil
+	m.addexitJitoTip = nil
+	delete(m.clearedFields, settings.FieldExitJitoTip)
+}
+
+// SetSimulateTx sets the "simulateTx" field.
+func (m *SettingsMutation) SetSimulateTx(b bool) {
+	m.simulateTx = &b
+}
+
+// SimulateTx returns the value of the "simulateTx" field in the mutation.
+func (m *SettingsMutation) SimulateTx() (r bool, exists bool) {
+	v := m.simulateTx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSimulateTx returns the old "simulateTx" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldSimulateTx(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSimulateTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSimulateTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSimulateTx: %w", err)
+	}
+	return oldValue.SimulateTx, nil
+}
+
+// ResetSimulateTx resets all changes to the "simulateTx" field.
+func (m *SettingsMutation) ResetSimulateTx() {
+	m.simulateTx = nil
+}
+
+// SetSkipExitSimulation sets the "skipExitSimulation" field.
+func (m *SettingsMutation) SetSkipExitSimulation(b bool) {
+	m.skipExitSimulation = &b
+}
+
+// SkipExitSimulation returns the value of the "skipExitSimulation" field in the mutation.
+func (m *SettingsMutation) SkipExitSimulation() (r bool, exists bool) {
+	v := m.skipExitSimulation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipExitSimulation returns the old "skipExitSimulation" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldSkipExitSimulation(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipExitSimulation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipExitSimulation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipExitSimulation: %w", err)
+	}
+	return oldValue.SkipExitSimulation, nil
+}
+
+// ResetSkipExitSimulation resets all changes to the "skipExitSimulation" field.
+func (m *SettingsMutation) ResetSkipExitSimulation() {
+	m.skipExitSimulation = nil
+}
+
+// Where appends a list predicates to the SettingsMutation builder.
+func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settings, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settings).
+func (m *SettingsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingsMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.create_time != nil {
+		fields = append(fields, settings.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, settings.FieldUpdateTime)
+	}
+	if m.userId != nil {
+		fields = append(fields, settings.FieldUserId)
+	}
+	if m.maxRetries != nil {
+		fields = append(fields, settings.FieldMaxRetries)
+	}
+	if m.slippageBps != nil {
+		fields = append(fields, settings.FieldSlippageBps)
+	}
+	if m.sellSlippageBps != nil {
+		fields = append(fields, settings.FieldSellSlippageBps)
+	}
+	if m.exitSlippageBps != nil {
+		fields = append(fields, settings.FieldExitSlippageBps)
+	}
+	if m.maxLamports != nil {
+		fields = append(fields, settings.FieldMaxLamports)
+	}
+	if m.priorityLevel != nil {
+		fields = append(fields, settings.FieldPriorityLevel)
+	}
+	if m.dexAggregator != nil {
+		fields = append(fields, settings.FieldDexAggregator)
+	}
+	if m.aggregatorPriority != nil {
+		fields = append(fields, settings.FieldAggregatorPriority)
+	}
+	if m.txSender != nil {
+		fields = append(fields, settings.FieldTxSender)
+	}
+	if m.jitoTip != nil {
+		fields = append(fields, settings.FieldJitoTip)
+	}
+	if m.exitJitoTip != nil {
+		fields = append(fields, settings.FieldExitJitoTip)
+	}
+	if m.simulateTx != nil {
+		fields = append(fields, settings.FieldSimulateTx)
+	}
+	if m.skipExitSimulation != nil {
+		fields = append(fields, settings.FieldSkipExitSimulation)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case settings.FieldCreateTime:
+		return m.CreateTime()
+	case settings.FieldUpdateTime:
+		return m.UpdateTime()
+	case settings.FieldUserId:
+		return m.UserId()
+	case settings.FieldMaxRetries:
+		return m.MaxRetries()
+	case settings.FieldSlippageBps:
+		return m.SlippageBps()
+	case settings.FieldSellSlippageBps:
+		return m.SellSlippageBps()
+	case settings.FieldExitSlippageBps:
+		return m.ExitSlippageBps()
+	case settings.FieldMaxLamports:
+		return m.MaxLamports()
+	case settings.FieldPriorityLevel:
+		return m.PriorityLevel()
+	case settings.FieldDexAggregator:
+		return m.DexAggregator()
+	case settings.FieldAggregatorPriority:
+		return m.AggregatorPriority()
+	case settings.FieldTxSender:
+		return m.TxSender()
+	case settings.FieldJitoTip:
+		return m.JitoTip()
+	case settings.FieldExitJitoTip:
+		return m.ExitJitoTip()
+	case settings.FieldSimulateTx:
+		return m.SimulateTx()
+	case settings.FieldSkipExitSimulation:
+		return m.SkipExitSimulation()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case settings.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case settings.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case settings.FieldUserId:
+		return m.OldUserId(ctx)
+	case settings.FieldMaxRetries:
+		return m.OldMaxRetries(ctx)
+	case settings.FieldSlippageBps:
+		return m.OldSlippageBps(ctx)
+	case settings.FieldSellSlippageBps:
+		return m.OldSellSlippageBps(ctx)
+	case settings.FieldExitSlippageBps:
+		return m.OldExitSlippageBps(ctx)
+	case settings.FieldMaxLamports:
+		return m.OldMaxLamports(ctx)
+	case settings.FieldPriorityLevel:
+		return m.OldPriorityLevel(ctx)
+	case settings.FieldDexAggregator:
+		return m.OldDexAggregator(ctx)
+	case settings.FieldAggregatorPriority:
+		return m.OldAggregatorPriority(ctx)
+	case settings.FieldTxSender:
+		return m.OldTxSender(ctx)
+	case settings.FieldJitoTip:
+		return m.OldJitoTip(ctx)
+	case settings.FieldExitJitoTip:
+		return m.OldExitJitoTip(ctx)
+	case settings.FieldSimulateTx:
+		return m.OldSimulateTx(ctx)
+	case settings.FieldSkipExitSimulation:
+		return m.OldSkipExitSimulation(ctx)
+	}
+	return nil, fmt.Errorf("unknown Settings field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case settings.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case settings.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case settings.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case settings.FieldMaxRetries:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRetries(v)
+		return nil
+	case settings.FieldSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlippageBps(v)
+		return nil
+	case settings.FieldSellSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellSlippageBps(v)
+		return nil
+	case settings.FieldExitSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitSlippageBps(v)
+		return nil
+	case settings.FieldMaxLamports:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLamports(v)
+		return nil
+	case settings.FieldPriorityLevel:
+		v, ok := value.(settings.PriorityLevel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorityLevel(v)
+		return nil
+	case settings.FieldDexAggregator:
+		v, ok := value.(settings.DexAggregator)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDexAggregator(v)
+		return nil
+	case settings.FieldAggregatorPriority:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregatorPriority(v)
+		return nil
+	case settings.FieldTxSender:
+		v, ok := value.(settings.TxSender)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxSender(v)
+		return nil
+	case settings.FieldJitoTip:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJitoTip(v)
+		return nil
+	case settings.FieldExitJitoTip:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitJitoTip(v)
+		return nil
+	case settings.FieldSimulateTx:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSimulateTx(v)
+		return nil
+	case settings.FieldSkipExitSimulation:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipExitSimulation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settings field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingsMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, settings.FieldUserId)
+	}
+	if m.addmaxRetries != nil {
+		fields = append(fields, settings.FieldMaxRetries)
+	}
+	if m.addslippageBps != nil {
+		fields = append(fields, settings.FieldSlippageBps)
+	}
+	if m.addsellSlippageBps != nil {
+		fields = append(fields, settings.FieldSellSlippageBps)
+	}
+	if m.addexitSlippageBps != nil {
+		fields = append(fields, settings.FieldExitSlippageBps)
+	}
+	if m.addmaxLamports != nil {
+		fields = append(fields, settings.FieldMaxLamports)
+	}
+	if m.addjitoTip != nil {
+		fields = append(fields, settings.FieldJitoTip)
+	}
+	if m.addexitJitoTip != nil {
+		fields = append(fields, settings.FieldExitJitoTip)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case settings.FieldUserId:
+		return m.AddedUserId()
+	case settings.FieldMaxRetries:
+		return m.AddedMaxRetries()
+	case settings.FieldSlippageBps:
+		return m.AddedSlippageBps()
+	case settings.FieldSellSlippageBps:
+		return m.AddedSellSlippageBps()
+	case settings.FieldExitSlippageBps:
+		return m.AddedExitSlippageBps()
+	case settings.FieldMaxLamports:
+		return m.AddedMaxLamports()
+	case settings.FieldJitoTip:
+		return m.AddedJitoTip()
+	case settings.FieldExitJitoTip:
+		return m.AddedExitJitoTip()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case settings.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case settings.FieldMaxRetries:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRetries(v)
+		return nil
+	case settings.FieldSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSlippageBps(v)
+		return nil
+	case settings.FieldSellSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSellSlippageBps(v)
+		return nil
+	case settings.FieldExitSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitSlippageBps(v)
+		return nil
+	case settings.FieldMaxLamports:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLamports(v)
+		return nil
+	case settings.FieldJitoTip:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJitoTip(v)
+		return nil
+	case settings.FieldExitJitoTip:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitJitoTip(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settings numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(settings.FieldSellSlippageBps) {
+		fields = append(fields, settings.FieldSellSlippageBps)
+	}
+	if m.FieldCleared(settings.FieldExitSlippageBps) {
+		fields = append(fields, settings.FieldExitSlippageBps)
+	}
+	if m.FieldCleared(settings.FieldAggregatorPriority) {
+		fields = append(fields, settings.FieldAggregatorPriority)
+	}
+	if m.FieldCleared(settings.FieldJitoTip) {
+		fields = append(fields, settings.FieldJitoTip)
+	}
+	if m.FieldCleared(settings.FieldExitJitoTip) {
+		fields = append(fields, settings.FieldExitJitoTip)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingsMutation) ClearField(name string) error {
+	switch name {
+	case settings.FieldSellSlippageBps:
+		m.ClearSellSlippageBps()
+		return nil
+	case settings.FieldExitSlippageBps:
+		m.ClearExitSlippageBps()
+		return nil
+	case settings.FieldAggregatorPriority:
+		m.ClearAggregatorPriority()
+		return nil
+	case settings.FieldJitoTip:
+		m.ClearJitoTip()
+		return nil
+	case settings.FieldExitJitoTip:
+		m.ClearExitJitoTip()
+		return nil
+	}
+	return fmt.Errorf("unknown Settings nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingsMutation) ResetField(name string) error {
+	switch name {
+	case settings.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case settings.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case settings.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case settings.FieldMaxRetries:
+		m.ResetMaxRetries()
+		return nil
+	case settings.FieldSlippageBps:
+		m.ResetSlippageBps()
+		return nil
+	case settings.FieldSellSlippageBps:
+		m.ResetSellSlippageBps()
+		return nil
+	case settings.FieldExitSlippageBps:
+		m.ResetExitSlippageBps()
+		return nil
+	case settings.FieldMaxLamports:
+		m.ResetMaxLamports()
+		return nil
+	case settings.FieldPriorityLevel:
+		m.ResetPriorityLevel()
+		return nil
+	case settings.FieldDexAggregator:
+		m.ResetDexAggregator()
+		return nil
+	case settings.FieldAggregatorPriority:
+		m.ResetAggregatorPriority()
+		return nil
+	case settings.FieldTxSender:
+		m.ResetTxSender()
+		return nil
+	case settings.FieldJitoTip:
+		m.ResetJitoTip()
+		return nil
+	case settings.FieldExitJitoTip:
+		m.ResetExitJitoTip()
+		return nil
+	case settings.FieldSimulateTx:
+		m.ResetSimulateTx()
+		return nil
+	case settings.FieldSkipExitSimulation:
+		m.ResetSkipExitSimulation()
+		return nil
+	}
+	return fmt.Errorf("unknown Settings field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Settings unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Settings edge %s", name)
+}
+
+// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
+type StrategyMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	create_time                 *time.Time
+	update_time                 *time.Time
+	guid                        *string
+	userId                      *int64
+	adduserId                   *int64
+	account                     *string
+	token                       *string
+	symbol                      *string
+	_type                       *strategy.Type
+	martinFactor                *float64
+	addmartinFactor             *float64
+	maxGridLimit                *int
+	addmaxGridLimit             *int
+	takeProfitRatio             *decimal.Decimal
+	upperPriceBound             *decimal.Decimal
+	lowerPriceBound             *decimal.Decimal
+	gridMode                    *strategy.GridMode
+	gridCount                   *int
+	addgridCount                *int
+	gridStep                    *decimal.Decimal
+	initialOrderSize            *decimal.Decimal
+	lastKlineVolume             *decimal.Decimal
+	fiveKlineVolume             *decimal.Decimal
+	firstOrderId                *int
+	addfirstOrderId             *int
+	upperBoundExit              *decimal.Decimal
+	stopLossExit                *decimal.Decimal
+	takeProfitExit              *decimal.Decimal
+	globalTakeProfitRatio       *decimal.Decimal
+	dynamicStopLoss             *bool
+	paperTrading                *bool
+	dropOn                      *bool
+	candlesToCheck              *int
+	addcandlesToCheck           *int
+	dropThreshold               *decimal.Decimal
+	trailingOn                  *bool
+	trailingCandles             *int
+	addtrailingCandles          *int
+	trailingMaxShifts           *int
+	addtrailingMaxShifts        *int
+	trailingShifts              *int
+	addtrailingShifts           *int
+	buyConditions               *string
+	dcaInterval                 *int
+	adddcaInterval              *int
+	dcaDropRatio                *decimal.Decimal
+	dcaMaxOrders                *int
+	adddcaMaxOrders             *int
+	dcaSellInterval             *int
+	adddcaSellInterval          *int
+	dcaSellProfitRatio          *decimal.Decimal
+	dcaLastBuyTime              *time.Time
+	dcaLastBuyPrice             *decimal.Decimal
+	dcaLastSellTime             *time.Time
+	enableAutoBuy               *bool
+	enableAutoSell              *bool
+	enableAutoExit              *bool
+	enablePushNotification      *bool
+	status                      *strategy.Status
+	gridTrend                   *string
+	lastLowerThresholdAlertTime *time.Time
+	lastUpperThresholdAlertTime *time.Time
+	clearedFields               map[string]struct{}
+	done                        bool
+	oldValue                    func(context.Context) (*Strategy, error)
+	predicates                  []predicate.Strategy
+}
+
+var _ ent.Mutation = (*StrategyMutation)(nil)
+
+// strategyOption allows management of the mutation configuration using functional options.
+type strategyOption func(*StrategyMutation)
+
+// newStrategyMutation creates new mutation for the Strategy entity.
+func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
+	m := &StrategyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStrategyID sets the ID field of the mutation.
+func withStrategyID(id int) strategyOption {
+	return func(m *StrategyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Strategy
+		)
+		m.oldValue = func(ctx context.Context) (*Strategy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Strategy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStrategy sets the old Strategy of the mutation.
+func withStrategy(node *Strategy) strategyOption {
+	return func(m *StrategyMutation) {
+		m.oldValue = func(context.Context) (*Strategy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *StrategyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *StrategyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *StrategyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *StrategyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetGUID sets the "guid" field.
+func (m *StrategyMutation) SetGUID(s string) {
+	m.guid = &s
+}
+
+// GUID returns the value of the "guid" field in the mutation.
+func (m *StrategyMutation) GUID() (r string, exists bool) {
+	v := m.guid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGUID returns the old "guid" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
+	}
+	return oldValue.GUID, nil
+}
+
+// ResetGUID resets all changes to the "guid" field.
+func (m *StrategyMutation) ResetGUID() {
+	m.guid = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *StrategyMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *StrategyMutation) UserId() (r int64, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUserId(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *StrategyMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *StrategyMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *StrategyMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *StrategyMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *StrategyMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ClearAccount clears the value of the "account" field.
+func (m *StrategyMutation) ClearAccount() {
+	m.account = nil
+	m.clearedFields[strategy.FieldAccount] = struct{}{}
+}
+
+// AccountCleared returns if the "account" field was cleared in this mutation.
+func (m *StrategyMutation) AccountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAccount]
+	return ok
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *StrategyMutation) ResetAccount() {
+	m.account = nil
+	delete(m.clearedFields, strategy.FieldAccount)
+}
+
+// SetToken sets the "token" field.
+func (m *StrategyMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *StrategyMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *StrategyMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *StrategyMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *StrategyMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *StrategyMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetType sets the "type" field.
+func (m *StrategyMutation) SetType(s strategy.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *StrategyMutation) GetType() (r strategy.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldType(ctx context.Context) (v strategy.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *StrategyMutation) ResetType() {
+	m._type = nil
+}
+
+// SetMartinFactor sets the "martinFactor" field.
+func (m *StrategyMutation) SetMartinFactor(f float64) {
+	m.martinFactor = &f
+	m.addmartinFactor = nil
+}
+
+// MartinFactor returns the value of the "martinFactor" field in the mutation.
+func (m *StrategyMutation) MartinFactor() (r float64, exists bool) {
+	v := m.martinFactor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMartinFactor returns the old "martinFactor" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMartinFactor(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMartinFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMartinFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMartinFactor: %w", err)
+	}
+	return oldValue.MartinFactor, nil
+}
+
+// AddMartinFactor adds f to the "martinFactor" field.
+func (m *StrategyMutation) AddMartinFactor(f float64) {
+	if m.addmartinFactor != nil {
+		*m.addmartinFactor += f
+	} else {
+		m.addmartinFactor = &f
+	}
+}
+
+// AddedMartinFactor returns the value that was added to the "martinFactor" field in this mutation.
+func (m *StrategyMutation) AddedMartinFactor() (r float64, exists bool) {
+	v := m.addmartinFactor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMartinFactor resets all changes to the "martinFactor" field.
+func (m *StrategyMutation) ResetMartinFactor() {
+	m.martinFactor = nil
+	m.addmartinFactor = nil
+}
+
+// SetMaxGridLimit sets the "maxGridLimit" field.
+func (m *StrategyMutation) SetMaxGridLimit(i int) {
+	m.maxGridLimit = &i
+	m.addmaxGridLimit = nil
+}
+
+// MaxGridLimit returns the value of the "maxGridLimit" field in the mutation.
+func (m *StrategyMutation) MaxGridLimit() (r int, exists bool) {
+	v := m.maxGridLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxGridLimit returns the old "maxGridLimit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMaxGridLimit(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxGridLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxGridLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxGridLimit: %w", err)
+	}
+	return oldValue.MaxGridLimit, nil
+}
+
+// AddMaxGridLimit adds i to the "maxGridLimit" field.
+func (m *StrategyMutation) AddMaxGridLimit(i int) {
+	if m.addmaxGridLimit != nil {
+		*m.addmaxGridLimit += i
+	} else {
+		m.addmaxGridLimit = &i
+	}
+}
+
+// AddedMaxGridLimit returns the value that was added to the "maxGridLimit" field in this mutation.
+func (m *StrategyMutation) AddedMaxGridLimit() (r int, exists bool) {
+	v := m.addmaxGridLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxGridLimit clears the value of the "maxGridLimit" field.
+func (m *StrategyMutation) ClearMaxGridLimit() {
+	m.maxGridLimit = nil
+	m.addmaxGridLimit = nil
+	m.clearedFields[strategy.FieldMaxGridLimit] = struct{}{}
+}
+
+// MaxGridLimitCleared returns if the "maxGridLimit" field was cleared in this mutation.
+func (m *StrategyMutation) MaxGridLimitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldMaxGridLimit]
+	return ok
+}
+
+// ResetMaxGridLimit resets all changes to the "maxGridLimit" field.
+func (m *StrategyMutation) ResetMaxGridLimit() {
+	m.maxGridLimit = nil
+	m.addmaxGridLimit = nil
+	delete(m.clearedFields, strategy.FieldMaxGridLimit)
+}
+
+// SetTakeProfitRatio sets the "takeProfitRatio" field.
+func (m *StrategyMutation) SetTakeProfitRatio(d decimal.Decimal) {
+	m.takeProfitRatio = &d
+}
+
+// TakeProfitRatio returns the value of the "takeProfitRatio" field in the mutation.
+func (m *StrategyMutation) TakeProfitRatio() (r decimal.Decimal, exists bool) {
+	v := m.takeProfitRatio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakeProfitRatio returns the old "takeProfitRatio" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTakeProfitRatio(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakeProfitRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakeProfitRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakeProfitRatio: %w", err)
+	}
+	return oldValue.TakeProfitRatio, nil
+}
+
+// ResetTakeProfitRatio resets all changes to the "takeProfitRatio" field.
+func (m *StrategyMutation) ResetTakeProfitRatio() {
+	m.takeProfitRatio = nil
+}
+
+// SetUpperPriceBound sets the "upperPriceBound" field.
+func (m *StrategyMutation) SetUpperPriceBound(d decimal.Decimal) {
+	m.upperPriceBound = &d
+}
+
+// UpperPriceBound returns the value of the "upperPriceBound" field in the mutation.
+func (m *StrategyMutation) UpperPriceBound() (r decimal.Decimal, exists bool) {
+	v := m.upperPriceBound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpperPriceBound returns the old "upperPriceBound" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUpperPriceBound(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpperPriceBound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpperPriceBound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpperPriceBound: %w", err)
+	}
+	return oldValue.UpperPriceBound, nil
+}
+
+// ResetUpperPriceBound resets all changes to the "upperPriceBound" field.
+func (m *StrategyMutation) ResetUpperPriceBound() {
+	m.upperPriceBound = nil
+}
+
+// SetLowerPriceBound sets the "lowerPriceBound" field.
+func (m *StrategyMutation) SetLowerPriceBound(d decimal.Decimal) {
+	m.lowerPriceBound = &d
+}
+
+// LowerPriceBound returns the value of the "lowerPriceBound" field in the mutation.
+func (m *StrategyMutation) LowerPriceBound() (r decimal.Decimal, exists bool) {
+	v := m.lowerPriceBound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLowerPriceBound returns the old "lowerPriceBound" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldLowerPriceBound(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLowerPriceBound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLowerPriceBound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLowerPriceBound: %w", err)
+	}
+	return oldValue.LowerPriceBound, nil
+}
+
+// ResetLowerPriceBound resets all changes to the "lowerPriceBound" field.
+func (m *StrategyMutation) ResetLowerPriceBound() {
+	m.lowerPriceBound = nil
+}
+
+// SetGridMode sets the "gridMode" field.
+func (m *StrategyMutation) SetGridMode(sm strategy.GridMode) {
+	m.gridMode = &sm
+}
+
+// GridMode returns the value of the "gridMode" field in the mutation.
+func (m *StrategyMutation) GridMode() (r strategy.GridMode, exists bool) {
+	v := m.gridMode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGridMode returns the old "gridMode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridMode(ctx context.Context) (v strategy.GridMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridMode: %w", err)
+	}
+	return oldValue.GridMode, nil
+}
+
+// ResetGridMode resets all changes to the "gridMode" field.
+func (m *StrategyMutation) ResetGridMode() {
+	m.gridMode = nil
+}
+
+// SetGridCount sets the "gridCount" field.
+func (m *StrategyMutation) SetGridCount(i int) {
+	m.gridCount = &i
+	m.addgridCount = nil
+}
+
+// GridCount returns the value of the "gridCount" field in the mutation.
+func (m *StrategyMutation) GridCount() (r int, exists bool) {
+	v := m.gridCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGridCount returns the old "gridCount" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridCount: %w", err)
+	}
+	return oldValue.GridCount, nil
+}
+
+// AddGridCount adds i to the "gridCount" field.
+func (m *StrategyMutation) AddGridCount(i int) {
+	if m.addgridCount != nil {
+		*m.addgridCount += i
+	} else {
+		m.addgridCount = &i
+	}
+}
+
+// AddedGridCount returns the value that was added to the "gridCount" field in this mutation.
+func (m *StrategyMutation) AddedGridCount() (r int, exists bool) {
+	v := m.addgridCount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGridCount clears the value of the "gridCount" field.
+func (m *StrategyMutation) ClearGridCount() {
+	m.gridCount = nil
+	m.addgridCount = nil
+	m.clearedFields[strategy.FieldGridCount] = struct{}{}
+}
+
+// GridCountCleared returns if the "gridCount" field was cleared in this mutation.
+func (m *StrategyMutation) GridCountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGridCount]
+	return ok
+}
+
+// ResetGridCount resets all changes to the "gridCount" field.
+func (m *StrategyMutation) ResetGridCount() {
+	m.gridCount = nil
+	m.addgridCount = nil
+	delete(m.clearedFields, strategy.FieldGridCount)
+}
+
+// SetGridStep sets the "gridStep" field.
+func (m *StrategyMutation) SetGridStep(d decimal.Decimal) {
+	m.gridStep = &d
+}
+
+// GridStep returns the value of the "gridStep" field in the mutation.
+func (m *StrategyMutation) GridStep() (r decimal.Decimal, exists bool) {
+	v := m.gridStep
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGridStep returns the old "gridStep" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridStep(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridStep: %w", err)
+	}
+	return oldValue.GridStep, nil
+}
+
+// ClearGridStep clears the value of the "gridStep" field.
+func (m *StrategyMutation) ClearGridStep() {
+	m.gridStep = nil
+	m.clearedFields[strategy.FieldGridStep] = struct{}{}
+}
+
+// GridStepCleared returns if the "gridStep" field was cleared in this mutation.
+func (m *StrategyMutation) GridStepCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGridStep]
+	return ok
+}
+
+// ResetGridStep resets all changes to the "gridStep" field.
+func (m *StrategyMutation) ResetGridStep() {
+	m.gridStep = nil
+	delete(m.clearedFields, strategy.FieldGridStep)
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (m *StrategyMutation) SetInitialOrderSize(d decimal.Decimal) {
+	m.initialOrderSize = &d
+}
+
+// InitialOrderSize returns the value of the "initialOrderSize" field in the mutation.
+func (m *StrategyMutation) InitialOrderSize() (r decimal.Decimal, exists bool) {
+	v := m.initialOrderSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialOrderSize returns the old "initialOrderSize" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldInitialOrderSize(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitialOrderSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialOrderSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialOrderSize: %w", err)
+	}
+	return oldValue.InitialOrderSize, nil
+}
+
+// ResetInitialOrderSize resets all changes to the "initialOrderSize" field.
+func (m *StrategyMutation) ResetInitialOrderSize() {
+	m.initialOrderSize = nil
+}
+
+// SetLastKlineVolume sets the "lastKlineVolume" field.
+func (m *StrategyMutation) SetLastKlineVolume(d decimal.Decimal) {
+	m.lastKlineVolume = &d
+}
+
+// LastKlineVolume returns the value of the "lastKlineVolume" field in the mutation.
+func (m *StrategyMutation) LastKlineVolume() (r decimal.Decimal, exists bool) {
+	v := m.lastKlineVolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastKlineVolume returns the old "lastKlineVolume" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldLastKlineVolume(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastKlineVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastKlineVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastKlineVolume: %w", err)
+	}
+	return oldValue.LastKlineVolume, nil
+}
+
+// ClearLastKlineVolume clears the value of the "lastKlineVolume" field.
+func (m *StrategyMutation) ClearLastKlineVolume() {
+	m.lastKlineVolume = nil
+	m.clearedFields[strategy.FieldLastKlineVolume] = struct{}{}
+}
+
+// LastKlineVolumeCleared returns if the "lastKlineVolume" field was cleared in this mutation.
+func (m *StrategyMutation) LastKlineVolumeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldLastKlineVolume]
+	return ok
+}
+
+// ResetLastKlineVolume resets all changes to the "lastKlineVolume" field.
+func (m *StrategyMutation) ResetLastKlineVolume() {
+	m.lastKlineVolume = nil
+	delete(m.clearedFields, strategy.FieldLastKlineVolume)
+}
+
+// SetFiveKlineVolume sets the "fiveKlineVolume" field.
+func (m *StrategyMutation) SetFiveKlineVolume(d decimal.Decimal) {
+	m.fiveKlineVolume = &d
+}
+
+// FiveKlineVolume returns the value of the "fiveKlineVolume" field in the mutation.
+func (m *StrategyMutation) FiveKlineVolume() (r decimal.Decimal, exists bool) {
+	v := m.fiveKlineVolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFiveKlineVolume returns the old "fiveKlineVolume" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldFiveKlineVolume(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFiveKlineVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFiveKlineVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFiveKlineVolume: %w", err)
+	}
+	return oldValue.FiveKlineVolume, nil
+}
+
+// ClearFiveKlineVolume clears the value of the "fiveKlineVolume" field.
+func (m *StrategyMutation) ClearFiveKlineVolume() {
+	m.fiveKlineVolume = nil
+	m.clearedFields[strategy.FieldFiveKlineVolume] = struct{}{}
+}
+
+// FiveKlineVolumeCleared returns if the "fiveKlineVolume" field was cleared in this mutation.
+func (m *StrategyMutation) FiveKlineVolumeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldFiveKlineVolume]
+	return ok
+}
+
+// ResetFiveKlineVolume resets all changes to the "fiveKlineVolume" field.
+func (m *StrategyMutation) ResetFiveKlineVolume() {
+	m.fiveKlineVolume = nil
+	delete(m.clearedFields, strategy.FieldFiveKlineVolume)
+}
+
+// SetFirstOrderId sets the "firstOrderId" field.
+func (m *StrategyMutation) SetFirstOrderId(i int) {
+	m.firstOrderId = &i
+	m.addfirstOrderId = nil
+}
+
+// FirstOrderId returns the value of the "firstOrderId" field in the mutation.
+func (m *StrategyMutation) FirstOrderId() (r int, exists bool) {
+	v := m.firstOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstOrderId returns the old "firstOrderId" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldFirstOrderId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstOrderId: %w", err)
+	}
+	return oldValue.FirstOrderId, nil
+}
+
+// AddFirstOrderId adds i to the "firstOrderId" field.
+func (m *StrategyMutation) AddFirstOrderId(i int) {
+	if m.addfirstOrderId != nil {
+		*m.addfirstOrderId += i
+	} else {
+		m.addfirstOrderId = &i
+	}
+}
+
+// AddedFirstOrderId returns the value that was added to the "firstOrderId" field in this mutation.
+func (m *StrategyMutation) AddedFirstOrderId() (r int, exists bool) {
+	v := m.addfirstOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFirstOrderId clears the value of the "firstOrderId" field.
+func (m *StrategyMutation) ClearFirstOrderId() {
+	m.firstOrderId = nil
+	m.addfirstOrderId = nil
+	m.clearedFields[strategy.FieldFirstOrderId] = struct{}{}
+}
+
+// FirstOrderIdCleared returns if the "firstOrderId" field was cleared in this mutation.
+func (m *StrategyMutation) FirstOrderIdCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldFirstOrderId]
+	return ok
+}
+
+// ResetFirstOrderId resets all changes to the "firstOrderId" field.
+func (m *StrategyMutation) ResetFirstOrderId() {
+	m.firstOrderId = nil
+	m.addfirstOrderId = nil
+	delete(m.clearedFields, strategy.FieldFirstOrderId)
+}
+
+// SetUpperBoundExit sets the "upperBoundExit" field.
+func (m *StrategyMutation) SetUpperBoundExit(d decimal.Decimal) {
+	m.upperBoundExit = &d
+}
+
+// UpperBoundExit returns the value of the "upperBoundExit" field in the mutation.
+func (m *StrategyMutation) UpperBoundExit() (r decimal.Decimal, exists bool) {
+	v := m.upperBoundExit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpperBoundExit returns the old "upperBoundExit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUpperBoundExit(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpperBoundExit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpperBoundExit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpperBoundExit: %w", err)
+	}
+	return oldValue.UpperBoundExit, nil
+}
+
+// ClearUpperBoundExit clears the value of the "upperBoundExit" field.
+func (m *StrategyMutation) ClearUpperBoundExit() {
+	m.upperBoundExit = nil
+	m.clearedFields[strategy.FieldUpperBoundExit] = struct{}{}
+}
+
+// UpperBoundExitCleared returns if the "upperBoundExit" field was cleared in this mutation.
+func (m *StrategyMutation) UpperBoundExitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldUpperBoundExit]
+	return ok
+}
+
+// ResetUpperBoundExit resets all changes to the "upperBoundExit" field.
+func (m *StrategyMutation) ResetUpperBoundExit() {
+	m.upperBoundExit = nil
+	delete(m.clearedFields, strategy.FieldUpperBoundExit)
+}
+
+// SetStopLossExit sets the "stopLossExit" field.
+func (m *StrategyMutation) SetStopLossExit(d decimal.Decimal) {
+	m.stopLossExit = &d
+}
+
+// StopLossExit returns the value of the "stopLossExit" field in the mutation.
+func (m *StrategyMutation) StopLossExit() (r decimal.Decimal, exists bool) {
+	v := m.stopLossExit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStopLossExit returns the old "stopLossExit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldStopLossExit(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStopLossExit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStopLossExit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStopLossExit: %w", err)
+	}
+	return oldValue.StopLossExit, nil
+}
+
+// ClearStopLossExit clears the value of the "stopLossExit" field.
+func (m *StrategyMutation) ClearStopLossExit() {
+	m.stopLossExit = nil
+	m.clearedFields[strategy.FieldStopLossExit] = struct{}{}
+}
+
+// StopLossExitCleared returns if the "stopLossExit" field was cleared in this mutation.
+func (m *StrategyMutation) StopLossExitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldStopLossExit]
+	return ok
+}
+
+// ResetStopLossExit resets all changes to the "stopLossExit" field.
+func (m *StrategyMutation) ResetStopLossExit() {
+	m.stopLossExit = nil
+	delete(m.clearedFields, strategy.FieldStopLossExit)
+}
+
+// SetTakeProfitExit sets the "takeProfitExit" field.
+func (m *StrategyMutation) SetTakeProfitExit(d decimal.Decimal) {
+	m.takeProfitExit = &d
+}
+
+// TakeProfitExit returns the value of the "takeProfitExit" field in the mutation.
+func (m *StrategyMutation) TakeProfitExit() (r decimal.Decimal, exists bool) {
+	v := m.takeProfitExit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakeProfitExit returns the old "takeProfitExit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTakeProfitExit(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakeProfitExit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakeProfitExit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakeProfitExit: %w", err)
+	}
+	return oldValue.TakeProfitExit, nil
+}
+
+// ClearTakeProfitExit clears the value of the "takeProfitExit" field.
+func (m *StrategyMutation) ClearTakeProfitExit() {
+	m.takeProfitExit = nil
+	m.clearedFields[strategy.FieldTakeProfitExit] = struct{}{}
+}
+
+// TakeProfitExitCleared returns if the "takeProfitExit" field was cleared in this mutation.
+func (m *StrategyMutation) TakeProfitExitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTakeProfitExit]
+	return ok
+}
+
+// ResetTakeProfitExit resets all changes to the "takeProfitExit" field.
+func (m *StrategyMutation) ResetTakeProfitExit() {
+	m.takeProfitExit = nil
+	delete(m.clearedFields, strategy.FieldTakeProfitExit)
+}
+
+// SetGlobalTakeProfitRatio sets the "globalTakeProfitRatio" field.
+func (m *StrategyMutation) SetGlobalTakeProfitRatio(d decimal.Decimal) {
+	m.globalTakeProfitRatio = &d
+}
+
+// GlobalTakeProfitRatio returns the value of the "globalTakeProfitRatio" field in the mutation.
+func (m *StrategyMutation) GlobalTakeProfitRatio() (r decimal.Decimal, exists bool) {
+	v := m.globalTakeProfitRatio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGlobalTakeProfitRatio returns the old "globalTakeProfitRatio" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGlobalTakeProfitRatio(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGlobalTakeProfitRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGlobalTakeProfitRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGlobalTakeProfitRatio: %w", err)
+	}
+	return oldValue.GlobalTakeProfitRatio, nil
+}
+
+// ClearGlobalTakeProfitRatio clears the value of the "globalTakeProfitRatio" field.
+func (m *StrategyMutation) ClearGlobalTakeProfitRatio() {
+	m.globalTakeProfitRatio = nil
+	m.clearedFields[strategy.FieldGlobalTakeProfitRatio] = struct{}{}
+}
+
+// GlobalTakeProfitRatioCleared returns if the "globalTakeProfitRatio" field was cleared in this mutation.
+func (m *StrategyMutation) GlobalTakeProfitRatioCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGlobalTakeProfitRatio]
+	return ok
+}
+
+// ResetGlobalTakeProfitRatio resets all changes to the "globalTakeProfitRatio" field.
+func (m *StrategyMutation) ResetGlobalTakeProfitRatio() {
+	m.globalTakeProfitRatio = nil
+	delete(m.clearedFields, strategy.FieldGlobalTakeProfitRatio)
+}
+
+// SetDynamicStopLoss sets the "dynamicStopLoss" field.
+func (m *StrategyMutation) SetDynamicStopLoss(b bool) {
+	m.dynamicStopLoss = &b
+}
+
+// DynamicStopLoss returns the value of the "dynamicStopLoss" field in the mutation.
+func (m *StrategyMutation) DynamicStopLoss() (r bool, exists bool) {
+	v := m.dynamicStopLoss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDynamicStopLoss returns the old "dynamicStopLoss" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDynamicStopLoss(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDynamicStopLoss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDynamicStopLoss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDynamicStopLoss: %w", err)
+	}
+	return oldValue.DynamicStopLoss, nil
+}
+
+// ClearDynamicStopLoss clears the value of the "dynamicStopLoss" field.
+func (m *StrategyMutation) ClearDynamicStopLoss() {
+	m.dynamicStopLoss = nil
+	m.clearedFields[strategy.FieldDynamicStopLoss] = struct{}{}
+}
+
+// DynamicStopLossCleared returns if the "dynamicStopLoss" field was cleared in this mutation.
+func (m *StrategyMutation) DynamicStopLossCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDynamicStopLoss]
+	return ok
+}
+
+// ResetDynamicStopLoss resets all changes to the "dynamicStopLoss" field.
+func (m *StrategyMutation) ResetDynamicStopLoss() {
+	m.dynamicStopLoss = nil
+	delete(m.clearedFields, strategy.FieldDynamicStopLoss)
+}
+
+// SetPaperTrading sets the "paperTrading" field.
+func (m *StrategyMutation) SetPaperTrading(b bool) {
+	m.paperTrading = &b
+}
+
+// PaperTrading returns the value of the "paperTrading" field in the mutation.
+func (m *StrategyMutation) PaperTrading() (r bool, exists bool) {
+	v := m.paperTrading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaperTrading returns the old "paperTrading" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldPaperTrading(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaperTrading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaperTrading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaperTrading: %w", err)
+	}
+	return oldValue.PaperTrading, nil
+}
+
+// ClearPaperTrading clears the value of the "paperTrading" field.
+func (m *StrategyMutation) ClearPaperTrading() {
+	m.paperTrading = nil
+	m.clearedFields[strategy.FieldPaperTrading] = struct{}{}
+}
+
+// PaperTradingCleared returns if the "paperTrading" field was cleared in this mutation.
+func (m *StrategyMutation) PaperTradingCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldPaperTrading]
+	return ok
+}
+
+// ResetPaperTrading resets all changes to the "paperTrading" field.
+func (m *StrategyMutation) ResetPaperTrading() {
+	m.paperTrading = nil
+	delete(m.clearedFields, strategy.FieldPaperTrading)
+}
+
+// SetDropOn sets the "dropOn" field.
+func (m *StrategyMutation) SetDropOn(b bool) {
+	m.dropOn = &b
+}
+
+// DropOn returns the value of the "dropOn" field in the mutation.
+func (m *StrategyMutation) DropOn() (r bool, exists bool) {
+	v := m.dropOn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDropOn returns the old "dropOn" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDropOn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDropOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDropOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDropOn: %w", err)
+	}
+	return oldValue.DropOn, nil
+}
+
+// ClearDropOn clears the value of the "dropOn" field.
+func (m *StrategyMutation) ClearDropOn() {
+	m.dropOn = nil
+	m.clearedFields[strategy.FieldDropOn] = struct{}{}
+}
+
+// DropOnCleared returns if the "dropOn" field was cleared in this mutation.
+func (m *StrategyMutation) DropOnCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDropOn]
+	return ok
+}
+
+// ResetDropOn resets all changes to the "dropOn" field.
+func (m *StrategyMutation) ResetDropOn() {
+	m.dropOn = nil
+	delete(m.clearedFields, strategy.FieldDropOn)
+}
+
+// SetCandlesToCheck sets the "candlesToCheck" field.
+func (m *StrategyMutation) SetCandlesToCheck(i int) {
+	m.candlesToCheck = &i
+	m.addcandlesToCheck = nil
+}
+
+// CandlesToCheck returns the value of the "candlesToCheck" field in the mutation.
+func (m *StrategyMutation) CandlesToCheck() (r int, exists bool) {
+	v := m.candlesToCheck
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCandlesToCheck returns the old "candlesToCheck" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCandlesToCheck(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCandlesToCheck is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCandlesToCheck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCandlesToCheck: %w", err)
+	}
+	return oldValue.CandlesToCheck, nil
+}
+
+// AddCandlesToCheck adds i to the "candlesToCheck" field.
+func (m *StrategyMutation) AddCandlesToCheck(i int) {
+	if m.addcandlesToCheck != nil {
+		*m.addcandlesToCheck += i
+	} else {
+		m.addcandlesToCheck = &i
+	}
+}
+
+// AddedCandlesToCheck returns the value that was added to the "candlesToCheck" field in this mutation.
+func (m *StrategyMutation) AddedCandlesToCheck() (r int, exists bool) {
+	v := m.addcandlesToCheck
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCandlesToCheck clears the value of the "candlesToCheck" field.
+func (m *StrategyMutation) ClearCandlesToCheck() {
+	m.candlesToCheck = nil
+	m.addcandlesToCheck = nil
+	m.clearedFields[strategy.FieldCandlesToCheck] = struct{}{}
+}
+
+// CandlesToCheckCleared returns if the "candlesToCheck" field was cleared in this mutation.
+func (m *StrategyMutation) CandlesToCheckCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldCandlesToCheck]
+	return ok
+}
+
+// ResetCandlesToCheck resets all changes to the "candlesToCheck" field.
+func (m *StrategyMutation) ResetCandlesToCheck() {
+	m.candlesToCheck = nil
+	m.addcandlesToCheck = nil
+	delete(m.clearedFields, strategy.FieldCandlesToCheck)
+}
+
+// SetDropThreshold sets the "dropThreshold" field.
+func (m *StrategyMutation) SetDropThreshold(d decimal.Decimal) {
+	m.dropThreshold = &d
+}
+
+// DropThreshold returns the value of the "dropThreshold" field in the mutation.
+func (m *StrategyMutation) DropThreshold() (r decimal.Decimal, exists bool) {
+	v := m.dropThreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDropThreshold returns the old "dropThreshold" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDropThreshold(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDropThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDropThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDropThreshold: %w", err)
+	}
+	return oldValue.DropThreshold, nil
+}
+
+// ClearDropThreshold clears the value of the "dropThreshold" field.
+func (m *StrategyMutation) ClearDropThreshold() {
+	m.dropThreshold = nil
+	m.clearedFields[strategy.FieldDropThreshold] = struct{}{}
+}
+
+// DropThresholdCleared returns if the "dropThreshold" field was cleared in this mutation.
+func (m *StrategyMutation) DropThresholdCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDropThreshold]
+	return ok
+}
+
+// ResetDropThreshold resets all changes to the "dropThreshold" field.
+func (m *StrategyMutation) ResetDropThreshold() {
+	m.dropThreshold = nil
+	delete(m.clearedFields, strategy.FieldDropThreshold)
+}
+
+// SetTrailingOn sets the "trailingOn" field.
+func (m *StrategyMutation) SetTrailingOn(b bool) {
+	m.trailingOn = &b
+}
+
+// TrailingOn returns the value of the "trailingOn" field in the mutation.
+func (m *StrategyMutation) TrailingOn() (r bool, exists bool) {
+	v := m.trailingOn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingOn returns the old "trailingOn" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingOn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingOn: %w", err)
+	}
+	return oldValue.TrailingOn, nil
+}
+
+// ClearTrailingOn clears the value of the "trailingOn" field.
+func (m *StrategyMutation) ClearTrailingOn() {
+	m.trailingOn = nil
+	m.clearedFields[strategy.FieldTrailingOn] = struct{}{}
+}
+
+// TrailingOnCleared returns if the "trailingOn" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingOnCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingOn]
+	return ok
+}
+
+// ResetTrailingOn resets all changes to the "trailingOn" field.
+func (m *StrategyMutation) ResetTrailingOn() {
+	m.trailingOn = nil
+	delete(m.clearedFields, strategy.FieldTrailingOn)
+}
+
+// SetTrailingCandles sets the "trailingCandles" field.
+func (m *StrategyMutation) SetTrailingCandles(i int) {
+	m.trailingCandles = &i
+	m.addtrailingCandles = nil
+}
+
+// TrailingCandles returns the value of the "trailingCandles" field in the mutation.
+func (m *StrategyMutation) TrailingCandles() (r int, exists bool) {
+	v := m.trailingCandles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingCandles returns the old "trailingCandles" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingCandles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingCandles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingCandles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingCandles: %w", err)
+	}
+	return oldValue.TrailingCandles, nil
+}
+
+// AddTrailingCandles adds i to the "trailingCandles" field.
+func (m *StrategyMutation) AddTrailingCandles(i int) {
+	if m.addtrailingCandles != nil {
+		*m.addtrailingCandles += i
+	} else {
+		m.addtrailingCandles = &i
+	}
+}
+
+// AddedTrailingCandles returns the value that was added to the "trailingCandles" field in this mutation.
+func (m *StrategyMutation) AddedTrailingCandles() (r int, exists bool) {
+	v := m.addtrailingCandles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrailingCandles clears the value of the "trailingCandles" field.
+func (m *StrategyMutation) ClearTrailingCandles() {
+	m.trailingCandles = nil
+	m.addtrailingCandles = nil
+	m.clearedFields[strategy.FieldTrailingCandles] = struct{}{}
+}
+
+// TrailingCandlesCleared returns if the "trailingCandles" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingCandlesCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingCandles]
+	return ok
+}
+
+// ResetTrailingCandles resets all changes to the "trailingCandles" field.
+func (m *StrategyMutation) ResetTrailingCandles() {
+	m.trailingCandles = nil
+	m.addtrailingCandles = nil
+	delete(m.clearedFields, strategy.FieldTrailingCandles)
+}
+
+// SetTrailingMaxShifts sets the "trailingMaxShifts" field.
+func (m *StrategyMutation) SetTrailingMaxShifts(i int) {
+	m.trailingMaxShifts = &i
+	m.addtrailingMaxShifts = nil
+}
+
+// TrailingMaxShifts returns the value of the "trailingMaxShifts" field in the mutation.
+func (m *StrategyMutation) TrailingMaxShifts() (r int, exists bool) {
+	v := m.trailingMaxShifts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingMaxShifts returns the old "trailingMaxShifts" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingMaxShifts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingMaxShifts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingMaxShifts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingMaxShifts: %w", err)
+	}
+	return oldValue.TrailingMaxShifts, nil
+}
+
+// AddTrailingMaxShifts adds i to the "trailingMaxShifts" field.
+func (m *StrategyMutation) AddTrailingMaxShifts(i int) {
+	if m.addtrailingMaxShifts != nil {
+		*m.addtrailingMaxShifts += i
+	} else {
+		m.addtrailingMaxShifts = &i
+	}
+}
+
+// AddedTrailingMaxShifts returns the value that was added to the "trailingMaxShifts" field in this mutation.
+func (m *StrategyMutation) AddedTrailingMaxShifts() (r int, exists bool) {
+	v := m.addtrailingMaxShifts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrailingMaxShifts clears the value of the "trailingMaxShifts" field.
+func (m *StrategyMutation) ClearTrailingMaxShifts() {
+	m.trailingMaxShifts = nil
+	m.addtrailingMaxShifts = nil
+	m.clearedFields[strategy.FieldTrailingMaxShifts] = struct{}{}
+}
+
+// TrailingMaxShiftsCleared returns if the "trailingMaxShifts" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingMaxShiftsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingMaxShifts]
+	return ok
+}
+
+// ResetTrailingMaxShifts resets all changes to the "trailingMaxShifts" field.
+func (m *StrategyMutation) ResetTrailingMaxShifts() {
+	m.trailingMaxShifts = nil
+	m.addtrailingMaxShifts = nil
+	delete(m.clearedFields, strategy.FieldTrailingMaxShifts)
+}
+
+// SetTrailingShifts sets the "trailingShifts" field.
+func (m *StrategyMutation) SetTrailingShifts(i int) {
+	m.trailingShifts = &i
+	m.addtrailingShifts = nil
+}
+
+// TrailingShifts returns the value of the "trailingShifts" field in the mutation.
+func (m *StrategyMutation) TrailingShifts() (r int, exists bool) {
+	v := m.trailingShifts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingShifts returns the old "trailingShifts" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingShifts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingShifts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingShifts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingShifts: %w", err)
+	}
+	return oldValue.TrailingShifts, nil
+}
+
+// AddTrailingShifts adds i to the "trailingShifts" field.
+func (m *StrategyMutation) AddTrailingShifts(i int) {
+	if m.addtrailingShifts != nil {
+		*m.addtrailingShifts += i
+	} else {
+		m.addtrailingShifts = &i
+	}
+}
+
+// AddedTrailingShifts returns the value that was added to the "trailingShifts" field in this mutation.
+func (m *StrategyMutation) AddedTrailingShifts() (r int, exists bool) {
+	v := m.addtrailingShifts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrailingShifts clears the value of the "trailingShifts" field.
+func (m *StrategyMutation) ClearTrailingShifts() {
+	m.trailingShifts = nil
+	m.addtrailingShifts = nil
+	m.clearedFields[strategy.FieldTrailingShifts] = struct{}{}
+}
+
+// TrailingShiftsCleared returns if the "trailingShifts" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingShiftsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingShifts]
+	return ok
+}
+
+// ResetTrailingShifts resets all changes to the "trailingShifts" field.
+func (m *StrategyMutation) ResetTrailingShifts() {
+	m.trailingShifts = nil
+	m.addtrailingShifts = nil
+	delete(m.clearedFields, strategy.FieldTrailingShifts)
+}
+
+// SetBuyConditions sets the "buyConditions" field.
+func (m *StrategyMutation) SetBuyConditions(s string) {
+	m.buyConditions = &s
+}
+
+// BuyConditions returns the value of the "buyConditions" field in the mutation.
+func (m *StrategyMutation) BuyConditions() (r string, exists bool) {
+	v := m.buyConditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyConditions returns the old "buyConditions" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldBuyConditions(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyConditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyConditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyConditions: %w", err)
+	}
+	return oldValue.BuyConditions, nil
+}
+
+// ClearBuyConditions clears the value of the "buyConditions" field.
+func (m *StrategyMutation) ClearBuyConditions() {
+	m.buyConditions = nil
+	m.clearedFields[strategy.FieldBuyConditions] = struct{}{}
+}
+
+// BuyConditionsCleared returns if the "buyConditions" field was cleared in this mutation.
+func (m *StrategyMutation) BuyConditionsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldBuyConditions]
+	return ok
+}
+
+// ResetBuyConditions resets all changes to the "buyConditions" field.
+func (m *StrategyMutation) ResetBuyConditions() {
+	m.buyConditions = nil
+	delete(m.clearedFields, strategy.FieldBuyConditions)
+}
+
+// SetDcaInterval sets the "dcaInterval" field.
+func (m *StrategyMutation) SetDcaInterval(i int) {
+	m.dcaInterval = &i
+	m.adddcaInterval = nil
+}
+
+// DcaInterval returns the value of the "dcaInterval" field in the mutation.
+func (m *StrategyMutation) DcaInterval() (r int, exists bool) {
+	v := m.dcaInterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaInterval returns the old "dcaInterval" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaInterval: %w", err)
+	}
+	return oldValue.DcaInterval, nil
+}
+
+// AddDcaInterval adds i to the "dcaInterval" field.
+func (m *StrategyMutation) AddDcaInterval(i int) {
+	if m.adddcaInterval != nil {
+		*m.adddcaInterval += i
+	} else {
+		m.adddcaInterval = &i
+	}
+}
+
+// AddedDcaInterval returns the value that was added to the "dcaInterval" field in this mutation.
+func (m *StrategyMutation) AddedDcaInterval() (r int, exists bool) {
+	v := m.adddcaInterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDcaInterval clears the value of the "dcaInterval" field.
+func (m *StrategyMutation) ClearDcaInterval() {
+	m.dcaInterval = nil
+	m.adddcaInterval = nil
+	m.clearedFields[strategy.FieldDcaInterval] = struct{}{}
+}
+
+// DcaIntervalCleared returns if the "dcaInterval" field was cleared in this mutation.
+func (m *StrategyMutation) DcaIntervalCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaInterval]
+	return ok
+}
+
+// ResetDcaInterval resets all changes to the "dcaInterval" field.
+func (m *StrategyMutation) ResetDcaInterval() {
+	m.dcaInterval = nil
+	m.adddcaInterval = nil
+	delete(m.clearedFields, strategy.FieldDcaInterval)
+}
+
+// SetDcaDropRatio sets the "dcaDropRatio" field.
+func (m *StrategyMutation) SetDcaDropRatio(d decimal.Decimal) {
+	m.dcaDropRatio = &d
+}
+
+// DcaDropRatio returns the value of the "dcaDropRatio" field in the mutation.
+func (m *StrategyMutation) DcaDropRatio() (r decimal.Decimal, exists bool) {
+	v := m.dcaDropRatio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaDropRatio returns the old "dcaDropRatio" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaDropRatio(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaDropRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaDropRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaDropRatio: %w", err)
+	}
+	return oldValue.DcaDropRatio, nil
+}
+
+// ClearDcaDropRatio clears the value of the "dcaDropRatio" field.
+func (m *StrategyMutation) ClearDcaDropRatio() {
+	m.dcaDropRatio = nil
+	m.clearedFields[strategy.FieldDcaDropRatio] = struct{}{}
+}
+
+// DcaDropRatioCleared returns if the "dcaDropRatio" field was cleared in this mutation.
+func (m *StrategyMutation) DcaDropRatioCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaDropRatio]
+	return ok
+}
+
+// ResetDcaDropRatio resets all changes to the "dcaDropRatio" field.
+func (m *StrategyMutation) ResetDcaDropRatio() {
+	m.dcaDropRatio = nil
+	delete(m.clearedFields, strategy.FieldDcaDropRatio)
+}
+
+// SetDcaMaxOrders sets the "dcaMaxOrders" field.
+func (m *StrategyMutation) SetDcaMaxOrders(i int) {
+	m.dcaMaxOrders = &i
+	m.adddcaMaxOrders = nil
+}
+
+// DcaMaxOrders returns the value of the "dcaMaxOrders" field in the mutation.
+func (m *StrategyMutation) DcaMaxOrders() (r int, exists bool) {
+	v := m.dcaMaxOrders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaMaxOrders returns the old "dcaMaxOrders" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaMaxOrders(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaMaxOrders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaMaxOrders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaMaxOrders: %w", err)
+	}
+	return oldValue.DcaMaxOrders, nil
+}
+
+// AddDcaMaxOrders adds i to the "dcaMaxOrders" field.
+func (m *StrategyMutation) AddDcaMaxOrders(i int) {
+	if m.adddcaMaxOrders != nil {
+		*m.adddcaMaxOrders += i
+	} else {
+		m.adddcaMaxOrders = &i
+	}
+}
+
+// AddedDcaMaxOrders returns the value that was added to the "dcaMaxOrders" field in this mutation.
+func (m *StrategyMutation) AddedDcaMaxOrders() (r int, exists bool) {
+	v := m.adddcaMaxOrders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDcaMaxOrders clears the value of the "dcaMaxOrders" field.
+func (m *StrategyMutation) ClearDcaMaxOrders() {
+	m.dcaMaxOrders = nil
+	m.adddcaMaxOrders = nil
+	m.clearedFields[strategy.FieldDcaMaxOrders] = struct{}{}
+}
+
+// DcaMaxOrdersCleared returns if the "dcaMaxOrders" field was cleared in this mutation.
+func (m *StrategyMutation) DcaMaxOrdersCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaMaxOrders]
+	return ok
+}
+
+// ResetDcaMaxOrders resets all changes to the "dcaMaxOrders" field.
+func (m *StrategyMutation) ResetDcaMaxOrders() {
+	m.dcaMaxOrders = nil
+	m.adddcaMaxOrders = nil
+	delete(m.clearedFields, strategy.FieldDcaMaxOrders)
+}
+
+// SetDcaSellInterval sets the "dcaSellInterval" field.
+func (m *StrategyMutation) SetDcaSellInterval(i int) {
+	m.dcaSellInterval = &i
+	m.adddcaSellInterval = nil
+}
+
+// DcaSellInterval returns the value of the "dcaSellInterval" field in the mutation.
+func (m *StrategyMutation) DcaSellInterval() (r int, exists bool) {
+	v := m.dcaSellInterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaSellInterval returns the old "dcaSellInterval" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaSellInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaSellInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaSellInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaSellInterval: %w", err)
+	}
+	return oldValue.DcaSellInterval, nil
+}
+
+// AddDcaSellInterval adds i to the "dcaSellInterval" field.
+func (m *StrategyMutation) AddDcaSellInterval(i int) {
+	if m.adddcaSellInterval != nil {
+		*m.adddcaSellInterval += i
+	} else {
+		m.adddcaSellInterval = &i
+	}
+}
+
+// AddedDcaSellInterval returns the value that was added to the "dcaSellInterval" field in this mutation.
+func (m *StrategyMutation) AddedDcaSellInterval() (r int, exists bool) {
+	v := m.adddcaSellInterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDcaSellInterval clears the value of the "dcaSellInterval" field.
+func (m *StrategyMutation) ClearDcaSellInterval() {
+	m.dcaSellInterval = nil
+	m.adddcaSellInterval = nil
+	m.clearedFields[strategy.FieldDcaSellInterval] = struct{}{}
+}
+
+// DcaSellIntervalCleared returns if the "dcaSellInterval" field was cleared in this mutation.
+func (m *StrategyMutation) DcaSellIntervalCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaSellInterval]
+	return ok
+}
+
+// ResetDcaSellInterval resets all changes to the "dcaSellInterval" field.
+func (m *StrategyMutation) ResetDcaSellInterval() {
+	m.dcaSellInterval = nil
+	m.adddcaSellInterval = nil
+	delete(m.clearedFields, strategy.FieldDcaSellInterval)
+}
+
+// SetDcaSellProfitRatio sets the "dcaSellProfitRatio" field.
+func (m *StrategyMutation) SetDcaSellProfitRatio(d decimal.Decimal) {
+	m.dcaSellProfitRatio = &d
+}
+
+// DcaSellProfitRatio returns the value of the "dcaSellProfitRatio" field in the mutation.
+func (m *StrategyMutation) DcaSellProfitRatio() (r decimal.Decimal, exists bool) {
+	v := m.dcaSellProfitRatio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaSellProfitRatio returns the old "dcaSellProfitRatio" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaSellProfitRatio(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaSellProfitRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaSellProfitRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaSellProfitRatio: %w", err)
+	}
+	return oldValue.DcaSellProfitRatio, nil
+}
+
+// ClearDcaSellProfitRatio clears the value of the "dcaSellProfitRatio" field.
+func (m *StrategyMutation) ClearDcaSellProfitRatio() {
+	m.dcaSellProfitRatio = nil
+	m.clearedFields[strategy.FieldDcaSellProfitRatio] = struct{}{}
+}
+
+// DcaSellProfitRatioCleared returns if the "dcaSellProfitRatio" field was cleared in this mutation.
+func (m *StrategyMutation) DcaSellProfitRatioCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaSellProfitRatio]
+	return ok
+}
+
+// ResetDcaSellProfitRatio resets all changes to the "dcaSellProfitRatio" field.
+func (m *StrategyMutation) ResetDcaSellProfitRatio() {
+	m.dcaSellProfitRatio = nil
+	delete(m.clearedFields, strategy.FieldDcaSellProfitRatio)
+}
+
+// SetDcaLastBuyTime sets the "dcaLastBuyTime" field.
+func (m *StrategyMutation) SetDcaLastBuyTime(t time.Time) {
+	m.dcaLastBuyTime = &t
+}
+
+// DcaLastBuyTime returns the value of the "dcaLastBuyTime" field in the mutation.
+func (m *StrategyMutation) DcaLastBuyTime() (r time.Time, exists bool) {
+	v := m.dcaLastBuyTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaLastBuyTime returns the old "dcaLastBuyTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaLastBuyTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaLastBuyTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaLastBuyTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaLastBuyTime: %w", err)
+	}
+	return oldValue.DcaLastBuyTime, nil
+}
+
+// ClearDcaLastBuyTime clears the value of the "dcaLastBuyTime" field.
+func (m *StrategyMutation) ClearDcaLastBuyTime() {
+	m.dcaLastBuyTime = nil
+	m.clearedFields[strategy.FieldDcaLastBuyTime] = struct{}{}
+}
+
+// DcaLastBuyTimeCleared returns if the "dcaLastBuyTime" field was cleared in this mutation.
+func (m *StrategyMutation) DcaLastBuyTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaLastBuyTime]
+	return ok
+}
+
+// ResetDcaLastBuyTime resets all changes to the "dcaLastBuyTime" field.
+func (m *StrategyMutation) ResetDcaLastBuyTime() {
+	m.dcaLastBuyTime = nil
+	delete(m.clearedFields, strategy.FieldDcaLastBuyTime)
+}
+
+// SetDcaLastBuyPrice sets the "dcaLastBuyPrice" field.
+func (m *StrategyMutation) SetDcaLastBuyPrice(d decimal.Decimal) {
+	m.dcaLastBuyPrice = &d
+}
+
+// DcaLastBuyPrice returns the value of the "dcaLastBuyPrice" field in the mutation.
+func (m *StrategyMutation) DcaLastBuyPrice() (r decimal.Decimal, exists bool) {
+	v := m.dcaLastBuyPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDcaLastBuyPrice returns the old "dcaLastBuyPrice" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDcaLastBuyPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDcaLastBuyPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDcaLastBuyPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDcaLastBuyPrice: %w", err)
+	}
+	return oldValue.DcaLastBuyPrice, nil
 }
 
-// SellSlippageBpsCleared returns if the "sellSlippageBps" field was cleared in this mutation.
-func (m *SettingsMutation) SellSlippageBpsCleared() bool {
-	_, ok := m.clearedFields[settings.FieldSellSlippageBps]
+// ClearDcaLastBuyPrice clears the value of the "dcaLastBuyPrice" field.
+func (m *StrategyMutation) ClearDcaLastBuyPrice() {
+	m.dcaLastBuyPrice = nil
+	m.clearedFields[strategy.FieldDcaLastBuyPrice] = struct{}{}
+}
+
+// DcaLastBuyPriceCleared returns if the "dcaLastBuyPrice" field was cleared in this mutation.
+func (m *StrategyMutation) DcaLastBuyPriceCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaLastBuyPrice]
 	return ok
 }
 
-// ResetSellSlippageBps resets all changes to the "sellSlippageBps" field.
-func (m *SettingsMutation) ResetSellSlippageBps() {
-	m.sellSlippageBps = nil
-	m.addsellSlippageBps = nil
-	delete(m.clearedFields, settings.FieldSellSlippageBps)
+// ResetDcaLastBuyPrice resets all changes to the "dcaLastBuyPrice" field.
+func (m *StrategyMutation) ResetDcaLastBuyPrice() {
+	m.dcaLastBuyPrice = nil
+	delete(m.clearedFields, strategy.FieldDcaLastBuyPrice)
 }
 
-// SetExitSlippageBps sets the "exitSlippageBps" field.
-func (m *SettingsMutation) SetExitSlippageBps(i int) {
-	m.exitSlippageBps = &i
-	m.addexitSlippageBps = nil
+// SetDcaLastSellTime sets the "dcaLastSellTime" field.
+func (m *StrategyMutation) SetDcaLastSellTime(t time.Time) {
+	m.dcaLastSellTime = &t
 }
 
-// ExitSlippageBps returns the value of the "exitSlippageBps" field in the mutation.
-func (m *SettingsMutation) ExitSlippageBps() (r int, exists bool) {
-	v := m.exitSlippageBps
+// DcaLastSellTime returns the value of the "dcaLastSellTime" field in the mutation.
+func (m *StrategyMutation) DcaLastSellTime() (r time.Time, exists bool) {
+	v := m.dcaLastSellTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExitSlippageBps returns the old "exitSlippageBps" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldDcaLastSellTime returns the old "dcaLastSellTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldExitSlippageBps(ctx context.Context) (v *int, err error) {
+func (m *StrategyMutation) OldDcaLastSellTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExitSlippageBps is only allowed on UpdateOne operations")
+		return v, errors.New("OldDcaLastSellTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExitSlippageBps requires an ID field in the mutation")
+		return v, errors.New("OldDcaLastSellTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExitSlippageBps: %w", err)
+		return v, fmt.Errorf("querying old value for OldDcaLastSellTime: %w", err)
 	}
-	return oldValue.ExitSlippageBps, nil
+	return oldValue.DcaLastSellTime, nil
 }
 
-// AddExitSlippageBps adds i to the "exitSlippageBps" field.
-func (m *SettingsMutation) AddExitSlippageBps(i int) {
-	if m.addexitSlippageBps != nil {
-		*m.addexitSlippageBps += i
-	} else {
-		m.addexitSlippageBps = &i
+// ClearDcaLastSellTime clears the value of the "dcaLastSellTime" field.
+func (m *StrategyMutation) ClearDcaLastSellTime() {
+	m.dcaLastSellTime = nil
+	m.clearedFields[strategy.FieldDcaLastSellTime] = struct{}{}
+}
+
+// DcaLastSellTimeCleared returns if the "dcaLastSellTime" field was cleared in this mutation.
+func (m *StrategyMutation) DcaLastSellTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDcaLastSellTime]
+	return ok
+}
+
+// ResetDcaLastSellTime resets all changes to the "dcaLastSellTime" field.
+func (m *StrategyMutation) ResetDcaLastSellTime() {
+	m.dcaLastSellTime = nil
+	delete(m.clearedFields, strategy.FieldDcaLastSellTime)
+}
+
+// SetEnableAutoBuy sets the "enableAutoBuy" field.
+func (m *StrategyMutation) SetEnableAutoBuy(b bool) {
+	m.enableAutoBuy = &b
+}
+
+// EnableAutoBuy returns the value of the "enableAutoBuy" field in the mutation.
+func (m *StrategyMutation) EnableAutoBuy() (r bool, exists bool) {
+	v := m.enableAutoBuy
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedExitSlippageBps returns the value that was added to the "exitSlippageBps" field in this mutation.
-func (m *SettingsMutation) AddedExitSlippageBps() (r int, exists bool) {
-	v := m.addexitSlippageBps
+// OldEnableAutoBuy returns the old "enableAutoBuy" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEnableAutoBuy(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableAutoBuy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableAutoBuy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableAutoBuy: %w", err)
+	}
+	return oldValue.EnableAutoBuy, nil
+}
+
+// ResetEnableAutoBuy resets all changes to the "enableAutoBuy" field.
+func (m *StrategyMutation) ResetEnableAutoBuy() {
+	m.enableAutoBuy = nil
+}
+
+// SetEnableAutoSell sets the "enableAutoSell" field.
+func (m *StrategyMutation) SetEnableAutoSell(b bool) {
+	m.enableAutoSell = &b
+}
+
+// EnableAutoSell returns the value of the "enableAutoSell" field in the mutation.
+func (m *StrategyMutation) EnableAutoSell() (r bool, exists bool) {
+	v := m.enableAutoSell
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearExitSlippageBps clears the value of the "exitSlippageBps" field.
-func (m *SettingsMutation) ClearExitSlippageBps() {
-	m.exitSlippageBps = nil
-	m.addexitSlippageBps = nil
-	m.clearedFields[settings.FieldExitSlippageBps] = struct{}{}
+// OldEnableAutoSell returns the old "enableAutoSell" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEnableAutoSell(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableAutoSell is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableAutoSell requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableAutoSell: %w", err)
+	}
+	return oldValue.EnableAutoSell, nil
 }
 
-// ExitSlippageBpsCleared returns if the "exitSlippageBps" field was cleared in this mutation.
-func (m *SettingsMutation) ExitSlippageBpsCleared() bool {
-	_, ok := m.clearedFields[settings.FieldExitSlippageBps]
-	return ok
+// ResetEnableAutoSell resets all changes to the "enableAutoSell" field.
+func (m *StrategyMutation) ResetEnableAutoSell() {
+	m.enableAutoSell = nil
 }
 
-// ResetExitSlippageBps resets all changes to the "exitSlippageBps" field.
-func (m *SettingsMutation) ResetExitSlippageBps() {
-	m.exitSlippageBps = nil
-	m.addexitSlippageBps = nil
-	delete(m.clearedFields, settings.FieldExitSlippageBps)
+// SetEnableAutoExit sets the "enableAutoExit" field.
+func (m *StrategyMutation) SetEnableAutoExit(b bool) {
+	m.enableAutoExit = &b
 }
 
-// SetMaxLamports sets the "maxLamports" field.
-func (m *SettingsMutation) SetMaxLamports(i int64) {
-	m.maxLamports = &i
-	m.addmaxLamports = nil
+// EnableAutoExit returns the value of the "enableAutoExit" field in the mutation.
+func (m *StrategyMutation) EnableAutoExit() (r bool, exists bool) {
+	v := m.enableAutoExit
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// MaxLamports returns the value of the "maxLamports" field in the mutation.
-func (m *SettingsMutation) MaxLamports() (r int64, exists bool) {
-	v := m.maxLamports
+// OldEnableAutoExit returns the old "enableAutoExit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEnableAutoExit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableAutoExit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableAutoExit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableAutoExit: %w", err)
+	}
+	return oldValue.EnableAutoExit, nil
+}
+
+// ResetEnableAutoExit resets all changes to the "enableAutoExit" field.
+func (m *StrategyMutation) ResetEnableAutoExit() {
+	m.enableAutoExit = nil
+}
+
+// SetEnablePushNotification sets the "enablePushNotification" field.
+func (m *StrategyMutation) SetEnablePushNotification(b bool) {
+	m.enablePushNotification = &b
+}
+
+// EnablePushNotification returns the value of the "enablePushNotification" field in the mutation.
+func (m *StrategyMutation) EnablePushNotification() (r bool, exists bool) {
+	v := m.enablePushNotification
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxLamports returns the old "maxLamports" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldEnablePushNotification returns the old "enablePushNotification" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEnablePushNotification(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnablePushNotification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnablePushNotification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnablePushNotification: %w", err)
+	}
+	return oldValue.EnablePushNotification, nil
+}
+
+// ResetEnablePushNotification resets all changes to the "enablePushNotification" field.
+func (m *StrategyMutation) ResetEnablePushNotification() {
+	m.enablePushNotification = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *StrategyMutation) SetStatus(s strategy.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *StrategyMutation) Status() (r strategy.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldStatus(ctx context.Context) (v strategy.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *StrategyMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetGridTrend sets the "gridTrend" field.
+func (m *StrategyMutation) SetGridTrend(s string) {
+	m.gridTrend = &s
+}
+
+// GridTrend returns the value of the "gridTrend" field in the mutation.
+func (m *StrategyMutation) GridTrend() (r string, exists bool) {
+	v := m.gridTrend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGridTrend returns the old "gridTrend" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldMaxLamports(ctx context.Context) (v int64, err error) {
+func (m *StrategyMutation) OldGridTrend(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxLamports is only allowed on UpdateOne operations")
+		return v, errors.New("OldGridTrend is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxLamports requires an ID field in the mutation")
+		return v, errors.New("OldGridTrend requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxLamports: %w", err)
+		return v, fmt.Errorf("querying old value for OldGridTrend: %w", err)
 	}
-	return oldValue.MaxLamports, nil
+	return oldValue.GridTrend, nil
 }
 
-// AddMaxLamports adds i to the "maxLamports" field.
-func (m *SettingsMutation) AddMaxLamports(i int64) {
-	if m.addmaxLamports != nil {
-		*m.addmaxLamports += i
-	} else {
-		m.addmaxLamports = &i
-	}
+// ClearGridTrend clears the value of the "gridTrend" field.
+func (m *StrategyMutation) ClearGridTrend() {
+	m.gridTrend = nil
+	m.clearedFields[strategy.FieldGridTrend] = struct{}{}
 }
 
-// AddedMaxLamports returns the value that was added to the "maxLamports" field in this mutation.
-func (m *SettingsMutation) AddedMaxLamports() (r int64, exists bool) {
-	v := m.addmaxLamports
-	if v == nil {
-		return
-	}
-	return *v, true
+// GridTrendCleared returns if the "gridTrend" field was cleared in this mutation.
+func (m *StrategyMutation) GridTrendCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldGridTrend]
+	return ok
 }
 
-// ResetMaxLamports resets all changes to the "maxLamports" field.
-func (m *SettingsMutation) ResetMaxLamports() {
-	m.maxLamports = nil
-	m.addmaxLamports = nil
+// ResetGridTrend resets all changes to the "gridTrend" field.
+func (m *StrategyMutation) ResetGridTrend() {
+	m.gridTrend = nil
+	delete(m.clearedFields, strategy.FieldGridTrend)
 }
 
-// SetPriorityLevel sets the "priorityLevel" field.
-func (m *SettingsMutation) SetPriorityLevel(sl settings.PriorityLevel) {
-	m.priorityLevel = &sl
+// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
+func (m *StrategyMutation) SetLastLowerThresholdAlertTime(t time.Time) {
+	m.lastLowerThresholdAlertTime = &t
 }
 
-// PriorityLevel returns the value of the "priorityLevel" field in the mutation.
-func (m *SettingsMutation) PriorityLevel() (r settings.PriorityLevel, exists bool) {
-	v := m.priorityLevel
+// LastLowerThresholdAlertTime returns the value of the "lastLowerThresholdAlertTime" field in the mutation.
+func (m *StrategyMutation) LastLowerThresholdAlertTime() (r time.Time, exists bool) {
+	v := m.lastLowerThresholdAlertTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriorityLevel returns the old "priorityLevel" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldLastLowerThresholdAlertTime returns the old "lastLowerThresholdAlertTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldPriorityLevel(ctx context.Context) (v settings.PriorityLevel, err error) {
+func (m *StrategyMutation) OldLastLowerThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriorityLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastLowerThresholdAlertTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriorityLevel requires an ID field in the mutation")
+		return v, errors.New("OldLastLowerThresholdAlertTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriorityLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastLowerThresholdAlertTime: %w", err)
 	}
-	return oldValue.PriorityLevel, nil
+	return oldValue.LastLowerThresholdAlertTime, nil
 }
 
-// ResetPriorityLevel resets all changes to the "priorityLevel" field.
-func (m *SettingsMutation) ResetPriorityLevel() {
-	m.priorityLevel = nil
+// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
+func (m *StrategyMutation) ClearLastLowerThresholdAlertTime() {
+	m.lastLowerThresholdAlertTime = nil
+	m.clearedFields[strategy.FieldLastLowerThresholdAlertTime] = struct{}{}
 }
 
-// SetDexAggregator sets the "dexAggregator" field.
-func (m *SettingsMutation) SetDexAggregator(sa settings.DexAggregator) {
-	m.dexAggregator = &sa
+// LastLowerThresholdAlertTimeCleared returns if the "lastLowerThresholdAlertTime" field was cleared in this mutation.
+func (m *StrategyMutation) LastLowerThresholdAlertTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldLastLowerThresholdAlertTime]
+	return ok
 }
 
-// DexAggregator returns the value of the "dexAggregator" field in the mutation.
-func (m *SettingsMutation) DexAggregator() (r settings.DexAggregator, exists bool) {
-	v := m.dexAggregator
+// ResetLastLowerThresholdAlertTime resets all changes to the "lastLowerThresholdAlertTime" field.
+func (m *StrategyMutation) ResetLastLowerThresholdAlertTime() {
+	m.lastLowerThresholdAlertTime = nil
+	delete(m.clearedFields, strategy.FieldLastLowerThresholdAlertTime)
+}
+
+// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
+func (m *StrategyMutation) SetLastUpperThresholdAlertTime(t time.Time) {
+	m.lastUpperThresholdAlertTime = &t
+}
+
+// LastUpperThresholdAlertTime returns the value of the "lastUpperThresholdAlertTime" field in the mutation.
+func (m *StrategyMutation) LastUpperThresholdAlertTime() (r time.Time, exists bool) {
+	v := m.lastUpperThresholdAlertTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDexAggregator returns the old "dexAggregator" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUpperThresholdAlertTime returns the old "lastUpperThresholdAlertTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldDexAggregator(ctx context.Context) (v settings.DexAggregator, err error) {
+func (m *StrategyMutation) OldLastUpperThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDexAggregator is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUpperThresholdAlertTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDexAggregator requires an ID field in the mutation")
+		return v, errors.New("OldLastUpperThresholdAlertTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDexAggregator: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUpperThresholdAlertTime: %w", err)
 	}
-	return oldValue.DexAggregator, nil
+	return oldValue.LastUpperThresholdAlertTime, nil
 }
 
-// ResetDexAggregator resets all changes to the "dexAggregator" field.
-func (m *SettingsMutation) ResetDexAggregator() {
-	m.dexAggregator = nil
+// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
+func (m *StrategyMutation) ClearLastUpperThresholdAlertTime() {
+	m.lastUpperThresholdAlertTime = nil
+	m.clearedFields[strategy.FieldLastUpperThresholdAlertTime] = struct{}{}
 }
 
-// Where appends a list predicates to the SettingsMutation builder.
-func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+// LastUpperThresholdAlertTimeCleared returns if the "lastUpperThresholdAlertTime" field was cleared in this mutation.
+func (m *StrategyMutation) LastUpperThresholdAlertTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldLastUpperThresholdAlertTime]
+	return ok
+}
+
+// ResetLastUpperThresholdAlertTime resets all changes to the "lastUpperThresholdAlertTime" field.
+func (m *StrategyMutation) ResetLastUpperThresholdAlertTime() {
+	m.lastUpperThresholdAlertTime = nil
+	delete(m.clearedFields, strategy.FieldLastUpperThresholdAlertTime)
+}
+
+// Where appends a list predicates to the StrategyMutation builder.
+func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Settings, len(ps))
+func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Strategy, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3056,54 +7323,174 @@ func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingsMutation) Op() Op {
+func (m *StrategyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingsMutation) SetOp(op Op) {
+func (m *StrategyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Settings).
-func (m *SettingsMutation) Type() string {
+// Type returns the node type of this mutation (Strategy).
+func (m *StrategyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingsMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *StrategyMutation) Fields() []string {
+	fields := make([]string, 0, 50)
 	if m.create_time != nil {
-		fields = append(fields, settings.FieldCreateTime)
+		fields = append(fields, strategy.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, settings.FieldUpdateTime)
+		fields = append(fields, strategy.FieldUpdateTime)
+	}
+	if m.guid != nil {
+		fields = append(fields, strategy.FieldGUID)
 	}
 	if m.userId != nil {
-		fields = append(fields, settings.FieldUserId)
+		fields = append(fields, strategy.FieldUserId)
 	}
-	if m.maxRetries != nil {
-		fields = append(fields, settings.FieldMaxRetries)
+	if m.account != nil {
+		fields = append(fields, strategy.FieldAccount)
 	}
-	if m.slippageBps != nil {
-		fields = append(fields, settings.FieldSlippageBps)
+	if m.token != nil {
+		fields = append(fields, strategy.FieldToken)
 	}
-	if m.sellSlippageBps != nil {
-		fields = append(fields, settings.FieldSellSlippageBps)
+	if m.symbol != nil {
+		fields = append(fields, strategy.FieldSymbol)
 	}
-	if m.exitSlippageBps != nil {
-		fields = append(fields, settings.FieldExitSlippageBps)
+	if m._type != nil {
+		fields = append(fields, strategy.FieldType)
+	}
+	if m.martinFactor != nil {
+		fields = append(fields, strategy.FieldMartinFactor)
+	}
+	if m.maxGridLimit != nil {
+		fields = append(fields, strategy.FieldMaxGridLimit)
+	}
+	if m.takeProfitRatio != nil {
+		fields = append(fields, strategy.FieldTakeProfitRatio)
+	}
+	if m.upperPriceBound != nil {
+		fields = append(fields, strategy.FieldUpperPriceBound)
+	}
+	if m.lowerPriceBound != nil {
+		fields = append(fields, strategy.FieldLowerPriceBound)
+	}
+	if m.gridMode != nil {
+		fields = append(fields, strategy.FieldGridMode)
+	}
+	if m.gridCount != nil {
+		fields = append(fields, strategy.FieldGridCount)
+	}
+	if m.gridStep != nil {
+		fields = append(fields, strategy.FieldGridStep)
+	}
+	if m.initialOrderSize != nil {
+		fields = append(fields, strategy.FieldInitialOrderSize)
+	}
+	if m.lastKlineVolume != nil {
+		fields = append(fields, strategy.FieldLastKlineVolume)
+	}
+	if m.fiveKlineVolume != nil {
+		fields = append(fields, strategy.FieldFiveKlineVolume)
+	}
+	if m.firstOrderId != nil {
+		fields = append(fields, strategy.FieldFirstOrderId)
+	}
+	if m.upperBoundExit != nil {
+		fields = append(fields, strategy.FieldUpperBoundExit)
+	}
+	if m.stopLossExit != nil {
+		fields = append(fields, strategy.FieldStopLossExit)
+	}
+	if m.takeProfitExit != nil {
+		fields = append(fields, strategy.FieldTakeProfitExit)
+	}
+	if m.globalTakeProfitRatio != nil {
+		fields = append(fields, strategy.FieldGlobalTakeProfitRatio)
+	}
+	if m.dynamicStopLoss != nil {
+		fields = append(fields, strategy.FieldDynamicStopLoss)
+	}
+	if m.paperTrading != nil {
+		fields = append(fields, strategy.FieldPaperTrading)
+	}
+	if m.dropOn != nil {
+		fields = append(fields, strategy.FieldDropOn)
+	}
+	if m.candlesToCheck != nil {
+		fields = append(fields, strategy.FieldCandlesToCheck)
+	}
+	if m.dropThreshold != nil {
+		fields = append(fields, strategy.FieldDropThreshold)
+	}
+	if m.trailingOn != nil {
+		fields = append(fields, strategy.FieldTrailingOn)
+	}
+	if m.trailingCandles != nil {
+		fields = append(fields, strategy.FieldTrailingCandles)
+	}
+	if m.trailingMaxShifts != nil {
+		fields = append(fields, strategy.FieldTrailingMaxShifts)
+	}
+	if m.trailingShifts != nil {
+		fields = append(fields, strategy.FieldTrailingShifts)
+	}
+	if m.buyConditions != nil {
+		fields = append(fields, strategy.FieldBuyConditions)
+	}
+	if m.dcaInterval != nil {
+		fields = append(fields, strategy.FieldDcaInterval)
+	}
+	if m.dcaDropRatio != nil {
+		fields = append(fields, strategy.FieldDcaDropRatio)
+	}
+	if m.dcaMaxOrders != nil {
+		fields = append(fields, strategy.FieldDcaMaxOrders)
+	}
+	if m.dcaSellInterval != nil {
+		fields = append(fields, strategy.FieldDcaSellInterval)
+	}
+	if m.dcaSellProfitRatio != nil {
+		fields = append(fields, strategy.FieldDcaSellProfitRatio)
+	}
+	if m.dcaLastBuyTime != nil {
+		fields = append(fields, strategy.FieldDcaLastBuyTime)
+	}
+	if m.dcaLastBuyPrice != nil {
+		fields = append(fields, strategy.FieldDcaLastBuyPrice)
+	}
+	if m.dcaLastSellTime != nil {
+		fields = append(fields, strategy.FieldDcaLastSellTime)
+	}
+	if m.enableAutoBuy != nil {
+		fields = append(fields, strategy.FieldEnableAutoBuy)
+	}
+	if m.enableAutoSell != nil {
+		fields = append(fields, strategy.FieldEnableAutoSell)
+	}
+	if m.enableAutoExit != nil {
+		fields = append(fields, strategy.FieldEnableAutoExit)
+	}
+	if m.enablePushNotification != nil {
+		fields = append(fields, strategy.FieldEnablePushNotification)
+	}
+	if m.status != nil {
+		fields = append(fields, strategy.FieldStatus)
 	}
-	if m.maxLamports != nil {
-		fields = append(fields, settings.FieldMaxLamports)
+	if m.gridTrend != nil {
+		fields = append(fields, strategy.FieldGridTrend)
 	}
-	if m.priorityLevel != nil {
-		fields = append(fields, settings.FieldPriorityLevel)
+	if m.lastLowerThresholdAlertTime != nil {
+		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
 	}
-	if m.dexAggregator != nil {
-		fields = append(fields, settings.FieldDexAggregator)
+	if m.lastUpperThresholdAlertTime != nil {
+		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
 	}
 	return fields
 }
@@ -3111,28 +7498,108 @@ func (m *SettingsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
+func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case settings.FieldCreateTime:
+	case strategy.FieldCreateTime:
 		return m.CreateTime()
-	case settings.FieldUpdateTime:
+	case strategy.FieldUpdateTime:
 		return m.UpdateTime()
-	case settings.FieldUserId:
+	case strategy.FieldGUID:
+		return m.GUID()
+	case strategy.FieldUserId:
 		return m.UserId()
-	case settings.FieldMaxRetries:
-		return m.MaxRetries()
-	case settings.FieldSlippageBps:
-		return m.SlippageBps()
-	case settings.FieldSellSlippageBps:
-		return m.SellSlippageBps()
-	case settings.FieldExitSlippageBps:
-		return m.ExitSlippageBps()
-	case settings.FieldMaxLamports:
-		return m.MaxLamports()
-	case settings.FieldPriorityLevel:
-		return m.PriorityLevel()
-	case settings.FieldDexAggregator:
-		return m.DexAggregator()
+	case strategy.FieldAccount:
+		return m.Account()
+	case strategy.FieldToken:
+		return m.Token()
+	case strategy.FieldSymbol:
+		return m.Symbol()
+	case strategy.FieldType:
+		return m.GetType()
+	case strategy.FieldMartinFactor:
+		return m.MartinFactor()
+	case strategy.FieldMaxGridLimit:
+		return m.MaxGridLimit()
+	case strategy.FieldTakeProfitRatio:
+		return m.TakeProfitRatio()
+	case strategy.FieldUpperPriceBound:
+		return m.UpperPriceBound()
+	case strategy.FieldLowerPriceBound:
+		return m.LowerPriceBound()
+	case strategy.FieldGridMode:
+		return m.GridMode()
+	case strategy.FieldGridCount:
+		return m.GridCount()
+	case strategy.FieldGridStep:
+		return m.GridStep()
+	case strategy.FieldInitialOrderSize:
+		return m.InitialOrderSize()
+	case strategy.FieldLastKlineVolume:
+		return m.LastKlineVolume()
+	case strategy.FieldFiveKlineVolume:
+		return m.FiveKlineVolume()
+	case strategy.FieldFirstOrderId:
+		return m.FirstOrderId()
+	case strategy.FieldUpperBoundExit:
+		return m.UpperBoundExit()
+	case strategy.FieldStopLossExit:
+		return m.StopLossExit()
+	case strategy.FieldTakeProfitExit:
+		return m.TakeProfitExit()
+	case strategy.FieldGlobalTakeProfitRatio:
+		return m.GlobalTakeProfitRatio()
+	case strategy.FieldDynamicStopLoss:
+		return m.DynamicStopLoss()
+	case strategy.FieldPaperTrading:
+		return m.PaperTrading()
+	case strategy.FieldDropOn:
+		return m.DropOn()
+	case strategy.FieldCandlesToCheck:
+		return m.CandlesToCheck()
+	case strategy.FieldDropThreshold:
+		return m.DropThreshold()
+	case strategy.FieldTrailingOn:
+		return m.TrailingOn()
+	case strategy.FieldTrailingCandles:
+		return m.TrailingCandles()
+	case strategy.FieldTrailingMaxShifts:
+		return m.TrailingMaxShifts()
+	case strategy.FieldTrailingShifts:
+		return m.TrailingShifts()
+	case strategy.FieldBuyConditions:
+		return m.BuyConditions()
+	case strategy.FieldDcaInterval:
+		return m.DcaInterval()
+	case strategy.FieldDcaDropRatio:
+		return m.DcaDropRatio()
+	case strategy.FieldDcaMaxOrders:
+		return m.DcaMaxOrders()
+	case strategy.FieldDcaSellInterval:
+		return m.DcaSellInterval()
+	case strategy.FieldDcaSellProfitRatio:
+		return m.DcaSellProfitRatio()
+	case strategy.FieldDcaLastBuyTime:
+		return m.DcaLastBuyTime()
+	case strategy.FieldDcaLastBuyPrice:
+		return m.DcaLastBuyPrice()
+	case strategy.FieldDcaLastSellTime:
+		return m.DcaLastSellTime()
+	case strategy.FieldEnableAutoBuy:
+		return m.EnableAutoBuy()
+	case strategy.FieldEnableAutoSell:
+		return m.EnableAutoSell()
+	case strategy.FieldEnableAutoExit:
+		return m.EnableAutoExit()
+	case strategy.FieldEnablePushNotification:
+		return m.EnablePushNotification()
+	case strategy.FieldStatus:
+		return m.Status()
+	case strategy.FieldGridTrend:
+		return m.GridTrend()
+	case strategy.FieldLastLowerThresholdAlertTime:
+		return m.LastLowerThresholdAlertTime()
+	case strategy.FieldLastUpperThresholdAlertTime:
+		return m.LastUpperThresholdAlertTime()
 	}
 	return nil, false
 }
@@ -3140,1897 +7607,2179 @@ func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case settings.FieldCreateTime:
+	case strategy.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case settings.FieldUpdateTime:
+	case strategy.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case settings.FieldUserId:
+	case strategy.FieldGUID:
+		return m.OldGUID(ctx)
+	case strategy.FieldUserId:
 		return m.OldUserId(ctx)
-	case settings.FieldMaxRetries:
-		return m.OldMaxRetries(ctx)
-	case settings.FieldSlippageBps:
-		return m.OldSlippageBps(ctx)
-	case settings.FieldSellSlippageBps:
-		return m.OldSellSlippageBps(ctx)
-	case settings.FieldExitSlippageBps:
-		return m.OldExitSlippageBps(ctx)
-	case settings.FieldMaxLamports:
-		return m.OldMaxLamports(ctx)
-	case settings.FieldPriorityLevel:
-		return m.OldPriorityLevel(ctx)
-	case settings.FieldDexAggregator:
-		return m.OldDexAggregator(ctx)
+	case strategy.FieldAccount:
+		return m.OldAccount(ctx)
+	case strategy.FieldToken:
+		return m.OldToken(ctx)
+	case strategy.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case strategy.FieldType:
+		return m.OldType(ctx)
+	case strategy.FieldMartinFactor:
+		return m.OldMartinFactor(ctx)
+	case strategy.FieldMaxGridLimit:
+		return m.OldMaxGridLimit(ctx)
+	case strategy.FieldTakeProfitRatio:
+		return m.OldTakeProfitRatio(ctx)
+	case strategy.FieldUpperPriceBound:
+		return m.OldUpperPriceBound(ctx)
+	case strategy.FieldLowerPriceBound:
+		return m.OldLowerPriceBound(ctx)
+	case strategy.FieldGridMode:
+		return m.OldGridMode(ctx)
+	case strategy.FieldGridCount:
+		return m.OldGridCount(ctx)
+	case strategy.FieldGridStep:
+		return m.OldGridStep(ctx)
+	case strategy.FieldInitialOrderSize:
+		return m.OldInitialOrderSize(ctx)
+	case strategy.FieldLastKlineVolume:
+		return m.OldLastKlineVolume(ctx)
+	case strategy.FieldFiveKlineVolume:
+		return m.OldFiveKlineVolume(ctx)
+	case strategy.FieldFirstOrderId:
+		return m.OldFirstOrderId(ctx)
+	case strategy.FieldUpperBoundExit:
+		return m.OldUpperBoundExit(ctx)
+	case strategy.FieldStopLossExit:
+		return m.OldStopLossExit(ctx)
+	case strategy.FieldTakeProfitExit:
+		return m.OldTakeProfitExit(ctx)
+	case strategy.FieldGlobalTakeProfitRatio:
+		return m.OldGlobalTakeProfitRatio(ctx)
+	case strategy.FieldDynamicStopLoss:
+		return m.OldDynamicStopLoss(ctx)
+	case strategy.FieldPaperTrading:
+		return m.OldPaperTrading(ctx)
+	case strategy.FieldDropOn:
+		return m.OldDropOn(ctx)
+	case strategy.FieldCandlesToCheck:
+		return m.OldCandlesToCheck(ctx)
+	case strategy.FieldDropThreshold:
+		return m.OldDropThreshold(ctx)
+	case strategy.FieldTrailingOn:
+		return m.OldTrailingOn(ctx)
+	case strategy.FieldTrailingCandles:
+		return m.OldTrailingCandles(ctx)
+	case strategy.FieldTrailingMaxShifts:
+		return m.OldTrailingMaxShifts(ctx)
+	case strategy.FieldTrailingShifts:
+		return m.OldTrailingShifts(ctx)
+	case strategy.FieldBuyConditions:
+		return m.OldBuyConditions(ctx)
+	case strategy.FieldDcaInterval:
+		return m.OldDcaInterval(ctx)
+	case strategy.FieldDcaDropRatio:
+		return m.OldDcaDropRatio(ctx)
+	case strategy.FieldDcaMaxOrders:
+		return m.OldDcaMaxOrders(ctx)
+	case strategy.FieldDcaSellInterval:
+		return m.OldDcaSellInterval(ctx)
+	case strategy.FieldDcaSellProfitRatio:
+		return m.OldDcaSellProfitRatio(ctx)
+	case strategy.FieldDcaLastBuyTime:
+		return m.OldDcaLastBuyTime(ctx)
+	case strategy.FieldDcaLastBuyPrice:
+		return m.OldDcaLastBuyPrice(ctx)
+	case strategy.FieldDcaLastSellTime:
+		return m.OldDcaLastSellTime(ctx)
+	case strategy.FieldEnableAutoBuy:
+		return m.OldEnableAutoBuy(ctx)
+	case strategy.FieldEnableAutoSell:
+		return m.OldEnableAutoSell(ctx)
+	case strategy.FieldEnableAutoExit:
+		return m.OldEnableAutoExit(ctx)
+	case strategy.FieldEnablePushNotification:
+		return m.OldEnablePushNotification(ctx)
+	case strategy.FieldStatus:
+		return m.OldStatus(ctx)
+	case strategy.FieldGridTrend:
+		return m.OldGridTrend(ctx)
+	case strategy.FieldLastLowerThresholdAlertTime:
+		return m.OldLastLowerThresholdAlertTime(ctx)
+	case strategy.FieldLastUpperThresholdAlertTime:
+		return m.OldLastUpperThresholdAlertTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Settings field %s", name)
+	return nil, fmt.Errorf("unknown Strategy field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingsMutation) SetField(name string, value ent.Value) error {
+func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case settings.FieldCreateTime:
+	case strategy.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case strategy.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetUpdateTime(v)
+		return nil
+	case strategy.FieldGUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGUID(v)
+		return nil
+	case strategy.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case strategy.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case strategy.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case strategy.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case strategy.FieldType:
+		v, ok := value.(strategy.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case strategy.FieldMartinFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMartinFactor(v)
+		return nil
+	case strategy.FieldMaxGridLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxGridLimit(v)
+		return nil
+	case strategy.FieldTakeProfitRatio:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakeProfitRatio(v)
+		return nil
+	case strategy.FieldUpperPriceBound:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpperPriceBound(v)
+		return nil
+	case strategy.FieldLowerPriceBound:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLowerPriceBound(v)
 		return nil
-	case settings.FieldUpdateTime:
-		v, ok := value.(time.Time)
+	case strategy.FieldGridMode:
+		v, ok := value.(strategy.GridMode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetGridMode(v)
 		return nil
-	case settings.FieldUserId:
-		v, ok := value.(int64)
+	case strategy.FieldGridCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserId(v)
+		m.SetGridCount(v)
 		return nil
-	case settings.FieldMaxRetries:
-		v, ok := value.(int64)
+	case strategy.FieldGridStep:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxRetries(v)
+		m.SetGridStep(v)
 		return nil
-	case settings.FieldSlippageBps:
-		v, ok := value.(int)
+	case strategy.FieldInitialOrderSize:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlippageBps(v)
+		m.SetInitialOrderSize(v)
 		return nil
-	case settings.FieldSellSlippageBps:
-		v, ok := value.(int)
+	case strategy.FieldLastKlineVolume:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellSlippageBps(v)
+		m.SetLastKlineVolume(v)
 		return nil
-	case settings.FieldExitSlippageBps:
+	case strategy.FieldFiveKlineVolume:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFiveKlineVolume(v)
+		return nil
+	case strategy.FieldFirstOrderId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExitSlippageBps(v)
+		m.SetFirstOrderId(v)
 		return nil
-	case settings.FieldMaxLamports:
-		v, ok := value.(int64)
+	case strategy.FieldUpperBoundExit:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxLamports(v)
+		m.SetUpperBoundExit(v)
 		return nil
-	case settings.FieldPriorityLevel:
-		v, ok := value.(settings.PriorityLevel)
+	case strategy.FieldStopLossExit:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriorityLevel(v)
+		m.SetStopLossExit(v)
 		return nil
-	case settings.FieldDexAggregator:
-		v, ok := value.(settings.DexAggregator)
+	case strategy.FieldTakeProfitExit:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDexAggregator(v)
+		m.SetTakeProfitExit(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Settings field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SettingsMutation) AddedFields() []string {
-	var fields []string
-	if m.adduserId != nil {
-		fields = append(fields, settings.FieldUserId)
-	}
-	if m.addmaxRetries != nil {
-		fields = append(fields, settings.FieldMaxRetries)
-	}
-	if m.addslippageBps != nil {
-		fields = append(fields, settings.FieldSlippageBps)
-	}
-	if m.addsellSlippageBps != nil {
-		fields = append(fields, settings.FieldSellSlippageBps)
-	}
-	if m.addexitSlippageBps != nil {
-		fields = append(fields, settings.FieldExitSlippageBps)
-	}
-	if m.addmaxLamports != nil {
-		fields = append(fields, settings.FieldMaxLamports)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case settings.FieldUserId:
-		return m.AddedUserId()
-	case settings.FieldMaxRetries:
-		return m.AddedMaxRetries()
-	case settings.FieldSlippageBps:
-		return m.AddedSlippageBps()
-	case settings.FieldSellSlippageBps:
-		return m.AddedSellSlippageBps()
-	case settings.FieldExitSlippageBps:
-		return m.AddedExitSlippageBps()
-	case settings.FieldMaxLamports:
-		return m.AddedMaxLamports()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SettingsMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case settings.FieldUserId:
-		v, ok := value.(int64)
+	case strategy.FieldGlobalTakeProfitRatio:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserId(v)
+		m.SetGlobalTakeProfitRatio(v)
 		return nil
-	case settings.FieldMaxRetries:
-		v, ok := value.(int64)
+	case strategy.FieldDynamicStopLoss:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxRetries(v)
+		m.SetDynamicStopLoss(v)
 		return nil
-	case settings.FieldSlippageBps:
+	case strategy.FieldPaperTrading:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaperTrading(v)
+		return nil
+	case strategy.FieldDropOn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDropOn(v)
+		return nil
+	case strategy.FieldCandlesToCheck:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSlippageBps(v)
+		m.SetCandlesToCheck(v)
 		return nil
-	case settings.FieldSellSlippageBps:
+	case strategy.FieldDropThreshold:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDropThreshold(v)
+		return nil
+	case strategy.FieldTrailingOn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingOn(v)
+		return nil
+	case strategy.FieldTrailingCandles:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSellSlippageBps(v)
+		m.SetTrailingCandles(v)
 		return nil
-	case settings.FieldExitSlippageBps:
+	case strategy.FieldTrailingMaxShifts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExitSlippageBps(v)
+		m.SetTrailingMaxShifts(v)
 		return nil
-	case settings.FieldMaxLamports:
-		v, ok := value.(int64)
+	case strategy.FieldTrailingShifts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxLamports(v)
+		m.SetTrailingShifts(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Settings numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SettingsMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(settings.FieldSellSlippageBps) {
-		fields = append(fields, settings.FieldSellSlippageBps)
-	}
-	if m.FieldCleared(settings.FieldExitSlippageBps) {
-		fields = append(fields, settings.FieldExitSlippageBps)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SettingsMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SettingsMutation) ClearField(name string) error {
-	switch name {
-	case settings.FieldSellSlippageBps:
-		m.ClearSellSlippageBps()
+	case strategy.FieldBuyConditions:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyConditions(v)
 		return nil
-	case settings.FieldExitSlippageBps:
-		m.ClearExitSlippageBps()
+	case strategy.FieldDcaInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaInterval(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Settings nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SettingsMutation) ResetField(name string) error {
-	switch name {
-	case settings.FieldCreateTime:
-		m.ResetCreateTime()
+	case strategy.FieldDcaDropRatio:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaDropRatio(v)
 		return nil
-	case settings.FieldUpdateTime:
-		m.ResetUpdateTime()
+	case strategy.FieldDcaMaxOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaMaxOrders(v)
 		return nil
-	case settings.FieldUserId:
-		m.ResetUserId()
+	case strategy.FieldDcaSellInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaSellInterval(v)
 		return nil
-	case settings.FieldMaxRetries:
-		m.ResetMaxRetries()
+	case strategy.FieldDcaSellProfitRatio:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaSellProfitRatio(v)
+		return nil
+	case strategy.FieldDcaLastBuyTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaLastBuyTime(v)
+		return nil
+	case strategy.FieldDcaLastBuyPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaLastBuyPrice(v)
+		return nil
+	case strategy.FieldDcaLastSellTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDcaLastSellTime(v)
+		return nil
+	case strategy.FieldEnableAutoBuy:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableAutoBuy(v)
 		return nil
-	case settings.FieldSlippageBps:
-		m.ResetSlippageBps()
+	case strategy.FieldEnableAutoSell:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableAutoSell(v)
 		return nil
-	case settings.FieldSellSlippageBps:
-		m.ResetSellSlippageBps()
+	case strategy.FieldEnableAutoExit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableAutoExit(v)
 		return nil
-	case settings.FieldExitSlippageBps:
-		m.ResetExitSlippageBps()
+	case strategy.FieldEnablePushNotification:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnablePushNotification(v)
 		return nil
-	case settings.FieldMaxLamports:
-		m.ResetMaxLamports()
+	case strategy.FieldStatus:
+		v, ok := value.(strategy.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
 		return nil
-	case settings.FieldPriorityLevel:
-		m.ResetPriorityLevel()
+	case strategy.FieldGridTrend:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridTrend(v)
 		return nil
-	case settings.FieldDexAggregator:
-		m.ResetDexAggregator()
+	case strategy.FieldLastLowerThresholdAlertTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLowerThresholdAlertTime(v)
+		return nil
+	case strategy.FieldLastUpperThresholdAlertTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpperThresholdAlertTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Settings field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SettingsMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SettingsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Settings unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SettingsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Settings edge %s", name)
-}
-
-// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
-type StrategyMutation struct {
-	config
-	op                          Op
-	typ                         string
-	id                          *int
-	create_time                 *time.Time
-	update_time                 *time.Time
-	guid                        *string
-	userId                      *int64
-	adduserId                   *int64
-	token                       *string
-	symbol                      *string
-	martinFactor                *float64
-	addmartinFactor             *float64
-	maxGridLimit                *int
-	addmaxGridLimit             *int
-	takeProfitRatio             *decimal.Decimal
-	upperPriceBound             *decimal.Decimal
-	lowerPriceBound             *decimal.Decimal
-	initialOrderSize            *decimal.Decimal
-	lastKlineVolume             *decimal.Decimal
-	fiveKlineVolume             *decimal.Decimal
-	firstOrderId                *int
-	addfirstOrderId             *int
-	upperBoundExit              *decimal.Decimal
-	stopLossExit                *decimal.Decimal
-	takeProfitExit              *decimal.Decimal
-	globalTakeProfitRatio       *decimal.Decimal
-	dynamicStopLoss             *bool
-	dropOn                      *bool
-	candlesToCheck              *int
-	addcandlesToCheck           *int
-	dropThreshold               *decimal.Decimal
-	enableAutoBuy               *bool
-	enableAutoSell              *bool
-	enableAutoExit              *bool
-	enablePushNotification      *bool
-	status                      *strategy.Status
-	gridTrend                   *string
-	lastLowerThresholdAlertTime *time.Time
-	lastUpperThresholdAlertTime *time.Time
-	clearedFields               map[string]struct{}
-	done                        bool
-	oldValue                    func(context.Context) (*Strategy, error)
-	predicates                  []predicate.Strategy
+	return fmt.Errorf("unknown Strategy field %s", name)
 }
 
-var _ ent.Mutation = (*StrategyMutation)(nil)
-
-// strategyOption allows management of the mutation configuration using functional options.
-type strategyOption func(*StrategyMutation)
-
-// newStrategyMutation creates new mutation for the Strategy entity.
-func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
-	m := &StrategyMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeStrategy,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StrategyMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, strategy.FieldUserId)
 	}
-	return m
-}
-
-// withStrategyID sets the ID field of the mutation.
-func withStrategyID(id int) strategyOption {
-	return func(m *StrategyMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Strategy
-		)
-		m.oldValue = func(ctx context.Context) (*Strategy, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Strategy.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	if m.addmartinFactor != nil {
+		fields = append(fields, strategy.FieldMartinFactor)
 	}
-}
-
-// withStrategy sets the old Strategy of the mutation.
-func withStrategy(node *Strategy) strategyOption {
-	return func(m *StrategyMutation) {
-		m.oldValue = func(context.Context) (*Strategy, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.addmaxGridLimit != nil {
+		fields = append(fields, strategy.FieldMaxGridLimit)
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m StrategyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	if m.addgridCount != nil {
+		fields = append(fields, strategy.FieldGridCount)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *StrategyMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	if m.addfirstOrderId != nil {
+		fields = append(fields, strategy.FieldFirstOrderId)
 	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	if m.addcandlesToCheck != nil {
+		fields = append(fields, strategy.FieldCandlesToCheck)
 	}
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *StrategyMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
+	if m.addtrailingCandles != nil {
+		fields = append(fields, strategy.FieldTrailingCandles)
 	}
-	return *v, true
-}
-
-// OldCreateTime returns the old "create_time" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	if m.addtrailingMaxShifts != nil {
+		fields = append(fields, strategy.FieldTrailingMaxShifts)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	if m.addtrailingShifts != nil {
+		fields = append(fields, strategy.FieldTrailingShifts)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	if m.adddcaInterval != nil {
+		fields = append(fields, strategy.FieldDcaInterval)
 	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *StrategyMutation) ResetCreateTime() {
-	m.create_time = nil
+	if m.adddcaMaxOrders != nil {
+		fields = append(fields, strategy.FieldDcaMaxOrders)
+	}
+	if m.adddcaSellInterval != nil {
+		fields = append(fields, strategy.FieldDcaSellInterval)
+	}
+	return fields
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *StrategyMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case strategy.FieldUserId:
+		return m.AddedUserId()
+	case strategy.FieldMartinFactor:
+		return m.AddedMartinFactor()
+	case strategy.FieldMaxGridLimit:
+		return m.AddedMaxGridLimit()
+	case strategy.FieldGridCount:
+		return m.AddedGridCount()
+	case strategy.FieldFirstOrderId:
+		return m.AddedFirstOrderId()
+	case strategy.FieldCandlesToCheck:
+		return m.AddedCandlesToCheck()
+	case strategy.FieldTrailingCandles:
+		return m.AddedTrailingCandles()
+	case strategy.FieldTrailingMaxShifts:
+		return m.AddedTrailingMaxShifts()
+	case strategy.FieldTrailingShifts:
+		return m.AddedTrailingShifts()
+	case strategy.FieldDcaInterval:
+		return m.AddedDcaInterval()
+	case strategy.FieldDcaMaxOrders:
+		return m.AddedDcaMaxOrders()
+	case strategy.FieldDcaSellInterval:
+		return m.AddedDcaSellInterval()
+	}
+	return nil, false
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case strategy.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
+	case strategy.FieldMartinFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMartinFactor(v)
+		return nil
+	case strategy.FieldMaxGridLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxGridLimit(v)
+		return nil
+	case strategy.FieldGridCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridCount(v)
+		return nil
+	case strategy.FieldFirstOrderId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstOrderId(v)
+		return nil
+	case strategy.FieldCandlesToCheck:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCandlesToCheck(v)
+		return nil
+	case strategy.FieldTrailingCandles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingCandles(v)
+		return nil
+	case strategy.FieldTrailingMaxShifts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingMaxShifts(v)
+		return nil
+	case strategy.FieldTrailingShifts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingShifts(v)
+		return nil
+	case strategy.FieldDcaInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDcaInterval(v)
+		return nil
+	case strategy.FieldDcaMaxOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDcaMaxOrders(v)
+		return nil
+	case strategy.FieldDcaSellInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDcaSellInterval(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Strategy numeric field %s", name)
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StrategyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(strategy.FieldAccount) {
+		fields = append(fields, strategy.FieldAccount)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	if m.FieldCleared(strategy.FieldMaxGridLimit) {
+		fields = append(fields, strategy.FieldMaxGridLimit)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	if m.FieldCleared(strategy.FieldGridCount) {
+		fields = append(fields, strategy.FieldGridCount)
 	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *StrategyMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetGUID sets the "guid" field.
-func (m *StrategyMutation) SetGUID(s string) {
-	m.guid = &s
-}
-
-// GUID returns the value of the "guid" field in the mutation.
-func (m *StrategyMutation) GUID() (r string, exists bool) {
-	v := m.guid
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldGridStep) {
+		fields = append(fields, strategy.FieldGridStep)
 	}
-	return *v, true
-}
-
-// OldGUID returns the old "guid" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGUID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
+	if m.FieldCleared(strategy.FieldLastKlineVolume) {
+		fields = append(fields, strategy.FieldLastKlineVolume)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGUID requires an ID field in the mutation")
+	if m.FieldCleared(strategy.FieldFiveKlineVolume) {
+		fields = append(fields, strategy.FieldFiveKlineVolume)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
+	if m.FieldCleared(strategy.FieldFirstOrderId) {
+		fields = append(fields, strategy.FieldFirstOrderId)
 	}
-	return oldValue.GUID, nil
-}
-
-// ResetGUID resets all changes to the "guid" field.
-func (m *StrategyMutation) ResetGUID() {
-	m.guid = nil
-}
-
-// SetUserId sets the "userId" field.
-func (m *StrategyMutation) SetUserId(i int64) {
-	m.userId = &i
-	m.adduserId = nil
-}
-
-// UserId returns the value of the "userId" field in the mutation.
-func (m *StrategyMutation) UserId() (r int64, exists bool) {
-	v := m.userId
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldUpperBoundExit) {
+		fields = append(fields, strategy.FieldUpperBoundExit)
 	}
-	return *v, true
-}
-
-// OldUserId returns the old "userId" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUserId(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	if m.FieldCleared(strategy.FieldStopLossExit) {
+		fields = append(fields, strategy.FieldStopLossExit)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserId requires an ID field in the mutation")
+	if m.FieldCleared(strategy.FieldTakeProfitExit) {
+		fields = append(fields, strategy.FieldTakeProfitExit)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	if m.FieldCleared(strategy.FieldGlobalTakeProfitRatio) {
+		fields = append(fields, strategy.FieldGlobalTakeProfitRatio)
 	}
-	return oldValue.UserId, nil
-}
-
-// AddUserId adds i to the "userId" field.
-func (m *StrategyMutation) AddUserId(i int64) {
-	if m.adduserId != nil {
-		*m.adduserId += i
-	} else {
-		m.adduserId = &i
+	if m.FieldCleared(strategy.FieldDynamicStopLoss) {
+		fields = append(fields, strategy.FieldDynamicStopLoss)
+	}
+	if m.FieldCleared(strategy.FieldPaperTrading) {
+		fields = append(fields, strategy.FieldPaperTrading)
+	}
+	if m.FieldCleared(strategy.FieldDropOn) {
+		fields = append(fields, strategy.FieldDropOn)
+	}
+	if m.FieldCleared(strategy.FieldCandlesToCheck) {
+		fields = append(fields, strategy.FieldCandlesToCheck)
+	}
+	if m.FieldCleared(strategy.FieldDropThreshold) {
+		fields = append(fields, strategy.FieldDropThreshold)
+	}
+	if m.FieldCleared(strategy.FieldTrailingOn) {
+		fields = append(fields, strategy.FieldTrailingOn)
 	}
-}
-
-// AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *StrategyMutation) AddedUserId() (r int64, exists bool) {
-	v := m.adduserId
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldTrailingCandles) {
+		fields = append(fields, strategy.FieldTrailingCandles)
 	}
-	return *v, true
-}
-
-// ResetUserId resets all changes to the "userId" field.
-func (m *StrategyMutation) ResetUserId() {
-	m.userId = nil
-	m.adduserId = nil
-}
-
-// SetToken sets the "token" field.
-func (m *StrategyMutation) SetToken(s string) {
-	m.token = &s
-}
-
-// Token returns the value of the "token" field in the mutation.
-func (m *StrategyMutation) Token() (r string, exists bool) {
-	v := m.token
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldTrailingMaxShifts) {
+		fields = append(fields, strategy.FieldTrailingMaxShifts)
 	}
-	return *v, true
-}
-
-// OldToken returns the old "token" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	if m.FieldCleared(strategy.FieldTrailingShifts) {
+		fields = append(fields, strategy.FieldTrailingShifts)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+	if m.FieldCleared(strategy.FieldBuyConditions) {
+		fields = append(fields, strategy.FieldBuyConditions)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	if m.FieldCleared(strategy.FieldDcaInterval) {
+		fields = append(fields, strategy.FieldDcaInterval)
 	}
-	return oldValue.Token, nil
-}
-
-// ResetToken resets all changes to the "token" field.
-func (m *StrategyMutation) ResetToken() {
-	m.token = nil
-}
-
-// SetSymbol sets the "symbol" field.
-func (m *StrategyMutation) SetSymbol(s string) {
-	m.symbol = &s
-}
-
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *StrategyMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldDcaDropRatio) {
+		fields = append(fields, strategy.FieldDcaDropRatio)
 	}
-	return *v, true
-}
-
-// OldSymbol returns the old "symbol" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	if m.FieldCleared(strategy.FieldDcaMaxOrders) {
+		fields = append(fields, strategy.FieldDcaMaxOrders)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	if m.FieldCleared(strategy.FieldDcaSellInterval) {
+		fields = append(fields, strategy.FieldDcaSellInterval)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	if m.FieldCleared(strategy.FieldDcaSellProfitRatio) {
+		fields = append(fields, strategy.FieldDcaSellProfitRatio)
 	}
-	return oldValue.Symbol, nil
-}
-
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *StrategyMutation) ResetSymbol() {
-	m.symbol = nil
-}
-
-// SetMartinFactor sets the "martinFactor" field.
-func (m *StrategyMutation) SetMartinFactor(f float64) {
-	m.martinFactor = &f
-	m.addmartinFactor = nil
-}
-
-// MartinFactor returns the value of the "martinFactor" field in the mutation.
-func (m *StrategyMutation) MartinFactor() (r float64, exists bool) {
-	v := m.martinFactor
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldDcaLastBuyTime) {
+		fields = append(fields, strategy.FieldDcaLastBuyTime)
 	}
-	return *v, true
-}
-
-// OldMartinFactor returns the old "martinFactor" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldMartinFactor(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMartinFactor is only allowed on UpdateOne operations")
+	if m.FieldCleared(strategy.FieldDcaLastBuyPrice) {
+		fields = append(fields, strategy.FieldDcaLastBuyPrice)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMartinFactor requires an ID field in the mutation")
+	if m.FieldCleared(strategy.FieldDcaLastSellTime) {
+		fields = append(fields, strategy.FieldDcaLastSellTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMartinFactor: %w", err)
+	if m.FieldCleared(strategy.FieldGridTrend) {
+		fields = append(fields, strategy.FieldGridTrend)
 	}
-	return oldValue.MartinFactor, nil
-}
-
-// AddMartinFactor adds f to the "martinFactor" field.
-func (m *StrategyMutation) AddMartinFactor(f float64) {
-	if m.addmartinFactor != nil {
-		*m.addmartinFactor += f
-	} else {
-		m.addmartinFactor = &f
+	if m.FieldCleared(strategy.FieldLastLowerThresholdAlertTime) {
+		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
 	}
-}
-
-// AddedMartinFactor returns the value that was added to the "martinFactor" field in this mutation.
-func (m *StrategyMutation) AddedMartinFactor() (r float64, exists bool) {
-	v := m.addmartinFactor
-	if v == nil {
-		return
+	if m.FieldCleared(strategy.FieldLastUpperThresholdAlertTime) {
+		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
 	}
-	return *v, true
-}
-
-// ResetMartinFactor resets all changes to the "martinFactor" field.
-func (m *StrategyMutation) ResetMartinFactor() {
-	m.martinFactor = nil
-	m.addmartinFactor = nil
+	return fields
 }
 
-// SetMaxGridLimit sets the "maxGridLimit" field.
-func (m *StrategyMutation) SetMaxGridLimit(i int) {
-	m.maxGridLimit = &i
-	m.addmaxGridLimit = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StrategyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// MaxGridLimit returns the value of the "maxGridLimit" field in the mutation.
-func (m *StrategyMutation) MaxGridLimit() (r int, exists bool) {
-	v := m.maxGridLimit
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StrategyMutation) ClearField(name string) error {
+	switch name {
+	case strategy.FieldAccount:
+		m.ClearAccount()
+		return nil
+	case strategy.FieldMaxGridLimit:
+		m.ClearMaxGridLimit()
+		return nil
+	case strategy.FieldGridCount:
+		m.ClearGridCount()
+		return nil
+	case strategy.FieldGridStep:
+		m.ClearGridStep()
+		return nil
+	case strategy.FieldLastKlineVolume:
+		m.ClearLastKlineVolume()
+		return nil
+	case strategy.FieldFiveKlineVolume:
+		m.ClearFiveKlineVolume()
+		return nil
+	case strategy.FieldFirstOrderId:
+		m.ClearFirstOrderId()
+		return nil
+	case strategy.FieldUpperBoundExit:
+		m.ClearUpperBoundExit()
+		return nil
+	case strategy.FieldStopLossExit:
+		m.ClearStopLossExit()
+		return nil
+	case strategy.FieldTakeProfitExit:
+		m.ClearTakeProfitExit()
+		return nil
+	case strategy.FieldGlobalTakeProfitRatio:
+		m.ClearGlobalTakeProfitRatio()
+		return nil
+	case strategy.FieldDynamicStopLoss:
+		m.ClearDynamicStopLoss()
+		return nil
+	case strategy.FieldPaperTrading:
+		m.ClearPaperTrading()
+		return nil
+	case strategy.FieldDropOn:
+		m.ClearDropOn()
+		return nil
+	case strategy.FieldCandlesToCheck:
+		m.ClearCandlesToCheck()
+		return nil
+	case strategy.FieldDropThreshold:
+		m.ClearDropThreshold()
+		return nil
+	case strategy.FieldTrailingOn:
+		m.ClearTrailingOn()
+		return nil
+	case strategy.FieldTrailingCandles:
+		m.ClearTrailingCandles()
+		return nil
+	case strategy.FieldTrailingMaxShifts:
+		m.ClearTrailingMaxShifts()
+		return nil
+	case strategy.FieldTrailingShifts:
+		m.ClearTrailingShifts()
+		return nil
+	case strategy.FieldBuyConditions:
+		m.ClearBuyConditions()
+		return nil
+	case strategy.FieldDcaInterval:
+		m.ClearDcaInterval()
+		return nil
+	case strategy.FieldDcaDropRatio:
+		m.ClearDcaDropRatio()
+		return nil
+	case strategy.FieldDcaMaxOrders:
+		m.ClearDcaMaxOrders()
+		return nil
+	case strategy.FieldDcaSellInterval:
+		m.ClearDcaSellInterval()
+		return nil
+	case strategy.FieldDcaSellProfitRatio:
+		m.ClearDcaSellProfitRatio()
+		return nil
+	case strategy.FieldDcaLastBuyTime:
+		m.ClearDcaLastBuyTime()
+		return nil
+	case strategy.FieldDcaLastBuyPrice:
+		m.ClearDcaLastBuyPrice()
+		return nil
+	case strategy.FieldDcaLastSellTime:
+		m.ClearDcaLastSellTime()
+		return nil
+	case strategy.FieldGridTrend:
+		m.ClearGridTrend()
+		return nil
+	case strategy.FieldLastLowerThresholdAlertTime:
+		m.ClearLastLowerThresholdAlertTime()
+		return nil
+	case strategy.FieldLastUpperThresholdAlertTime:
+		m.ClearLastUpperThresholdAlertTime()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Strategy nullable field %s", name)
 }
 
-// OldMaxGridLimit returns the old "maxGridLimit" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldMaxGridLimit(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxGridLimit is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxGridLimit requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxGridLimit: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StrategyMutation) ResetField(name string) error {
+	switch name {
+	case strategy.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case strategy.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case strategy.FieldGUID:
+		m.ResetGUID()
+		return nil
+	case strategy.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case strategy.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case strategy.FieldToken:
+		m.ResetToken()
+		return nil
+	case strategy.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case strategy.FieldType:
+		m.ResetType()
+		return nil
+	case strategy.FieldMartinFactor:
+		m.ResetMartinFactor()
+		return nil
+	case strategy.FieldMaxGridLimit:
+		m.ResetMaxGridLimit()
+		return nil
+	case strategy.FieldTakeProfitRatio:
+		m.ResetTakeProfitRatio()
+		return nil
+	case strategy.FieldUpperPriceBound:
+		m.ResetUpperPriceBound()
+		return nil
+	case strategy.FieldLowerPriceBound:
+		m.ResetLowerPriceBound()
+		return nil
+	case strategy.FieldGridMode:
+		m.ResetGridMode()
+		return nil
+	case strategy.FieldGridCount:
+		m.ResetGridCount()
+		return nil
+	case strategy.FieldGridStep:
+		m.ResetGridStep()
+		return nil
+	case strategy.FieldInitialOrderSize:
+		m.ResetInitialOrderSize()
+		return nil
+	case strategy.FieldLastKlineVolume:
+		m.ResetLastKlineVolume()
+		return nil
+	case strategy.FieldFiveKlineVolume:
+		m.ResetFiveKlineVolume()
+		return nil
+	case strategy.FieldFirstOrderId:
+		m.ResetFirstOrderId()
+		return nil
+	case strategy.FieldUpperBoundExit:
+		m.ResetUpperBoundExit()
+		return nil
+	case strategy.FieldStopLossExit:
+		m.ResetStopLossExit()
+		return nil
+	case strategy.FieldTakeProfitExit:
+		m.ResetTakeProfitExit()
+		return nil
+	case strategy.FieldGlobalTakeProfitRatio:
+		m.ResetGlobalTakeProfitRatio()
+		return nil
+	case strategy.FieldDynamicStopLoss:
+		m.ResetDynamicStopLoss()
+		return nil
+	case strategy.FieldPaperTrading:
+		m.ResetPaperTrading()
+		return nil
+	case strategy.FieldDropOn:
+		m.ResetDropOn()
+		return nil
+	case strategy.FieldCandlesToCheck:
+		m.ResetCandlesToCheck()
+		return nil
+	case strategy.FieldDropThreshold:
+		m.ResetDropThreshold()
+		return nil
+	case strategy.FieldTrailingOn:
+		m.ResetTrailingOn()
+		return nil
+	case strategy.FieldTrailingCandles:
+		m.ResetTrailingCandles()
+		return nil
+	case strategy.FieldTrailingMaxShifts:
+		m.ResetTrailingMaxShifts()
+		return nil
+	case strategy.FieldTrailingShifts:
+		m.ResetTrailingShifts()
+		return nil
+	case strategy.FieldBuyConditions:
+		m.ResetBuyConditions()
+		return nil
+	case strategy.FieldDcaInterval:
+		m.ResetDcaInterval()
+		return nil
+	case strategy.FieldDcaDropRatio:
+		m.ResetDcaDropRatio()
+		return nil
+	case strategy.FieldDcaMaxOrders:
+		m.ResetDcaMaxOrders()
+		return nil
+	case strategy.FieldDcaSellInterval:
+		m.ResetDcaSellInterval()
+		return nil
+	case strategy.FieldDcaSellProfitRatio:
+		m.ResetDcaSellProfitRatio()
+		return nil
+	case strategy.FieldDcaLastBuyTime:
+		m.ResetDcaLastBuyTime()
+		return nil
+	case strategy.FieldDcaLastBuyPrice:
+		m.ResetDcaLastBuyPrice()
+		return nil
+	case strategy.FieldDcaLastSellTime:
+		m.ResetDcaLastSellTime()
+		return nil
+	case strategy.FieldEnableAutoBuy:
+		m.ResetEnableAutoBuy()
+		return nil
+	case strategy.FieldEnableAutoSell:
+		m.ResetEnableAutoSell()
+		return nil
+	case strategy.FieldEnableAutoExit:
+		m.ResetEnableAutoExit()
+		return nil
+	case strategy.FieldEnablePushNotification:
+		m.ResetEnablePushNotification()
+		return nil
+	case strategy.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case strategy.FieldGridTrend:
+		m.ResetGridTrend()
+		return nil
+	case strategy.FieldLastLowerThresholdAlertTime:
+		m.ResetLastLowerThresholdAlertTime()
+		return nil
+	case strategy.FieldLastUpperThresholdAlertTime:
+		m.ResetLastUpperThresholdAlertTime()
+		return nil
 	}
-	return oldValue.MaxGridLimit, nil
+	return fmt.Errorf("unknown Strategy field %s", name)
 }
 
-// AddMaxGridLimit adds i to the "maxGridLimit" field.
-func (m *StrategyMutation) AddMaxGridLimit(i int) {
-	if m.addmaxGridLimit != nil {
-		*m.addmaxGridLimit += i
-	} else {
-		m.addmaxGridLimit = &i
-	}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StrategyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddedMaxGridLimit returns the value that was added to the "maxGridLimit" field in this mutation.
-func (m *StrategyMutation) AddedMaxGridLimit() (r int, exists bool) {
-	v := m.addmaxGridLimit
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearMaxGridLimit clears the value of the "maxGridLimit" field.
-func (m *StrategyMutation) ClearMaxGridLimit() {
-	m.maxGridLimit = nil
-	m.addmaxGridLimit = nil
-	m.clearedFields[strategy.FieldMaxGridLimit] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StrategyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// MaxGridLimitCleared returns if the "maxGridLimit" field was cleared in this mutation.
-func (m *StrategyMutation) MaxGridLimitCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldMaxGridLimit]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetMaxGridLimit resets all changes to the "maxGridLimit" field.
-func (m *StrategyMutation) ResetMaxGridLimit() {
-	m.maxGridLimit = nil
-	m.addmaxGridLimit = nil
-	delete(m.clearedFields, strategy.FieldMaxGridLimit)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StrategyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetTakeProfitRatio sets the "takeProfitRatio" field.
-func (m *StrategyMutation) SetTakeProfitRatio(d decimal.Decimal) {
-	m.takeProfitRatio = &d
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StrategyMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// TakeProfitRatio returns the value of the "takeProfitRatio" field in the mutation.
-func (m *StrategyMutation) TakeProfitRatio() (r decimal.Decimal, exists bool) {
-	v := m.takeProfitRatio
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StrategyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Strategy unique edge %s", name)
 }
 
-// OldTakeProfitRatio returns the old "takeProfitRatio" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTakeProfitRatio(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTakeProfitRatio is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTakeProfitRatio requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTakeProfitRatio: %w", err)
-	}
-	return oldValue.TakeProfitRatio, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StrategyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Strategy edge %s", name)
 }
 
-// ResetTakeProfitRatio resets all changes to the "takeProfitRatio" field.
-func (m *StrategyMutation) ResetTakeProfitRatio() {
-	m.takeProfitRatio = nil
+// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
+type WalletMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	userId        *int64
+	adduserId     *int64
+	account       *string
+	password      *string
+	privateKey    *string
+	name          *string
+	isDefault     *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Wallet, error)
+	predicates    []predicate.Wallet
 }
 
-// SetUpperPriceBound sets the "upperPriceBound" field.
-func (m *StrategyMutation) SetUpperPriceBound(d decimal.Decimal) {
-	m.upperPriceBound = &d
-}
+var _ ent.Mutation = (*WalletMutation)(nil)
 
-// UpperPriceBound returns the value of the "upperPriceBound" field in the mutation.
-func (m *StrategyMutation) UpperPriceBound() (r decimal.Decimal, exists bool) {
-	v := m.upperPriceBound
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// walletOption allows management of the mutation configuration using functional options.
+type walletOption func(*WalletMutation)
 
-// OldUpperPriceBound returns the old "upperPriceBound" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpperPriceBound(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpperPriceBound is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpperPriceBound requires an ID field in the mutation")
+// newWalletMutation creates new mutation for the Wallet entity.
+func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
+	m := &WalletMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWallet,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpperPriceBound: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.UpperPriceBound, nil
-}
-
-// ResetUpperPriceBound resets all changes to the "upperPriceBound" field.
-func (m *StrategyMutation) ResetUpperPriceBound() {
-	m.upperPriceBound = nil
-}
-
-// SetLowerPriceBound sets the "lowerPriceBound" field.
-func (m *StrategyMutation) SetLowerPriceBound(d decimal.Decimal) {
-	m.lowerPriceBound = &d
+	return m
 }
 
-// LowerPriceBound returns the value of the "lowerPriceBound" field in the mutation.
-func (m *StrategyMutation) LowerPriceBound() (r decimal.Decimal, exists bool) {
-	v := m.lowerPriceBound
-	if v == nil {
-		return
+// withWalletID sets the ID field of the mutation.
+func withWalletID(id int) walletOption {
+	return func(m *WalletMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Wallet
+		)
+		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Wallet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldLowerPriceBound returns the old "lowerPriceBound" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLowerPriceBound(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLowerPriceBound is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLowerPriceBound requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLowerPriceBound: %w", err)
+// withWallet sets the old Wallet of the mutation.
+func withWallet(node *Wallet) walletOption {
+	return func(m *WalletMutation) {
+		m.oldValue = func(context.Context) (*Wallet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.LowerPriceBound, nil
 }
 
-// ResetLowerPriceBound resets all changes to the "lowerPriceBound" field.
-func (m *StrategyMutation) ResetLowerPriceBound() {
-	m.lowerPriceBound = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetInitialOrderSize sets the "initialOrderSize" field.
-func (m *StrategyMutation) SetInitialOrderSize(d decimal.Decimal) {
-	m.initialOrderSize = &d
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// InitialOrderSize returns the value of the "initialOrderSize" field in the mutation.
-func (m *StrategyMutation) InitialOrderSize() (r decimal.Decimal, exists bool) {
-	v := m.initialOrderSize
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldInitialOrderSize returns the old "initialOrderSize" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldInitialOrderSize(ctx context.Context) (v decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitialOrderSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitialOrderSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitialOrderSize: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.InitialOrderSize, nil
-}
-
-// ResetInitialOrderSize resets all changes to the "initialOrderSize" field.
-func (m *StrategyMutation) ResetInitialOrderSize() {
-	m.initialOrderSize = nil
 }
 
-// SetLastKlineVolume sets the "lastKlineVolume" field.
-func (m *StrategyMutation) SetLastKlineVolume(d decimal.Decimal) {
-	m.lastKlineVolume = &d
+// SetCreateTime sets the "create_time" field.
+func (m *WalletMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// LastKlineVolume returns the value of the "lastKlineVolume" field in the mutation.
-func (m *StrategyMutation) LastKlineVolume() (r decimal.Decimal, exists bool) {
-	v := m.lastKlineVolume
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WalletMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastKlineVolume returns the old "lastKlineVolume" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLastKlineVolume(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *WalletMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastKlineVolume is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastKlineVolume requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastKlineVolume: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.LastKlineVolume, nil
-}
-
-// ClearLastKlineVolume clears the value of the "lastKlineVolume" field.
-func (m *StrategyMutation) ClearLastKlineVolume() {
-	m.lastKlineVolume = nil
-	m.clearedFields[strategy.FieldLastKlineVolume] = struct{}{}
-}
-
-// LastKlineVolumeCleared returns if the "lastKlineVolume" field was cleared in this mutation.
-func (m *StrategyMutation) LastKlineVolumeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldLastKlineVolume]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetLastKlineVolume resets all changes to the "lastKlineVolume" field.
-func (m *StrategyMutation) ResetLastKlineVolume() {
-	m.lastKlineVolume = nil
-	delete(m.clearedFields, strategy.FieldLastKlineVolume)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WalletMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetFiveKlineVolume sets the "fiveKlineVolume" field.
-func (m *StrategyMutation) SetFiveKlineVolume(d decimal.Decimal) {
-	m.fiveKlineVolume = &d
+// SetUpdateTime sets the "update_time" field.
+func (m *WalletMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// FiveKlineVolume returns the value of the "fiveKlineVolume" field in the mutation.
-func (m *StrategyMutation) FiveKlineVolume() (r decimal.Decimal, exists bool) {
-	v := m.fiveKlineVolume
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WalletMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFiveKlineVolume returns the old "fiveKlineVolume" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldFiveKlineVolume(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *WalletMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFiveKlineVolume is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFiveKlineVolume requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFiveKlineVolume: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.FiveKlineVolume, nil
-}
-
-// ClearFiveKlineVolume clears the value of the "fiveKlineVolume" field.
-func (m *StrategyMutation) ClearFiveKlineVolume() {
-	m.fiveKlineVolume = nil
-	m.clearedFields[strategy.FieldFiveKlineVolume] = struct{}{}
-}
-
-// FiveKlineVolumeCleared returns if the "fiveKlineVolume" field was cleared in this mutation.
-func (m *StrategyMutation) FiveKlineVolumeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldFiveKlineVolume]
-	return ok
+	return oldValue.UpdateTime, nil
 }
 
-// ResetFiveKlineVolume resets all changes to the "fiveKlineVolume" field.
-func (m *StrategyMutation) ResetFiveKlineVolume() {
-	m.fiveKlineVolume = nil
-	delete(m.clearedFields, strategy.FieldFiveKlineVolume)
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WalletMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetFirstOrderId sets the "firstOrderId" field.
-func (m *StrategyMutation) SetFirstOrderId(i int) {
-	m.firstOrderId = &i
-	m.addfirstOrderId = nil
+// SetUserId sets the "userId" field.
+func (m *WalletMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
 }
 
-// FirstOrderId returns the value of the "firstOrderId" field in the mutation.
-func (m *StrategyMutation) FirstOrderId() (r int, exists bool) {
-	v := m.firstOrderId
+// UserId returns the value of the "userId" field in the mutation.
+func (m *WalletMutation) UserId() (r int64, exists bool) {
+	v := m.userId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstOrderId returns the old "firstOrderId" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldFirstOrderId(ctx context.Context) (v *int, err error) {
+func (m *WalletMutation) OldUserId(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstOrderId is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstOrderId requires an ID field in the mutation")
+		return v, errors.New("OldUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstOrderId: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
-	return oldValue.FirstOrderId, nil
+	return oldValue.UserId, nil
 }
 
-// AddFirstOrderId adds i to the "firstOrderId" field.
-func (m *StrategyMutation) AddFirstOrderId(i int) {
-	if m.addfirstOrderId != nil {
-		*m.addfirstOrderId += i
+// AddUserId adds i to the "userId" field.
+func (m *WalletMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
 	} else {
-		m.addfirstOrderId = &i
+		m.adduserId = &i
 	}
 }
 
-// AddedFirstOrderId returns the value that was added to the "firstOrderId" field in this mutation.
-func (m *StrategyMutation) AddedFirstOrderId() (r int, exists bool) {
-	v := m.addfirstOrderId
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *WalletMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// ClearFirstOrderId clears the value of the "firstOrderId" field.
-func (m *StrategyMutation) ClearFirstOrderId() {
-	m.firstOrderId = nil
-	m.addfirstOrderId = nil
-	m.clearedFields[strategy.FieldFirstOrderId] = struct{}{}
-}
-
-// FirstOrderIdCleared returns if the "firstOrderId" field was cleared in this mutation.
-func (m *StrategyMutation) FirstOrderIdCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldFirstOrderId]
-	return ok
-}
-
-// ResetFirstOrderId resets all changes to the "firstOrderId" field.
-func (m *StrategyMutation) ResetFirstOrderId() {
-	m.firstOrderId = nil
-	m.addfirstOrderId = nil
-	delete(m.clearedFields, strategy.FieldFirstOrderId)
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *WalletMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
 }
 
-// SetUpperBoundExit sets the "upperBoundExit" field.
-func (m *StrategyMutation) SetUpperBoundExit(d decimal.Decimal) {
-	m.upperBoundExit = &d
+// SetAccount sets the "account" field.
+func (m *WalletMutation) SetAccount(s string) {
+	m.account = &s
 }
 
-// UpperBoundExit returns the value of the "upperBoundExit" field in the mutation.
-func (m *StrategyMutation) UpperBoundExit() (r decimal.Decimal, exists bool) {
-	v := m.upperBoundExit
+// Account returns the value of the "account" field in the mutation.
+func (m *WalletMutation) Account() (r string, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpperBoundExit returns the old "upperBoundExit" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpperBoundExit(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *WalletMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpperBoundExit is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpperBoundExit requires an ID field in the mutation")
+		return v, errors.New("OldAccount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpperBoundExit: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
 	}
-	return oldValue.UpperBoundExit, nil
-}
-
-// ClearUpperBoundExit clears the value of the "upperBoundExit" field.
-func (m *StrategyMutation) ClearUpperBoundExit() {
-	m.upperBoundExit = nil
-	m.clearedFields[strategy.FieldUpperBoundExit] = struct{}{}
-}
-
-// UpperBoundExitCleared returns if the "upperBoundExit" field was cleared in this mutation.
-func (m *StrategyMutation) UpperBoundExitCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldUpperBoundExit]
-	return ok
+	return oldValue.Account, nil
 }
 
-// ResetUpperBoundExit resets all changes to the "upperBoundExit" field.
-func (m *StrategyMutation) ResetUpperBoundExit() {
-	m.upperBoundExit = nil
-	delete(m.clearedFields, strategy.FieldUpperBoundExit)
+// ResetAccount resets all changes to the "account" field.
+func (m *WalletMutation) ResetAccount() {
+	m.account = nil
 }
 
-// SetStopLossExit sets the "stopLossExit" field.
-func (m *StrategyMutation) SetStopLossExit(d decimal.Decimal) {
-	m.stopLossExit = &d
+// SetPassword sets the "password" field.
+func (m *WalletMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// StopLossExit returns the value of the "stopLossExit" field in the mutation.
-func (m *StrategyMutation) StopLossExit() (r decimal.Decimal, exists bool) {
-	v := m.stopLossExit
+// Password returns the value of the "password" field in the mutation.
+func (m *WalletMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStopLossExit returns the old "stopLossExit" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldStopLossExit(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *WalletMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStopLossExit is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStopLossExit requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStopLossExit: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.StopLossExit, nil
-}
-
-// ClearStopLossExit clears the value of the "stopLossExit" field.
-func (m *StrategyMutation) ClearStopLossExit() {
-	m.stopLossExit = nil
-	m.clearedFields[strategy.FieldStopLossExit] = struct{}{}
-}
-
-// StopLossExitCleared returns if the "stopLossExit" field was cleared in this mutation.
-func (m *StrategyMutation) StopLossExitCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldStopLossExit]
-	return ok
+	return oldValue.Password, nil
 }
 
-// ResetStopLossExit resets all changes to the "stopLossExit" field.
-func (m *StrategyMutation) ResetStopLossExit() {
-	m.stopLossExit = nil
-	delete(m.clearedFields, strategy.FieldStopLossExit)
+// ResetPassword resets all changes to the "password" field.
+func (m *WalletMutation) ResetPassword() {
+	m.password = nil
 }
 
-// SetTakeProfitExit sets the "takeProfitExit" field.
-func (m *StrategyMutation) SetTakeProfitExit(d decimal.Decimal) {
-	m.takeProfitExit = &d
+// SetPrivateKey sets the "privateKey" field.
+func (m *WalletMutation) SetPrivateKey(s string) {
+	m.privateKey = &s
 }
 
-// TakeProfitExit returns the value of the "takeProfitExit" field in the mutation.
-func (m *StrategyMutation) TakeProfitExit() (r decimal.Decimal, exists bool) {
-	v := m.takeProfitExit
+// PrivateKey returns the value of the "privateKey" field in the mutation.
+func (m *WalletMutation) PrivateKey() (r string, exists bool) {
+	v := m.privateKey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTakeProfitExit returns the old "takeProfitExit" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldPrivateKey returns the old "privateKey" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTakeProfitExit(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *WalletMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTakeProfitExit is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTakeProfitExit requires an ID field in the mutation")
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTakeProfitExit: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
 	}
-	return oldValue.TakeProfitExit, nil
-}
-
-// ClearTakeProfitExit clears the value of the "takeProfitExit" field.
-func (m *StrategyMutation) ClearTakeProfitExit() {
-	m.takeProfitExit = nil
-	m.clearedFields[strategy.FieldTakeProfitExit] = struct{}{}
-}
-
-// TakeProfitExitCleared returns if the "takeProfitExit" field was cleared in this mutation.
-func (m *StrategyMutation) TakeProfitExitCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldTakeProfitExit]
-	return ok
+	return oldValue.PrivateKey, nil
 }
 
-// ResetTakeProfitExit resets all changes to the "takeProfitExit" field.
-func (m *StrategyMutation) ResetTakeProfitExit() {
-	m.takeProfitExit = nil
-	delete(m.clearedFields, strategy.FieldTakeProfitExit)
+// ResetPrivateKey resets all changes to the "privateKey" field.
+func (m *WalletMutation) ResetPrivateKey() {
+	m.privateKey = nil
 }
 
-// SetGlobalTakeProfitRatio sets the "globalTakeProfitRatio" field.
-func (m *StrategyMutation) SetGlobalTakeProfitRatio(d decimal.Decimal) {
-	m.globalTakeProfitRatio = &d
+// SetName sets the "name" field.
+func (m *WalletMutation) SetName(s string) {
+	m.name = &s
 }
 
-// GlobalTakeProfitRatio returns the value of the "globalTakeProfitRatio" field in the mutation.
-func (m *StrategyMutation) GlobalTakeProfitRatio() (r decimal.Decimal, exists bool) {
-	v := m.globalTakeProfitRatio
+// Name returns the value of the "name" field in the mutation.
+func (m *WalletMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGlobalTakeProfitRatio returns the old "globalTakeProfitRatio" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGlobalTakeProfitRatio(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *WalletMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGlobalTakeProfitRatio is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGlobalTakeProfitRatio requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGlobalTakeProfitRatio: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.GlobalTakeProfitRatio, nil
+	return oldValue.Name, nil
 }
 
-// ClearGlobalTakeProfitRatio clears the value of the "globalTakeProfitRatio" field.
-func (m *StrategyMutation) ClearGlobalTakeProfitRatio() {
-	m.globalTakeProfitRatio = nil
-	m.clearedFields[strategy.FieldGlobalTakeProfitRatio] = struct{}{}
+// ClearName clears the value of the "name" field.
+func (m *WalletMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[wallet.FieldName] = struct{}{}
 }
 
-// GlobalTakeProfitRatioCleared returns if the "globalTakeProfitRatio" field was cleared in this mutation.
-func (m *StrategyMutation) GlobalTakeProfitRatioCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldGlobalTakeProfitRatio]
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *WalletMutation) NameCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldName]
 	return ok
 }
 
-// ResetGlobalTakeProfitRatio resets all changes to the "globalTakeProfitRatio" field.
-func (m *StrategyMutation) ResetGlobalTakeProfitRatio() {
-	m.globalTakeProfitRatio = nil
-	delete(m.clearedFields, strategy.FieldGlobalTakeProfitRatio)
+// ResetName resets all changes to the "name" field.
+func (m *WalletMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, wallet.FieldName)
 }
 
-// SetDynamicStopLoss sets the "dynamicStopLoss" field.
-func (m *StrategyMutation) SetDynamicStopLoss(b bool) {
-	m.dynamicStopLoss = &b
+// SetIsDefault sets the "isDefault" field.
+func (m *WalletMutation) SetIsDefault(b bool) {
+	m.isDefault = &b
 }
 
-// DynamicStopLoss returns the value of the "dynamicStopLoss" field in the mutation.
-func (m *StrategyMutation) DynamicStopLoss() (r bool, exists bool) {
-	v := m.dynamicStopLoss
+// IsDefault returns the value of the "isDefault" field in the mutation.
+func (m *WalletMutation) IsDefault() (r bool, exists bool) {
+	v := m.isDefault
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDynamicStopLoss returns the old "dynamicStopLoss" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldIsDefault returns the old "isDefault" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldDynamicStopLoss(ctx context.Context) (v bool, err error) {
+func (m *WalletMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDynamicStopLoss is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDynamicStopLoss requires an ID field in the mutation")
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDynamicStopLoss: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
 	}
-	return oldValue.DynamicStopLoss, nil
+	return oldValue.IsDefault, nil
 }
 
-// ClearDynamicStopLoss clears the value of the "dynamicStopLoss" field.
-func (m *StrategyMutation) ClearDynamicStopLoss() {
-	m.dynamicStopLoss = nil
-	m.clearedFields[strategy.FieldDynamicStopLoss] = struct{}{}
+// ClearIsDefault clears the value of the "isDefault" field.
+func (m *WalletMutation) ClearIsDefault() {
+	m.isDefault = nil
+	m.clearedFields[wallet.FieldIsDefault] = struct{}{}
 }
 
-// DynamicStopLossCleared returns if the "dynamicStopLoss" field was cleared in this mutation.
-func (m *StrategyMutation) DynamicStopLossCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldDynamicStopLoss]
+// IsDefaultCleared returns if the "isDefault" field was cleared in this mutation.
+func (m *WalletMutation) IsDefaultCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldIsDefault]
 	return ok
 }
 
-// ResetDynamicStopLoss resets all changes to the "dynamicStopLoss" field.
-func (m *StrategyMutation) ResetDynamicStopLoss() {
-	m.dynamicStopLoss = nil
-	delete(m.clearedFields, strategy.FieldDynamicStopLoss)
+// ResetIsDefault resets all changes to the "isDefault" field.
+func (m *WalletMutation) ResetIsDefault() {
+	m.isDefault = nil
+	delete(m.clearedFields, wallet.FieldIsDefault)
 }
 
-// SetDropOn sets the "dropOn" field.
-func (m *StrategyMutation) SetDropOn(b bool) {
-	m.dropOn = &b
+// Where appends a list predicates to the WalletMutation builder.
+func (m *WalletMutation) Where(ps ...predicate.Wallet) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// DropOn returns the value of the "dropOn" field in the mutation.
-func (m *StrategyMutation) DropOn() (r bool, exists bool) {
-	v := m.dropOn
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the WalletMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WalletMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Wallet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldDropOn returns the old "dropOn" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldDropOn(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDropOn is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDropOn requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDropOn: %w", err)
-	}
-	return oldValue.DropOn, nil
+// Op returns the operation name.
+func (m *WalletMutation) Op() Op {
+	return m.op
 }
 
-// ClearDropOn clears the value of the "dropOn" field.
-func (m *StrategyMutation) ClearDropOn() {
-	m.dropOn = nil
-	m.clearedFields[strategy.FieldDropOn] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *WalletMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// DropOnCleared returns if the "dropOn" field was cleared in this mutation.
-func (m *StrategyMutation) DropOnCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldDropOn]
-	return ok
+// Type returns the node type of this mutation (Wallet).
+func (m *WalletMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WalletMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, wallet.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, wallet.FieldUpdateTime)
+	}
+	if m.userId != nil {
+		fields = append(fields, wallet.FieldUserId)
+	}
+	if m.account != nil {
+		fields = append(fields, wallet.FieldAccount)
+	}
+	if m.password != nil {
+		fields = append(fields, wallet.FieldPassword)
+	}
+	if m.privateKey != nil {
+		fields = append(fields, wallet.FieldPrivateKey)
+	}
+	if m.name != nil {
+		fields = append(fields, wallet.FieldName)
+	}
+	if m.isDefault != nil {
+		fields = append(fields, wallet.FieldIsDefault)
+	}
+	return fields
 }
 
-// ResetDropOn resets all changes to the "dropOn" field.
-func (m *StrategyMutation) ResetDropOn() {
-	m.dropOn = nil
-	delete(m.clearedFields, strategy.FieldDropOn)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WalletMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wallet.FieldCreateTime:
+		return m.CreateTime()
+	case wallet.FieldUpdateTime:
+		return m.UpdateTime()
+	case wallet.FieldUserId:
+		return m.UserId()
+	case wallet.FieldAccount:
+		return m.Account()
+	case wallet.FieldPassword:
+		return m.Password()
+	case wallet.FieldPrivateKey:
+		return m.PrivateKey()
+	case wallet.FieldName:
+		return m.Name()
+	case wallet.FieldIsDefault:
+		return m.IsDefault()
+	}
+	return nil, false
 }
 
-// SetCandlesToCheck sets the "candlesToCheck" field.
-func (m *StrategyMutation) SetCandlesToCheck(i int) {
-	m.candlesToCheck = &i
-	m.addcandlesToCheck = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wallet.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case wallet.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case wallet.FieldUserId:
+		return m.OldUserId(ctx)
+	case wallet.FieldAccount:
+		return m.OldAccount(ctx)
+	case wallet.FieldPassword:
+		return m.OldPassword(ctx)
+	case wallet.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case wallet.FieldName:
+		return m.OldName(ctx)
+	case wallet.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	}
+	return nil, fmt.Errorf("unknown Wallet field %s", name)
 }
 
-// CandlesToCheck returns the value of the "candlesToCheck" field in the mutation.
-func (m *StrategyMutation) CandlesToCheck() (r int, exists bool) {
-	v := m.candlesToCheck
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wallet.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case wallet.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case wallet.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case wallet.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case wallet.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case wallet.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case wallet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case wallet.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Wallet field %s", name)
 }
 
-// OldCandlesToCheck returns the old "candlesToCheck" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldCandlesToCheck(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCandlesToCheck is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCandlesToCheck requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCandlesToCheck: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WalletMutation) AddedFields() []string {
+	var fields []string
+	if m.adduserId != nil {
+		fields = append(fields, wallet.FieldUserId)
 	}
-	return oldValue.CandlesToCheck, nil
+	return fields
 }
 
-// AddCandlesToCheck adds i to the "candlesToCheck" field.
-func (m *StrategyMutation) AddCandlesToCheck(i int) {
-	if m.addcandlesToCheck != nil {
-		*m.addcandlesToCheck += i
-	} else {
-		m.addcandlesToCheck = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wallet.FieldUserId:
+		return m.AddedUserId()
 	}
+	return nil, false
 }
 
-// AddedCandlesToCheck returns the value that was added to the "candlesToCheck" field in this mutation.
-func (m *StrategyMutation) AddedCandlesToCheck() (r int, exists bool) {
-	v := m.addcandlesToCheck
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case wallet.FieldUserId:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserId(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Wallet numeric field %s", name)
 }
 
-// ClearCandlesToCheck clears the value of the "candlesToCheck" field.
-func (m *StrategyMutation) ClearCandlesToCheck() {
-	m.candlesToCheck = nil
-	m.addcandlesToCheck = nil
-	m.clearedFields[strategy.FieldCandlesToCheck] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WalletMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wallet.FieldName) {
+		fields = append(fields, wallet.FieldName)
+	}
+	if m.FieldCleared(wallet.FieldIsDefault) {
+		fields = append(fields, wallet.FieldIsDefault)
+	}
+	return fields
 }
 
-// CandlesToCheckCleared returns if the "candlesToCheck" field was cleared in this mutation.
-func (m *StrategyMutation) CandlesToCheckCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldCandlesToCheck]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WalletMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCandlesToCheck resets all changes to the "candlesToCheck" field.
-func (m *StrategyMutation) ResetCandlesToCheck() {
-	m.candlesToCheck = nil
-	m.addcandlesToCheck = nil
-	delete(m.clearedFields, strategy.FieldCandlesToCheck)
-}
-
-// SetDropThreshold sets the "dropThreshold" field.
-func (m *StrategyMutation) SetDropThreshold(d decimal.Decimal) {
-	m.dropThreshold = &d
-}
-
-// DropThreshold returns the value of the "dropThreshold" field in the mutation.
-func (m *StrategyMutation) DropThreshold() (r decimal.Decimal, exists bool) {
-	v := m.dropThreshold
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WalletMutation) ClearField(name string) error {
+	switch name {
+	case wallet.FieldName:
+		m.ClearName()
+		return nil
+	case wallet.FieldIsDefault:
+		m.ClearIsDefault()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Wallet nullable field %s", name)
 }
 
-// OldDropThreshold returns the old "dropThreshold" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldDropThreshold(ctx context.Context) (v *decimal.Decimal, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDropThreshold is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDropThreshold requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDropThreshold: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WalletMutation) ResetField(name string) error {
+	switch name {
+	case wallet.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case wallet.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case wallet.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case wallet.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case wallet.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case wallet.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case wallet.FieldName:
+		m.ResetName()
+		return nil
+	case wallet.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
 	}
-	return oldValue.DropThreshold, nil
+	return fmt.Errorf("unknown Wallet field %s", name)
 }
 
-// ClearDropThreshold clears the value of the "dropThreshold" field.
-func (m *StrategyMutation) ClearDropThreshold() {
-	m.dropThreshold = nil
-	m.clearedFields[strategy.FieldDropThreshold] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WalletMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DropThresholdCleared returns if the "dropThreshold" field was cleared in this mutation.
-func (m *StrategyMutation) DropThresholdCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldDropThreshold]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WalletMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetDropThreshold resets all changes to the "dropThreshold" field.
-func (m *StrategyMutation) ResetDropThreshold() {
-	m.dropThreshold = nil
-	delete(m.clearedFields, strategy.FieldDropThreshold)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WalletMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetEnableAutoBuy sets the "enableAutoBuy" field.
-func (m *StrategyMutation) SetEnableAutoBuy(b bool) {
-	m.enableAutoBuy = &b
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// EnableAutoBuy returns the value of the "enableAutoBuy" field in the mutation.
-func (m *StrategyMutation) EnableAutoBuy() (r bool, exists bool) {
-	v := m.enableAutoBuy
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WalletMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldEnableAutoBuy returns the old "enableAutoBuy" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEnableAutoBuy(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnableAutoBuy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnableAutoBuy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnableAutoBuy: %w", err)
-	}
-	return oldValue.EnableAutoBuy, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WalletMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetEnableAutoBuy resets all changes to the "enableAutoBuy" field.
-func (m *StrategyMutation) ResetEnableAutoBuy() {
-	m.enableAutoBuy = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WalletMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Wallet unique edge %s", name)
 }
 
-// SetEnableAutoSell sets the "enableAutoSell" field.
-func (m *StrategyMutation) SetEnableAutoSell(b bool) {
-	m.enableAutoSell = &b
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WalletMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Wallet edge %s", name)
 }
 
-// EnableAutoSell returns the value of the "enableAutoSell" field in the mutation.
-func (m *StrategyMutation) EnableAutoSell() (r bool, exists bool) {
-	v := m.enableAutoSell
-	if v == nil {
-		return
-	}
-	return *v, true
+// WithdrawAddressMutation represents an operation that mutates the WithdrawAddress nodes in the graph.
+type WithdrawAddressMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	userId        *int64
+	adduserId     *int64
+	address       *string
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WithdrawAddress, error)
+	predicates    []predicate.WithdrawAddress
 }
 
-// OldEnableAutoSell returns the old "enableAutoSell" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEnableAutoSell(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnableAutoSell is only allowed on UpdateOne operations")
+var _ ent.Mutation = (*WithdrawAddressMutation)(nil)
+
+// withdrawaddressOption allows management of the mutation configuration using functional options.
+type withdrawaddressOption func(*WithdrawAddressMutation)
+
+// newWithdrawAddressMutation creates new mutation for the WithdrawAddress entity.
+func newWithdrawAddressMutation(c config, op Op, opts ...withdrawaddressOption) *WithdrawAddressMutation {
+	m := &WithdrawAddressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithdrawAddress,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnableAutoSell requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnableAutoSell: %w", err)
+	return m
+}
+
+// withWithdrawAddressID sets the ID field of the mutation.
+func withWithdrawAddressID(id int) withdrawaddressOption {
+	return func(m *WithdrawAddressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithdrawAddress
+		)
+		m.oldValue = func(ctx context.Context) (*WithdrawAddress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithdrawAddress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.EnableAutoSell, nil
 }
 
-// ResetEnableAutoSell resets all changes to the "enableAutoSell" field.
-func (m *StrategyMutation) ResetEnableAutoSell() {
-	m.enableAutoSell = nil
+// withWithdrawAddress sets the old WithdrawAddress of the mutation.
+func withWithdrawAddress(node *WithdrawAddress) withdrawaddressOption {
+	return func(m *WithdrawAddressMutation) {
+		m.oldValue = func(context.Context) (*WithdrawAddress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetEnableAutoExit sets the "enableAutoExit" field.
-func (m *StrategyMutation) SetEnableAutoExit(b bool) {
-	m.enableAutoExit = &b
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithdrawAddressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// EnableAutoExit returns the value of the "enableAutoExit" field in the mutation.
-func (m *StrategyMutation) EnableAutoExit() (r bool, exists bool) {
-	v := m.enableAutoExit
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithdrawAddressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldEnableAutoExit returns the old "enableAutoExit" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEnableAutoExit(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnableAutoExit is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnableAutoExit requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnableAutoExit: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithdrawAddressMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.EnableAutoExit, nil
+	return *m.id, true
 }
 
-// ResetEnableAutoExit resets all changes to the "enableAutoExit" field.
-func (m *StrategyMutation) ResetEnableAutoExit() {
-	m.enableAutoExit = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithdrawAddressMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithdrawAddress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEnablePushNotification sets the "enablePushNotification" field.
-func (m *StrategyMutation) SetEnablePushNotification(b bool) {
-	m.enablePushNotification = &b
+// SetCreateTime sets the "create_time" field.
+func (m *WithdrawAddressMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// EnablePushNotification returns the value of the "enablePushNotification" field in the mutation.
-func (m *StrategyMutation) EnablePushNotification() (r bool, exists bool) {
-	v := m.enablePushNotification
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WithdrawAddressMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnablePushNotification returns the old "enablePushNotification" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WithdrawAddress entity.
+// If the WithdrawAddress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEnablePushNotification(ctx context.Context) (v bool, err error) {
+func (m *WithdrawAddressMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnablePushNotification is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnablePushNotification requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnablePushNotification: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.EnablePushNotification, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetEnablePushNotification resets all changes to the "enablePushNotification" field.
-func (m *StrategyMutation) ResetEnablePushNotification() {
-	m.enablePushNotification = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WithdrawAddressMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *StrategyMutation) SetStatus(s strategy.Status) {
-	m.status = &s
+// SetUpdateTime sets the "update_time" field.
+func (m *WithdrawAddressMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *StrategyMutation) Status() (r strategy.Status, exists bool) {
-	v := m.status
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WithdrawAddressMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the WithdrawAddress entity.
+// If the WithdrawAddress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldStatus(ctx context.Context) (v strategy.Status, err error) {
+func (m *WithdrawAddressMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *StrategyMutation) ResetStatus() {
-	m.status = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WithdrawAddressMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetGridTrend sets the "gridTrend" field.
-func (m *StrategyMutation) SetGridTrend(s string) {
-	m.gridTrend = &s
+// SetUserId sets the "userId" field.
+func (m *WithdrawAddressMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
 }
 
-// GridTrend returns the value of the "gridTrend" field in the mutation.
-func (m *StrategyMutation) GridTrend() (r string, exists bool) {
-	v := m.gridTrend
+// UserId returns the value of the "userId" field in the mutation.
+func (m *WithdrawAddressMutation) UserId() (r int64, exists bool) {
+	v := m.userId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGridTrend returns the old "gridTrend" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUserId returns the old "userId" field's value of the WithdrawAddress entity.
+// If the WithdrawAddress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGridTrend(ctx context.Context) (v *string, err error) {
+func (m *WithdrawAddressMutation) OldUserId(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGridTrend is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGridTrend requires an ID field in the mutation")
+		return v, errors.New("OldUserId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGridTrend: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
-	return oldValue.GridTrend, nil
+	return oldValue.UserId, nil
 }
 
-// ClearGridTrend clears the value of the "gridTrend" field.
-func (m *StrategyMutation) ClearGridTrend() {
-	m.gridTrend = nil
-	m.clearedFields[strategy.FieldGridTrend] = struct{}{}
+// AddUserId adds i to the "userId" field.
+func (m *WithdrawAddressMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
 }
 
-// GridTrendCleared returns if the "gridTrend" field was cleared in this mutation.
-func (m *StrategyMutation) GridTrendCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldGridTrend]
-	return ok
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *WithdrawAddressMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetGridTrend resets all changes to the "gridTrend" field.
-func (m *StrategyMutation) ResetGridTrend() {
-	m.gridTrend = nil
-	delete(m.clearedFields, strategy.FieldGridTrend)
+// ResetUserId resets all changes to the "userId" field.
+func (m *WithdrawAddressMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
 }
 
-// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
-func (m *StrategyMutation) SetLastLowerThresholdAlertTime(t time.Time) {
-	m.lastLowerThresholdAlertTime = &t
+// SetAddress sets the "address" field.
+func (m *WithdrawAddressMutation) SetAddress(s string) {
+	m.address = &s
 }
 
-// LastLowerThresholdAlertTime returns the value of the "lastLowerThresholdAlertTime" field in the mutation.
-func (m *StrategyMutation) LastLowerThresholdAlertTime() (r time.Time, exists bool) {
-	v := m.lastLowerThresholdAlertTime
+// Address returns the value of the "address" field in the mutation.
+func (m *WithdrawAddressMutation) Address() (r string, exists bool) {
+	v := m.address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastLowerThresholdAlertTime returns the old "lastLowerThresholdAlertTime" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldAddress returns the old "address" field's value of the WithdrawAddress entity.
+// If the WithdrawAddress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLastLowerThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
+func (m *WithdrawAddressMutation) OldAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLowerThresholdAlertTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLowerThresholdAlertTime requires an ID field in the mutation")
+		return v, errors.New("OldAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLowerThresholdAlertTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
-	return oldValue.LastLowerThresholdAlertTime, nil
-}
-
-// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
-func (m *StrategyMutation) ClearLastLowerThresholdAlertTime() {
-	m.lastLowerThresholdAlertTime = nil
-	m.clearedFields[strategy.FieldLastLowerThresholdAlertTime] = struct{}{}
-}
-
-// LastLowerThresholdAlertTimeCleared returns if the "lastLowerThresholdAlertTime" field was cleared in this mutation.
-func (m *StrategyMutation) LastLowerThresholdAlertTimeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldLastLowerThresholdAlertTime]
-	return ok
+	return oldValue.Address, nil
 }
 
-// ResetLastLowerThresholdAlertTime resets all changes to the "lastLowerThresholdAlertTime" field.
-func (m *StrategyMutation) ResetLastLowerThresholdAlertTime() {
-	m.lastLowerThresholdAlertTime = nil
-	delete(m.clearedFields, strategy.FieldLastLowerThresholdAlertTime)
+// ResetAddress resets all changes to the "address" field.
+func (m *WithdrawAddressMutation) ResetAddress() {
+	m.address = nil
 }
 
-// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
-func (m *StrategyMutation) SetLastUpperThresholdAlertTime(t time.Time) {
-	m.lastUpperThresholdAlertTime = &t
+// SetName sets the "name" field.
+func (m *WithdrawAddressMutation) SetName(s string) {
+	m.name = &s
 }
 
-// LastUpperThresholdAlertTime returns the value of the "lastUpperThresholdAlertTime" field in the mutation.
-func (m *StrategyMutation) LastUpperThresholdAlertTime() (r time.Time, exists bool) {
-	v := m.lastUpperThresholdAlertTime
+// Name returns the value of the "name" field in the mutation.
+func (m *WithdrawAddressMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpperThresholdAlertTime returns the old "lastUpperThresholdAlertTime" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WithdrawAddress entity.
+// If the WithdrawAddress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLastUpperThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
+func (m *WithdrawAddressMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpperThresholdAlertTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpperThresholdAlertTime requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpperThresholdAlertTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.LastUpperThresholdAlertTime, nil
+	return oldValue.Name, nil
 }
 
-// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
-func (m *StrategyMutation) ClearLastUpperThresholdAlertTime() {
-	m.lastUpperThresholdAlertTime = nil
-	m.clearedFields[strategy.FieldLastUpperThresholdAlertTime] = struct{}{}
+// ClearName clears the value of the "name" field.
+func (m *WithdrawAddressMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[withdrawaddress.FieldName] = struct{}{}
 }
 
-// LastUpperThresholdAlertTimeCleared returns if the "lastUpperThresholdAlertTime" field was cleared in this mutation.
-func (m *StrategyMutation) LastUpperThresholdAlertTimeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldLastUpperThresholdAlertTime]
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *WithdrawAddressMutation) NameCleared() bool {
+	_, ok := m.clearedFields[withdrawaddress.FieldName]
 	return ok
 }
 
-// ResetLastUpperThresholdAlertTime resets all changes to the "lastUpperThresholdAlertTime" field.
-func (m *StrategyMutation) ResetLastUpperThresholdAlertTime() {
-	m.lastUpperThresholdAlertTime = nil
-	delete(m.clearedFields, strategy.FieldLastUpperThresholdAlertTime)
+// ResetName resets all changes to the "name" field.
+func (m *WithdrawAddressMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, withdrawaddress.FieldName)
 }
 
-// Where appends a list predicates to the StrategyMutation builder.
-func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
+// Where appends a list predicates to the WithdrawAddressMutation builder.
+func (m *WithdrawAddressMutation) Where(ps ...predicate.WithdrawAddress) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WithdrawAddressMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Strategy, len(ps))
+func (m *WithdrawAddressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WithdrawAddress, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5038,188 +9787,58 @@ func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyMutation) Op() Op {
+func (m *WithdrawAddressMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyMutation) SetOp(op Op) {
+func (m *WithdrawAddressMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Strategy).
-func (m *StrategyMutation) Type() string {
+// Type returns the node type of this mutation (WithdrawAddress).
+func (m *WithdrawAddressMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyMutation) Fields() []string {
-	fields := make([]string, 0, 31)
+func (m *WithdrawAddressMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, strategy.FieldCreateTime)
+		fields = append(fields, withdrawaddress.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, strategy.FieldUpdateTime)
-	}
-	if m.guid != nil {
-		fields = append(fields, strategy.FieldGUID)
+		fields = append(fields, withdrawaddress.FieldUpdateTime)
 	}
 	if m.userId != nil {
-		fields = append(fields, strategy.FieldUserId)
-	}
-	if m.token != nil {
-		fields = append(fields, strategy.FieldToken)
-	}
-	if m.symbol != nil {
-		fields = append(fields, strategy.FieldSymbol)
-	}
-	if m.martinFactor != nil {
-		fields = append(fields, strategy.FieldMartinFactor)
-	}
-	if m.maxGridLimit != nil {
-		fields = append(fields, strategy.FieldMaxGridLimit)
-	}
-	if m.takeProfitRatio != nil {
-		fields = append(fields, strategy.FieldTakeProfitRatio)
-	}
-	if m.upperPriceBound != nil {
-		fields = append(fields, strategy.FieldUpperPriceBound)
-	}
-	if m.lowerPriceBound != nil {
-		fields = append(fields, strategy.FieldLowerPriceBound)
-	}
-	if m.initialOrderSize != nil {
-		fields = append(fields, strategy.FieldInitialOrderSize)
-	}
-	if m.lastKlineVolume != nil {
-		fields = append(fields, strategy.FieldLastKlineVolume)
-	}
-	if m.fiveKlineVolume != nil {
-		fields = append(fields, strategy.FieldFiveKlineVolume)
-	}
-	if m.firstOrderId != nil {
-		fields = append(fields, strategy.FieldFirstOrderId)
-	}
-	if m.upperBoundExit != nil {
-		fields = append(fields, strategy.FieldUpperBoundExit)
-	}
-	if m.stopLossExit != nil {
-		fields = append(fields, strategy.FieldStopLossExit)
-	}
-	if m.takeProfitExit != nil {
-		fields = append(fields, strategy.FieldTakeProfitExit)
-	}
-	if m.globalTakeProfitRatio != nil {
-		fields = append(fields, strategy.FieldGlobalTakeProfitRatio)
-	}
-	if m.dynamicStopLoss != nil {
-		fields = append(fields, strategy.FieldDynamicStopLoss)
-	}
-	if m.dropOn != nil {
-		fields = append(fields, strategy.FieldDropOn)
+		fields = append(fields, withdrawaddress.FieldUserId)
 	}
-	if m.candlesToCheck != nil {
-		fields = append(fields, strategy.FieldCandlesToCheck)
-	}
-	if m.dropThreshold != nil {
-		fields = append(fields, strategy.FieldDropThreshold)
-	}
-	if m.enableAutoBuy != nil {
-		fields = append(fields, strategy.FieldEnableAutoBuy)
-	}
-	if m.enableAutoSell != nil {
-		fields = append(fields, strategy.FieldEnableAutoSell)
-	}
-	if m.enableAutoExit != nil {
-		fields = append(fields, strategy.FieldEnableAutoExit)
-	}
-	if m.enablePushNotification != nil {
-		fields = append(fields, strategy.FieldEnablePushNotification)
-	}
-	if m.status != nil {
-		fields = append(fields, strategy.FieldStatus)
-	}
-	if m.gridTrend != nil {
-		fields = append(fields, strategy.FieldGridTrend)
-	}
-	if m.lastLowerThresholdAlertTime != nil {
-		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
+	if m.address != nil {
+		fields = append(fields, withdrawaddress.FieldAddress)
 	}
-	if m.lastUpperThresholdAlertTime != nil {
-		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
+	if m.name != nil {
+		fields = append(fields, withdrawaddress.FieldName)
 	}
 	return fields
 }
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case strategy.FieldCreateTime:
-		return m.CreateTime()
-	case strategy.FieldUpdateTime:
-		return m.UpdateTime()
-	case strategy.FieldGUID:
-		return m.GUID()
-	case strategy.FieldUserId:
-		return m.UserId()
-	case strategy.FieldToken:
-		return m.Token()
-	case strategy.FieldSymbol:
-		return m.Symbol()
-	case strategy.FieldMartinFactor:
-		return m.MartinFactor()
-	case strategy.FieldMaxGridLimit:
-		return m.MaxGridLimit()
-	case strategy.FieldTakeProfitRatio:
-		return m.TakeProfitRatio()
-	case strategy.FieldUpperPriceBound:
-		return m.UpperPriceBound()
-	case strategy.FieldLowerPriceBound:
-		return m.LowerPriceBound()
-	case strategy.FieldInitialOrderSize:
-		return m.InitialOrderSize()
-	case strategy.FieldLastKlineVolume:
-		return m.LastKlineVolume()
-	case strategy.FieldFiveKlineVolume:
-		return m.FiveKlineVolume()
-	case strategy.FieldFirstOrderId:
-		return m.FirstOrderId()
-	case strategy.FieldUpperBoundExit:
-		return m.UpperBoundExit()
-	case strategy.FieldStopLossExit:
-		return m.StopLossExit()
-	case strategy.FieldTakeProfitExit:
-		return m.TakeProfitExit()
-	case strategy.FieldGlobalTakeProfitRatio:
-		return m.GlobalTakeProfitRatio()
-	case strategy.FieldDynamicStopLoss:
-		return m.DynamicStopLoss()
-	case strategy.FieldDropOn:
-		return m.DropOn()
-	case strategy.FieldCandlesToCheck:
-		return m.CandlesToCheck()
-	case strategy.FieldDropThreshold:
-		return m.DropThreshold()
-	case strategy.FieldEnableAutoBuy:
-		return m.EnableAutoBuy()
-	case strategy.FieldEnableAutoSell:
-		return m.EnableAutoSell()
-	case strategy.FieldEnableAutoExit:
-		return m.EnableAutoExit()
-	case strategy.FieldEnablePushNotification:
-		return m.EnablePushNotification()
-	case strategy.FieldStatus:
-		return m.Status()
-	case strategy.FieldGridTrend:
-		return m.GridTrend()
-	case strategy.FieldLastLowerThresholdAlertTime:
-		return m.LastLowerThresholdAlertTime()
-	case strategy.FieldLastUpperThresholdAlertTime:
-		return m.LastUpperThresholdAlertTime()
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithdrawAddressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withdrawaddress.FieldCreateTime:
+		return m.CreateTime()
+	case withdrawaddress.FieldUpdateTime:
+		return m.UpdateTime()
+	case withdrawaddress.FieldUserId:
+		return m.UserId()
+	case withdrawaddress.FieldAddress:
+		return m.Address()
+	case withdrawaddress.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -5227,318 +9846,72 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WithdrawAddressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategy.FieldCreateTime:
+	case withdrawaddress.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case strategy.FieldUpdateTime:
+	case withdrawaddress.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case strategy.FieldGUID:
-		return m.OldGUID(ctx)
-	case strategy.FieldUserId:
+	case withdrawaddress.FieldUserId:
 		return m.OldUserId(ctx)
-	case strategy.FieldToken:
-		return m.OldToken(ctx)
-	case strategy.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case strategy.FieldMartinFactor:
-		return m.OldMartinFactor(ctx)
-	case strategy.FieldMaxGridLimit:
-		return m.OldMaxGridLimit(ctx)
-	case strategy.FieldTakeProfitRatio:
-		return m.OldTakeProfitRatio(ctx)
-	case strategy.FieldUpperPriceBound:
-		return m.OldUpperPriceBound(ctx)
-	case strategy.FieldLowerPriceBound:
-		return m.OldLowerPriceBound(ctx)
-	case strategy.FieldInitialOrderSize:
-		return m.OldInitialOrderSize(ctx)
-	case strategy.FieldLastKlineVolume:
-		return m.OldLastKlineVolume(ctx)
-	case strategy.FieldFiveKlineVolume:
-		return m.OldFiveKlineVolume(ctx)
-	case strategy.FieldFirstOrderId:
-		return m.OldFirstOrderId(ctx)
-	case strategy.FieldUpperBoundExit:
-		return m.OldUpperBoundExit(ctx)
-	case strategy.FieldStopLossExit:
-		return m.OldStopLossExit(ctx)
-	case strategy.FieldTakeProfitExit:
-		return m.OldTakeProfitExit(ctx)
-	case strategy.FieldGlobalTakeProfitRatio:
-		return m.OldGlobalTakeProfitRatio(ctx)
-	case strategy.FieldDynamicStopLoss:
-		return m.OldDynamicStopLoss(ctx)
-	case strategy.FieldDropOn:
-		return m.OldDropOn(ctx)
-	case strategy.FieldCandlesToCheck:
-		return m.OldCandlesToCheck(ctx)
-	case strategy.FieldDropThreshold:
-		return m.OldDropThreshold(ctx)
-	case strategy.FieldEnableAutoBuy:
-		return m.OldEnableAutoBuy(ctx)
-	case strategy.FieldEnableAutoSell:
-		return m.OldEnableAutoSell(ctx)
-	case strategy.FieldEnableAutoExit:
-		return m.OldEnableAutoExit(ctx)
-	case strategy.FieldEnablePushNotification:
-		return m.OldEnablePushNotification(ctx)
-	case strategy.FieldStatus:
-		return m.OldStatus(ctx)
-	case strategy.FieldGridTrend:
-		return m.OldGridTrend(ctx)
-	case strategy.FieldLastLowerThresholdAlertTime:
-		return m.OldLastLowerThresholdAlertTime(ctx)
-	case strategy.FieldLastUpperThresholdAlertTime:
-		return m.OldLastUpperThresholdAlertTime(ctx)
+	case withdrawaddress.FieldAddress:
+		return m.OldAddress(ctx)
+	case withdrawaddress.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown Strategy field %s", name)
+	return nil, fmt.Errorf("unknown WithdrawAddress field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) SetField(name string, value ent.Value) error {
+func (m *WithdrawAddressMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldCreateTime:
+	case withdrawaddress.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
-		return nil
-	case strategy.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case strategy.FieldGUID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGUID(v)
-		return nil
-	case strategy.FieldUserId:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserId(v)
-		return nil
-	case strategy.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case strategy.FieldSymbol:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSymbol(v)
-		return nil
-	case strategy.FieldMartinFactor:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMartinFactor(v)
-		return nil
-	case strategy.FieldMaxGridLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxGridLimit(v)
-		return nil
-	case strategy.FieldTakeProfitRatio:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTakeProfitRatio(v)
-		return nil
-	case strategy.FieldUpperPriceBound:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpperPriceBound(v)
-		return nil
-	case strategy.FieldLowerPriceBound:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLowerPriceBound(v)
-		return nil
-	case strategy.FieldInitialOrderSize:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInitialOrderSize(v)
-		return nil
-	case strategy.FieldLastKlineVolume:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastKlineVolume(v)
-		return nil
-	case strategy.FieldFiveKlineVolume:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFiveKlineVolume(v)
-		return nil
-	case strategy.FieldFirstOrderId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstOrderId(v)
-		return nil
-	case strategy.FieldUpperBoundExit:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpperBoundExit(v)
-		return nil
-	case strategy.FieldStopLossExit:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStopLossExit(v)
-		return nil
-	case strategy.FieldTakeProfitExit:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTakeProfitExit(v)
-		return nil
-	case strategy.FieldGlobalTakeProfitRatio:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGlobalTakeProfitRatio(v)
-		return nil
-	case strategy.FieldDynamicStopLoss:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDynamicStopLoss(v)
-		return nil
-	case strategy.FieldDropOn:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDropOn(v)
-		return nil
-	case strategy.FieldCandlesToCheck:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCandlesToCheck(v)
-		return nil
-	case strategy.FieldDropThreshold:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDropThreshold(v)
-		return nil
-	case strategy.FieldEnableAutoBuy:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnableAutoBuy(v)
-		return nil
-	case strategy.FieldEnableAutoSell:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnableAutoSell(v)
-		return nil
-	case strategy.FieldEnableAutoExit:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnableAutoExit(v)
-		return nil
-	case strategy.FieldEnablePushNotification:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnablePushNotification(v)
-		return nil
-	case strategy.FieldStatus:
-		v, ok := value.(strategy.Status)
+		return nil
+	case withdrawaddress.FieldUpdateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetUpdateTime(v)
 		return nil
-	case strategy.FieldGridTrend:
-		v, ok := value.(string)
+	case withdrawaddress.FieldUserId:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGridTrend(v)
+		m.SetUserId(v)
 		return nil
-	case strategy.FieldLastLowerThresholdAlertTime:
-		v, ok := value.(time.Time)
+	case withdrawaddress.FieldAddress:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastLowerThresholdAlertTime(v)
+		m.SetAddress(v)
 		return nil
-	case strategy.FieldLastUpperThresholdAlertTime:
-		v, ok := value.(time.Time)
+	case withdrawaddress.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUpperThresholdAlertTime(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown WithdrawAddress field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyMutation) AddedFields() []string {
+func (m *WithdrawAddressMutation) AddedFields() []string {
 	var fields []string
 	if m.adduserId != nil {
-		fields = append(fields, strategy.FieldUserId)
-	}
-	if m.addmartinFactor != nil {
-		fields = append(fields, strategy.FieldMartinFactor)
-	}
-	if m.addmaxGridLimit != nil {
-		fields = append(fields, strategy.FieldMaxGridLimit)
-	}
-	if m.addfirstOrderId != nil {
-		fields = append(fields, strategy.FieldFirstOrderId)
-	}
-	if m.addcandlesToCheck != nil {
-		fields = append(fields, strategy.FieldCandlesToCheck)
+		fields = append(fields, withdrawaddress.FieldUserId)
 	}
 	return fields
 }
@@ -5546,18 +9919,10 @@ func (m *StrategyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WithdrawAddressMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case strategy.FieldUserId:
+	case withdrawaddress.FieldUserId:
 		return m.AddedUserId()
-	case strategy.FieldMartinFactor:
-		return m.AddedMartinFactor()
-	case strategy.FieldMaxGridLimit:
-		return m.AddedMaxGridLimit()
-	case strategy.FieldFirstOrderId:
-		return m.AddedFirstOrderId()
-	case strategy.FieldCandlesToCheck:
-		return m.AddedCandlesToCheck()
 	}
 	return nil, false
 }
@@ -5565,670 +9930,894 @@ func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+func (m *WithdrawAddressMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldUserId:
+	case withdrawaddress.FieldUserId:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUserId(v)
 		return nil
-	case strategy.FieldMartinFactor:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMartinFactor(v)
-		return nil
-	case strategy.FieldMaxGridLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxGridLimit(v)
-		return nil
-	case strategy.FieldFirstOrderId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFirstOrderId(v)
-		return nil
-	case strategy.FieldCandlesToCheck:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCandlesToCheck(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Strategy numeric field %s", name)
+	return fmt.Errorf("unknown WithdrawAddress numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyMutation) ClearedFields() []string {
+func (m *WithdrawAddressMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(strategy.FieldMaxGridLimit) {
-		fields = append(fields, strategy.FieldMaxGridLimit)
-	}
-	if m.FieldCleared(strategy.FieldLastKlineVolume) {
-		fields = append(fields, strategy.FieldLastKlineVolume)
-	}
-	if m.FieldCleared(strategy.FieldFiveKlineVolume) {
-		fields = append(fields, strategy.FieldFiveKlineVolume)
-	}
-	if m.FieldCleared(strategy.FieldFirstOrderId) {
-		fields = append(fields, strategy.FieldFirstOrderId)
-	}
-	if m.FieldCleared(strategy.FieldUpperBoundExit) {
-		fields = append(fields, strategy.FieldUpperBoundExit)
-	}
-	if m.FieldCleared(strategy.FieldStopLossExit) {
-		fields = append(fields, strategy.FieldStopLossExit)
-	}
-	if m.FieldCleared(strategy.FieldTakeProfitExit) {
-		fields = append(fields, strategy.FieldTakeProfitExit)
-	}
-	if m.FieldCleared(strategy.FieldGlobalTakeProfitRatio) {
-		fields = append(fields, strategy.FieldGlobalTakeProfitRatio)
-	}
-	if m.FieldCleared(strategy.FieldDynamicStopLoss) {
-		fields = append(fields, strategy.FieldDynamicStopLoss)
-	}
-	if m.FieldCleared(strategy.FieldDropOn) {
-		fields = append(fields, strategy.FieldDropOn)
-	}
-	if m.FieldCleared(strategy.FieldCandlesToCheck) {
-		fields = append(fields, strategy.FieldCandlesToCheck)
-	}
-	if m.FieldCleared(strategy.FieldDropThreshold) {
-		fields = append(fields, strategy.FieldDropThreshold)
-	}
-	if m.FieldCleared(strategy.FieldGridTrend) {
-		fields = append(fields, strategy.FieldGridTrend)
-	}
-	if m.FieldCleared(strategy.FieldLastLowerThresholdAlertTime) {
-		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
-	}
-	if m.FieldCleared(strategy.FieldLastUpperThresholdAlertTime) {
-		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
+	if m.FieldCleared(withdrawaddress.FieldName) {
+		fields = append(fields, withdrawaddress.FieldName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyMutation) FieldCleared(name string) bool {
+func (m *WithdrawAddressMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StrategyMutation) ClearField(name string) error {
+func (m *WithdrawAddressMutation) ClearField(name string) error {
 	switch name {
-	case strategy.FieldMaxGridLimit:
-		m.ClearMaxGridLimit()
-		return nil
-	case strategy.FieldLastKlineVolume:
-		m.ClearLastKlineVolume()
-		return nil
-	case strategy.FieldFiveKlineVolume:
-		m.ClearFiveKlineVolume()
-		return nil
-	case strategy.FieldFirstOrderId:
-		m.ClearFirstOrderId()
-		return nil
-	case strategy.FieldUpperBoundExit:
-		m.ClearUpperBoundExit()
-		return nil
-	case strategy.FieldStopLossExit:
-		m.ClearStopLossExit()
-		return nil
-	case strategy.FieldTakeProfitExit:
-		m.ClearTakeProfitExit()
-		return nil
-	case strategy.FieldGlobalTakeProfitRatio:
-		m.ClearGlobalTakeProfitRatio()
-		return nil
-	case strategy.FieldDynamicStopLoss:
-		m.ClearDynamicStopLoss()
-		return nil
-	case strategy.FieldDropOn:
-		m.ClearDropOn()
-		return nil
-	case strategy.FieldCandlesToCheck:
-		m.ClearCandlesToCheck()
-		return nil
-	case strategy.FieldDropThreshold:
-		m.ClearDropThreshold()
-		return nil
-	case strategy.FieldGridTrend:
-		m.ClearGridTrend()
-		return nil
-	case strategy.FieldLastLowerThresholdAlertTime:
-		m.ClearLastLowerThresholdAlertTime()
-		return nil
-	case strategy.FieldLastUpperThresholdAlertTime:
-		m.ClearLastUpperThresholdAlertTime()
+	case withdrawaddress.FieldName:
+		m.ClearName()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy nullable field %s", name)
+	return fmt.Errorf("unknown WithdrawAddress nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyMutation) ResetField(name string) error {
+func (m *WithdrawAddressMutation) ResetField(name string) error {
 	switch name {
-	case strategy.FieldCreateTime:
+	case withdrawaddress.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case strategy.FieldUpdateTime:
+	case withdrawaddress.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case strategy.FieldGUID:
-		m.ResetGUID()
-		return nil
-	case strategy.FieldUserId:
+	case withdrawaddress.FieldUserId:
 		m.ResetUserId()
 		return nil
-	case strategy.FieldToken:
-		m.ResetToken()
-		return nil
-	case strategy.FieldSymbol:
-		m.ResetSymbol()
-		return nil
-	case strategy.FieldMartinFactor:
-		m.ResetMartinFactor()
-		return nil
-	case strategy.FieldMaxGridLimit:
-		m.ResetMaxGridLimit()
-		return nil
-	case strategy.FieldTakeProfitRatio:
-		m.ResetTakeProfitRatio()
-		return nil
-	case strategy.FieldUpperPriceBound:
-		m.ResetUpperPriceBound()
-		return nil
-	case strategy.FieldLowerPriceBound:
-		m.ResetLowerPriceBound()
-		return nil
-	case strategy.FieldInitialOrderSize:
-		m.ResetInitialOrderSize()
-		return nil
-	case strategy.FieldLastKlineVolume:
-		m.ResetLastKlineVolume()
-		return nil
-	case strategy.FieldFiveKlineVolume:
-		m.ResetFiveKlineVolume()
-		return nil
-	case strategy.FieldFirstOrderId:
-		m.ResetFirstOrderId()
-		return nil
-	case strategy.FieldUpperBoundExit:
-		m.ResetUpperBoundExit()
-		return nil
-	case strategy.FieldStopLossExit:
-		m.ResetStopLossExit()
-		return nil
-	case strategy.FieldTakeProfitExit:
-		m.ResetTakeProfitExit()
-		return nil
-	case strategy.FieldGlobalTakeProfitRatio:
-		m.ResetGlobalTakeProfitRatio()
-		return nil
-	case strategy.FieldDynamicStopLoss:
-		m.ResetDynamicStopLoss()
-		return nil
-	case strategy.FieldDropOn:
-		m.ResetDropOn()
-		return nil
-	case strategy.FieldCandlesToCheck:
-		m.ResetCandlesToCheck()
-		return nil
-	case strategy.FieldDropThreshold:
-		m.ResetDropThreshold()
-		return nil
-	case strategy.FieldEnableAutoBuy:
-		m.ResetEnableAutoBuy()
-		return nil
-	case strategy.FieldEnableAutoSell:
-		m.ResetEnableAutoSell()
-		return nil
-	case strategy.FieldEnableAutoExit:
-		m.ResetEnableAutoExit()
-		return nil
-	case strategy.FieldEnablePushNotification:
-		m.ResetEnablePushNotification()
-		return nil
-	case strategy.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case strategy.FieldGridTrend:
-		m.ResetGridTrend()
-		return nil
-	case strategy.FieldLastLowerThresholdAlertTime:
-		m.ResetLastLowerThresholdAlertTime()
+	case withdrawaddress.FieldAddress:
+		m.ResetAddress()
 		return nil
-	case strategy.FieldLastUpperThresholdAlertTime:
-		m.ResetLastUpperThresholdAlertTime()
+	case withdrawaddress.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown WithdrawAddress field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyMutation) AddedEdges() []string {
+func (m *WithdrawAddressMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+func (m *WithdrawAddressMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyMutation) RemovedEdges() []string {
+func (m *WithdrawAddressMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
+func (m *WithdrawAddressMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyMutation) ClearedEdges() []string {
+func (m *WithdrawAddressMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StrategyMutation) EdgeCleared(name string) bool {
+func (m *WithdrawAddressMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Strategy unique edge %s", name)
+func (m *WithdrawAddressMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithdrawAddress unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Strategy edge %s", name)
+func (m *WithdrawAddressMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithdrawAddress edge %s", name)
 }
 
-// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
-type WalletMutation struct {
+// WithdrawalMutation represents an operation that mutates the Withdrawal nodes in the graph.
+type WithdrawalMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	userId        *int64
-	adduserId     *int64
-	account       *string
-	password      *string
-	privateKey    *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Wallet, error)
-	predicates    []predicate.Wallet
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	userId         *int64
+	adduserId      *int64
+	account        *string
+	token          *string
+	symbol         *string
+	destination    *string
+	amount         *decimal.Decimal
+	status         *withdrawal.Status
+	txHash         *string
+	reason         *string
+	baseFee        *int64
+	addbaseFee     *int64
+	priorityFee    *int64
+	addpriorityFee *int64
+	rentFee        *int64
+	addrentFee     *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Withdrawal, error)
+	predicates     []predicate.Withdrawal
+}
+
+var _ ent.Mutation = (*WithdrawalMutation)(nil)
+
+// withdrawalOption allows management of the mutation configuration using functional options.
+type withdrawalOption func(*WithdrawalMutation)
+
+// newWithdrawalMutation creates new mutation for the Withdrawal entity.
+func newWithdrawalMutation(c config, op Op, opts ...withdrawalOption) *WithdrawalMutation {
+	m := &WithdrawalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithdrawal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-var _ ent.Mutation = (*WalletMutation)(nil)
+// withWithdrawalID sets the ID field of the mutation.
+func withWithdrawalID(id int) withdrawalOption {
+	return func(m *WithdrawalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Withdrawal
+		)
+		m.oldValue = func(ctx context.Context) (*Withdrawal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Withdrawal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
 
-// walletOption allows management of the mutation configuration using functional options.
-type walletOption func(*WalletMutation)
+// withWithdrawal sets the old Withdrawal of the mutation.
+func withWithdrawal(node *Withdrawal) withdrawalOption {
+	return func(m *WithdrawalMutation) {
+		m.oldValue = func(context.Context) (*Withdrawal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
 
-// newWalletMutation creates new mutation for the Wallet entity.
-func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
-	m := &WalletMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeWallet,
-		clearedFields: make(map[string]struct{}),
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithdrawalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithdrawalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithdrawalMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithdrawalMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Withdrawal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *WithdrawalMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WithdrawalMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WithdrawalMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *WithdrawalMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WithdrawalMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WithdrawalMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserId sets the "userId" field.
+func (m *WithdrawalMutation) SetUserId(i int64) {
+	m.userId = &i
+	m.adduserId = nil
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *WithdrawalMutation) UserId() (r int64, exists bool) {
+	v := m.userId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldUserId(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// AddUserId adds i to the "userId" field.
+func (m *WithdrawalMutation) AddUserId(i int64) {
+	if m.adduserId != nil {
+		*m.adduserId += i
+	} else {
+		m.adduserId = &i
+	}
+}
+
+// AddedUserId returns the value that was added to the "userId" field in this mutation.
+func (m *WithdrawalMutation) AddedUserId() (r int64, exists bool) {
+	v := m.adduserId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *WithdrawalMutation) ResetUserId() {
+	m.userId = nil
+	m.adduserId = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *WithdrawalMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *WithdrawalMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *WithdrawalMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetToken sets the "token" field.
+func (m *WithdrawalMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *WithdrawalMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
-	for _, opt := range opts {
-		opt(m)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return m
+	return oldValue.Token, nil
 }
 
-// withWalletID sets the ID field of the mutation.
-func withWalletID(id int) walletOption {
-	return func(m *WalletMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Wallet
-		)
-		m.oldValue = func(ctx context.Context) (*Wallet, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Wallet.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetToken resets all changes to the "token" field.
+func (m *WithdrawalMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *WithdrawalMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *WithdrawalMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withWallet sets the old Wallet of the mutation.
-func withWallet(node *Wallet) walletOption {
-	return func(m *WalletMutation) {
-		m.oldValue = func(context.Context) (*Wallet, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldSymbol returns the old "symbol" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
 	}
+	return oldValue.Symbol, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WalletMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *WithdrawalMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m WalletMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetDestination sets the "destination" field.
+func (m *WithdrawalMutation) SetDestination(s string) {
+	m.destination = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *WalletMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Destination returns the value of the "destination" field in the mutation.
+func (m *WithdrawalMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *WalletMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldDestination returns the old "destination" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
 	}
+	return oldValue.Destination, nil
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *WalletMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// ResetDestination resets all changes to the "destination" field.
+func (m *WithdrawalMutation) ResetDestination() {
+	m.destination = nil
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *WalletMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// SetAmount sets the "amount" field.
+func (m *WithdrawalMutation) SetAmount(d decimal.Decimal) {
+	m.amount = &d
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *WithdrawalMutation) Amount() (r decimal.Decimal, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WithdrawalMutation) OldAmount(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.Amount, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *WalletMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *WithdrawalMutation) ResetAmount() {
+	m.amount = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *WalletMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetStatus sets the "status" field.
+func (m *WithdrawalMutation) SetStatus(w withdrawal.Status) {
+	m.status = &w
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *WalletMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// Status returns the value of the "status" field in the mutation.
+func (m *WithdrawalMutation) Status() (r withdrawal.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WithdrawalMutation) OldStatus(ctx context.Context) (v withdrawal.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.Status, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *WalletMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WithdrawalMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetUserId sets the "userId" field.
-func (m *WalletMutation) SetUserId(i int64) {
-	m.userId = &i
-	m.adduserId = nil
+// SetTxHash sets the "txHash" field.
+func (m *WithdrawalMutation) SetTxHash(s string) {
+	m.txHash = &s
 }
 
-// UserId returns the value of the "userId" field in the mutation.
-func (m *WalletMutation) UserId() (r int64, exists bool) {
-	v := m.userId
+// TxHash returns the value of the "txHash" field in the mutation.
+func (m *WithdrawalMutation) TxHash() (r string, exists bool) {
+	v := m.txHash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldTxHash returns the old "txHash" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldUserId(ctx context.Context) (v int64, err error) {
+func (m *WithdrawalMutation) OldTxHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserId is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserId requires an ID field in the mutation")
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
 	}
-	return oldValue.UserId, nil
+	return oldValue.TxHash, nil
 }
 
-// AddUserId adds i to the "userId" field.
-func (m *WalletMutation) AddUserId(i int64) {
-	if m.adduserId != nil {
-		*m.adduserId += i
-	} else {
-		m.adduserId = &i
-	}
+// ResetTxHash resets all changes to the "txHash" field.
+func (m *WithdrawalMutation) ResetTxHash() {
+	m.txHash = nil
 }
 
-// AddedUserId returns the value that was added to the "userId" field in this mutation.
-func (m *WalletMutation) AddedUserId() (r int64, exists bool) {
-	v := m.adduserId
+// SetReason sets the "reason" field.
+func (m *WithdrawalMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *WithdrawalMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUserId resets all changes to the "userId" field.
-func (m *WalletMutation) ResetUserId() {
-	m.userId = nil
-	m.adduserId = nil
+// OldReason returns the old "reason" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithdrawalMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// SetAccount sets the "account" field.
-func (m *WalletMutation) SetAccount(s string) {
-	m.account = &s
+// ClearReason clears the value of the "reason" field.
+func (m *WithdrawalMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[withdrawal.FieldReason] = struct{}{}
 }
 
-// Account returns the value of the "account" field in the mutation.
-func (m *WalletMutation) Account() (r string, exists bool) {
-	v := m.account
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *WithdrawalMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[withdrawal.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *WithdrawalMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, withdrawal.FieldReason)
+}
+
+// SetBaseFee sets the "baseFee" field.
+func (m *WithdrawalMutation) SetBaseFee(i int64) {
+	m.baseFee = &i
+	m.addbaseFee = nil
+}
+
+// BaseFee returns the value of the "baseFee" field in the mutation.
+func (m *WithdrawalMutation) BaseFee() (r int64, exists bool) {
+	v := m.baseFee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldBaseFee returns the old "baseFee" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *WithdrawalMutation) OldBaseFee(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+		return v, errors.New("OldBaseFee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccount requires an ID field in the mutation")
+		return v, errors.New("OldBaseFee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaseFee: %w", err)
 	}
-	return oldValue.Account, nil
+	return oldValue.BaseFee, nil
 }
 
-// ResetAccount resets all changes to the "account" field.
-func (m *WalletMutation) ResetAccount() {
-	m.account = nil
+// AddBaseFee adds i to the "baseFee" field.
+func (m *WithdrawalMutation) AddBaseFee(i int64) {
+	if m.addbaseFee != nil {
+		*m.addbaseFee += i
+	} else {
+		m.addbaseFee = &i
+	}
+}
+
+// AddedBaseFee returns the value that was added to the "baseFee" field in this mutation.
+func (m *WithdrawalMutation) AddedBaseFee() (r int64, exists bool) {
+	v := m.addbaseFee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBaseFee clears the value of the "baseFee" field.
+func (m *WithdrawalMutation) ClearBaseFee() {
+	m.baseFee = nil
+	m.addbaseFee = nil
+	m.clearedFields[withdrawal.FieldBaseFee] = struct{}{}
+}
+
+// BaseFeeCleared returns if the "baseFee" field was cleared in this mutation.
+func (m *WithdrawalMutation) BaseFeeCleared() bool {
+	_, ok := m.clearedFields[withdrawal.FieldBaseFee]
+	return ok
+}
+
+// ResetBaseFee resets all changes to the "baseFee" field.
+func (m *WithdrawalMutation) ResetBaseFee() {
+	m.baseFee = nil
+	m.addbaseFee = nil
+	delete(m.clearedFields, withdrawal.FieldBaseFee)
 }
 
-// SetPassword sets the "password" field.
-func (m *WalletMutation) SetPassword(s string) {
-	m.password = &s
+// SetPriorityFee sets the "priorityFee" field.
+func (m *WithdrawalMutation) SetPriorityFee(i int64) {
+	m.priorityFee = &i
+	m.addpriorityFee = nil
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *WalletMutation) Password() (r string, exists bool) {
-	v := m.password
+// PriorityFee returns the value of the "priorityFee" field in the mutation.
+func (m *WithdrawalMutation) PriorityFee() (r int64, exists bool) {
+	v := m.priorityFee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldPriorityFee returns the old "priorityFee" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *WithdrawalMutation) OldPriorityFee(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriorityFee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldPriorityFee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriorityFee: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.PriorityFee, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *WalletMutation) ResetPassword() {
-	m.password = nil
+// AddPriorityFee adds i to the "priorityFee" field.
+func (m *WithdrawalMutation) AddPriorityFee(i int64) {
+	if m.addpriorityFee != nil {
+		*m.addpriorityFee += i
+	} else {
+		m.addpriorityFee = &i
+	}
 }
 
-// SetPrivateKey sets the "privateKey" field.
-func (m *WalletMutation) SetPrivateKey(s string) {
-	m.privateKey = &s
+// AddedPriorityFee returns the value that was added to the "priorityFee" field in this mutation.
+func (m *WithdrawalMutation) AddedPriorityFee() (r int64, exists bool) {
+	v := m.addpriorityFee
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PrivateKey returns the value of the "privateKey" field in the mutation.
-func (m *WalletMutation) PrivateKey() (r string, exists bool) {
-	v := m.privateKey
+// ClearPriorityFee clears the value of the "priorityFee" field.
+func (m *WithdrawalMutation) ClearPriorityFee() {
+	m.priorityFee = nil
+	m.addpriorityFee = nil
+	m.clearedFields[withdrawal.FieldPriorityFee] = struct{}{}
+}
+
+// PriorityFeeCleared returns if the "priorityFee" field was cleared in this mutation.
+func (m *WithdrawalMutation) PriorityFeeCleared() bool {
+	_, ok := m.clearedFields[withdrawal.FieldPriorityFee]
+	return ok
+}
+
+// ResetPriorityFee resets all changes to the "priorityFee" field.
+func (m *WithdrawalMutation) ResetPriorityFee() {
+	m.priorityFee = nil
+	m.addpriorityFee = nil
+	delete(m.clearedFields, withdrawal.FieldPriorityFee)
+}
+
+// SetRentFee sets the "rentFee" field.
+func (m *WithdrawalMutation) SetRentFee(i int64) {
+	m.rentFee = &i
+	m.addrentFee = nil
+}
+
+// RentFee returns the value of the "rentFee" field in the mutation.
+func (m *WithdrawalMutation) RentFee() (r int64, exists bool) {
+	v := m.rentFee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrivateKey returns the old "privateKey" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldRentFee returns the old "rentFee" field's value of the Withdrawal entity.
+// If the Withdrawal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+func (m *WithdrawalMutation) OldRentFee(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldRentFee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+		return v, errors.New("OldRentFee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldRentFee: %w", err)
 	}
-	return oldValue.PrivateKey, nil
+	return oldValue.RentFee, nil
 }
 
-// ResetPrivateKey resets all changes to the "privateKey" field.
-func (m *WalletMutation) ResetPrivateKey() {
-	m.privateKey = nil
+// AddRentFee adds i to the "rentFee" field.
+func (m *WithdrawalMutation) AddRentFee(i int64) {
+	if m.addrentFee != nil {
+		*m.addrentFee += i
+	} else {
+		m.addrentFee = &i
+	}
 }
 
-// Where appends a list predicates to the WalletMutation builder.
-func (m *WalletMutation) Where(ps ...predicate.Wallet) {
+// AddedRentFee returns the value that was added to the "rentFee" field in this mutation.
+func (m *WithdrawalMutation) AddedRentFee() (r int64, exists bool) {
+	v := m.addrentFee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRentFee clears the value of the "rentFee" field.
+func (m *WithdrawalMutation) ClearRentFee() {
+	m.rentFee = nil
+	m.addrentFee = nil
+	m.clearedFields[withdrawal.FieldRentFee] = struct{}{}
+}
+
+// RentFeeCleared returns if the "rentFee" field was cleared in this mutation.
+func (m *WithdrawalMutation) RentFeeCleared() bool {
+	_, ok := m.clearedFields[withdrawal.FieldRentFee]
+	return ok
+}
+
+// ResetRentFee resets all changes to the "rentFee" field.
+func (m *WithdrawalMutation) ResetRentFee() {
+	m.rentFee = nil
+	m.addrentFee = nil
+	delete(m.clearedFields, withdrawal.FieldRentFee)
+}
+
+// Where appends a list predicates to the WithdrawalMutation builder.
+func (m *WithdrawalMutation) Where(ps ...predicate.Withdrawal) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the WalletMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WithdrawalMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *WalletMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Wallet, len(ps))
+func (m *WithdrawalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Withdrawal, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6236,42 +10825,66 @@ func (m *WalletMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *WalletMutation) Op() Op {
+func (m *WithdrawalMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *WalletMutation) SetOp(op Op) {
+func (m *WithdrawalMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Wallet).
-func (m *WalletMutation) Type() string {
+// Type returns the node type of this mutation (Withdrawal).
+func (m *WithdrawalMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WalletMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *WithdrawalMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.create_time != nil {
-		fields = append(fields, wallet.FieldCreateTime)
+		fields = append(fields, withdrawal.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, wallet.FieldUpdateTime)
+		fields = append(fields, withdrawal.FieldUpdateTime)
 	}
 	if m.userId != nil {
-		fields = append(fields, wallet.FieldUserId)
+		fields = append(fields, withdrawal.FieldUserId)
 	}
 	if m.account != nil {
-		fields = append(fields, wallet.FieldAccount)
+		fields = append(fields, withdrawal.FieldAccount)
 	}
-	if m.password != nil {
-		fields = append(fields, wallet.FieldPassword)
+	if m.token != nil {
+		fields = append(fields, withdrawal.FieldToken)
 	}
-	if m.privateKey != nil {
-		fields = append(fields, wallet.FieldPrivateKey)
+	if m.symbol != nil {
+		fields = append(fields, withdrawal.FieldSymbol)
+	}
+	if m.destination != nil {
+		fields = append(fields, withdrawal.FieldDestination)
+	}
+	if m.amount != nil {
+		fields = append(fields, withdrawal.FieldAmount)
+	}
+	if m.status != nil {
+		fields = append(fields, withdrawal.FieldStatus)
+	}
+	if m.txHash != nil {
+		fields = append(fields, withdrawal.FieldTxHash)
+	}
+	if m.reason != nil {
+		fields = append(fields, withdrawal.FieldReason)
+	}
+	if m.baseFee != nil {
+		fields = append(fields, withdrawal.FieldBaseFee)
+	}
+	if m.priorityFee != nil {
+		fields = append(fields, withdrawal.FieldPriorityFee)
+	}
+	if m.rentFee != nil {
+		fields = append(fields, withdrawal.FieldRentFee)
 	}
 	return fields
 }
@@ -6279,20 +10892,36 @@ func (m *WalletMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WalletMutation) Field(name string) (ent.Value, bool) {
+func (m *WithdrawalMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case wallet.FieldCreateTime:
+	case withdrawal.FieldCreateTime:
 		return m.CreateTime()
-	case wallet.FieldUpdateTime:
+	case withdrawal.FieldUpdateTime:
 		return m.UpdateTime()
-	case wallet.FieldUserId:
+	case withdrawal.FieldUserId:
 		return m.UserId()
-	case wallet.FieldAccount:
+	case withdrawal.FieldAccount:
 		return m.Account()
-	case wallet.FieldPassword:
-		return m.Password()
-	case wallet.FieldPrivateKey:
-		return m.PrivateKey()
+	case withdrawal.FieldToken:
+		return m.Token()
+	case withdrawal.FieldSymbol:
+		return m.Symbol()
+	case withdrawal.FieldDestination:
+		return m.Destination()
+	case withdrawal.FieldAmount:
+		return m.Amount()
+	case withdrawal.FieldStatus:
+		return m.Status()
+	case withdrawal.FieldTxHash:
+		return m.TxHash()
+	case withdrawal.FieldReason:
+		return m.Reason()
+	case withdrawal.FieldBaseFee:
+		return m.BaseFee()
+	case withdrawal.FieldPriorityFee:
+		return m.PriorityFee()
+	case withdrawal.FieldRentFee:
+		return m.RentFee()
 	}
 	return nil, false
 }
@@ -6300,81 +10929,162 @@ func (m *WalletMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WithdrawalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case wallet.FieldCreateTime:
+	case withdrawal.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case wallet.FieldUpdateTime:
+	case withdrawal.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case wallet.FieldUserId:
+	case withdrawal.FieldUserId:
 		return m.OldUserId(ctx)
-	case wallet.FieldAccount:
+	case withdrawal.FieldAccount:
 		return m.OldAccount(ctx)
-	case wallet.FieldPassword:
-		return m.OldPassword(ctx)
-	case wallet.FieldPrivateKey:
-		return m.OldPrivateKey(ctx)
+	case withdrawal.FieldToken:
+		return m.OldToken(ctx)
+	case withdrawal.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case withdrawal.FieldDestination:
+		return m.OldDestination(ctx)
+	case withdrawal.FieldAmount:
+		return m.OldAmount(ctx)
+	case withdrawal.FieldStatus:
+		return m.OldStatus(ctx)
+	case withdrawal.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case withdrawal.FieldReason:
+		return m.OldReason(ctx)
+	case withdrawal.FieldBaseFee:
+		return m.OldBaseFee(ctx)
+	case withdrawal.FieldPriorityFee:
+		return m.OldPriorityFee(ctx)
+	case withdrawal.FieldRentFee:
+		return m.OldRentFee(ctx)
 	}
-	return nil, fmt.Errorf("unknown Wallet field %s", name)
+	return nil, fmt.Errorf("unknown Withdrawal field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WalletMutation) SetField(name string, value ent.Value) error {
+func (m *WithdrawalMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case wallet.FieldCreateTime:
+	case withdrawal.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case wallet.FieldUpdateTime:
+	case withdrawal.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case wallet.FieldUserId:
+	case withdrawal.FieldUserId:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserId(v)
 		return nil
-	case wallet.FieldAccount:
+	case withdrawal.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAccount(v)
 		return nil
-	case wallet.FieldPassword:
+	case withdrawal.FieldToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetToken(v)
 		return nil
-	case wallet.FieldPrivateKey:
+	case withdrawal.FieldSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrivateKey(v)
+		m.SetSymbol(v)
+		return nil
+	case withdrawal.FieldDestination:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestination(v)
+		return nil
+	case withdrawal.FieldAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case withdrawal.FieldStatus:
+		v, ok := value.(withdrawal.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case withdrawal.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case withdrawal.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case withdrawal.FieldBaseFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseFee(v)
+		return nil
+	case withdrawal.FieldPriorityFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorityFee(v)
+		return nil
+	case withdrawal.FieldRentFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRentFee(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet field %s", name)
+	return fmt.Errorf("unknown Withdrawal field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WalletMutation) AddedFields() []string {
+func (m *WithdrawalMutation) AddedFields() []string {
 	var fields []string
 	if m.adduserId != nil {
-		fields = append(fields, wallet.FieldUserId)
+		fields = append(fields, withdrawal.FieldUserId)
+	}
+	if m.addbaseFee != nil {
+		fields = append(fields, withdrawal.FieldBaseFee)
+	}
+	if m.addpriorityFee != nil {
+		fields = append(fields, withdrawal.FieldPriorityFee)
+	}
+	if m.addrentFee != nil {
+		fields = append(fields, withdrawal.FieldRentFee)
 	}
 	return fields
 }
@@ -6382,10 +11092,16 @@ func (m *WalletMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WithdrawalMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case wallet.FieldUserId:
+	case withdrawal.FieldUserId:
 		return m.AddedUserId()
+	case withdrawal.FieldBaseFee:
+		return m.AddedBaseFee()
+	case withdrawal.FieldPriorityFee:
+		return m.AddedPriorityFee()
+	case withdrawal.FieldRentFee:
+		return m.AddedRentFee()
 	}
 	return nil, false
 }
@@ -6393,108 +11109,180 @@ func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WalletMutation) AddField(name string, value ent.Value) error {
+func (m *WithdrawalMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case wallet.FieldUserId:
+	case withdrawal.FieldUserId:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddUserId(v)
 		return nil
+	case withdrawal.FieldBaseFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBaseFee(v)
+		return nil
+	case withdrawal.FieldPriorityFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriorityFee(v)
+		return nil
+	case withdrawal.FieldRentFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRentFee(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Wallet numeric field %s", name)
+	return fmt.Errorf("unknown Withdrawal numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WalletMutation) ClearedFields() []string {
-	return nil
+func (m *WithdrawalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(withdrawal.FieldReason) {
+		fields = append(fields, withdrawal.FieldReason)
+	}
+	if m.FieldCleared(withdrawal.FieldBaseFee) {
+		fields = append(fields, withdrawal.FieldBaseFee)
+	}
+	if m.FieldCleared(withdrawal.FieldPriorityFee) {
+		fields = append(fields, withdrawal.FieldPriorityFee)
+	}
+	if m.FieldCleared(withdrawal.FieldRentFee) {
+		fields = append(fields, withdrawal.FieldRentFee)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WalletMutation) FieldCleared(name string) bool {
+func (m *WithdrawalMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WalletMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Wallet nullable field %s", name)
+func (m *WithdrawalMutation) ClearField(name string) error {
+	switch name {
+	case withdrawal.FieldReason:
+		m.ClearReason()
+		return nil
+	case withdrawal.FieldBaseFee:
+		m.ClearBaseFee()
+		return nil
+	case withdrawal.FieldPriorityFee:
+		m.ClearPriorityFee()
+		return nil
+	case withdrawal.FieldRentFee:
+		m.ClearRentFee()
+		return nil
+	}
+	return fmt.Errorf("unknown Withdrawal nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WalletMutation) ResetField(name string) error {
+func (m *WithdrawalMutation) ResetField(name string) error {
 	switch name {
-	case wallet.FieldCreateTime:
+	case withdrawal.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case wallet.FieldUpdateTime:
+	case withdrawal.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case wallet.FieldUserId:
+	case withdrawal.FieldUserId:
 		m.ResetUserId()
 		return nil
-	case wallet.FieldAccount:
+	case withdrawal.FieldAccount:
 		m.ResetAccount()
 		return nil
-	case wallet.FieldPassword:
-		m.ResetPassword()
+	case withdrawal.FieldToken:
+		m.ResetToken()
 		return nil
-	case wallet.FieldPrivateKey:
-		m.ResetPrivateKey()
+	case withdrawal.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case withdrawal.FieldDestination:
+		m.ResetDestination()
+		return nil
+	case withdrawal.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case withdrawal.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case withdrawal.FieldTxHash:
+		m.ResetTxHash()
+		return nil
+	case withdrawal.FieldReason:
+		m.ResetReason()
+		return nil
+	case withdrawal.FieldBaseFee:
+		m.ResetBaseFee()
+		return nil
+	case withdrawal.FieldPriorityFee:
+		m.ResetPriorityFee()
+		return nil
+	case withdrawal.FieldRentFee:
+		m.ResetRentFee()
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet field %s", name)
+	return fmt.Errorf("unknown Withdrawal field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WalletMutation) AddedEdges() []string {
+func (m *WithdrawalMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WalletMutation) AddedIDs(name string) []ent.Value {
+func (m *WithdrawalMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WalletMutation) RemovedEdges() []string {
+func (m *WithdrawalMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
+func (m *WithdrawalMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WalletMutation) ClearedEdges() []string {
+func (m *WithdrawalMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WalletMutation) EdgeCleared(name string) bool {
+func (m *WithdrawalMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WalletMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Wallet unique edge %s", name)
+func (m *WithdrawalMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Withdrawal unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WalletMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Wallet edge %s", name)
+func (m *WithdrawalMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Withdrawal edge %s", name)
 }
@@ -53,7 +53,23 @@ type Order struct {
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
 	// Profit holds the value of the "profit" field.
-	Profit       *decimal.Decimal `json:"profit,omitempty"`
+	Profit *decimal.Decimal `json:"profit,omitempty"`
+	// Paper holds the value of the "paper" field.
+	Paper bool `json:"paper,omitempty"`
+	// Aggregator holds the value of the "aggregator" field.
+	Aggregator string `json:"aggregator,omitempty"`
+	// BaseFee holds the value of the "baseFee" field.
+	BaseFee int64 `json:"baseFee,omitempty"`
+	// PriorityFee holds the value of the "priorityFee" field.
+	PriorityFee int64 `json:"priorityFee,omitempty"`
+	// ComputeUnits holds the value of the "computeUnits" field.
+	ComputeUnits int64 `json:"computeUnits,omitempty"`
+	// JitoTip holds the value of the "jitoTip" field.
+	JitoTip int64 `json:"jitoTip,omitempty"`
+	// RentFee holds the value of the "rentFee" field.
+	RentFee int64 `json:"rentFee,omitempty"`
+	// FeeUsd holds the value of the "feeUsd" field.
+	FeeUsd       *decimal.Decimal `json:"feeUsd,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -62,13 +78,15 @@ func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldGridBuyCost, order.FieldProfit:
+		case order.FieldGridBuyCost, order.FieldProfit, order.FieldFeeUsd:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case order.FieldPrice, order.FieldFinalPrice, order.FieldInAmount, order.FieldOutAmount:
 			values[i] = new(decimal.Decimal)
-		case order.FieldID, order.FieldGridNumber:
+		case order.FieldPaper:
+			values[i] = new(sql.NullBool)
+		case order.FieldID, order.FieldGridNumber, order.FieldBaseFee, order.FieldPriorityFee, order.FieldComputeUnits, order.FieldJitoTip, order.FieldRentFee:
 			values[i] = new(sql.NullInt64)
-		case order.FieldAccount, order.FieldToken, order.FieldSymbol, order.FieldGridId, order.FieldStrategyId, order.FieldType, order.FieldStatus, order.FieldTxHash, order.FieldReason:
+		case order.FieldAccount, order.FieldToken, order.FieldSymbol, order.FieldGridId, order.FieldStrategyId, order.FieldType, order.FieldStatus, order.FieldTxHash, order.FieldReason, order.FieldAggregator:
 			values[i] = new(sql.NullString)
 		case order.FieldCreateTime, order.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -205,6 +223,55 @@ func (o *Order) assignValues(columns []string, values []any) error {
 				o.Profit = new(decimal.Decimal)
 				*o.Profit = *value.S.(*decimal.Decimal)
 			}
+		case order.FieldPaper:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paper", values[i])
+			} else if value.Valid {
+				o.Paper = value.Bool
+			}
+		case order.FieldAggregator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aggregator", values[i])
+			} else if value.Valid {
+				o.Aggregator = value.String
+			}
+		case order.FieldBaseFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field baseFee", values[i])
+			} else if value.Valid {
+				o.BaseFee = value.Int64
+			}
+		case order.FieldPriorityFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priorityFee", values[i])
+			} else if value.Valid {
+				o.PriorityFee = value.Int64
+			}
+		case order.FieldComputeUnits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field computeUnits", values[i])
+			} else if value.Valid {
+				o.ComputeUnits = value.Int64
+			}
+		case order.FieldJitoTip:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field jitoTip", values[i])
+			} else if value.Valid {
+				o.JitoTip = value.Int64
+			}
+		case order.FieldRentFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rentFee", values[i])
+			} else if value.Valid {
+				o.RentFee = value.Int64
+			}
+		case order.FieldFeeUsd:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field feeUsd", values[i])
+			} else if value.Valid {
+				o.FeeUsd = new(decimal.Decimal)
+				*o.FeeUsd = *value.S.(*decimal.Decimal)
+			}
 		default:
 			o.selectValues.Set(columns[i], values[i])
 		}
@@ -302,6 +369,32 @@ func (o *Order) String() string {
 		builder.WriteString("profit=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("paper=")
+	builder.WriteString(fmt.Sprintf("%v", o.Paper))
+	builder.WriteString(", ")
+	builder.WriteString("aggregator=")
+	builder.WriteString(o.Aggregator)
+	builder.WriteString(", ")
+	builder.WriteString("baseFee=")
+	builder.WriteString(fmt.Sprintf("%v", o.BaseFee))
+	builder.WriteString(", ")
+	builder.WriteString("priorityFee=")
+	builder.WriteString(fmt.Sprintf("%v", o.PriorityFee))
+	builder.WriteString(", ")
+	builder.WriteString("computeUnits=")
+	builder.WriteString(fmt.Sprintf("%v", o.ComputeUnits))
+	builder.WriteString(", ")
+	builder.WriteString("jitoTip=")
+	builder.WriteString(fmt.Sprintf("%v", o.JitoTip))
+	builder.WriteString(", ")
+	builder.WriteString("rentFee=")
+	builder.WriteString(fmt.Sprintf("%v", o.RentFee))
+	builder.WriteString(", ")
+	if v := o.FeeUsd; v != nil {
+		builder.WriteString("feeUsd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
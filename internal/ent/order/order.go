@@ -50,6 +50,22 @@ const (
 	FieldReason = "reason"
 	// FieldProfit holds the string denoting the profit field in the database.
 	FieldProfit = "profit"
+	// FieldPaper holds the string denoting the paper field in the database.
+	FieldPaper = "paper"
+	// FieldAggregator holds the string denoting the aggregator field in the database.
+	FieldAggregator = "aggregator"
+	// FieldBaseFee holds the string denoting the basefee field in the database.
+	FieldBaseFee = "base_fee"
+	// FieldPriorityFee holds the string denoting the priorityfee field in the database.
+	FieldPriorityFee = "priority_fee"
+	// FieldComputeUnits holds the string denoting the computeunits field in the database.
+	FieldComputeUnits = "compute_units"
+	// FieldJitoTip holds the string denoting the jitotip field in the database.
+	FieldJitoTip = "jito_tip"
+	// FieldRentFee holds the string denoting the rentfee field in the database.
+	FieldRentFee = "rent_fee"
+	// FieldFeeUsd holds the string denoting the feeusd field in the database.
+	FieldFeeUsd = "fee_usd"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 )
@@ -75,6 +91,14 @@ var Columns = []string{
 	FieldTxHash,
 	FieldReason,
 	FieldProfit,
+	FieldPaper,
+	FieldAggregator,
+	FieldBaseFee,
+	FieldPriorityFee,
+	FieldComputeUnits,
+	FieldJitoTip,
+	FieldRentFee,
+	FieldFeeUsd,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -108,6 +132,8 @@ var (
 	TxHashValidator func(string) error
 	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	ReasonValidator func(string) error
+	// AggregatorValidator is a validator for the "aggregator" field. It is called by the builders before save.
+	AggregatorValidator func(string) error
 )
 
 // Type defines the type for the "type" enum field.
@@ -254,3 +280,43 @@ func ByReason(opts ...sql.OrderTermOption) OrderOption {
 func ByProfit(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProfit, opts...).ToFunc()
 }
+
+// ByPaper orders the results by the paper field.
+func ByPaper(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaper, opts...).ToFunc()
+}
+
+// ByAggregator orders the results by the aggregator field.
+func ByAggregator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAggregator, opts...).ToFunc()
+}
+
+// ByBaseFee orders the results by the baseFee field.
+func ByBaseFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseFee, opts...).ToFunc()
+}
+
+// ByPriorityFee orders the results by the priorityFee field.
+func ByPriorityFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriorityFee, opts...).ToFunc()
+}
+
+// ByComputeUnits orders the results by the computeUnits field.
+func ByComputeUnits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputeUnits, opts...).ToFunc()
+}
+
+// ByJitoTip orders the results by the jitoTip field.
+func ByJitoTip(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitoTip, opts...).ToFunc()
+}
+
+// ByRentFee orders the results by the rentFee field.
+func ByRentFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRentFee, opts...).ToFunc()
+}
+
+// ByFeeUsd orders the results by the feeUsd field.
+func ByFeeUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeUsd, opts...).ToFunc()
+}
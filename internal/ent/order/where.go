@@ -135,6 +135,46 @@ func Profit(v decimal.Decimal) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldProfit, v))
 }
 
+// Paper applies equality check predicate on the "paper" field. It's identical to PaperEQ.
+func Paper(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPaper, v))
+}
+
+// Aggregator applies equality check predicate on the "aggregator" field. It's identical to AggregatorEQ.
+func Aggregator(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAggregator, v))
+}
+
+// BaseFee applies equality check predicate on the "baseFee" field. It's identical to BaseFeeEQ.
+func BaseFee(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldBaseFee, v))
+}
+
+// PriorityFee applies equality check predicate on the "priorityFee" field. It's identical to PriorityFeeEQ.
+func PriorityFee(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPriorityFee, v))
+}
+
+// ComputeUnits applies equality check predicate on the "computeUnits" field. It's identical to ComputeUnitsEQ.
+func ComputeUnits(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldComputeUnits, v))
+}
+
+// JitoTip applies equality check predicate on the "jitoTip" field. It's identical to JitoTipEQ.
+func JitoTip(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldJitoTip, v))
+}
+
+// RentFee applies equality check predicate on the "rentFee" field. It's identical to RentFeeEQ.
+func RentFee(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRentFee, v))
+}
+
+// FeeUsd applies equality check predicate on the "feeUsd" field. It's identical to FeeUsdEQ.
+func FeeUsd(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFeeUsd, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreateTime, v))
@@ -1210,6 +1250,431 @@ func ProfitContainsFold(v decimal.Decimal) predicate.Order {
 	return predicate.Order(sql.FieldContainsFold(FieldProfit, vc))
 }
 
+// PaperEQ applies the EQ predicate on the "paper" field.
+func PaperEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPaper, v))
+}
+
+// PaperNEQ applies the NEQ predicate on the "paper" field.
+func PaperNEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPaper, v))
+}
+
+// PaperIsNil applies the IsNil predicate on the "paper" field.
+func PaperIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldPaper))
+}
+
+// PaperNotNil applies the NotNil predicate on the "paper" field.
+func PaperNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldPaper))
+}
+
+// AggregatorEQ applies the EQ predicate on the "aggregator" field.
+func AggregatorEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAggregator, v))
+}
+
+// AggregatorNEQ applies the NEQ predicate on the "aggregator" field.
+func AggregatorNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldAggregator, v))
+}
+
+// AggregatorIn applies the In predicate on the "aggregator" field.
+func AggregatorIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldAggregator, vs...))
+}
+
+// AggregatorNotIn applies the NotIn predicate on the "aggregator" field.
+func AggregatorNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldAggregator, vs...))
+}
+
+// AggregatorGT applies the GT predicate on the "aggregator" field.
+func AggregatorGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldAggregator, v))
+}
+
+// AggregatorGTE applies the GTE predicate on the "aggregator" field.
+func AggregatorGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldAggregator, v))
+}
+
+// AggregatorLT applies the LT predicate on the "aggregator" field.
+func AggregatorLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldAggregator, v))
+}
+
+// AggregatorLTE applies the LTE predicate on the "aggregator" field.
+func AggregatorLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldAggregator, v))
+}
+
+// AggregatorContains applies the Contains predicate on the "aggregator" field.
+func AggregatorContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldAggregator, v))
+}
+
+// AggregatorHasPrefix applies the HasPrefix predicate on the "aggregator" field.
+func AggregatorHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldAggregator, v))
+}
+
+// AggregatorHasSuffix applies the HasSuffix predicate on the "aggregator" field.
+func AggregatorHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldAggregator, v))
+}
+
+// AggregatorIsNil applies the IsNil predicate on the "aggregator" field.
+func AggregatorIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldAggregator))
+}
+
+// AggregatorNotNil applies the NotNil predicate on the "aggregator" field.
+func AggregatorNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldAggregator))
+}
+
+// AggregatorEqualFold applies the EqualFold predicate on the "aggregator" field.
+func AggregatorEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldAggregator, v))
+}
+
+// AggregatorContainsFold applies the ContainsFold predicate on the "aggregator" field.
+func AggregatorContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldAggregator, v))
+}
+
+// BaseFeeEQ applies the EQ predicate on the "baseFee" field.
+func BaseFeeEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldBaseFee, v))
+}
+
+// BaseFeeNEQ applies the NEQ predicate on the "baseFee" field.
+func BaseFeeNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldBaseFee, v))
+}
+
+// BaseFeeIn applies the In predicate on the "baseFee" field.
+func BaseFeeIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldBaseFee, vs...))
+}
+
+// BaseFeeNotIn applies the NotIn predicate on the "baseFee" field.
+func BaseFeeNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldBaseFee, vs...))
+}
+
+// BaseFeeGT applies the GT predicate on the "baseFee" field.
+func BaseFeeGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldBaseFee, v))
+}
+
+// BaseFeeGTE applies the GTE predicate on the "baseFee" field.
+func BaseFeeGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldBaseFee, v))
+}
+
+// BaseFeeLT applies the LT predicate on the "baseFee" field.
+func BaseFeeLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldBaseFee, v))
+}
+
+// BaseFeeLTE applies the LTE predicate on the "baseFee" field.
+func BaseFeeLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldBaseFee, v))
+}
+
+// BaseFeeIsNil applies the IsNil predicate on the "baseFee" field.
+func BaseFeeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldBaseFee))
+}
+
+// BaseFeeNotNil applies the NotNil predicate on the "baseFee" field.
+func BaseFeeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldBaseFee))
+}
+
+// PriorityFeeEQ applies the EQ predicate on the "priorityFee" field.
+func PriorityFeeEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPriorityFee, v))
+}
+
+// PriorityFeeNEQ applies the NEQ predicate on the "priorityFee" field.
+func PriorityFeeNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPriorityFee, v))
+}
+
+// PriorityFeeIn applies the In predicate on the "priorityFee" field.
+func PriorityFeeIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldPriorityFee, vs...))
+}
+
+// PriorityFeeNotIn applies the NotIn predicate on the "priorityFee" field.
+func PriorityFeeNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldPriorityFee, vs...))
+}
+
+// PriorityFeeGT applies the GT predicate on the "priorityFee" field.
+func PriorityFeeGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldPriorityFee, v))
+}
+
+// PriorityFeeGTE applies the GTE predicate on the "priorityFee" field.
+func PriorityFeeGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldPriorityFee, v))
+}
+
+// PriorityFeeLT applies the LT predicate on the "priorityFee" field.
+func PriorityFeeLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldPriorityFee, v))
+}
+
+// PriorityFeeLTE applies the LTE predicate on the "priorityFee" field.
+func PriorityFeeLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldPriorityFee, v))
+}
+
+// PriorityFeeIsNil applies the IsNil predicate on the "priorityFee" field.
+func PriorityFeeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldPriorityFee))
+}
+
+// PriorityFeeNotNil applies the NotNil predicate on the "priorityFee" field.
+func PriorityFeeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldPriorityFee))
+}
+
+// ComputeUnitsEQ applies the EQ predicate on the "computeUnits" field.
+func ComputeUnitsEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldComputeUnits, v))
+}
+
+// ComputeUnitsNEQ applies the NEQ predicate on the "computeUnits" field.
+func ComputeUnitsNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldComputeUnits, v))
+}
+
+// ComputeUnitsIn applies the In predicate on the "computeUnits" field.
+func ComputeUnitsIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldComputeUnits, vs...))
+}
+
+// ComputeUnitsNotIn applies the NotIn predicate on the "computeUnits" field.
+func ComputeUnitsNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldComputeUnits, vs...))
+}
+
+// ComputeUnitsGT applies the GT predicate on the "computeUnits" field.
+func ComputeUnitsGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldComputeUnits, v))
+}
+
+// ComputeUnitsGTE applies the GTE predicate on the "computeUnits" field.
+func ComputeUnitsGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldComputeUnits, v))
+}
+
+// ComputeUnitsLT applies the LT predicate on the "computeUnits" field.
+func ComputeUnitsLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldComputeUnits, v))
+}
+
+// ComputeUnitsLTE applies the LTE predicate on the "computeUnits" field.
+func ComputeUnitsLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldComputeUnits, v))
+}
+
+// ComputeUnitsIsNil applies the IsNil predicate on the "computeUnits" field.
+func ComputeUnitsIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldComputeUnits))
+}
+
+// ComputeUnitsNotNil applies the NotNil predicate on the "computeUnits" field.
+func ComputeUnitsNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldComputeUnits))
+}
+
+// JitoTipEQ applies the EQ predicate on the "jitoTip" field.
+func JitoTipEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldJitoTip, v))
+}
+
+// JitoTipNEQ applies the NEQ predicate on the "jitoTip" field.
+func JitoTipNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldJitoTip, v))
+}
+
+// JitoTipIn applies the In predicate on the "jitoTip" field.
+func JitoTipIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldJitoTip, vs...))
+}
+
+// JitoTipNotIn applies the NotIn predicate on the "jitoTip" field.
+func JitoTipNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldJitoTip, vs...))
+}
+
+// JitoTipGT applies the GT predicate on the "jitoTip" field.
+func JitoTipGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldJitoTip, v))
+}
+
+// JitoTipGTE applies the GTE predicate on the "jitoTip" field.
+func JitoTipGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldJitoTip, v))
+}
+
+// JitoTipLT applies the LT predicate on the "jitoTip" field.
+func JitoTipLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldJitoTip, v))
+}
+
+// JitoTipLTE applies the LTE predicate on the "jitoTip" field.
+func JitoTipLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldJitoTip, v))
+}
+
+// JitoTipIsNil applies the IsNil predicate on the "jitoTip" field.
+func JitoTipIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldJitoTip))
+}
+
+// JitoTipNotNil applies the NotNil predicate on the "jitoTip" field.
+func JitoTipNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldJitoTip))
+}
+
+// RentFeeEQ applies the EQ predicate on the "rentFee" field.
+func RentFeeEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRentFee, v))
+}
+
+// RentFeeNEQ applies the NEQ predicate on the "rentFee" field.
+func RentFeeNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRentFee, v))
+}
+
+// RentFeeIn applies the In predicate on the "rentFee" field.
+func RentFeeIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRentFee, vs...))
+}
+
+// RentFeeNotIn applies the NotIn predicate on the "rentFee" field.
+func RentFeeNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRentFee, vs...))
+}
+
+// RentFeeGT applies the GT predicate on the "rentFee" field.
+func RentFeeGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRentFee, v))
+}
+
+// RentFeeGTE applies the GTE predicate on the "rentFee" field.
+func RentFeeGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRentFee, v))
+}
+
+// RentFeeLT applies the LT predicate on the "rentFee" field.
+func RentFeeLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRentFee, v))
+}
+
+// RentFeeLTE applies the LTE predicate on the "rentFee" field.
+func RentFeeLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRentFee, v))
+}
+
+// RentFeeIsNil applies the IsNil predicate on the "rentFee" field.
+func RentFeeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRentFee))
+}
+
+// RentFeeNotNil applies the NotNil predicate on the "rentFee" field.
+func RentFeeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRentFee))
+}
+
+// FeeUsdEQ applies the EQ predicate on the "feeUsd" field.
+func FeeUsdEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFeeUsd, v))
+}
+
+// FeeUsdNEQ applies the NEQ predicate on the "feeUsd" field.
+func FeeUsdNEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldFeeUsd, v))
+}
+
+// FeeUsdIn applies the In predicate on the "feeUsd" field.
+func FeeUsdIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldFeeUsd, vs...))
+}
+
+// FeeUsdNotIn applies the NotIn predicate on the "feeUsd" field.
+func FeeUsdNotIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldFeeUsd, vs...))
+}
+
+// FeeUsdGT applies the GT predicate on the "feeUsd" field.
+func FeeUsdGT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldFeeUsd, v))
+}
+
+// FeeUsdGTE applies the GTE predicate on the "feeUsd" field.
+func FeeUsdGTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldFeeUsd, v))
+}
+
+// FeeUsdLT applies the LT predicate on the "feeUsd" field.
+func FeeUsdLT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldFeeUsd, v))
+}
+
+// FeeUsdLTE applies the LTE predicate on the "feeUsd" field.
+func FeeUsdLTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldFeeUsd, v))
+}
+
+// FeeUsdContains applies the Contains predicate on the "feeUsd" field.
+func FeeUsdContains(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldContains(FieldFeeUsd, vc))
+}
+
+// FeeUsdHasPrefix applies the HasPrefix predicate on the "feeUsd" field.
+func FeeUsdHasPrefix(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldHasPrefix(FieldFeeUsd, vc))
+}
+
+// FeeUsdHasSuffix applies the HasSuffix predicate on the "feeUsd" field.
+func FeeUsdHasSuffix(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldHasSuffix(FieldFeeUsd, vc))
+}
+
+// FeeUsdIsNil applies the IsNil predicate on the "feeUsd" field.
+func FeeUsdIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldFeeUsd))
+}
+
+// FeeUsdNotNil applies the NotNil predicate on the "feeUsd" field.
+func FeeUsdNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldFeeUsd))
+}
+
+// FeeUsdEqualFold applies the EqualFold predicate on the "feeUsd" field.
+func FeeUsdEqualFold(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldEqualFold(FieldFeeUsd, vc))
+}
+
+// FeeUsdContainsFold applies the ContainsFold predicate on the "feeUsd" field.
+func FeeUsdContainsFold(v decimal.Decimal) predicate.Order {
+	vc := v.String()
+	return predicate.Order(sql.FieldContainsFold(FieldFeeUsd, vc))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
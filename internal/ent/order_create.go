@@ -177,6 +177,118 @@ func (oc *OrderCreate) SetNillableProfit(d *decimal.Decimal) *OrderCreate {
 	return oc
 }
 
+// SetPaper sets the "paper" field.
+func (oc *OrderCreate) SetPaper(b bool) *OrderCreate {
+	oc.mutation.SetPaper(b)
+	return oc
+}
+
+// SetNillablePaper sets the "paper" field if the given value is not nil.
+func (oc *OrderCreate) SetNillablePaper(b *bool) *OrderCreate {
+	if b != nil {
+		oc.SetPaper(*b)
+	}
+	return oc
+}
+
+// SetAggregator sets the "aggregator" field.
+func (oc *OrderCreate) SetAggregator(s string) *OrderCreate {
+	oc.mutation.SetAggregator(s)
+	return oc
+}
+
+// SetNillableAggregator sets the "aggregator" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableAggregator(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetAggregator(*s)
+	}
+	return oc
+}
+
+// SetBaseFee sets the "baseFee" field.
+func (oc *OrderCreate) SetBaseFee(i int64) *OrderCreate {
+	oc.mutation.SetBaseFee(i)
+	return oc
+}
+
+// SetNillableBaseFee sets the "baseFee" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableBaseFee(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetBaseFee(*i)
+	}
+	return oc
+}
+
+// SetPriorityFee sets the "priorityFee" field.
+func (oc *OrderCreate) SetPriorityFee(i int64) *OrderCreate {
+	oc.mutation.SetPriorityFee(i)
+	return oc
+}
+
+// SetNillablePriorityFee sets the "priorityFee" field if the given value is not nil.
+func (oc *OrderCreate) SetNillablePriorityFee(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetPriorityFee(*i)
+	}
+	return oc
+}
+
+// SetComputeUnits sets the "computeUnits" field.
+func (oc *OrderCreate) SetComputeUnits(i int64) *OrderCreate {
+	oc.mutation.SetComputeUnits(i)
+	return oc
+}
+
+// SetNillableComputeUnits sets the "computeUnits" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableComputeUnits(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetComputeUnits(*i)
+	}
+	return oc
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (oc *OrderCreate) SetJitoTip(i int64) *OrderCreate {
+	oc.mutation.SetJitoTip(i)
+	return oc
+}
+
+// SetNillableJitoTip sets the "jitoTip" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableJitoTip(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetJitoTip(*i)
+	}
+	return oc
+}
+
+// SetRentFee sets the "rentFee" field.
+func (oc *OrderCreate) SetRentFee(i int64) *OrderCreate {
+	oc.mutation.SetRentFee(i)
+	return oc
+}
+
+// SetNillableRentFee sets the "rentFee" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableRentFee(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetRentFee(*i)
+	}
+	return oc
+}
+
+// SetFeeUsd sets the "feeUsd" field.
+func (oc *OrderCreate) SetFeeUsd(d decimal.Decimal) *OrderCreate {
+	oc.mutation.SetFeeUsd(d)
+	return oc
+}
+
+// SetNillableFeeUsd sets the "feeUsd" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableFeeUsd(d *decimal.Decimal) *OrderCreate {
+	if d != nil {
+		oc.SetFeeUsd(*d)
+	}
+	return oc
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (oc *OrderCreate) Mutation() *OrderMutation {
 	return oc.mutation
@@ -311,6 +423,11 @@ func (oc *OrderCreate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Order.reason": %w`, err)}
 		}
 	}
+	if v, ok := oc.mutation.Aggregator(); ok {
+		if err := order.AggregatorValidator(v); err != nil {
+			return &ValidationError{Name: "aggregator", err: fmt.Errorf(`ent: validator failed for field "Order.aggregator": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -409,6 +526,38 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldProfit, field.TypeString, value)
 		_node.Profit = &value
 	}
+	if value, ok := oc.mutation.Paper(); ok {
+		_spec.SetField(order.FieldPaper, field.TypeBool, value)
+		_node.Paper = value
+	}
+	if value, ok := oc.mutation.Aggregator(); ok {
+		_spec.SetField(order.FieldAggregator, field.TypeString, value)
+		_node.Aggregator = value
+	}
+	if value, ok := oc.mutation.BaseFee(); ok {
+		_spec.SetField(order.FieldBaseFee, field.TypeInt64, value)
+		_node.BaseFee = value
+	}
+	if value, ok := oc.mutation.PriorityFee(); ok {
+		_spec.SetField(order.FieldPriorityFee, field.TypeInt64, value)
+		_node.PriorityFee = value
+	}
+	if value, ok := oc.mutation.ComputeUnits(); ok {
+		_spec.SetField(order.FieldComputeUnits, field.TypeInt64, value)
+		_node.ComputeUnits = value
+	}
+	if value, ok := oc.mutation.JitoTip(); ok {
+		_spec.SetField(order.FieldJitoTip, field.TypeInt64, value)
+		_node.JitoTip = value
+	}
+	if value, ok := oc.mutation.RentFee(); ok {
+		_spec.SetField(order.FieldRentFee, field.TypeInt64, value)
+		_node.RentFee = value
+	}
+	if value, ok := oc.mutation.FeeUsd(); ok {
+		_spec.SetField(order.FieldFeeUsd, field.TypeString, value)
+		_node.FeeUsd = &value
+	}
 	return _node, _spec
 }
 
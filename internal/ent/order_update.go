@@ -290,6 +290,201 @@ func (ou *OrderUpdate) ClearProfit() *OrderUpdate {
 	return ou
 }
 
+// SetPaper sets the "paper" field.
+func (ou *OrderUpdate) SetPaper(b bool) *OrderUpdate {
+	ou.mutation.SetPaper(b)
+	return ou
+}
+
+// SetNillablePaper sets the "paper" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillablePaper(b *bool) *OrderUpdate {
+	if b != nil {
+		ou.SetPaper(*b)
+	}
+	return ou
+}
+
+// ClearPaper clears the value of the "paper" field.
+func (ou *OrderUpdate) ClearPaper() *OrderUpdate {
+	ou.mutation.ClearPaper()
+	return ou
+}
+
+// SetAggregator sets the "aggregator" field.
+func (ou *OrderUpdate) SetAggregator(s string) *OrderUpdate {
+	ou.mutation.SetAggregator(s)
+	return ou
+}
+
+// SetNillableAggregator sets the "aggregator" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableAggregator(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetAggregator(*s)
+	}
+	return ou
+}
+
+// ClearAggregator clears the value of the "aggregator" field.
+func (ou *OrderUpdate) ClearAggregator() *OrderUpdate {
+	ou.mutation.ClearAggregator()
+	return ou
+}
+
+// SetBaseFee sets the "baseFee" field.
+func (ou *OrderUpdate) SetBaseFee(i int64) *OrderUpdate {
+	ou.mutation.ResetBaseFee()
+	ou.mutation.SetBaseFee(i)
+	return ou
+}
+
+// SetNillableBaseFee sets the "baseFee" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableBaseFee(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetBaseFee(*i)
+	}
+	return ou
+}
+
+// AddBaseFee adds i to the "baseFee" field.
+func (ou *OrderUpdate) AddBaseFee(i int64) *OrderUpdate {
+	ou.mutation.AddBaseFee(i)
+	return ou
+}
+
+// ClearBaseFee clears the value of the "baseFee" field.
+func (ou *OrderUpdate) ClearBaseFee() *OrderUpdate {
+	ou.mutation.ClearBaseFee()
+	return ou
+}
+
+// SetPriorityFee sets the "priorityFee" field.
+func (ou *OrderUpdate) SetPriorityFee(i int64) *OrderUpdate {
+	ou.mutation.ResetPriorityFee()
+	ou.mutation.SetPriorityFee(i)
+	return ou
+}
+
+// SetNillablePriorityFee sets the "priorityFee" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillablePriorityFee(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetPriorityFee(*i)
+	}
+	return ou
+}
+
+// AddPriorityFee adds i to the "priorityFee" field.
+func (ou *OrderUpdate) AddPriorityFee(i int64) *OrderUpdate {
+	ou.mutation.AddPriorityFee(i)
+	return ou
+}
+
+// ClearPriorityFee clears the value of the "priorityFee" field.
+func (ou *OrderUpdate) ClearPriorityFee() *OrderUpdate {
+	ou.mutation.ClearPriorityFee()
+	return ou
+}
+
+// SetComputeUnits sets the "computeUnits" field.
+func (ou *OrderUpdate) SetComputeUnits(i int64) *OrderUpdate {
+	ou.mutation.ResetComputeUnits()
+	ou.mutation.SetComputeUnits(i)
+	return ou
+}
+
+// SetNillableComputeUnits sets the "computeUnits" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableComputeUnits(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetComputeUnits(*i)
+	}
+	return ou
+}
+
+// AddComputeUnits adds i to the "computeUnits" field.
+func (ou *OrderUpdate) AddComputeUnits(i int64) *OrderUpdate {
+	ou.mutation.AddComputeUnits(i)
+	return ou
+}
+
+// ClearComputeUnits clears the value of the "computeUnits" field.
+func (ou *OrderUpdate) ClearComputeUnits() *OrderUpdate {
+	ou.mutation.ClearComputeUnits()
+	return ou
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (ou *OrderUpdate) SetJitoTip(i int64) *OrderUpdate {
+	ou.mutation.ResetJitoTip()
+	ou.mutation.SetJitoTip(i)
+	return ou
+}
+
+// SetNillableJitoTip sets the "jitoTip" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableJitoTip(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetJitoTip(*i)
+	}
+	return ou
+}
+
+// AddJitoTip adds i to the "jitoTip" field.
+func (ou *OrderUpdate) AddJitoTip(i int64) *OrderUpdate {
+	ou.mutation.AddJitoTip(i)
+	return ou
+}
+
+// ClearJitoTip clears the value of the "jitoTip" field.
+func (ou *OrderUpdate) ClearJitoTip() *OrderUpdate {
+	ou.mutation.ClearJitoTip()
+	return ou
+}
+
+// SetRentFee sets the "rentFee" field.
+func (ou *OrderUpdate) SetRentFee(i int64) *OrderUpdate {
+	ou.mutation.ResetRentFee()
+	ou.mutation.SetRentFee(i)
+	return ou
+}
+
+// SetNillableRentFee sets the "rentFee" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableRentFee(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetRentFee(*i)
+	}
+	return ou
+}
+
+// AddRentFee adds i to the "rentFee" field.
+func (ou *OrderUpdate) AddRentFee(i int64) *OrderUpdate {
+	ou.mutation.AddRentFee(i)
+	return ou
+}
+
+// ClearRentFee clears the value of the "rentFee" field.
+func (ou *OrderUpdate) ClearRentFee() *OrderUpdate {
+	ou.mutation.ClearRentFee()
+	return ou
+}
+
+// SetFeeUsd sets the "feeUsd" field.
+func (ou *OrderUpdate) SetFeeUsd(d decimal.Decimal) *OrderUpdate {
+	ou.mutation.SetFeeUsd(d)
+	return ou
+}
+
+// SetNillableFeeUsd sets the "feeUsd" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableFeeUsd(d *decimal.Decimal) *OrderUpdate {
+	if d != nil {
+		ou.SetFeeUsd(*d)
+	}
+	return ou
+}
+
+// ClearFeeUsd clears the value of the "feeUsd" field.
+func (ou *OrderUpdate) ClearFeeUsd() *OrderUpdate {
+	ou.mutation.ClearFeeUsd()
+	return ou
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ou *OrderUpdate) Mutation() *OrderMutation {
 	return ou.mutation
@@ -378,6 +573,11 @@ func (ou *OrderUpdate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Order.reason": %w`, err)}
 		}
 	}
+	if v, ok := ou.mutation.Aggregator(); ok {
+		if err := order.AggregatorValidator(v); err != nil {
+			return &ValidationError{Name: "aggregator", err: fmt.Errorf(`ent: validator failed for field "Order.aggregator": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -459,6 +659,69 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ou.mutation.ProfitCleared() {
 		_spec.ClearField(order.FieldProfit, field.TypeString)
 	}
+	if value, ok := ou.mutation.Paper(); ok {
+		_spec.SetField(order.FieldPaper, field.TypeBool, value)
+	}
+	if ou.mutation.PaperCleared() {
+		_spec.ClearField(order.FieldPaper, field.TypeBool)
+	}
+	if value, ok := ou.mutation.Aggregator(); ok {
+		_spec.SetField(order.FieldAggregator, field.TypeString, value)
+	}
+	if ou.mutation.AggregatorCleared() {
+		_spec.ClearField(order.FieldAggregator, field.TypeString)
+	}
+	if value, ok := ou.mutation.BaseFee(); ok {
+		_spec.SetField(order.FieldBaseFee, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedBaseFee(); ok {
+		_spec.AddField(order.FieldBaseFee, field.TypeInt64, value)
+	}
+	if ou.mutation.BaseFeeCleared() {
+		_spec.ClearField(order.FieldBaseFee, field.TypeInt64)
+	}
+	if value, ok := ou.mutation.PriorityFee(); ok {
+		_spec.SetField(order.FieldPriorityFee, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedPriorityFee(); ok {
+		_spec.AddField(order.FieldPriorityFee, field.TypeInt64, value)
+	}
+	if ou.mutation.PriorityFeeCleared() {
+		_spec.ClearField(order.FieldPriorityFee, field.TypeInt64)
+	}
+	if value, ok := ou.mutation.ComputeUnits(); ok {
+		_spec.SetField(order.FieldComputeUnits, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedComputeUnits(); ok {
+		_spec.AddField(order.FieldComputeUnits, field.TypeInt64, value)
+	}
+	if ou.mutation.ComputeUnitsCleared() {
+		_spec.ClearField(order.FieldComputeUnits, field.TypeInt64)
+	}
+	if value, ok := ou.mutation.JitoTip(); ok {
+		_spec.SetField(order.FieldJitoTip, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedJitoTip(); ok {
+		_spec.AddField(order.FieldJitoTip, field.TypeInt64, value)
+	}
+	if ou.mutation.JitoTipCleared() {
+		_spec.ClearField(order.FieldJitoTip, field.TypeInt64)
+	}
+	if value, ok := ou.mutation.RentFee(); ok {
+		_spec.SetField(order.FieldRentFee, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedRentFee(); ok {
+		_spec.AddField(order.FieldRentFee, field.TypeInt64, value)
+	}
+	if ou.mutation.RentFeeCleared() {
+		_spec.ClearField(order.FieldRentFee, field.TypeInt64)
+	}
+	if value, ok := ou.mutation.FeeUsd(); ok {
+		_spec.SetField(order.FieldFeeUsd, field.TypeString, value)
+	}
+	if ou.mutation.FeeUsdCleared() {
+		_spec.ClearField(order.FieldFeeUsd, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -740,6 +1003,201 @@ func (ouo *OrderUpdateOne) ClearProfit() *OrderUpdateOne {
 	return ouo
 }
 
+// SetPaper sets the "paper" field.
+func (ouo *OrderUpdateOne) SetPaper(b bool) *OrderUpdateOne {
+	ouo.mutation.SetPaper(b)
+	return ouo
+}
+
+// SetNillablePaper sets the "paper" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillablePaper(b *bool) *OrderUpdateOne {
+	if b != nil {
+		ouo.SetPaper(*b)
+	}
+	return ouo
+}
+
+// ClearPaper clears the value of the "paper" field.
+func (ouo *OrderUpdateOne) ClearPaper() *OrderUpdateOne {
+	ouo.mutation.ClearPaper()
+	return ouo
+}
+
+// SetAggregator sets the "aggregator" field.
+func (ouo *OrderUpdateOne) SetAggregator(s string) *OrderUpdateOne {
+	ouo.mutation.SetAggregator(s)
+	return ouo
+}
+
+// SetNillableAggregator sets the "aggregator" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableAggregator(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetAggregator(*s)
+	}
+	return ouo
+}
+
+// ClearAggregator clears the value of the "aggregator" field.
+func (ouo *OrderUpdateOne) ClearAggregator() *OrderUpdateOne {
+	ouo.mutation.ClearAggregator()
+	return ouo
+}
+
+// SetBaseFee sets the "baseFee" field.
+func (ouo *OrderUpdateOne) SetBaseFee(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetBaseFee()
+	ouo.mutation.SetBaseFee(i)
+	return ouo
+}
+
+// SetNillableBaseFee sets the "baseFee" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableBaseFee(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetBaseFee(*i)
+	}
+	return ouo
+}
+
+// AddBaseFee adds i to the "baseFee" field.
+func (ouo *OrderUpdateOne) AddBaseFee(i int64) *OrderUpdateOne {
+	ouo.mutation.AddBaseFee(i)
+	return ouo
+}
+
+// ClearBaseFee clears the value of the "baseFee" field.
+func (ouo *OrderUpdateOne) ClearBaseFee() *OrderUpdateOne {
+	ouo.mutation.ClearBaseFee()
+	return ouo
+}
+
+// SetPriorityFee sets the "priorityFee" field.
+func (ouo *OrderUpdateOne) SetPriorityFee(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetPriorityFee()
+	ouo.mutation.SetPriorityFee(i)
+	return ouo
+}
+
+// SetNillablePriorityFee sets the "priorityFee" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillablePriorityFee(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetPriorityFee(*i)
+	}
+	return ouo
+}
+
+// AddPriorityFee adds i to the "priorityFee" field.
+func (ouo *OrderUpdateOne) AddPriorityFee(i int64) *OrderUpdateOne {
+	ouo.mutation.AddPriorityFee(i)
+	return ouo
+}
+
+// ClearPriorityFee clears the value of the "priorityFee" field.
+func (ouo *OrderUpdateOne) ClearPriorityFee() *OrderUpdateOne {
+	ouo.mutation.ClearPriorityFee()
+	return ouo
+}
+
+// SetComputeUnits sets the "computeUnits" field.
+func (ouo *OrderUpdateOne) SetComputeUnits(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetComputeUnits()
+	ouo.mutation.SetComputeUnits(i)
+	return ouo
+}
+
+// SetNillableComputeUnits sets the "computeUnits" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableComputeUnits(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetComputeUnits(*i)
+	}
+	return ouo
+}
+
+// AddComputeUnits adds i to the "computeUnits" field.
+func (ouo *OrderUpdateOne) AddComputeUnits(i int64) *OrderUpdateOne {
+	ouo.mutation.AddComputeUnits(i)
+	return ouo
+}
+
+// ClearComputeUnits clears the value of the "computeUnits" field.
+func (ouo *OrderUpdateOne) ClearComputeUnits() *OrderUpdateOne {
+	ouo.mutation.ClearComputeUnits()
+	return ouo
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (ouo *OrderUpdateOne) SetJitoTip(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetJitoTip()
+	ouo.mutation.SetJitoTip(i)
+	return ouo
+}
+
+// SetNillableJitoTip sets the "jitoTip" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableJitoTip(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetJitoTip(*i)
+	}
+	return ouo
+}
+
+// AddJitoTip adds i to the "jitoTip" field.
+func (ouo *OrderUpdateOne) AddJitoTip(i int64) *OrderUpdateOne {
+	ouo.mutation.AddJitoTip(i)
+	return ouo
+}
+
+// ClearJitoTip clears the value of the "jitoTip" field.
+func (ouo *OrderUpdateOne) ClearJitoTip() *OrderUpdateOne {
+	ouo.mutation.ClearJitoTip()
+	return ouo
+}
+
+// SetRentFee sets the "rentFee" field.
+func (ouo *OrderUpdateOne) SetRentFee(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetRentFee()
+	ouo.mutation.SetRentFee(i)
+	return ouo
+}
+
+// SetNillableRentFee sets the "rentFee" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableRentFee(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetRentFee(*i)
+	}
+	return ouo
+}
+
+// AddRentFee adds i to the "rentFee" field.
+func (ouo *OrderUpdateOne) AddRentFee(i int64) *OrderUpdateOne {
+	ouo.mutation.AddRentFee(i)
+	return ouo
+}
+
+// ClearRentFee clears the value of the "rentFee" field.
+func (ouo *OrderUpdateOne) ClearRentFee() *OrderUpdateOne {
+	ouo.mutation.ClearRentFee()
+	return ouo
+}
+
+// SetFeeUsd sets the "feeUsd" field.
+func (ouo *OrderUpdateOne) SetFeeUsd(d decimal.Decimal) *OrderUpdateOne {
+	ouo.mutation.SetFeeUsd(d)
+	return ouo
+}
+
+// SetNillableFeeUsd sets the "feeUsd" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableFeeUsd(d *decimal.Decimal) *OrderUpdateOne {
+	if d != nil {
+		ouo.SetFeeUsd(*d)
+	}
+	return ouo
+}
+
+// ClearFeeUsd clears the value of the "feeUsd" field.
+func (ouo *OrderUpdateOne) ClearFeeUsd() *OrderUpdateOne {
+	ouo.mutation.ClearFeeUsd()
+	return ouo
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
 	return ouo.mutation
@@ -841,6 +1299,11 @@ func (ouo *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Order.reason": %w`, err)}
 		}
 	}
+	if v, ok := ouo.mutation.Aggregator(); ok {
+		if err := order.AggregatorValidator(v); err != nil {
+			return &ValidationError{Name: "aggregator", err: fmt.Errorf(`ent: validator failed for field "Order.aggregator": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -939,6 +1402,69 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if ouo.mutation.ProfitCleared() {
 		_spec.ClearField(order.FieldProfit, field.TypeString)
 	}
+	if value, ok := ouo.mutation.Paper(); ok {
+		_spec.SetField(order.FieldPaper, field.TypeBool, value)
+	}
+	if ouo.mutation.PaperCleared() {
+		_spec.ClearField(order.FieldPaper, field.TypeBool)
+	}
+	if value, ok := ouo.mutation.Aggregator(); ok {
+		_spec.SetField(order.FieldAggregator, field.TypeString, value)
+	}
+	if ouo.mutation.AggregatorCleared() {
+		_spec.ClearField(order.FieldAggregator, field.TypeString)
+	}
+	if value, ok := ouo.mutation.BaseFee(); ok {
+		_spec.SetField(order.FieldBaseFee, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedBaseFee(); ok {
+		_spec.AddField(order.FieldBaseFee, field.TypeInt64, value)
+	}
+	if ouo.mutation.BaseFeeCleared() {
+		_spec.ClearField(order.FieldBaseFee, field.TypeInt64)
+	}
+	if value, ok := ouo.mutation.PriorityFee(); ok {
+		_spec.SetField(order.FieldPriorityFee, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedPriorityFee(); ok {
+		_spec.AddField(order.FieldPriorityFee, field.TypeInt64, value)
+	}
+	if ouo.mutation.PriorityFeeCleared() {
+		_spec.ClearField(order.FieldPriorityFee, field.TypeInt64)
+	}
+	if value, ok := ouo.mutation.ComputeUnits(); ok {
+		_spec.SetField(order.FieldComputeUnits, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedComputeUnits(); ok {
+		_spec.AddField(order.FieldComputeUnits, field.TypeInt64, value)
+	}
+	if ouo.mutation.ComputeUnitsCleared() {
+		_spec.ClearField(order.FieldComputeUnits, field.TypeInt64)
+	}
+	if value, ok := ouo.mutation.JitoTip(); ok {
+		_spec.SetField(order.FieldJitoTip, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedJitoTip(); ok {
+		_spec.AddField(order.FieldJitoTip, field.TypeInt64, value)
+	}
+	if ouo.mutation.JitoTipCleared() {
+		_spec.ClearField(order.FieldJitoTip, field.TypeInt64)
+	}
+	if value, ok := ouo.mutation.RentFee(); ok {
+		_spec.SetField(order.FieldRentFee, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedRentFee(); ok {
+		_spec.AddField(order.FieldRentFee, field.TypeInt64, value)
+	}
+	if ouo.mutation.RentFeeCleared() {
+		_spec.ClearField(order.FieldRentFee, field.TypeInt64)
+	}
+	if value, ok := ouo.mutation.FeeUsd(); ok {
+		_spec.SetField(order.FieldFeeUsd, field.TypeString, value)
+	}
+	if ouo.mutation.FeeUsdCleared() {
+		_spec.ClearField(order.FieldFeeUsd, field.TypeString)
+	}
 	_node = &Order{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -20,3 +20,9 @@ type Strategy func(*sql.Selector)
 
 // Wallet is the predicate function for wallet builders.
 type Wallet func(*sql.Selector)
+
+// WithdrawAddress is the predicate function for withdrawaddress builders.
+type WithdrawAddress func(*sql.Selector)
+
+// Withdrawal is the predicate function for withdrawal builders.
+type Withdrawal func(*sql.Selector)
@@ -11,6 +11,8 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/ent/wallet"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawaddress"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -99,6 +101,10 @@ func init() {
 	orderDescReason := orderFields[14].Descriptor()
 	// order.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	order.ReasonValidator = orderDescReason.Validators[0].(func(string) error)
+	// orderDescAggregator is the schema descriptor for aggregator field.
+	orderDescAggregator := orderFields[17].Descriptor()
+	// order.AggregatorValidator is a validator for the "aggregator" field. It is called by the builders before save.
+	order.AggregatorValidator = orderDescAggregator.Validators[0].(func(string) error)
 	settingsMixin := schema.Settings{}.Mixin()
 	settingsMixinFields0 := settingsMixin[0].Fields()
 	_ = settingsMixinFields0
@@ -134,6 +140,26 @@ func init() {
 	settingsDescMaxLamports := settingsFields[5].Descriptor()
 	// settings.MaxLamportsValidator is a validator for the "maxLamports" field. It is called by the builders before save.
 	settings.MaxLamportsValidator = settingsDescMaxLamports.Validators[0].(func(int64) error)
+	// settingsDescAggregatorPriority is the schema descriptor for aggregatorPriority field.
+	settingsDescAggregatorPriority := settingsFields[8].Descriptor()
+	// settings.AggregatorPriorityValidator is a validator for the "aggregatorPriority" field. It is called by the builders before save.
+	settings.AggregatorPriorityValidator = settingsDescAggregatorPriority.Validators[0].(func(string) error)
+	// settingsDescJitoTip is the schema descriptor for jitoTip field.
+	settingsDescJitoTip := settingsFields[10].Descriptor()
+	// settings.JitoTipValidator is a validator for the "jitoTip" field. It is called by the builders before save.
+	settings.JitoTipValidator = settingsDescJitoTip.Validators[0].(func(int64) error)
+	// settingsDescExitJitoTip is the schema descriptor for exitJitoTip field.
+	settingsDescExitJitoTip := settingsFields[11].Descriptor()
+	// settings.ExitJitoTipValidator is a validator for the "exitJitoTip" field. It is called by the builders before save.
+	settings.ExitJitoTipValidator = settingsDescExitJitoTip.Validators[0].(func(int64) error)
+	// settingsDescSimulateTx is the schema descriptor for simulateTx field.
+	settingsDescSimulateTx := settingsFields[12].Descriptor()
+	// settings.DefaultSimulateTx holds the default value on creation for the simulateTx field.
+	settings.DefaultSimulateTx = settingsDescSimulateTx.Default.(bool)
+	// settingsDescSkipExitSimulation is the schema descriptor for skipExitSimulation field.
+	settingsDescSkipExitSimulation := settingsFields[13].Descriptor()
+	// settings.DefaultSkipExitSimulation holds the default value on creation for the skipExitSimulation field.
+	settings.DefaultSkipExitSimulation = settingsDescSkipExitSimulation.Default.(bool)
 	strategyMixin := schema.Strategy{}.Mixin()
 	strategyMixinFields0 := strategyMixin[0].Fields()
 	_ = strategyMixinFields0
@@ -153,26 +179,58 @@ func init() {
 	strategyDescGUID := strategyFields[0].Descriptor()
 	// strategy.GUIDValidator is a validator for the "guid" field. It is called by the builders before save.
 	strategy.GUIDValidator = strategyDescGUID.Validators[0].(func(string) error)
+	// strategyDescAccount is the schema descriptor for account field.
+	strategyDescAccount := strategyFields[2].Descriptor()
+	// strategy.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	strategy.AccountValidator = strategyDescAccount.Validators[0].(func(string) error)
 	// strategyDescToken is the schema descriptor for token field.
-	strategyDescToken := strategyFields[2].Descriptor()
+	strategyDescToken := strategyFields[3].Descriptor()
 	// strategy.TokenValidator is a validator for the "token" field. It is called by the builders before save.
 	strategy.TokenValidator = strategyDescToken.Validators[0].(func(string) error)
 	// strategyDescSymbol is the schema descriptor for symbol field.
-	strategyDescSymbol := strategyFields[3].Descriptor()
+	strategyDescSymbol := strategyFields[4].Descriptor()
 	// strategy.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
 	strategy.SymbolValidator = strategyDescSymbol.Validators[0].(func(string) error)
 	// strategyDescMartinFactor is the schema descriptor for martinFactor field.
-	strategyDescMartinFactor := strategyFields[4].Descriptor()
+	strategyDescMartinFactor := strategyFields[6].Descriptor()
 	// strategy.MartinFactorValidator is a validator for the "martinFactor" field. It is called by the builders before save.
 	strategy.MartinFactorValidator = strategyDescMartinFactor.Validators[0].(func(float64) error)
 	// strategyDescMaxGridLimit is the schema descriptor for maxGridLimit field.
-	strategyDescMaxGridLimit := strategyFields[5].Descriptor()
+	strategyDescMaxGridLimit := strategyFields[7].Descriptor()
 	// strategy.MaxGridLimitValidator is a validator for the "maxGridLimit" field. It is called by the builders before save.
 	strategy.MaxGridLimitValidator = strategyDescMaxGridLimit.Validators[0].(func(int) error)
+	// strategyDescGridCount is the schema descriptor for gridCount field.
+	strategyDescGridCount := strategyFields[12].Descriptor()
+	// strategy.DefaultGridCount holds the default value on creation for the gridCount field.
+	strategy.DefaultGridCount = strategyDescGridCount.Default.(int)
 	// strategyDescCandlesToCheck is the schema descriptor for candlesToCheck field.
-	strategyDescCandlesToCheck := strategyFields[19].Descriptor()
+	strategyDescCandlesToCheck := strategyFields[25].Descriptor()
 	// strategy.DefaultCandlesToCheck holds the default value on creation for the candlesToCheck field.
 	strategy.DefaultCandlesToCheck = strategyDescCandlesToCheck.Default.(int)
+	// strategyDescTrailingCandles is the schema descriptor for trailingCandles field.
+	strategyDescTrailingCandles := strategyFields[28].Descriptor()
+	// strategy.DefaultTrailingCandles holds the default value on creation for the trailingCandles field.
+	strategy.DefaultTrailingCandles = strategyDescTrailingCandles.Default.(int)
+	// strategyDescTrailingMaxShifts is the schema descriptor for trailingMaxShifts field.
+	strategyDescTrailingMaxShifts := strategyFields[29].Descriptor()
+	// strategy.DefaultTrailingMaxShifts holds the default value on creation for the trailingMaxShifts field.
+	strategy.DefaultTrailingMaxShifts = strategyDescTrailingMaxShifts.Default.(int)
+	// strategyDescTrailingShifts is the schema descriptor for trailingShifts field.
+	strategyDescTrailingShifts := strategyFields[30].Descriptor()
+	// strategy.DefaultTrailingShifts holds the default value on creation for the trailingShifts field.
+	strategy.DefaultTrailingShifts = strategyDescTrailingShifts.Default.(int)
+	// strategyDescDcaInterval is the schema descriptor for dcaInterval field.
+	strategyDescDcaInterval := strategyFields[32].Descriptor()
+	// strategy.DefaultDcaInterval holds the default value on creation for the dcaInterval field.
+	strategy.DefaultDcaInterval = strategyDescDcaInterval.Default.(int)
+	// strategyDescDcaMaxOrders is the schema descriptor for dcaMaxOrders field.
+	strategyDescDcaMaxOrders := strategyFields[34].Descriptor()
+	// strategy.DefaultDcaMaxOrders holds the default value on creation for the dcaMaxOrders field.
+	strategy.DefaultDcaMaxOrders = strategyDescDcaMaxOrders.Default.(int)
+	// strategyDescDcaSellInterval is the schema descriptor for dcaSellInterval field.
+	strategyDescDcaSellInterval := strategyFields[35].Descriptor()
+	// strategy.DefaultDcaSellInterval holds the default value on creation for the dcaSellInterval field.
+	strategy.DefaultDcaSellInterval = strategyDescDcaSellInterval.Default.(int)
 	walletMixin := schema.Wallet{}.Mixin()
 	walletMixinFields0 := walletMixin[0].Fields()
 	_ = walletMixinFields0
@@ -200,4 +258,70 @@ func init() {
 	walletDescPrivateKey := walletFields[3].Descriptor()
 	// wallet.PrivateKeyValidator is a validator for the "privateKey" field. It is called by the builders before save.
 	wallet.PrivateKeyValidator = walletDescPrivateKey.Validators[0].(func(string) error)
+	// walletDescName is the schema descriptor for name field.
+	walletDescName := walletFields[4].Descriptor()
+	// wallet.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	wallet.NameValidator = walletDescName.Validators[0].(func(string) error)
+	withdrawaddressMixin := schema.WithdrawAddress{}.Mixin()
+	withdrawaddressMixinFields0 := withdrawaddressMixin[0].Fields()
+	_ = withdrawaddressMixinFields0
+	withdrawaddressFields := schema.WithdrawAddress{}.Fields()
+	_ = withdrawaddressFields
+	// withdrawaddressDescCreateTime is the schema descriptor for create_time field.
+	withdrawaddressDescCreateTime := withdrawaddressMixinFields0[0].Descriptor()
+	// withdrawaddress.DefaultCreateTime holds the default value on creation for the create_time field.
+	withdrawaddress.DefaultCreateTime = withdrawaddressDescCreateTime.Default.(func() time.Time)
+	// withdrawaddressDescUpdateTime is the schema descriptor for update_time field.
+	withdrawaddressDescUpdateTime := withdrawaddressMixinFields0[1].Descriptor()
+	// withdrawaddress.DefaultUpdateTime holds the default value on creation for the update_time field.
+	withdrawaddress.DefaultUpdateTime = withdrawaddressDescUpdateTime.Default.(func() time.Time)
+	// withdrawaddress.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	withdrawaddress.UpdateDefaultUpdateTime = withdrawaddressDescUpdateTime.UpdateDefault.(func() time.Time)
+	// withdrawaddressDescAddress is the schema descriptor for address field.
+	withdrawaddressDescAddress := withdrawaddressFields[1].Descriptor()
+	// withdrawaddress.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	withdrawaddress.AddressValidator = withdrawaddressDescAddress.Validators[0].(func(string) error)
+	// withdrawaddressDescName is the schema descriptor for name field.
+	withdrawaddressDescName := withdrawaddressFields[2].Descriptor()
+	// withdrawaddress.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	withdrawaddress.NameValidator = withdrawaddressDescName.Validators[0].(func(string) error)
+	withdrawalMixin := schema.Withdrawal{}.Mixin()
+	withdrawalMixinFields0 := withdrawalMixin[0].Fields()
+	_ = withdrawalMixinFields0
+	withdrawalFields := schema.Withdrawal{}.Fields()
+	_ = withdrawalFields
+	// withdrawalDescCreateTime is the schema descriptor for create_time field.
+	withdrawalDescCreateTime := withdrawalMixinFields0[0].Descriptor()
+	// withdrawal.DefaultCreateTime holds the default value on creation for the create_time field.
+	withdrawal.DefaultCreateTime = withdrawalDescCreateTime.Default.(func() time.Time)
+	// withdrawalDescUpdateTime is the schema descriptor for update_time field.
+	withdrawalDescUpdateTime := withdrawalMixinFields0[1].Descriptor()
+	// withdrawal.DefaultUpdateTime holds the default value on creation for the update_time field.
+	withdrawal.DefaultUpdateTime = withdrawalDescUpdateTime.Default.(func() time.Time)
+	// withdrawal.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	withdrawal.UpdateDefaultUpdateTime = withdrawalDescUpdateTime.UpdateDefault.(func() time.Time)
+	// withdrawalDescAccount is the schema descriptor for account field.
+	withdrawalDescAccount := withdrawalFields[1].Descriptor()
+	// withdrawal.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	withdrawal.AccountValidator = withdrawalDescAccount.Validators[0].(func(string) error)
+	// withdrawalDescToken is the schema descriptor for token field.
+	withdrawalDescToken := withdrawalFields[2].Descriptor()
+	// withdrawal.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	withdrawal.TokenValidator = withdrawalDescToken.Validators[0].(func(string) error)
+	// withdrawalDescSymbol is the schema descriptor for symbol field.
+	withdrawalDescSymbol := withdrawalFields[3].Descriptor()
+	// withdrawal.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	withdrawal.SymbolValidator = withdrawalDescSymbol.Validators[0].(func(string) error)
+	// withdrawalDescDestination is the schema descriptor for destination field.
+	withdrawalDescDestination := withdrawalFields[4].Descriptor()
+	// withdrawal.DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	withdrawal.DestinationValidator = withdrawalDescDestination.Validators[0].(func(string) error)
+	// withdrawalDescTxHash is the schema descriptor for txHash field.
+	withdrawalDescTxHash := withdrawalFields[7].Descriptor()
+	// withdrawal.TxHashValidator is a validator for the "txHash" field. It is called by the builders before save.
+	withdrawal.TxHashValidator = withdrawalDescTxHash.Validators[0].(func(string) error)
+	// withdrawalDescReason is the schema descriptor for reason field.
+	withdrawalDescReason := withdrawalFields[8].Descriptor()
+	// withdrawal.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	withdrawal.ReasonValidator = withdrawalDescReason.Validators[0].(func(string) error)
 }
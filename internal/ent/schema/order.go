@@ -38,6 +38,14 @@ func (Order) Fields() []ent.Field {
 		field.String("txHash").MaxLen(100),
 		field.String("reason").MaxLen(500),
 		field.String("profit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Bool("paper").Optional(),
+		field.String("aggregator").MaxLen(20).Optional(),
+		field.Int64("baseFee").Optional(),
+		field.Int64("priorityFee").Optional(),
+		field.Int64("computeUnits").Optional(),
+		field.Int64("jitoTip").Optional(),
+		field.Int64("rentFee").Optional(),
+		field.String("feeUsd").GoType(decimal.Decimal{}).Nillable().Optional(),
 	}
 }
 
@@ -28,7 +28,13 @@ func (Settings) Fields() []ent.Field {
 		field.Int("exitSlippageBps").Min(0).Nillable().Optional(),
 		field.Int64("maxLamports").Min(0),
 		field.Enum("priorityLevel").Values("medium", "high", "veryHigh"),
-		field.Enum("dexAggregator").Values("jup", "okx", "relay"),
+		field.Enum("dexAggregator").Values("jup", "okx", "relay", "auto"),
+		field.String("aggregatorPriority").MaxLen(50).Optional(),
+		field.Enum("txSender").Values("rpc", "jito").Default("rpc"),
+		field.Int64("jitoTip").Min(0).Nillable().Optional(),
+		field.Int64("exitJitoTip").Min(0).Nillable().Optional(),
+		field.Bool("simulateTx").Default(false),
+		field.Bool("skipExitSimulation").Default(true),
 	}
 }
 
@@ -24,13 +24,18 @@ func (Strategy) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("guid").MaxLen(50),
 		field.Int64("userId"),
+		field.String("account").MaxLen(50).Optional(),
 		field.String("token").MaxLen(50),
 		field.String("symbol").MaxLen(32),
+		field.Enum("type").Values("grid", "dca").Default("grid"),
 		field.Float("martinFactor").Min(1),
 		field.Int("maxGridLimit").Min(1).Nillable().Optional(),
 		field.String("takeProfitRatio").GoType(decimal.Decimal{}),
 		field.String("upperPriceBound").GoType(decimal.Decimal{}),
 		field.String("lowerPriceBound").GoType(decimal.Decimal{}),
+		field.Enum("gridMode").Values("geometric", "arithmetic").Default("geometric"),
+		field.Int("gridCount").Optional().Default(0),
+		field.String("gridStep").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("initialOrderSize").GoType(decimal.Decimal{}),
 		field.String("lastKlineVolume").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("fiveKlineVolume").GoType(decimal.Decimal{}).Nillable().Optional(),
@@ -40,9 +45,23 @@ func (Strategy) Fields() []ent.Field {
 		field.String("takeProfitExit").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("globalTakeProfitRatio").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.Bool("dynamicStopLoss").Optional(),
+		field.Bool("paperTrading").Optional(),
 		field.Bool("dropOn").Optional(),
 		field.Int("candlesToCheck").Optional().Default(0),
 		field.String("dropThreshold").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Bool("trailingOn").Optional(),
+		field.Int("trailingCandles").Optional().Default(0),
+		field.Int("trailingMaxShifts").Optional().Default(0),
+		field.Int("trailingShifts").Optional().Default(0),
+		field.String("buyConditions").Optional(),
+		field.Int("dcaInterval").Optional().Default(0),
+		field.String("dcaDropRatio").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Int("dcaMaxOrders").Optional().Default(0),
+		field.Int("dcaSellInterval").Optional().Default(0),
+		field.String("dcaSellProfitRatio").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Time("dcaLastBuyTime").Nillable().Optional(),
+		field.String("dcaLastBuyPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Time("dcaLastSellTime").Nillable().Optional(),
 		field.Bool("enableAutoBuy"),
 		field.Bool("enableAutoSell"),
 		field.Bool("enableAutoExit"),
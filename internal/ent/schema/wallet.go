@@ -25,6 +25,8 @@ func (Wallet) Fields() []ent.Field {
 		field.String("account").MaxLen(50),
 		field.String("password").MaxLen(100),
 		field.String("privateKey").MaxLen(200),
+		field.String("name").MaxLen(64).Optional(),
+		field.Bool("isDefault").Optional(),
 	}
 }
 
@@ -36,7 +38,7 @@ func (Wallet) Edges() []ent.Edge {
 // Indexes of the Event.
 func (Wallet) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("userId").Unique(),
+		index.Fields("userId"),
 		index.Fields("account").Unique(),
 	}
 }
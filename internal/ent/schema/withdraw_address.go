@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// WithdrawAddress holds the schema definition for the WithdrawAddress entity.
+type WithdrawAddress struct {
+	ent.Schema
+}
+
+func (WithdrawAddress) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the WithdrawAddress.
+func (WithdrawAddress) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("userId"),
+		field.String("address").MaxLen(50),
+		field.String("name").MaxLen(64).Optional(),
+	}
+}
+
+// Edges of the WithdrawAddress.
+func (WithdrawAddress) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Event.
+func (WithdrawAddress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "address").Unique(),
+	}
+}
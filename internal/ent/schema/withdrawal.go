@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// Withdrawal holds the schema definition for the Withdrawal entity.
+type Withdrawal struct {
+	ent.Schema
+}
+
+func (Withdrawal) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Withdrawal.
+func (Withdrawal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("userId"),
+		field.String("account").MaxLen(50),
+		field.String("token").MaxLen(50),
+		field.String("symbol").MaxLen(32),
+		field.String("destination").MaxLen(50),
+		field.String("amount").GoType(decimal.Decimal{}),
+		field.Enum("status").Values("pending", "closed", "rejected"),
+		field.String("txHash").MaxLen(100),
+		field.String("reason").MaxLen(500).Optional(),
+		field.Int64("baseFee").Optional(),
+		field.Int64("priorityFee").Optional(),
+		field.Int64("rentFee").Optional(),
+	}
+}
+
+// Edges of the Withdrawal.
+func (Withdrawal) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Event.
+func (Withdrawal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId"),
+		index.Fields("txHash"),
+	}
+}
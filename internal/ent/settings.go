@@ -37,7 +37,19 @@ type Settings struct {
 	PriorityLevel settings.PriorityLevel `json:"priorityLevel,omitempty"`
 	// DexAggregator holds the value of the "dexAggregator" field.
 	DexAggregator settings.DexAggregator `json:"dexAggregator,omitempty"`
-	selectValues  sql.SelectValues
+	// AggregatorPriority holds the value of the "aggregatorPriority" field.
+	AggregatorPriority string `json:"aggregatorPriority,omitempty"`
+	// TxSender holds the value of the "txSender" field.
+	TxSender settings.TxSender `json:"txSender,omitempty"`
+	// JitoTip holds the value of the "jitoTip" field.
+	JitoTip *int64 `json:"jitoTip,omitempty"`
+	// ExitJitoTip holds the value of the "exitJitoTip" field.
+	ExitJitoTip *int64 `json:"exitJitoTip,omitempty"`
+	// SimulateTx holds the value of the "simulateTx" field.
+	SimulateTx bool `json:"simulateTx,omitempty"`
+	// SkipExitSimulation holds the value of the "skipExitSimulation" field.
+	SkipExitSimulation bool `json:"skipExitSimulation,omitempty"`
+	selectValues       sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -45,9 +57,11 @@ func (*Settings) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case settings.FieldID, settings.FieldUserId, settings.FieldMaxRetries, settings.FieldSlippageBps, settings.FieldSellSlippageBps, settings.FieldExitSlippageBps, settings.FieldMaxLamports:
+		case settings.FieldSimulateTx, settings.FieldSkipExitSimulation:
+			values[i] = new(sql.NullBool)
+		case settings.FieldID, settings.FieldUserId, settings.FieldMaxRetries, settings.FieldSlippageBps, settings.FieldSellSlippageBps, settings.FieldExitSlippageBps, settings.FieldMaxLamports, settings.FieldJitoTip, settings.FieldExitJitoTip:
 			values[i] = new(sql.NullInt64)
-		case settings.FieldPriorityLevel, settings.FieldDexAggregator:
+		case settings.FieldPriorityLevel, settings.FieldDexAggregator, settings.FieldAggregatorPriority, settings.FieldTxSender:
 			values[i] = new(sql.NullString)
 		case settings.FieldCreateTime, settings.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -134,6 +148,44 @@ func (s *Settings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.DexAggregator = settings.DexAggregator(value.String)
 			}
+		case settings.FieldAggregatorPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aggregatorPriority", values[i])
+			} else if value.Valid {
+				s.AggregatorPriority = value.String
+			}
+		case settings.FieldTxSender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field txSender", values[i])
+			} else if value.Valid {
+				s.TxSender = settings.TxSender(value.String)
+			}
+		case settings.FieldJitoTip:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field jitoTip", values[i])
+			} else if value.Valid {
+				s.JitoTip = new(int64)
+				*s.JitoTip = value.Int64
+			}
+		case settings.FieldExitJitoTip:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exitJitoTip", values[i])
+			} else if value.Valid {
+				s.ExitJitoTip = new(int64)
+				*s.ExitJitoTip = value.Int64
+			}
+		case settings.FieldSimulateTx:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field simulateTx", values[i])
+			} else if value.Valid {
+				s.SimulateTx = value.Bool
+			}
+		case settings.FieldSkipExitSimulation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field skipExitSimulation", values[i])
+			} else if value.Valid {
+				s.SkipExitSimulation = value.Bool
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +255,28 @@ func (s *Settings) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("dexAggregator=")
 	builder.WriteString(fmt.Sprintf("%v", s.DexAggregator))
+	builder.WriteString(", ")
+	builder.WriteString("aggregatorPriority=")
+	builder.WriteString(s.AggregatorPriority)
+	builder.WriteString(", ")
+	builder.WriteString("txSender=")
+	builder.WriteString(fmt.Sprintf("%v", s.TxSender))
+	builder.WriteString(", ")
+	if v := s.JitoTip; v != nil {
+		builder.WriteString("jitoTip=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.ExitJitoTip; v != nil {
+		builder.WriteString("exitJitoTip=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("simulateTx=")
+	builder.WriteString(fmt.Sprintf("%v", s.SimulateTx))
+	builder.WriteString(", ")
+	builder.WriteString("skipExitSimulation=")
+	builder.WriteString(fmt.Sprintf("%v", s.SkipExitSimulation))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -34,6 +34,18 @@ const (
 	FieldPriorityLevel = "priority_level"
 	// FieldDexAggregator holds the string denoting the dexaggregator field in the database.
 	FieldDexAggregator = "dex_aggregator"
+	// FieldAggregatorPriority holds the string denoting the aggregatorpriority field in the database.
+	FieldAggregatorPriority = "aggregator_priority"
+	// FieldTxSender holds the string denoting the txsender field in the database.
+	FieldTxSender = "tx_sender"
+	// FieldJitoTip holds the string denoting the jitotip field in the database.
+	FieldJitoTip = "jito_tip"
+	// FieldExitJitoTip holds the string denoting the exitjitotip field in the database.
+	FieldExitJitoTip = "exit_jito_tip"
+	// FieldSimulateTx holds the string denoting the simulatetx field in the database.
+	FieldSimulateTx = "simulate_tx"
+	// FieldSkipExitSimulation holds the string denoting the skipexitsimulation field in the database.
+	FieldSkipExitSimulation = "skip_exit_simulation"
 	// Table holds the table name of the settings in the database.
 	Table = "settings"
 )
@@ -51,6 +63,12 @@ var Columns = []string{
 	FieldMaxLamports,
 	FieldPriorityLevel,
 	FieldDexAggregator,
+	FieldAggregatorPriority,
+	FieldTxSender,
+	FieldJitoTip,
+	FieldExitJitoTip,
+	FieldSimulateTx,
+	FieldSkipExitSimulation,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +98,16 @@ var (
 	ExitSlippageBpsValidator func(int) error
 	// MaxLamportsValidator is a validator for the "maxLamports" field. It is called by the builders before save.
 	MaxLamportsValidator func(int64) error
+	// AggregatorPriorityValidator is a validator for the "aggregatorPriority" field. It is called by the builders before save.
+	AggregatorPriorityValidator func(string) error
+	// JitoTipValidator is a validator for the "jitoTip" field. It is called by the builders before save.
+	JitoTipValidator func(int64) error
+	// ExitJitoTipValidator is a validator for the "exitJitoTip" field. It is called by the builders before save.
+	ExitJitoTipValidator func(int64) error
+	// DefaultSimulateTx holds the default value on creation for the "simulateTx" field.
+	DefaultSimulateTx bool
+	// DefaultSkipExitSimulation holds the default value on creation for the "skipExitSimulation" field.
+	DefaultSkipExitSimulation bool
 )
 
 // PriorityLevel defines the type for the "priorityLevel" enum field.
@@ -114,6 +142,7 @@ const (
 	DexAggregatorJup   DexAggregator = "jup"
 	DexAggregatorOkx   DexAggregator = "okx"
 	DexAggregatorRelay DexAggregator = "relay"
+	DexAggregatorAuto  DexAggregator = "auto"
 )
 
 func (da DexAggregator) String() string {
@@ -123,13 +152,39 @@ func (da DexAggregator) String() string {
 // DexAggregatorValidator is a validator for the "dexAggregator" field enum values. It is called by the builders before save.
 func DexAggregatorValidator(da DexAggregator) error {
 	switch da {
-	case DexAggregatorJup, DexAggregatorOkx, DexAggregatorRelay:
+	case DexAggregatorJup, DexAggregatorOkx, DexAggregatorRelay, DexAggregatorAuto:
 		return nil
 	default:
 		return fmt.Errorf("settings: invalid enum value for dexAggregator field: %q", da)
 	}
 }
 
+// TxSender defines the type for the "txSender" enum field.
+type TxSender string
+
+// TxSenderRPC is the default value of the TxSender enum.
+const DefaultTxSender = TxSenderRPC
+
+// TxSender values.
+const (
+	TxSenderRPC  TxSender = "rpc"
+	TxSenderJito TxSender = "jito"
+)
+
+func (ts TxSender) String() string {
+	return string(ts)
+}
+
+// TxSenderValidator is a validator for the "txSender" field enum values. It is called by the builders before save.
+func TxSenderValidator(ts TxSender) error {
+	switch ts {
+	case TxSenderRPC, TxSenderJito:
+		return nil
+	default:
+		return fmt.Errorf("settings: invalid enum value for txSender field: %q", ts)
+	}
+}
+
 // OrderOption defines the ordering options for the Settings queries.
 type OrderOption func(*sql.Selector)
 
@@ -187,3 +242,33 @@ func ByPriorityLevel(opts ...sql.OrderTermOption) OrderOption {
 func ByDexAggregator(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDexAggregator, opts...).ToFunc()
 }
+
+// ByAggregatorPriority orders the results by the aggregatorPriority field.
+func ByAggregatorPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAggregatorPriority, opts...).ToFunc()
+}
+
+// ByTxSender orders the results by the txSender field.
+func ByTxSender(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxSender, opts...).ToFunc()
+}
+
+// ByJitoTip orders the results by the jitoTip field.
+func ByJitoTip(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitoTip, opts...).ToFunc()
+}
+
+// ByExitJitoTip orders the results by the exitJitoTip field.
+func ByExitJitoTip(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitJitoTip, opts...).ToFunc()
+}
+
+// BySimulateTx orders the results by the simulateTx field.
+func BySimulateTx(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSimulateTx, opts...).ToFunc()
+}
+
+// BySkipExitSimulation orders the results by the skipExitSimulation field.
+func BySkipExitSimulation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipExitSimulation, opts...).ToFunc()
+}
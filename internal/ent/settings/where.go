@@ -94,6 +94,31 @@ func MaxLamports(v int64) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldMaxLamports, v))
 }
 
+// AggregatorPriority applies equality check predicate on the "aggregatorPriority" field. It's identical to AggregatorPriorityEQ.
+func AggregatorPriority(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldAggregatorPriority, v))
+}
+
+// JitoTip applies equality check predicate on the "jitoTip" field. It's identical to JitoTipEQ.
+func JitoTip(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldJitoTip, v))
+}
+
+// ExitJitoTip applies equality check predicate on the "exitJitoTip" field. It's identical to ExitJitoTipEQ.
+func ExitJitoTip(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldExitJitoTip, v))
+}
+
+// SimulateTx applies equality check predicate on the "simulateTx" field. It's identical to SimulateTxEQ.
+func SimulateTx(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldSimulateTx, v))
+}
+
+// SkipExitSimulation applies equality check predicate on the "skipExitSimulation" field. It's identical to SkipExitSimulationEQ.
+func SkipExitSimulation(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldSkipExitSimulation, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldCreateTime, v))
@@ -474,6 +499,221 @@ func DexAggregatorNotIn(vs ...DexAggregator) predicate.Settings {
 	return predicate.Settings(sql.FieldNotIn(FieldDexAggregator, vs...))
 }
 
+// AggregatorPriorityEQ applies the EQ predicate on the "aggregatorPriority" field.
+func AggregatorPriorityEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityNEQ applies the NEQ predicate on the "aggregatorPriority" field.
+func AggregatorPriorityNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityIn applies the In predicate on the "aggregatorPriority" field.
+func AggregatorPriorityIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldAggregatorPriority, vs...))
+}
+
+// AggregatorPriorityNotIn applies the NotIn predicate on the "aggregatorPriority" field.
+func AggregatorPriorityNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldAggregatorPriority, vs...))
+}
+
+// AggregatorPriorityGT applies the GT predicate on the "aggregatorPriority" field.
+func AggregatorPriorityGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityGTE applies the GTE predicate on the "aggregatorPriority" field.
+func AggregatorPriorityGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityLT applies the LT predicate on the "aggregatorPriority" field.
+func AggregatorPriorityLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityLTE applies the LTE predicate on the "aggregatorPriority" field.
+func AggregatorPriorityLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityContains applies the Contains predicate on the "aggregatorPriority" field.
+func AggregatorPriorityContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityHasPrefix applies the HasPrefix predicate on the "aggregatorPriority" field.
+func AggregatorPriorityHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityHasSuffix applies the HasSuffix predicate on the "aggregatorPriority" field.
+func AggregatorPriorityHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityIsNil applies the IsNil predicate on the "aggregatorPriority" field.
+func AggregatorPriorityIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldAggregatorPriority))
+}
+
+// AggregatorPriorityNotNil applies the NotNil predicate on the "aggregatorPriority" field.
+func AggregatorPriorityNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldAggregatorPriority))
+}
+
+// AggregatorPriorityEqualFold applies the EqualFold predicate on the "aggregatorPriority" field.
+func AggregatorPriorityEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldAggregatorPriority, v))
+}
+
+// AggregatorPriorityContainsFold applies the ContainsFold predicate on the "aggregatorPriority" field.
+func AggregatorPriorityContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldAggregatorPriority, v))
+}
+
+// TxSenderEQ applies the EQ predicate on the "txSender" field.
+func TxSenderEQ(v TxSender) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldTxSender, v))
+}
+
+// TxSenderNEQ applies the NEQ predicate on the "txSender" field.
+func TxSenderNEQ(v TxSender) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldTxSender, v))
+}
+
+// TxSenderIn applies the In predicate on the "txSender" field.
+func TxSenderIn(vs ...TxSender) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldTxSender, vs...))
+}
+
+// TxSenderNotIn applies the NotIn predicate on the "txSender" field.
+func TxSenderNotIn(vs ...TxSender) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldTxSender, vs...))
+}
+
+// JitoTipEQ applies the EQ predicate on the "jitoTip" field.
+func JitoTipEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldJitoTip, v))
+}
+
+// JitoTipNEQ applies the NEQ predicate on the "jitoTip" field.
+func JitoTipNEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldJitoTip, v))
+}
+
+// JitoTipIn applies the In predicate on the "jitoTip" field.
+func JitoTipIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldJitoTip, vs...))
+}
+
+// JitoTipNotIn applies the NotIn predicate on the "jitoTip" field.
+func JitoTipNotIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldJitoTip, vs...))
+}
+
+// JitoTipGT applies the GT predicate on the "jitoTip" field.
+func JitoTipGT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldJitoTip, v))
+}
+
+// JitoTipGTE applies the GTE predicate on the "jitoTip" field.
+func JitoTipGTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldJitoTip, v))
+}
+
+// JitoTipLT applies the LT predicate on the "jitoTip" field.
+func JitoTipLT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldJitoTip, v))
+}
+
+// JitoTipLTE applies the LTE predicate on the "jitoTip" field.
+func JitoTipLTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldJitoTip, v))
+}
+
+// JitoTipIsNil applies the IsNil predicate on the "jitoTip" field.
+func JitoTipIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldJitoTip))
+}
+
+// JitoTipNotNil applies the NotNil predicate on the "jitoTip" field.
+func JitoTipNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldJitoTip))
+}
+
+// ExitJitoTipEQ applies the EQ predicate on the "exitJitoTip" field.
+func ExitJitoTipEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldExitJitoTip, v))
+}
+
+// ExitJitoTipNEQ applies the NEQ predicate on the "exitJitoTip" field.
+func ExitJitoTipNEQ(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldExitJitoTip, v))
+}
+
+// ExitJitoTipIn applies the In predicate on the "exitJitoTip" field.
+func ExitJitoTipIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldExitJitoTip, vs...))
+}
+
+// ExitJitoTipNotIn applies the NotIn predicate on the "exitJitoTip" field.
+func ExitJitoTipNotIn(vs ...int64) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldExitJitoTip, vs...))
+}
+
+// ExitJitoTipGT applies the GT predicate on the "exitJitoTip" field.
+func ExitJitoTipGT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldExitJitoTip, v))
+}
+
+// ExitJitoTipGTE applies the GTE predicate on the "exitJitoTip" field.
+func ExitJitoTipGTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldExitJitoTip, v))
+}
+
+// ExitJitoTipLT applies the LT predicate on the "exitJitoTip" field.
+func ExitJitoTipLT(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldExitJitoTip, v))
+}
+
+// ExitJitoTipLTE applies the LTE predicate on the "exitJitoTip" field.
+func ExitJitoTipLTE(v int64) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldExitJitoTip, v))
+}
+
+// ExitJitoTipIsNil applies the IsNil predicate on the "exitJitoTip" field.
+func ExitJitoTipIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldExitJitoTip))
+}
+
+// ExitJitoTipNotNil applies the NotNil predicate on the "exitJitoTip" field.
+func ExitJitoTipNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldExitJitoTip))
+}
+
+// SimulateTxEQ applies the EQ predicate on the "simulateTx" field.
+func SimulateTxEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldSimulateTx, v))
+}
+
+// SimulateTxNEQ applies the NEQ predicate on the "simulateTx" field.
+func SimulateTxNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldSimulateTx, v))
+}
+
+// SkipExitSimulationEQ applies the EQ predicate on the "skipExitSimulation" field.
+func SkipExitSimulationEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldSkipExitSimulation, v))
+}
+
+// SkipExitSimulationNEQ applies the NEQ predicate on the "skipExitSimulation" field.
+func SkipExitSimulationNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldSkipExitSimulation, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Settings) predicate.Settings {
 	return predicate.Settings(sql.AndPredicates(predicates...))
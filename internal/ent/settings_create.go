@@ -112,6 +112,90 @@ func (sc *SettingsCreate) SetDexAggregator(sa settings.DexAggregator) *SettingsC
 	return sc
 }
 
+// SetAggregatorPriority sets the "aggregatorPriority" field.
+func (sc *SettingsCreate) SetAggregatorPriority(s string) *SettingsCreate {
+	sc.mutation.SetAggregatorPriority(s)
+	return sc
+}
+
+// SetNillableAggregatorPriority sets the "aggregatorPriority" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableAggregatorPriority(s *string) *SettingsCreate {
+	if s != nil {
+		sc.SetAggregatorPriority(*s)
+	}
+	return sc
+}
+
+// SetTxSender sets the "txSender" field.
+func (sc *SettingsCreate) SetTxSender(ss settings.TxSender) *SettingsCreate {
+	sc.mutation.SetTxSender(ss)
+	return sc
+}
+
+// SetNillableTxSender sets the "txSender" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableTxSender(ss *settings.TxSender) *SettingsCreate {
+	if ss != nil {
+		sc.SetTxSender(*ss)
+	}
+	return sc
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (sc *SettingsCreate) SetJitoTip(i int64) *SettingsCreate {
+	sc.mutation.SetJitoTip(i)
+	return sc
+}
+
+// SetNillableJitoTip sets the "jitoTip" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableJitoTip(i *int64) *SettingsCreate {
+	if i != nil {
+		sc.SetJitoTip(*i)
+	}
+	return sc
+}
+
+// SetExitJitoTip sets the "exitJitoTip" field.
+func (sc *SettingsCreate) SetExitJitoTip(i int64) *SettingsCreate {
+	sc.mutation.SetExitJitoTip(i)
+	return sc
+}
+
+// SetNillableExitJitoTip sets the "exitJitoTip" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableExitJitoTip(i *int64) *SettingsCreate {
+	if i != nil {
+		sc.SetExitJitoTip(*i)
+	}
+	return sc
+}
+
+// SetSimulateTx sets the "simulateTx" field.
+func (sc *SettingsCreate) SetSimulateTx(b bool) *SettingsCreate {
+	sc.mutation.SetSimulateTx(b)
+	return sc
+}
+
+// SetNillableSimulateTx sets the "simulateTx" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableSimulateTx(b *bool) *SettingsCreate {
+	if b != nil {
+		sc.SetSimulateTx(*b)
+	}
+	return sc
+}
+
+// SetSkipExitSimulation sets the "skipExitSimulation" field.
+func (sc *SettingsCreate) SetSkipExitSimulation(b bool) *SettingsCreate {
+	sc.mutation.SetSkipExitSimulation(b)
+	return sc
+}
+
+// SetNillableSkipExitSimulation sets the "skipExitSimulation" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableSkipExitSimulation(b *bool) *SettingsCreate {
+	if b != nil {
+		sc.SetSkipExitSimulation(*b)
+	}
+	return sc
+}
+
 // Mutation returns the SettingsMutation object of the builder.
 func (sc *SettingsCreate) Mutation() *SettingsMutation {
 	return sc.mutation
@@ -155,6 +239,18 @@ func (sc *SettingsCreate) defaults() {
 		v := settings.DefaultUpdateTime()
 		sc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := sc.mutation.TxSender(); !ok {
+		v := settings.DefaultTxSender
+		sc.mutation.SetTxSender(v)
+	}
+	if _, ok := sc.mutation.SimulateTx(); !ok {
+		v := settings.DefaultSimulateTx
+		sc.mutation.SetSimulateTx(v)
+	}
+	if _, ok := sc.mutation.SkipExitSimulation(); !ok {
+		v := settings.DefaultSkipExitSimulation
+		sc.mutation.SetSkipExitSimulation(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -218,6 +314,35 @@ func (sc *SettingsCreate) check() error {
 			return &ValidationError{Name: "dexAggregator", err: fmt.Errorf(`ent: validator failed for field "Settings.dexAggregator": %w`, err)}
 		}
 	}
+	if v, ok := sc.mutation.AggregatorPriority(); ok {
+		if err := settings.AggregatorPriorityValidator(v); err != nil {
+			return &ValidationError{Name: "aggregatorPriority", err: fmt.Errorf(`ent: validator failed for field "Settings.aggregatorPriority": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.TxSender(); !ok {
+		return &ValidationError{Name: "txSender", err: errors.New(`ent: missing required field "Settings.txSender"`)}
+	}
+	if v, ok := sc.mutation.TxSender(); ok {
+		if err := settings.TxSenderValidator(v); err != nil {
+			return &ValidationError{Name: "txSender", err: fmt.Errorf(`ent: validator failed for field "Settings.txSender": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.JitoTip(); ok {
+		if err := settings.JitoTipValidator(v); err != nil {
+			return &ValidationError{Name: "jitoTip", err: fmt.Errorf(`ent: validator failed for field "Settings.jitoTip": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.ExitJitoTip(); ok {
+		if err := settings.ExitJitoTipValidator(v); err != nil {
+			return &ValidationError{Name: "exitJitoTip", err: fmt.Errorf(`ent: validator failed for field "Settings.exitJitoTip": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SimulateTx(); !ok {
+		return &ValidationError{Name: "simulateTx", err: errors.New(`ent: missing required field "Settings.simulateTx"`)}
+	}
+	if _, ok := sc.mutation.SkipExitSimulation(); !ok {
+		return &ValidationError{Name: "skipExitSimulation", err: errors.New(`ent: missing required field "Settings.skipExitSimulation"`)}
+	}
 	return nil
 }
 
@@ -284,6 +409,30 @@ func (sc *SettingsCreate) createSpec() (*Settings, *sqlgraph.CreateSpec) {
 		_spec.SetField(settings.FieldDexAggregator, field.TypeEnum, value)
 		_node.DexAggregator = value
 	}
+	if value, ok := sc.mutation.AggregatorPriority(); ok {
+		_spec.SetField(settings.FieldAggregatorPriority, field.TypeString, value)
+		_node.AggregatorPriority = value
+	}
+	if value, ok := sc.mutation.TxSender(); ok {
+		_spec.SetField(settings.FieldTxSender, field.TypeEnum, value)
+		_node.TxSender = value
+	}
+	if value, ok := sc.mutation.JitoTip(); ok {
+		_spec.SetField(settings.FieldJitoTip, field.TypeInt64, value)
+		_node.JitoTip = &value
+	}
+	if value, ok := sc.mutation.ExitJitoTip(); ok {
+		_spec.SetField(settings.FieldExitJitoTip, field.TypeInt64, value)
+		_node.ExitJitoTip = &value
+	}
+	if value, ok := sc.mutation.SimulateTx(); ok {
+		_spec.SetField(settings.FieldSimulateTx, field.TypeBool, value)
+		_node.SimulateTx = value
+	}
+	if value, ok := sc.mutation.SkipExitSimulation(); ok {
+		_spec.SetField(settings.FieldSkipExitSimulation, field.TypeBool, value)
+		_node.SkipExitSimulation = value
+	}
 	return _node, _spec
 }
 
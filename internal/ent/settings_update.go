@@ -200,6 +200,122 @@ func (su *SettingsUpdate) SetNillableDexAggregator(sa *settings.DexAggregator) *
 	return su
 }
 
+// SetAggregatorPriority sets the "aggregatorPriority" field.
+func (su *SettingsUpdate) SetAggregatorPriority(s string) *SettingsUpdate {
+	su.mutation.SetAggregatorPriority(s)
+	return su
+}
+
+// SetNillableAggregatorPriority sets the "aggregatorPriority" field if the given value is not nil.
+func (su *SettingsUpdate) SetNillableAggregatorPriority(s *string) *SettingsUpdate {
+	if s != nil {
+		su.SetAggregatorPriority(*s)
+	}
+	return su
+}
+
+// ClearAggregatorPriority clears the value of the "aggregatorPriority" field.
+func (su *SettingsUpdate) ClearAggregatorPriority() *SettingsUpdate {
+	su.mutation.ClearAggregatorPriority()
+	return su
+}
+
+// SetTxSender sets the "txSender" field.
+func (su *SettingsUpdate) SetTxSender(ss settings.TxSender) *SettingsUpdate {
+	su.mutation.SetTxSender(ss)
+	return su
+}
+
+// SetNillableTxSender sets the "txSender" field if the given value is not nil.
+func (su *SettingsUpdate) SetNillableTxSender(ss *settings.TxSender) *SettingsUpdate {
+	if ss != nil {
+		su.SetTxSender(*ss)
+	}
+	return su
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (su *SettingsUpdate) SetJitoTip(i int64) *SettingsUpdate {
+	su.mutation.ResetJitoTip()
+	su.mutation.SetJitoTip(i)
+	return su
+}
+
+// SetNillableJitoTip sets the "jitoTip" field if the given value is not nil.
+func (su *SettingsUpdate) SetNillableJitoTip(i *int64) *SettingsUpdate {
+	if i != nil {
+		su.SetJitoTip(*i)
+	}
+	return su
+}
+
+// AddJitoTip adds i to the "jitoTip" field.
+func (su *SettingsUpdate) AddJitoTip(i int64) *SettingsUpdate {
+	su.mutation.AddJitoTip(i)
+	return su
+}
+
+// ClearJitoTip clears the value of the "jitoTip" field.
+func (su *SettingsUpdate) ClearJitoTip() *SettingsUpdate {
+	su.mutation.ClearJitoTip()
+	return su
+}
+
+// SetExitJitoTip sets the "exitJitoTip" field.
+func (su *SettingsUpdate) SetExitJitoTip(i int64) *SettingsUpdate {
+	su.mutation.ResetExitJitoTip()
+	su.mutation.SetExitJitoTip(i)
+	return su
+}
+
+// SetNillableExitJitoTip sets the "exitJitoTip" field if the given value is not nil.
+func (su *SettingsUpdate) SetNillableExitJitoTip(i *int64) *SettingsUpdate {
+	if i != nil {
+		su.SetExitJitoTip(*i)
+	}
+	return su
+}
+
+// AddExitJitoTip adds i to the "exitJitoTip" field.
+func (su *SettingsUpdate) AddExitJitoTip(i int64) *SettingsUpdate {
+	su.mutation.AddExitJitoTip(i)
+	return su
+}
+
+// ClearExitJitoTip clears the value of the "exitJitoTip" field.
+func (su *SettingsUpdate) ClearExitJitoTip() *SettingsUpdate {
+	su.mutation.ClearExitJitoTip()
+	return su
+}
+
+// SetSimulateTx sets the "simulateTx" field.
+func (su *SettingsUpdate) SetSimulateTx(b bool) *SettingsUpdate {
+	su.mutation.SetSimulateTx(b)
+	return su
+}
+
+// SetNillableSimulateTx sets the "simulateTx" field if the given value is not nil.
+func (su *SettingsUpdate) SetNillableSimulateTx(b *bool) *SettingsUpdate {
+	if b != nil {
+		su.SetSimulateTx(*b)
+	}
+	return su
+}
+
+// SetSkipExitSimulation sets the "skipExitSimulation" field.
+func (su *SettingsUpdate) SetSkipExitSimulation(b bool) *SettingsUpdate {
+	su.mutation.SetSkipExitSimulation(b)
+	return su
+}
+
+// SetNillableSkipExitSimulation sets the "skipExitSimulation" field if the given value is not nil.
+func (su *SettingsUpdate) SetNillableSkipExitSimulation(b *bool) *SettingsUpdate {
+	if b != nil {
+		su.SetSkipExitSimulation(*b)
+	}
+	return su
+}
+
 // Mutation returns the SettingsMutation object of the builder.
 func (su *SettingsUpdate) Mutation() *SettingsMutation {
 	return su.mutation
@@ -278,6 +394,26 @@ func (su *SettingsUpdate) check() error {
 			return &ValidationError{Name: "dexAggregator", err: fmt.Errorf(`ent: validator failed for field "Settings.dexAggregator": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.AggregatorPriority(); ok {
+		if err := settings.AggregatorPriorityValidator(v); err != nil {
+			return &ValidationError{Name: "aggregatorPriority", err: fmt.Errorf(`ent: validator failed for field "Settings.aggregatorPriority": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.TxSender(); ok {
+		if err := settings.TxSenderValidator(v); err != nil {
+			return &ValidationError{Name: "txSender", err: fmt.Errorf(`ent: validator failed for field "Settings.txSender": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.JitoTip(); ok {
+		if err := settings.JitoTipValidator(v); err != nil {
+			return &ValidationError{Name: "jitoTip", err: fmt.Errorf(`ent: validator failed for field "Settings.jitoTip": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.ExitJitoTip(); ok {
+		if err := settings.ExitJitoTipValidator(v); err != nil {
+			return &ValidationError{Name: "exitJitoTip", err: fmt.Errorf(`ent: validator failed for field "Settings.exitJitoTip": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -344,6 +480,39 @@ func (su *SettingsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.DexAggregator(); ok {
 		_spec.SetField(settings.FieldDexAggregator, field.TypeEnum, value)
 	}
+	if value, ok := su.mutation.AggregatorPriority(); ok {
+		_spec.SetField(settings.FieldAggregatorPriority, field.TypeString, value)
+	}
+	if su.mutation.AggregatorPriorityCleared() {
+		_spec.ClearField(settings.FieldAggregatorPriority, field.TypeString)
+	}
+	if value, ok := su.mutation.TxSender(); ok {
+		_spec.SetField(settings.FieldTxSender, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.JitoTip(); ok {
+		_spec.SetField(settings.FieldJitoTip, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedJitoTip(); ok {
+		_spec.AddField(settings.FieldJitoTip, field.TypeInt64, value)
+	}
+	if su.mutation.JitoTipCleared() {
+		_spec.ClearField(settings.FieldJitoTip, field.TypeInt64)
+	}
+	if value, ok := su.mutation.ExitJitoTip(); ok {
+		_spec.SetField(settings.FieldExitJitoTip, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedExitJitoTip(); ok {
+		_spec.AddField(settings.FieldExitJitoTip, field.TypeInt64, value)
+	}
+	if su.mutation.ExitJitoTipCleared() {
+		_spec.ClearField(settings.FieldExitJitoTip, field.TypeInt64)
+	}
+	if value, ok := su.mutation.SimulateTx(); ok {
+		_spec.SetField(settings.FieldSimulateTx, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.SkipExitSimulation(); ok {
+		_spec.SetField(settings.FieldSkipExitSimulation, field.TypeBool, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{settings.Label}
@@ -536,6 +705,122 @@ func (suo *SettingsUpdateOne) SetNillableDexAggregator(sa *settings.DexAggregato
 	return suo
 }
 
+// SetAggregatorPriority sets the "aggregatorPriority" field.
+func (suo *SettingsUpdateOne) SetAggregatorPriority(s string) *SettingsUpdateOne {
+	suo.mutation.SetAggregatorPriority(s)
+	return suo
+}
+
+// SetNillableAggregatorPriority sets the "aggregatorPriority" field if the given value is not nil.
+func (suo *SettingsUpdateOne) SetNillableAggregatorPriority(s *string) *SettingsUpdateOne {
+	if s != nil {
+		suo.SetAggregatorPriority(*s)
+	}
+	return suo
+}
+
+// ClearAggregatorPriority clears the value of the "aggregatorPriority" field.
+func (suo *SettingsUpdateOne) ClearAggregatorPriority() *SettingsUpdateOne {
+	suo.mutation.ClearAggregatorPriority()
+	return suo
+}
+
+// SetTxSender sets the "txSender" field.
+func (suo *SettingsUpdateOne) SetTxSender(ss settings.TxSender) *SettingsUpdateOne {
+	suo.mutation.SetTxSender(ss)
+	return suo
+}
+
+// SetNillableTxSender sets the "txSender" field if the given value is not nil.
+func (suo *SettingsUpdateOne) SetNillableTxSender(ss *settings.TxSender) *SettingsUpdateOne {
+	if ss != nil {
+		suo.SetTxSender(*ss)
+	}
+	return suo
+}
+
+// SetJitoTip sets the "jitoTip" field.
+func (suo *SettingsUpdateOne) SetJitoTip(i int64) *SettingsUpdateOne {
+	suo.mutation.ResetJitoTip()
+	suo.mutation.SetJitoTip(i)
+	return suo
+}
+
+// SetNillableJitoTip sets the "jitoTip" field if the given value is not nil.
+func (suo *SettingsUpdateOne) SetNillableJitoTip(i *int64) *SettingsUpdateOne {
+	if i != nil {
+		suo.SetJitoTip(*i)
+	}
+	return suo
+}
+
+// AddJitoTip adds i to the "jitoTip" field.
+func (suo *SettingsUpdateOne) AddJitoTip(i int64) *SettingsUpdateOne {
+	suo.mutation.AddJitoTip(i)
+	return suo
+}
+
+// ClearJitoTip clears the value of the "jitoTip" field.
+func (suo *SettingsUpdateOne) ClearJitoTip() *SettingsUpdateOne {
+	suo.mutation.ClearJitoTip()
+	return suo
+}
+
+// SetExitJitoTip sets the "exitJitoTip" field.
+func (suo *SettingsUpdateOne) SetExitJitoTip(i int64) *SettingsUpdateOne {
+	suo.mutation.ResetExitJitoTip()
+	suo.mutation.SetExitJitoTip(i)
+	return suo
+}
+
+// SetNillableExitJitoTip sets the "exitJitoTip" field if the given value is not nil.
+func (suo *SettingsUpdateOne) SetNillableExitJitoTip(i *int64) *SettingsUpdateOne {
+	if i != nil {
+		suo.SetExitJitoTip(*i)
+	}
+	return suo
+}
+
+// AddExitJitoTip adds i to the "exitJitoTip" field.
+func (suo *SettingsUpdateOne) AddExitJitoTip(i int64) *SettingsUpdateOne {
+	suo.mutation.AddExitJitoTip(i)
+	return suo
+}
+
+// ClearExitJitoTip clears the value of the "exitJitoTip" field.
+func (suo *SettingsUpdateOne) ClearExitJitoTip() *SettingsUpdateOne {
+	suo.mutation.ClearExitJitoTip()
+	return suo
+}
+
+// SetSimulateTx sets the "simulateTx" field.
+func (suo *SettingsUpdateOne) SetSimulateTx(b bool) *SettingsUpdateOne {
+	suo.mutation.SetSimulateTx(b)
+	return suo
+}
+
+// SetNillableSimulateTx sets the "simulateTx" field if the given value is not nil.
+func (suo *SettingsUpdateOne) SetNillableSimulateTx(b *bool) *SettingsUpdateOne {
+	if b != nil {
+		suo.SetSimulateTx(*b)
+	}
+	return suo
+}
+
+// SetSkipExitSimulation sets the "skipExitSimulation" field.
+func (suo *SettingsUpdateOne) SetSkipExitSimulation(b bool) *SettingsUpdateOne {
+	suo.mutation.SetSkipExitSimulation(b)
+	return suo
+}
+
+// SetNillableSkipExitSimulation sets the "skipExitSimulation" field if the given value is not nil.
+func (suo *SettingsUpdateOne) SetNillableSkipExitSimulation(b *bool) *SettingsUpdateOne {
+	if b != nil {
+		suo.SetSkipExitSimulation(*b)
+	}
+	return suo
+}
+
 // Mutation returns the SettingsMutation object of the builder.
 func (suo *SettingsUpdateOne) Mutation() *SettingsMutation {
 	return suo.mutation
@@ -627,6 +912,26 @@ func (suo *SettingsUpdateOne) check() error {
 			return &ValidationError{Name: "dexAggregator", err: fmt.Errorf(`ent: validator failed for field "Settings.dexAggregator": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.AggregatorPriority(); ok {
+		if err := settings.AggregatorPriorityValidator(v); err != nil {
+			return &ValidationError{Name: "aggregatorPriority", err: fmt.Errorf(`ent: validator failed for field "Settings.aggregatorPriority": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.TxSender(); ok {
+		if err := settings.TxSenderValidator(v); err != nil {
+			return &ValidationError{Name: "txSender", err: fmt.Errorf(`ent: validator failed for field "Settings.txSender": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.JitoTip(); ok {
+		if err := settings.JitoTipValidator(v); err != nil {
+			return &ValidationError{Name: "jitoTip", err: fmt.Errorf(`ent: validator failed for field "Settings.jitoTip": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.ExitJitoTip(); ok {
+		if err := settings.ExitJitoTipValidator(v); err != nil {
+			return &ValidationError{Name: "exitJitoTip", err: fmt.Errorf(`ent: validator failed for field "Settings.exitJitoTip": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -710,6 +1015,39 @@ func (suo *SettingsUpdateOne) sqlSave(ctx context.Context) (_node *Settings, err
 	if value, ok := suo.mutation.DexAggregator(); ok {
 		_spec.SetField(settings.FieldDexAggregator, field.TypeEnum, value)
 	}
+	if value, ok := suo.mutation.AggregatorPriority(); ok {
+		_spec.SetField(settings.FieldAggregatorPriority, field.TypeString, value)
+	}
+	if suo.mutation.AggregatorPriorityCleared() {
+		_spec.ClearField(settings.FieldAggregatorPriority, field.TypeString)
+	}
+	if value, ok := suo.mutation.TxSender(); ok {
+		_spec.SetField(settings.FieldTxSender, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.JitoTip(); ok {
+		_spec.SetField(settings.FieldJitoTip, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedJitoTip(); ok {
+		_spec.AddField(settings.FieldJitoTip, field.TypeInt64, value)
+	}
+	if suo.mutation.JitoTipCleared() {
+		_spec.ClearField(settings.FieldJitoTip, field.TypeInt64)
+	}
+	if value, ok := suo.mutation.ExitJitoTip(); ok {
+		_spec.SetField(settings.FieldExitJitoTip, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedExitJitoTip(); ok {
+		_spec.AddField(settings.FieldExitJitoTip, field.TypeInt64, value)
+	}
+	if suo.mutation.ExitJitoTipCleared() {
+		_spec.ClearField(settings.FieldExitJitoTip, field.TypeInt64)
+	}
+	if value, ok := suo.mutation.SimulateTx(); ok {
+		_spec.SetField(settings.FieldSimulateTx, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.SkipExitSimulation(); ok {
+		_spec.SetField(settings.FieldSkipExitSimulation, field.TypeBool, value)
+	}
 	_node = &Settings{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
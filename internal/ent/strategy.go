@@ -26,10 +26,14 @@ type Strategy struct {
 	GUID string `json:"guid,omitempty"`
 	// UserId holds the value of the "userId" field.
 	UserId int64 `json:"userId,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
 	// Token holds the value of the "token" field.
 	Token string `json:"token,omitempty"`
 	// Symbol holds the value of the "symbol" field.
 	Symbol string `json:"symbol,omitempty"`
+	// Type holds the value of the "type" field.
+	Type strategy.Type `json:"type,omitempty"`
 	// MartinFactor holds the value of the "martinFactor" field.
 	MartinFactor float64 `json:"martinFactor,omitempty"`
 	// MaxGridLimit holds the value of the "maxGridLimit" field.
@@ -40,6 +44,12 @@ type Strategy struct {
 	UpperPriceBound decimal.Decimal `json:"upperPriceBound,omitempty"`
 	// LowerPriceBound holds the value of the "lowerPriceBound" field.
 	LowerPriceBound decimal.Decimal `json:"lowerPriceBound,omitempty"`
+	// GridMode holds the value of the "gridMode" field.
+	GridMode strategy.GridMode `json:"gridMode,omitempty"`
+	// GridCount holds the value of the "gridCount" field.
+	GridCount int `json:"gridCount,omitempty"`
+	// GridStep holds the value of the "gridStep" field.
+	GridStep *decimal.Decimal `json:"gridStep,omitempty"`
 	// InitialOrderSize holds the value of the "initialOrderSize" field.
 	InitialOrderSize decimal.Decimal `json:"initialOrderSize,omitempty"`
 	// LastKlineVolume holds the value of the "lastKlineVolume" field.
@@ -58,12 +68,40 @@ type Strategy struct {
 	GlobalTakeProfitRatio *decimal.Decimal `json:"globalTakeProfitRatio,omitempty"`
 	// DynamicStopLoss holds the value of the "dynamicStopLoss" field.
 	DynamicStopLoss bool `json:"dynamicStopLoss,omitempty"`
+	// PaperTrading holds the value of the "paperTrading" field.
+	PaperTrading bool `json:"paperTrading,omitempty"`
 	// DropOn holds the value of the "dropOn" field.
 	DropOn bool `json:"dropOn,omitempty"`
 	// CandlesToCheck holds the value of the "candlesToCheck" field.
 	CandlesToCheck int `json:"candlesToCheck,omitempty"`
 	// DropThreshold holds the value of the "dropThreshold" field.
 	DropThreshold *decimal.Decimal `json:"dropThreshold,omitempty"`
+	// TrailingOn holds the value of the "trailingOn" field.
+	TrailingOn bool `json:"trailingOn,omitempty"`
+	// TrailingCandles holds the value of the "trailingCandles" field.
+	TrailingCandles int `json:"trailingCandles,omitempty"`
+	// TrailingMaxShifts holds the value of the "trailingMaxShifts" field.
+	TrailingMaxShifts int `json:"trailingMaxShifts,omitempty"`
+	// TrailingShifts holds the value of the "trailingShifts" field.
+	TrailingShifts int `json:"trailingShifts,omitempty"`
+	// BuyConditions holds the value of the "buyConditions" field.
+	BuyConditions string `json:"buyConditions,omitempty"`
+	// DcaInterval holds the value of the "dcaInterval" field.
+	DcaInterval int `json:"dcaInterval,omitempty"`
+	// DcaDropRatio holds the value of the "dcaDropRatio" field.
+	DcaDropRatio *decimal.Decimal `json:"dcaDropRatio,omitempty"`
+	// DcaMaxOrders holds the value of the "dcaMaxOrders" field.
+	DcaMaxOrders int `json:"dcaMaxOrders,omitempty"`
+	// DcaSellInterval holds the value of the "dcaSellInterval" field.
+	DcaSellInterval int `json:"dcaSellInterval,omitempty"`
+	// DcaSellProfitRatio holds the value of the "dcaSellProfitRatio" field.
+	DcaSellProfitRatio *decimal.Decimal `json:"dcaSellProfitRatio,omitempty"`
+	// DcaLastBuyTime holds the value of the "dcaLastBuyTime" field.
+	DcaLastBuyTime *time.Time `json:"dcaLastBuyTime,omitempty"`
+	// DcaLastBuyPrice holds the value of the "dcaLastBuyPrice" field.
+	DcaLastBuyPrice *decimal.Decimal `json:"dcaLastBuyPrice,omitempty"`
+	// DcaLastSellTime holds the value of the "dcaLastSellTime" field.
+	DcaLastSellTime *time.Time `json:"dcaLastSellTime,omitempty"`
 	// EnableAutoBuy holds the value of the "enableAutoBuy" field.
 	EnableAutoBuy bool `json:"enableAutoBuy,omitempty"`
 	// EnableAutoSell holds the value of the "enableAutoSell" field.
@@ -88,19 +126,19 @@ func (*Strategy) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case strategy.FieldLastKlineVolume, strategy.FieldFiveKlineVolume, strategy.FieldUpperBoundExit, strategy.FieldStopLossExit, strategy.FieldTakeProfitExit, strategy.FieldGlobalTakeProfitRatio, strategy.FieldDropThreshold:
+		case strategy.FieldGridStep, strategy.FieldLastKlineVolume, strategy.FieldFiveKlineVolume, strategy.FieldUpperBoundExit, strategy.FieldStopLossExit, strategy.FieldTakeProfitExit, strategy.FieldGlobalTakeProfitRatio, strategy.FieldDropThreshold, strategy.FieldDcaDropRatio, strategy.FieldDcaSellProfitRatio, strategy.FieldDcaLastBuyPrice:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case strategy.FieldTakeProfitRatio, strategy.FieldUpperPriceBound, strategy.FieldLowerPriceBound, strategy.FieldInitialOrderSize:
 			values[i] = new(decimal.Decimal)
-		case strategy.FieldDynamicStopLoss, strategy.FieldDropOn, strategy.FieldEnableAutoBuy, strategy.FieldEnableAutoSell, strategy.FieldEnableAutoExit, strategy.FieldEnablePushNotification:
+		case strategy.FieldDynamicStopLoss, strategy.FieldPaperTrading, strategy.FieldDropOn, strategy.FieldTrailingOn, strategy.FieldEnableAutoBuy, strategy.FieldEnableAutoSell, strategy.FieldEnableAutoExit, strategy.FieldEnablePushNotification:
 			values[i] = new(sql.NullBool)
 		case strategy.FieldMartinFactor:
 			values[i] = new(sql.NullFloat64)
-		case strategy.FieldID, strategy.FieldUserId, strategy.FieldMaxGridLimit, strategy.FieldFirstOrderId, strategy.FieldCandlesToCheck:
+		case strategy.FieldID, strategy.FieldUserId, strategy.FieldMaxGridLimit, strategy.FieldGridCount, strategy.FieldFirstOrderId, strategy.FieldCandlesToCheck, strategy.FieldTrailingCandles, strategy.FieldTrailingMaxShifts, strategy.FieldTrailingShifts, strategy.FieldDcaInterval, strategy.FieldDcaMaxOrders, strategy.FieldDcaSellInterval:
 			values[i] = new(sql.NullInt64)
-		case strategy.FieldGUID, strategy.FieldToken, strategy.FieldSymbol, strategy.FieldStatus, strategy.FieldGridTrend:
+		case strategy.FieldGUID, strategy.FieldAccount, strategy.FieldToken, strategy.FieldSymbol, strategy.FieldType, strategy.FieldGridMode, strategy.FieldBuyConditions, strategy.FieldStatus, strategy.FieldGridTrend:
 			values[i] = new(sql.NullString)
-		case strategy.FieldCreateTime, strategy.FieldUpdateTime, strategy.FieldLastLowerThresholdAlertTime, strategy.FieldLastUpperThresholdAlertTime:
+		case strategy.FieldCreateTime, strategy.FieldUpdateTime, strategy.FieldDcaLastBuyTime, strategy.FieldDcaLastSellTime, strategy.FieldLastLowerThresholdAlertTime, strategy.FieldLastUpperThresholdAlertTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -147,6 +185,12 @@ func (s *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.UserId = value.Int64
 			}
+		case strategy.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				s.Account = value.String
+			}
 		case strategy.FieldToken:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field token", values[i])
@@ -159,6 +203,12 @@ func (s *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Symbol = value.String
 			}
+		case strategy.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				s.Type = strategy.Type(value.String)
+			}
 		case strategy.FieldMartinFactor:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field martinFactor", values[i])
@@ -190,6 +240,25 @@ func (s *Strategy) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				s.LowerPriceBound = *value
 			}
+		case strategy.FieldGridMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gridMode", values[i])
+			} else if value.Valid {
+				s.GridMode = strategy.GridMode(value.String)
+			}
+		case strategy.FieldGridCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gridCount", values[i])
+			} else if value.Valid {
+				s.GridCount = int(value.Int64)
+			}
+		case strategy.FieldGridStep:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field gridStep", values[i])
+			} else if value.Valid {
+				s.GridStep = new(decimal.Decimal)
+				*s.GridStep = *value.S.(*decimal.Decimal)
+			}
 		case strategy.FieldInitialOrderSize:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field initialOrderSize", values[i])
@@ -251,6 +320,12 @@ func (s *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.DynamicStopLoss = value.Bool
 			}
+		case strategy.FieldPaperTrading:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paperTrading", values[i])
+			} else if value.Valid {
+				s.PaperTrading = value.Bool
+			}
 		case strategy.FieldDropOn:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field dropOn", values[i])
@@ -270,6 +345,89 @@ func (s *Strategy) assignValues(columns []string, values []any) error {
 				s.DropThreshold = new(decimal.Decimal)
 				*s.DropThreshold = *value.S.(*decimal.Decimal)
 			}
+		case strategy.FieldTrailingOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingOn", values[i])
+			} else if value.Valid {
+				s.TrailingOn = value.Bool
+			}
+		case strategy.FieldTrailingCandles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingCandles", values[i])
+			} else if value.Valid {
+				s.TrailingCandles = int(value.Int64)
+			}
+		case strategy.FieldTrailingMaxShifts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingMaxShifts", values[i])
+			} else if value.Valid {
+				s.TrailingMaxShifts = int(value.Int64)
+			}
+		case strategy.FieldTrailingShifts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingShifts", values[i])
+			} else if value.Valid {
+				s.TrailingShifts = int(value.Int64)
+			}
+		case strategy.FieldBuyConditions:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyConditions", values[i])
+			} else if value.Valid {
+				s.BuyConditions = value.String
+			}
+		case strategy.FieldDcaInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaInterval", values[i])
+			} else if value.Valid {
+				s.DcaInterval = int(value.Int64)
+			}
+		case strategy.FieldDcaDropRatio:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaDropRatio", values[i])
+			} else if value.Valid {
+				s.DcaDropRatio = new(decimal.Decimal)
+				*s.DcaDropRatio = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldDcaMaxOrders:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaMaxOrders", values[i])
+			} else if value.Valid {
+				s.DcaMaxOrders = int(value.Int64)
+			}
+		case strategy.FieldDcaSellInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaSellInterval", values[i])
+			} else if value.Valid {
+				s.DcaSellInterval = int(value.Int64)
+			}
+		case strategy.FieldDcaSellProfitRatio:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaSellProfitRatio", values[i])
+			} else if value.Valid {
+				s.DcaSellProfitRatio = new(decimal.Decimal)
+				*s.DcaSellProfitRatio = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldDcaLastBuyTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaLastBuyTime", values[i])
+			} else if value.Valid {
+				s.DcaLastBuyTime = new(time.Time)
+				*s.DcaLastBuyTime = value.Time
+			}
+		case strategy.FieldDcaLastBuyPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaLastBuyPrice", values[i])
+			} else if value.Valid {
+				s.DcaLastBuyPrice = new(decimal.Decimal)
+				*s.DcaLastBuyPrice = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldDcaLastSellTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dcaLastSellTime", values[i])
+			} else if value.Valid {
+				s.DcaLastSellTime = new(time.Time)
+				*s.DcaLastSellTime = value.Time
+			}
 		case strategy.FieldEnableAutoBuy:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enableAutoBuy", values[i])
@@ -369,12 +527,18 @@ func (s *Strategy) String() string {
 	builder.WriteString("userId=")
 	builder.WriteString(fmt.Sprintf("%v", s.UserId))
 	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(s.Account)
+	builder.WriteString(", ")
 	builder.WriteString("token=")
 	builder.WriteString(s.Token)
 	builder.WriteString(", ")
 	builder.WriteString("symbol=")
 	builder.WriteString(s.Symbol)
 	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", s.Type))
+	builder.WriteString(", ")
 	builder.WriteString("martinFactor=")
 	builder.WriteString(fmt.Sprintf("%v", s.MartinFactor))
 	builder.WriteString(", ")
@@ -392,6 +556,17 @@ func (s *Strategy) String() string {
 	builder.WriteString("lowerPriceBound=")
 	builder.WriteString(fmt.Sprintf("%v", s.LowerPriceBound))
 	builder.WriteString(", ")
+	builder.WriteString("gridMode=")
+	builder.WriteString(fmt.Sprintf("%v", s.GridMode))
+	builder.WriteString(", ")
+	builder.WriteString("gridCount=")
+	builder.WriteString(fmt.Sprintf("%v", s.GridCount))
+	builder.WriteString(", ")
+	if v := s.GridStep; v != nil {
+		builder.WriteString("gridStep=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("initialOrderSize=")
 	builder.WriteString(fmt.Sprintf("%v", s.InitialOrderSize))
 	builder.WriteString(", ")
@@ -433,6 +608,9 @@ func (s *Strategy) String() string {
 	builder.WriteString("dynamicStopLoss=")
 	builder.WriteString(fmt.Sprintf("%v", s.DynamicStopLoss))
 	builder.WriteString(", ")
+	builder.WriteString("paperTrading=")
+	builder.WriteString(fmt.Sprintf("%v", s.PaperTrading))
+	builder.WriteString(", ")
 	builder.WriteString("dropOn=")
 	builder.WriteString(fmt.Sprintf("%v", s.DropOn))
 	builder.WriteString(", ")
@@ -444,6 +622,55 @@ func (s *Strategy) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("trailingOn=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrailingOn))
+	builder.WriteString(", ")
+	builder.WriteString("trailingCandles=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrailingCandles))
+	builder.WriteString(", ")
+	builder.WriteString("trailingMaxShifts=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrailingMaxShifts))
+	builder.WriteString(", ")
+	builder.WriteString("trailingShifts=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrailingShifts))
+	builder.WriteString(", ")
+	builder.WriteString("buyConditions=")
+	builder.WriteString(s.BuyConditions)
+	builder.WriteString(", ")
+	builder.WriteString("dcaInterval=")
+	builder.WriteString(fmt.Sprintf("%v", s.DcaInterval))
+	builder.WriteString(", ")
+	if v := s.DcaDropRatio; v != nil {
+		builder.WriteString("dcaDropRatio=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dcaMaxOrders=")
+	builder.WriteString(fmt.Sprintf("%v", s.DcaMaxOrders))
+	builder.WriteString(", ")
+	builder.WriteString("dcaSellInterval=")
+	builder.WriteString(fmt.Sprintf("%v", s.DcaSellInterval))
+	builder.WriteString(", ")
+	if v := s.DcaSellProfitRatio; v != nil {
+		builder.WriteString("dcaSellProfitRatio=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.DcaLastBuyTime; v != nil {
+		builder.WriteString("dcaLastBuyTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.DcaLastBuyPrice; v != nil {
+		builder.WriteString("dcaLastBuyPrice=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.DcaLastSellTime; v != nil {
+		builder.WriteString("dcaLastSellTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enableAutoBuy=")
 	builder.WriteString(fmt.Sprintf("%v", s.EnableAutoBuy))
 	builder.WriteString(", ")
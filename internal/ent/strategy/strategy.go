@@ -22,10 +22,14 @@ const (
 	FieldGUID = "guid"
 	// FieldUserId holds the string denoting the userid field in the database.
 	FieldUserId = "user_id"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
 	// FieldToken holds the string denoting the token field in the database.
 	FieldToken = "token"
 	// FieldSymbol holds the string denoting the symbol field in the database.
 	FieldSymbol = "symbol"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
 	// FieldMartinFactor holds the string denoting the martinfactor field in the database.
 	FieldMartinFactor = "martin_factor"
 	// FieldMaxGridLimit holds the string denoting the maxgridlimit field in the database.
@@ -36,6 +40,12 @@ const (
 	FieldUpperPriceBound = "upper_price_bound"
 	// FieldLowerPriceBound holds the string denoting the lowerpricebound field in the database.
 	FieldLowerPriceBound = "lower_price_bound"
+	// FieldGridMode holds the string denoting the gridmode field in the database.
+	FieldGridMode = "grid_mode"
+	// FieldGridCount holds the string denoting the gridcount field in the database.
+	FieldGridCount = "grid_count"
+	// FieldGridStep holds the string denoting the gridstep field in the database.
+	FieldGridStep = "grid_step"
 	// FieldInitialOrderSize holds the string denoting the initialordersize field in the database.
 	FieldInitialOrderSize = "initial_order_size"
 	// FieldLastKlineVolume holds the string denoting the lastklinevolume field in the database.
@@ -54,12 +64,40 @@ const (
 	FieldGlobalTakeProfitRatio = "global_take_profit_ratio"
 	// FieldDynamicStopLoss holds the string denoting the dynamicstoploss field in the database.
 	FieldDynamicStopLoss = "dynamic_stop_loss"
+	// FieldPaperTrading holds the string denoting the papertrading field in the database.
+	FieldPaperTrading = "paper_trading"
 	// FieldDropOn holds the string denoting the dropon field in the database.
 	FieldDropOn = "drop_on"
 	// FieldCandlesToCheck holds the string denoting the candlestocheck field in the database.
 	FieldCandlesToCheck = "candles_to_check"
 	// FieldDropThreshold holds the string denoting the dropthreshold field in the database.
 	FieldDropThreshold = "drop_threshold"
+	// FieldTrailingOn holds the string denoting the trailingon field in the database.
+	FieldTrailingOn = "trailing_on"
+	// FieldTrailingCandles holds the string denoting the trailingcandles field in the database.
+	FieldTrailingCandles = "trailing_candles"
+	// FieldTrailingMaxShifts holds the string denoting the trailingmaxshifts field in the database.
+	FieldTrailingMaxShifts = "trailing_max_shifts"
+	// FieldTrailingShifts holds the string denoting the trailingshifts field in the database.
+	FieldTrailingShifts = "trailing_shifts"
+	// FieldBuyConditions holds the string denoting the buyconditions field in the database.
+	FieldBuyConditions = "buy_conditions"
+	// FieldDcaInterval holds the string denoting the dcainterval field in the database.
+	FieldDcaInterval = "dca_interval"
+	// FieldDcaDropRatio holds the string denoting the dcadropratio field in the database.
+	FieldDcaDropRatio = "dca_drop_ratio"
+	// FieldDcaMaxOrders holds the string denoting the dcamaxorders field in the database.
+	FieldDcaMaxOrders = "dca_max_orders"
+	// FieldDcaSellInterval holds the string denoting the dcasellinterval field in the database.
+	FieldDcaSellInterval = "dca_sell_interval"
+	// FieldDcaSellProfitRatio holds the string denoting the dcasellprofitratio field in the database.
+	FieldDcaSellProfitRatio = "dca_sell_profit_ratio"
+	// FieldDcaLastBuyTime holds the string denoting the dcalastbuytime field in the database.
+	FieldDcaLastBuyTime = "dca_last_buy_time"
+	// FieldDcaLastBuyPrice holds the string denoting the dcalastbuyprice field in the database.
+	FieldDcaLastBuyPrice = "dca_last_buy_price"
+	// FieldDcaLastSellTime holds the string denoting the dcalastselltime field in the database.
+	FieldDcaLastSellTime = "dca_last_sell_time"
 	// FieldEnableAutoBuy holds the string denoting the enableautobuy field in the database.
 	FieldEnableAutoBuy = "enable_auto_buy"
 	// FieldEnableAutoSell holds the string denoting the enableautosell field in the database.
@@ -87,13 +125,18 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldGUID,
 	FieldUserId,
+	FieldAccount,
 	FieldToken,
 	FieldSymbol,
+	FieldType,
 	FieldMartinFactor,
 	FieldMaxGridLimit,
 	FieldTakeProfitRatio,
 	FieldUpperPriceBound,
 	FieldLowerPriceBound,
+	FieldGridMode,
+	FieldGridCount,
+	FieldGridStep,
 	FieldInitialOrderSize,
 	FieldLastKlineVolume,
 	FieldFiveKlineVolume,
@@ -103,9 +146,23 @@ var Columns = []string{
 	FieldTakeProfitExit,
 	FieldGlobalTakeProfitRatio,
 	FieldDynamicStopLoss,
+	FieldPaperTrading,
 	FieldDropOn,
 	FieldCandlesToCheck,
 	FieldDropThreshold,
+	FieldTrailingOn,
+	FieldTrailingCandles,
+	FieldTrailingMaxShifts,
+	FieldTrailingShifts,
+	FieldBuyConditions,
+	FieldDcaInterval,
+	FieldDcaDropRatio,
+	FieldDcaMaxOrders,
+	FieldDcaSellInterval,
+	FieldDcaSellProfitRatio,
+	FieldDcaLastBuyTime,
+	FieldDcaLastBuyPrice,
+	FieldDcaLastSellTime,
 	FieldEnableAutoBuy,
 	FieldEnableAutoSell,
 	FieldEnableAutoExit,
@@ -135,6 +192,8 @@ var (
 	UpdateDefaultUpdateTime func() time.Time
 	// GUIDValidator is a validator for the "guid" field. It is called by the builders before save.
 	GUIDValidator func(string) error
+	// AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	AccountValidator func(string) error
 	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
 	TokenValidator func(string) error
 	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
@@ -143,10 +202,76 @@ var (
 	MartinFactorValidator func(float64) error
 	// MaxGridLimitValidator is a validator for the "maxGridLimit" field. It is called by the builders before save.
 	MaxGridLimitValidator func(int) error
+	// DefaultGridCount holds the default value on creation for the "gridCount" field.
+	DefaultGridCount int
 	// DefaultCandlesToCheck holds the default value on creation for the "candlesToCheck" field.
 	DefaultCandlesToCheck int
+	// DefaultTrailingCandles holds the default value on creation for the "trailingCandles" field.
+	DefaultTrailingCandles int
+	// DefaultTrailingMaxShifts holds the default value on creation for the "trailingMaxShifts" field.
+	DefaultTrailingMaxShifts int
+	// DefaultTrailingShifts holds the default value on creation for the "trailingShifts" field.
+	DefaultTrailingShifts int
+	// DefaultDcaInterval holds the default value on creation for the "dcaInterval" field.
+	DefaultDcaInterval int
+	// DefaultDcaMaxOrders holds the default value on creation for the "dcaMaxOrders" field.
+	DefaultDcaMaxOrders int
+	// DefaultDcaSellInterval holds the default value on creation for the "dcaSellInterval" field.
+	DefaultDcaSellInterval int
 )
 
+// Type defines the type for the "type" enum field.
+type Type string
+
+// TypeGrid is the default value of the Type enum.
+const DefaultType = TypeGrid
+
+// Type values.
+const (
+	TypeGrid Type = "grid"
+	TypeDca  Type = "dca"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeGrid, TypeDca:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for type field: %q", _type)
+	}
+}
+
+// GridMode defines the type for the "gridMode" enum field.
+type GridMode string
+
+// GridModeGeometric is the default value of the GridMode enum.
+const DefaultGridMode = GridModeGeometric
+
+// GridMode values.
+const (
+	GridModeGeometric  GridMode = "geometric"
+	GridModeArithmetic GridMode = "arithmetic"
+)
+
+func (gm GridMode) String() string {
+	return string(gm)
+}
+
+// GridModeValidator is a validator for the "gridMode" field enum values. It is called by the builders before save.
+func GridModeValidator(gm GridMode) error {
+	switch gm {
+	case GridModeGeometric, GridModeArithmetic:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for gridMode field: %q", gm)
+	}
+}
+
 // Status defines the type for the "status" enum field.
 type Status string
 
@@ -198,6 +323,11 @@ func ByUserId(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserId, opts...).ToFunc()
 }
 
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
 // ByToken orders the results by the token field.
 func ByToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldToken, opts...).ToFunc()
@@ -208,6 +338,11 @@ func BySymbol(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
 }
 
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
 // ByMartinFactor orders the results by the martinFactor field.
 func ByMartinFactor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMartinFactor, opts...).ToFunc()
@@ -233,6 +368,21 @@ func ByLowerPriceBound(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLowerPriceBound, opts...).ToFunc()
 }
 
+// ByGridMode orders the results by the gridMode field.
+func ByGridMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridMode, opts...).ToFunc()
+}
+
+// ByGridCount orders the results by the gridCount field.
+func ByGridCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridCount, opts...).ToFunc()
+}
+
+// ByGridStep orders the results by the gridStep field.
+func ByGridStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridStep, opts...).ToFunc()
+}
+
 // ByInitialOrderSize orders the results by the initialOrderSize field.
 func ByInitialOrderSize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInitialOrderSize, opts...).ToFunc()
@@ -278,6 +428,11 @@ func ByDynamicStopLoss(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDynamicStopLoss, opts...).ToFunc()
 }
 
+// ByPaperTrading orders the results by the paperTrading field.
+func ByPaperTrading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaperTrading, opts...).ToFunc()
+}
+
 // ByDropOn orders the results by the dropOn field.
 func ByDropOn(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDropOn, opts...).ToFunc()
@@ -293,6 +448,71 @@ func ByDropThreshold(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDropThreshold, opts...).ToFunc()
 }
 
+// ByTrailingOn orders the results by the trailingOn field.
+func ByTrailingOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingOn, opts...).ToFunc()
+}
+
+// ByTrailingCandles orders the results by the trailingCandles field.
+func ByTrailingCandles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingCandles, opts...).ToFunc()
+}
+
+// ByTrailingMaxShifts orders the results by the trailingMaxShifts field.
+func ByTrailingMaxShifts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingMaxShifts, opts...).ToFunc()
+}
+
+// ByTrailingShifts orders the results by the trailingShifts field.
+func ByTrailingShifts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingShifts, opts...).ToFunc()
+}
+
+// ByBuyConditions orders the results by the buyConditions field.
+func ByBuyConditions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyConditions, opts...).ToFunc()
+}
+
+// ByDcaInterval orders the results by the dcaInterval field.
+func ByDcaInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaInterval, opts...).ToFunc()
+}
+
+// ByDcaDropRatio orders the results by the dcaDropRatio field.
+func ByDcaDropRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaDropRatio, opts...).ToFunc()
+}
+
+// ByDcaMaxOrders orders the results by the dcaMaxOrders field.
+func ByDcaMaxOrders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaMaxOrders, opts...).ToFunc()
+}
+
+// ByDcaSellInterval orders the results by the dcaSellInterval field.
+func ByDcaSellInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaSellInterval, opts...).ToFunc()
+}
+
+// ByDcaSellProfitRatio orders the results by the dcaSellProfitRatio field.
+func ByDcaSellProfitRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaSellProfitRatio, opts...).ToFunc()
+}
+
+// ByDcaLastBuyTime orders the results by the dcaLastBuyTime field.
+func ByDcaLastBuyTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaLastBuyTime, opts...).ToFunc()
+}
+
+// ByDcaLastBuyPrice orders the results by the dcaLastBuyPrice field.
+func ByDcaLastBuyPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaLastBuyPrice, opts...).ToFunc()
+}
+
+// ByDcaLastSellTime orders the results by the dcaLastSellTime field.
+func ByDcaLastSellTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDcaLastSellTime, opts...).ToFunc()
+}
+
 // ByEnableAutoBuy orders the results by the enableAutoBuy field.
 func ByEnableAutoBuy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnableAutoBuy, opts...).ToFunc()
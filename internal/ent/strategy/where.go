@@ -75,6 +75,11 @@ func UserId(v int64) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldUserId, v))
 }
 
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAccount, v))
+}
+
 // Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
 func Token(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldToken, v))
@@ -110,6 +115,16 @@ func LowerPriceBound(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldLowerPriceBound, v))
 }
 
+// GridCount applies equality check predicate on the "gridCount" field. It's identical to GridCountEQ.
+func GridCount(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridCount, v))
+}
+
+// GridStep applies equality check predicate on the "gridStep" field. It's identical to GridStepEQ.
+func GridStep(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridStep, v))
+}
+
 // InitialOrderSize applies equality check predicate on the "initialOrderSize" field. It's identical to InitialOrderSizeEQ.
 func InitialOrderSize(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldInitialOrderSize, v))
@@ -155,6 +170,11 @@ func DynamicStopLoss(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDynamicStopLoss, v))
 }
 
+// PaperTrading applies equality check predicate on the "paperTrading" field. It's identical to PaperTradingEQ.
+func PaperTrading(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPaperTrading, v))
+}
+
 // DropOn applies equality check predicate on the "dropOn" field. It's identical to DropOnEQ.
 func DropOn(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDropOn, v))
@@ -170,6 +190,71 @@ func DropThreshold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDropThreshold, v))
 }
 
+// TrailingOn applies equality check predicate on the "trailingOn" field. It's identical to TrailingOnEQ.
+func TrailingOn(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingOn, v))
+}
+
+// TrailingCandles applies equality check predicate on the "trailingCandles" field. It's identical to TrailingCandlesEQ.
+func TrailingCandles(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingCandles, v))
+}
+
+// TrailingMaxShifts applies equality check predicate on the "trailingMaxShifts" field. It's identical to TrailingMaxShiftsEQ.
+func TrailingMaxShifts(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingMaxShifts, v))
+}
+
+// TrailingShifts applies equality check predicate on the "trailingShifts" field. It's identical to TrailingShiftsEQ.
+func TrailingShifts(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingShifts, v))
+}
+
+// BuyConditions applies equality check predicate on the "buyConditions" field. It's identical to BuyConditionsEQ.
+func BuyConditions(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldBuyConditions, v))
+}
+
+// DcaInterval applies equality check predicate on the "dcaInterval" field. It's identical to DcaIntervalEQ.
+func DcaInterval(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaInterval, v))
+}
+
+// DcaDropRatio applies equality check predicate on the "dcaDropRatio" field. It's identical to DcaDropRatioEQ.
+func DcaDropRatio(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaDropRatio, v))
+}
+
+// DcaMaxOrders applies equality check predicate on the "dcaMaxOrders" field. It's identical to DcaMaxOrdersEQ.
+func DcaMaxOrders(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaMaxOrders, v))
+}
+
+// DcaSellInterval applies equality check predicate on the "dcaSellInterval" field. It's identical to DcaSellIntervalEQ.
+func DcaSellInterval(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaSellInterval, v))
+}
+
+// DcaSellProfitRatio applies equality check predicate on the "dcaSellProfitRatio" field. It's identical to DcaSellProfitRatioEQ.
+func DcaSellProfitRatio(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaSellProfitRatio, v))
+}
+
+// DcaLastBuyTime applies equality check predicate on the "dcaLastBuyTime" field. It's identical to DcaLastBuyTimeEQ.
+func DcaLastBuyTime(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyPrice applies equality check predicate on the "dcaLastBuyPrice" field. It's identical to DcaLastBuyPriceEQ.
+func DcaLastBuyPrice(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastSellTime applies equality check predicate on the "dcaLastSellTime" field. It's identical to DcaLastSellTimeEQ.
+func DcaLastSellTime(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaLastSellTime, v))
+}
+
 // EnableAutoBuy applies equality check predicate on the "enableAutoBuy" field. It's identical to EnableAutoBuyEQ.
 func EnableAutoBuy(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldEnableAutoBuy, v))
@@ -390,6 +475,81 @@ func UserIdLTE(v int64) predicate.Strategy {
 	return predicate.Strategy(sql.FieldLTE(FieldUserId, v))
 }
 
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountIsNil applies the IsNil predicate on the "account" field.
+func AccountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAccount))
+}
+
+// AccountNotNil applies the NotNil predicate on the "account" field.
+func AccountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAccount))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContainsFold(FieldAccount, v))
+}
+
 // TokenEQ applies the EQ predicate on the "token" field.
 func TokenEQ(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldToken, v))
@@ -520,6 +680,26 @@ func SymbolContainsFold(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldSymbol, v))
 }
 
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldType, vs...))
+}
+
 // MartinFactorEQ applies the EQ predicate on the "martinFactor" field.
 func MartinFactorEQ(v float64) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldMartinFactor, v))
@@ -820,6 +1000,156 @@ func LowerPriceBoundContainsFold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldLowerPriceBound, vc))
 }
 
+// GridModeEQ applies the EQ predicate on the "gridMode" field.
+func GridModeEQ(v GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridMode, v))
+}
+
+// GridModeNEQ applies the NEQ predicate on the "gridMode" field.
+func GridModeNEQ(v GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridMode, v))
+}
+
+// GridModeIn applies the In predicate on the "gridMode" field.
+func GridModeIn(vs ...GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridMode, vs...))
+}
+
+// GridModeNotIn applies the NotIn predicate on the "gridMode" field.
+func GridModeNotIn(vs ...GridMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridMode, vs...))
+}
+
+// GridCountEQ applies the EQ predicate on the "gridCount" field.
+func GridCountEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridCount, v))
+}
+
+// GridCountNEQ applies the NEQ predicate on the "gridCount" field.
+func GridCountNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridCount, v))
+}
+
+// GridCountIn applies the In predicate on the "gridCount" field.
+func GridCountIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridCount, vs...))
+}
+
+// GridCountNotIn applies the NotIn predicate on the "gridCount" field.
+func GridCountNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridCount, vs...))
+}
+
+// GridCountGT applies the GT predicate on the "gridCount" field.
+func GridCountGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldGridCount, v))
+}
+
+// GridCountGTE applies the GTE predicate on the "gridCount" field.
+func GridCountGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldGridCount, v))
+}
+
+// GridCountLT applies the LT predicate on the "gridCount" field.
+func GridCountLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldGridCount, v))
+}
+
+// GridCountLTE applies the LTE predicate on the "gridCount" field.
+func GridCountLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldGridCount, v))
+}
+
+// GridCountIsNil applies the IsNil predicate on the "gridCount" field.
+func GridCountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldGridCount))
+}
+
+// GridCountNotNil applies the NotNil predicate on the "gridCount" field.
+func GridCountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldGridCount))
+}
+
+// GridStepEQ applies the EQ predicate on the "gridStep" field.
+func GridStepEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldGridStep, v))
+}
+
+// GridStepNEQ applies the NEQ predicate on the "gridStep" field.
+func GridStepNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldGridStep, v))
+}
+
+// GridStepIn applies the In predicate on the "gridStep" field.
+func GridStepIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldGridStep, vs...))
+}
+
+// GridStepNotIn applies the NotIn predicate on the "gridStep" field.
+func GridStepNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldGridStep, vs...))
+}
+
+// GridStepGT applies the GT predicate on the "gridStep" field.
+func GridStepGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldGridStep, v))
+}
+
+// GridStepGTE applies the GTE predicate on the "gridStep" field.
+func GridStepGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldGridStep, v))
+}
+
+// GridStepLT applies the LT predicate on the "gridStep" field.
+func GridStepLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldGridStep, v))
+}
+
+// GridStepLTE applies the LTE predicate on the "gridStep" field.
+func GridStepLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldGridStep, v))
+}
+
+// GridStepContains applies the Contains predicate on the "gridStep" field.
+func GridStepContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldGridStep, vc))
+}
+
+// GridStepHasPrefix applies the HasPrefix predicate on the "gridStep" field.
+func GridStepHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldGridStep, vc))
+}
+
+// GridStepHasSuffix applies the HasSuffix predicate on the "gridStep" field.
+func GridStepHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldGridStep, vc))
+}
+
+// GridStepIsNil applies the IsNil predicate on the "gridStep" field.
+func GridStepIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldGridStep))
+}
+
+// GridStepNotNil applies the NotNil predicate on the "gridStep" field.
+func GridStepNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldGridStep))
+}
+
+// GridStepEqualFold applies the EqualFold predicate on the "gridStep" field.
+func GridStepEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldGridStep, vc))
+}
+
+// GridStepContainsFold applies the ContainsFold predicate on the "gridStep" field.
+func GridStepContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldGridStep, vc))
+}
+
 // InitialOrderSizeEQ applies the EQ predicate on the "initialOrderSize" field.
 func InitialOrderSizeEQ(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldInitialOrderSize, v))
@@ -1440,6 +1770,26 @@ func DynamicStopLossNotNil() predicate.Strategy {
 	return predicate.Strategy(sql.FieldNotNull(FieldDynamicStopLoss))
 }
 
+// PaperTradingEQ applies the EQ predicate on the "paperTrading" field.
+func PaperTradingEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPaperTrading, v))
+}
+
+// PaperTradingNEQ applies the NEQ predicate on the "paperTrading" field.
+func PaperTradingNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldPaperTrading, v))
+}
+
+// PaperTradingIsNil applies the IsNil predicate on the "paperTrading" field.
+func PaperTradingIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldPaperTrading))
+}
+
+// PaperTradingNotNil applies the NotNil predicate on the "paperTrading" field.
+func PaperTradingNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldPaperTrading))
+}
+
 // DropOnEQ applies the EQ predicate on the "dropOn" field.
 func DropOnEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldDropOn, v))
@@ -1590,6 +1940,741 @@ func DropThresholdContainsFold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldDropThreshold, vc))
 }
 
+// TrailingOnEQ applies the EQ predicate on the "trailingOn" field.
+func TrailingOnEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingOn, v))
+}
+
+// TrailingOnNEQ applies the NEQ predicate on the "trailingOn" field.
+func TrailingOnNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingOn, v))
+}
+
+// TrailingOnIsNil applies the IsNil predicate on the "trailingOn" field.
+func TrailingOnIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingOn))
+}
+
+// TrailingOnNotNil applies the NotNil predicate on the "trailingOn" field.
+func TrailingOnNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingOn))
+}
+
+// TrailingCandlesEQ applies the EQ predicate on the "trailingCandles" field.
+func TrailingCandlesEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingCandles, v))
+}
+
+// TrailingCandlesNEQ applies the NEQ predicate on the "trailingCandles" field.
+func TrailingCandlesNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingCandles, v))
+}
+
+// TrailingCandlesIn applies the In predicate on the "trailingCandles" field.
+func TrailingCandlesIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingCandles, vs...))
+}
+
+// TrailingCandlesNotIn applies the NotIn predicate on the "trailingCandles" field.
+func TrailingCandlesNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingCandles, vs...))
+}
+
+// TrailingCandlesGT applies the GT predicate on the "trailingCandles" field.
+func TrailingCandlesGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingCandles, v))
+}
+
+// TrailingCandlesGTE applies the GTE predicate on the "trailingCandles" field.
+func TrailingCandlesGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingCandles, v))
+}
+
+// TrailingCandlesLT applies the LT predicate on the "trailingCandles" field.
+func TrailingCandlesLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingCandles, v))
+}
+
+// TrailingCandlesLTE applies the LTE predicate on the "trailingCandles" field.
+func TrailingCandlesLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingCandles, v))
+}
+
+// TrailingCandlesIsNil applies the IsNil predicate on the "trailingCandles" field.
+func TrailingCandlesIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingCandles))
+}
+
+// TrailingCandlesNotNil applies the NotNil predicate on the "trailingCandles" field.
+func TrailingCandlesNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingCandles))
+}
+
+// TrailingMaxShiftsEQ applies the EQ predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingMaxShifts, v))
+}
+
+// TrailingMaxShiftsNEQ applies the NEQ predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingMaxShifts, v))
+}
+
+// TrailingMaxShiftsIn applies the In predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingMaxShifts, vs...))
+}
+
+// TrailingMaxShiftsNotIn applies the NotIn predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingMaxShifts, vs...))
+}
+
+// TrailingMaxShiftsGT applies the GT predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingMaxShifts, v))
+}
+
+// TrailingMaxShiftsGTE applies the GTE predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingMaxShifts, v))
+}
+
+// TrailingMaxShiftsLT applies the LT predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingMaxShifts, v))
+}
+
+// TrailingMaxShiftsLTE applies the LTE predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingMaxShifts, v))
+}
+
+// TrailingMaxShiftsIsNil applies the IsNil predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingMaxShifts))
+}
+
+// TrailingMaxShiftsNotNil applies the NotNil predicate on the "trailingMaxShifts" field.
+func TrailingMaxShiftsNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingMaxShifts))
+}
+
+// TrailingShiftsEQ applies the EQ predicate on the "trailingShifts" field.
+func TrailingShiftsEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingShifts, v))
+}
+
+// TrailingShiftsNEQ applies the NEQ predicate on the "trailingShifts" field.
+func TrailingShiftsNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingShifts, v))
+}
+
+// TrailingShiftsIn applies the In predicate on the "trailingShifts" field.
+func TrailingShiftsIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingShifts, vs...))
+}
+
+// TrailingShiftsNotIn applies the NotIn predicate on the "trailingShifts" field.
+func TrailingShiftsNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingShifts, vs...))
+}
+
+// TrailingShiftsGT applies the GT predicate on the "trailingShifts" field.
+func TrailingShiftsGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingShifts, v))
+}
+
+// TrailingShiftsGTE applies the GTE predicate on the "trailingShifts" field.
+func TrailingShiftsGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingShifts, v))
+}
+
+// TrailingShiftsLT applies the LT predicate on the "trailingShifts" field.
+func TrailingShiftsLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingShifts, v))
+}
+
+// TrailingShiftsLTE applies the LTE predicate on the "trailingShifts" field.
+func TrailingShiftsLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingShifts, v))
+}
+
+// TrailingShiftsIsNil applies the IsNil predicate on the "trailingShifts" field.
+func TrailingShiftsIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingShifts))
+}
+
+// TrailingShiftsNotNil applies the NotNil predicate on the "trailingShifts" field.
+func TrailingShiftsNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingShifts))
+}
+
+// BuyConditionsEQ applies the EQ predicate on the "buyConditions" field.
+func BuyConditionsEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldBuyConditions, v))
+}
+
+// BuyConditionsNEQ applies the NEQ predicate on the "buyConditions" field.
+func BuyConditionsNEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldBuyConditions, v))
+}
+
+// BuyConditionsIn applies the In predicate on the "buyConditions" field.
+func BuyConditionsIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldBuyConditions, vs...))
+}
+
+// BuyConditionsNotIn applies the NotIn predicate on the "buyConditions" field.
+func BuyConditionsNotIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldBuyConditions, vs...))
+}
+
+// BuyConditionsGT applies the GT predicate on the "buyConditions" field.
+func BuyConditionsGT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldBuyConditions, v))
+}
+
+// BuyConditionsGTE applies the GTE predicate on the "buyConditions" field.
+func BuyConditionsGTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldBuyConditions, v))
+}
+
+// BuyConditionsLT applies the LT predicate on the "buyConditions" field.
+func BuyConditionsLT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldBuyConditions, v))
+}
+
+// BuyConditionsLTE applies the LTE predicate on the "buyConditions" field.
+func BuyConditionsLTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldBuyConditions, v))
+}
+
+// BuyConditionsContains applies the Contains predicate on the "buyConditions" field.
+func BuyConditionsContains(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContains(FieldBuyConditions, v))
+}
+
+// BuyConditionsHasPrefix applies the HasPrefix predicate on the "buyConditions" field.
+func BuyConditionsHasPrefix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasPrefix(FieldBuyConditions, v))
+}
+
+// BuyConditionsHasSuffix applies the HasSuffix predicate on the "buyConditions" field.
+func BuyConditionsHasSuffix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasSuffix(FieldBuyConditions, v))
+}
+
+// BuyConditionsIsNil applies the IsNil predicate on the "buyConditions" field.
+func BuyConditionsIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldBuyConditions))
+}
+
+// BuyConditionsNotNil applies the NotNil predicate on the "buyConditions" field.
+func BuyConditionsNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldBuyConditions))
+}
+
+// BuyConditionsEqualFold applies the EqualFold predicate on the "buyConditions" field.
+func BuyConditionsEqualFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEqualFold(FieldBuyConditions, v))
+}
+
+// BuyConditionsContainsFold applies the ContainsFold predicate on the "buyConditions" field.
+func BuyConditionsContainsFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContainsFold(FieldBuyConditions, v))
+}
+
+// DcaIntervalEQ applies the EQ predicate on the "dcaInterval" field.
+func DcaIntervalEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaInterval, v))
+}
+
+// DcaIntervalNEQ applies the NEQ predicate on the "dcaInterval" field.
+func DcaIntervalNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaInterval, v))
+}
+
+// DcaIntervalIn applies the In predicate on the "dcaInterval" field.
+func DcaIntervalIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaInterval, vs...))
+}
+
+// DcaIntervalNotIn applies the NotIn predicate on the "dcaInterval" field.
+func DcaIntervalNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaInterval, vs...))
+}
+
+// DcaIntervalGT applies the GT predicate on the "dcaInterval" field.
+func DcaIntervalGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaInterval, v))
+}
+
+// DcaIntervalGTE applies the GTE predicate on the "dcaInterval" field.
+func DcaIntervalGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaInterval, v))
+}
+
+// DcaIntervalLT applies the LT predicate on the "dcaInterval" field.
+func DcaIntervalLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaInterval, v))
+}
+
+// DcaIntervalLTE applies the LTE predicate on the "dcaInterval" field.
+func DcaIntervalLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaInterval, v))
+}
+
+// DcaIntervalIsNil applies the IsNil predicate on the "dcaInterval" field.
+func DcaIntervalIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaInterval))
+}
+
+// DcaIntervalNotNil applies the NotNil predicate on the "dcaInterval" field.
+func DcaIntervalNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaInterval))
+}
+
+// DcaDropRatioEQ applies the EQ predicate on the "dcaDropRatio" field.
+func DcaDropRatioEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaDropRatio, v))
+}
+
+// DcaDropRatioNEQ applies the NEQ predicate on the "dcaDropRatio" field.
+func DcaDropRatioNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaDropRatio, v))
+}
+
+// DcaDropRatioIn applies the In predicate on the "dcaDropRatio" field.
+func DcaDropRatioIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaDropRatio, vs...))
+}
+
+// DcaDropRatioNotIn applies the NotIn predicate on the "dcaDropRatio" field.
+func DcaDropRatioNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaDropRatio, vs...))
+}
+
+// DcaDropRatioGT applies the GT predicate on the "dcaDropRatio" field.
+func DcaDropRatioGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaDropRatio, v))
+}
+
+// DcaDropRatioGTE applies the GTE predicate on the "dcaDropRatio" field.
+func DcaDropRatioGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaDropRatio, v))
+}
+
+// DcaDropRatioLT applies the LT predicate on the "dcaDropRatio" field.
+func DcaDropRatioLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaDropRatio, v))
+}
+
+// DcaDropRatioLTE applies the LTE predicate on the "dcaDropRatio" field.
+func DcaDropRatioLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaDropRatio, v))
+}
+
+// DcaDropRatioContains applies the Contains predicate on the "dcaDropRatio" field.
+func DcaDropRatioContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldDcaDropRatio, vc))
+}
+
+// DcaDropRatioHasPrefix applies the HasPrefix predicate on the "dcaDropRatio" field.
+func DcaDropRatioHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldDcaDropRatio, vc))
+}
+
+// DcaDropRatioHasSuffix applies the HasSuffix predicate on the "dcaDropRatio" field.
+func DcaDropRatioHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldDcaDropRatio, vc))
+}
+
+// DcaDropRatioIsNil applies the IsNil predicate on the "dcaDropRatio" field.
+func DcaDropRatioIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaDropRatio))
+}
+
+// DcaDropRatioNotNil applies the NotNil predicate on the "dcaDropRatio" field.
+func DcaDropRatioNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaDropRatio))
+}
+
+// DcaDropRatioEqualFold applies the EqualFold predicate on the "dcaDropRatio" field.
+func DcaDropRatioEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldDcaDropRatio, vc))
+}
+
+// DcaDropRatioContainsFold applies the ContainsFold predicate on the "dcaDropRatio" field.
+func DcaDropRatioContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldDcaDropRatio, vc))
+}
+
+// DcaMaxOrdersEQ applies the EQ predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaMaxOrders, v))
+}
+
+// DcaMaxOrdersNEQ applies the NEQ predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaMaxOrders, v))
+}
+
+// DcaMaxOrdersIn applies the In predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaMaxOrders, vs...))
+}
+
+// DcaMaxOrdersNotIn applies the NotIn predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaMaxOrders, vs...))
+}
+
+// DcaMaxOrdersGT applies the GT predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaMaxOrders, v))
+}
+
+// DcaMaxOrdersGTE applies the GTE predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaMaxOrders, v))
+}
+
+// DcaMaxOrdersLT applies the LT predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaMaxOrders, v))
+}
+
+// DcaMaxOrdersLTE applies the LTE predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaMaxOrders, v))
+}
+
+// DcaMaxOrdersIsNil applies the IsNil predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaMaxOrders))
+}
+
+// DcaMaxOrdersNotNil applies the NotNil predicate on the "dcaMaxOrders" field.
+func DcaMaxOrdersNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaMaxOrders))
+}
+
+// DcaSellIntervalEQ applies the EQ predicate on the "dcaSellInterval" field.
+func DcaSellIntervalEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaSellInterval, v))
+}
+
+// DcaSellIntervalNEQ applies the NEQ predicate on the "dcaSellInterval" field.
+func DcaSellIntervalNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaSellInterval, v))
+}
+
+// DcaSellIntervalIn applies the In predicate on the "dcaSellInterval" field.
+func DcaSellIntervalIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaSellInterval, vs...))
+}
+
+// DcaSellIntervalNotIn applies the NotIn predicate on the "dcaSellInterval" field.
+func DcaSellIntervalNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaSellInterval, vs...))
+}
+
+// DcaSellIntervalGT applies the GT predicate on the "dcaSellInterval" field.
+func DcaSellIntervalGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaSellInterval, v))
+}
+
+// DcaSellIntervalGTE applies the GTE predicate on the "dcaSellInterval" field.
+func DcaSellIntervalGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaSellInterval, v))
+}
+
+// DcaSellIntervalLT applies the LT predicate on the "dcaSellInterval" field.
+func DcaSellIntervalLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaSellInterval, v))
+}
+
+// DcaSellIntervalLTE applies the LTE predicate on the "dcaSellInterval" field.
+func DcaSellIntervalLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaSellInterval, v))
+}
+
+// DcaSellIntervalIsNil applies the IsNil predicate on the "dcaSellInterval" field.
+func DcaSellIntervalIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaSellInterval))
+}
+
+// DcaSellIntervalNotNil applies the NotNil predicate on the "dcaSellInterval" field.
+func DcaSellIntervalNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaSellInterval))
+}
+
+// DcaSellProfitRatioEQ applies the EQ predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaSellProfitRatio, v))
+}
+
+// DcaSellProfitRatioNEQ applies the NEQ predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaSellProfitRatio, v))
+}
+
+// DcaSellProfitRatioIn applies the In predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaSellProfitRatio, vs...))
+}
+
+// DcaSellProfitRatioNotIn applies the NotIn predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaSellProfitRatio, vs...))
+}
+
+// DcaSellProfitRatioGT applies the GT predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaSellProfitRatio, v))
+}
+
+// DcaSellProfitRatioGTE applies the GTE predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaSellProfitRatio, v))
+}
+
+// DcaSellProfitRatioLT applies the LT predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaSellProfitRatio, v))
+}
+
+// DcaSellProfitRatioLTE applies the LTE predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaSellProfitRatio, v))
+}
+
+// DcaSellProfitRatioContains applies the Contains predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldDcaSellProfitRatio, vc))
+}
+
+// DcaSellProfitRatioHasPrefix applies the HasPrefix predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldDcaSellProfitRatio, vc))
+}
+
+// DcaSellProfitRatioHasSuffix applies the HasSuffix predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldDcaSellProfitRatio, vc))
+}
+
+// DcaSellProfitRatioIsNil applies the IsNil predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaSellProfitRatio))
+}
+
+// DcaSellProfitRatioNotNil applies the NotNil predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaSellProfitRatio))
+}
+
+// DcaSellProfitRatioEqualFold applies the EqualFold predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldDcaSellProfitRatio, vc))
+}
+
+// DcaSellProfitRatioContainsFold applies the ContainsFold predicate on the "dcaSellProfitRatio" field.
+func DcaSellProfitRatioContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldDcaSellProfitRatio, vc))
+}
+
+// DcaLastBuyTimeEQ applies the EQ predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyTimeNEQ applies the NEQ predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyTimeIn applies the In predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaLastBuyTime, vs...))
+}
+
+// DcaLastBuyTimeNotIn applies the NotIn predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaLastBuyTime, vs...))
+}
+
+// DcaLastBuyTimeGT applies the GT predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyTimeGTE applies the GTE predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyTimeLT applies the LT predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyTimeLTE applies the LTE predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaLastBuyTime, v))
+}
+
+// DcaLastBuyTimeIsNil applies the IsNil predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaLastBuyTime))
+}
+
+// DcaLastBuyTimeNotNil applies the NotNil predicate on the "dcaLastBuyTime" field.
+func DcaLastBuyTimeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaLastBuyTime))
+}
+
+// DcaLastBuyPriceEQ applies the EQ predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastBuyPriceNEQ applies the NEQ predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastBuyPriceIn applies the In predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaLastBuyPrice, vs...))
+}
+
+// DcaLastBuyPriceNotIn applies the NotIn predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaLastBuyPrice, vs...))
+}
+
+// DcaLastBuyPriceGT applies the GT predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastBuyPriceGTE applies the GTE predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastBuyPriceLT applies the LT predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastBuyPriceLTE applies the LTE predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaLastBuyPrice, v))
+}
+
+// DcaLastBuyPriceContains applies the Contains predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldDcaLastBuyPrice, vc))
+}
+
+// DcaLastBuyPriceHasPrefix applies the HasPrefix predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldDcaLastBuyPrice, vc))
+}
+
+// DcaLastBuyPriceHasSuffix applies the HasSuffix predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldDcaLastBuyPrice, vc))
+}
+
+// DcaLastBuyPriceIsNil applies the IsNil predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaLastBuyPrice))
+}
+
+// DcaLastBuyPriceNotNil applies the NotNil predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaLastBuyPrice))
+}
+
+// DcaLastBuyPriceEqualFold applies the EqualFold predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldDcaLastBuyPrice, vc))
+}
+
+// DcaLastBuyPriceContainsFold applies the ContainsFold predicate on the "dcaLastBuyPrice" field.
+func DcaLastBuyPriceContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldDcaLastBuyPrice, vc))
+}
+
+// DcaLastSellTimeEQ applies the EQ predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldDcaLastSellTime, v))
+}
+
+// DcaLastSellTimeNEQ applies the NEQ predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldDcaLastSellTime, v))
+}
+
+// DcaLastSellTimeIn applies the In predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldDcaLastSellTime, vs...))
+}
+
+// DcaLastSellTimeNotIn applies the NotIn predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldDcaLastSellTime, vs...))
+}
+
+// DcaLastSellTimeGT applies the GT predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldDcaLastSellTime, v))
+}
+
+// DcaLastSellTimeGTE applies the GTE predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldDcaLastSellTime, v))
+}
+
+// DcaLastSellTimeLT applies the LT predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldDcaLastSellTime, v))
+}
+
+// DcaLastSellTimeLTE applies the LTE predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldDcaLastSellTime, v))
+}
+
+// DcaLastSellTimeIsNil applies the IsNil predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldDcaLastSellTime))
+}
+
+// DcaLastSellTimeNotNil applies the NotNil predicate on the "dcaLastSellTime" field.
+func DcaLastSellTimeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldDcaLastSellTime))
+}
+
 // EnableAutoBuyEQ applies the EQ predicate on the "enableAutoBuy" field.
 func EnableAutoBuyEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldEnableAutoBuy, v))
@@ -61,6 +61,20 @@ func (sc *StrategyCreate) SetUserId(i int64) *StrategyCreate {
 	return sc
 }
 
+// SetAccount sets the "account" field.
+func (sc *StrategyCreate) SetAccount(s string) *StrategyCreate {
+	sc.mutation.SetAccount(s)
+	return sc
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableAccount(s *string) *StrategyCreate {
+	if s != nil {
+		sc.SetAccount(*s)
+	}
+	return sc
+}
+
 // SetToken sets the "token" field.
 func (sc *StrategyCreate) SetToken(s string) *StrategyCreate {
 	sc.mutation.SetToken(s)
@@ -73,6 +87,20 @@ func (sc *StrategyCreate) SetSymbol(s string) *StrategyCreate {
 	return sc
 }
 
+// SetType sets the "type" field.
+func (sc *StrategyCreate) SetType(s strategy.Type) *StrategyCreate {
+	sc.mutation.SetType(s)
+	return sc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableType(s *strategy.Type) *StrategyCreate {
+	if s != nil {
+		sc.SetType(*s)
+	}
+	return sc
+}
+
 // SetMartinFactor sets the "martinFactor" field.
 func (sc *StrategyCreate) SetMartinFactor(f float64) *StrategyCreate {
 	sc.mutation.SetMartinFactor(f)
@@ -111,6 +139,48 @@ func (sc *StrategyCreate) SetLowerPriceBound(d decimal.Decimal) *StrategyCreate
 	return sc
 }
 
+// SetGridMode sets the "gridMode" field.
+func (sc *StrategyCreate) SetGridMode(sm strategy.GridMode) *StrategyCreate {
+	sc.mutation.SetGridMode(sm)
+	return sc
+}
+
+// SetNillableGridMode sets the "gridMode" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableGridMode(sm *strategy.GridMode) *StrategyCreate {
+	if sm != nil {
+		sc.SetGridMode(*sm)
+	}
+	return sc
+}
+
+// SetGridCount sets the "gridCount" field.
+func (sc *StrategyCreate) SetGridCount(i int) *StrategyCreate {
+	sc.mutation.SetGridCount(i)
+	return sc
+}
+
+// SetNillableGridCount sets the "gridCount" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableGridCount(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetGridCount(*i)
+	}
+	return sc
+}
+
+// SetGridStep sets the "gridStep" field.
+func (sc *StrategyCreate) SetGridStep(d decimal.Decimal) *StrategyCreate {
+	sc.mutation.SetGridStep(d)
+	return sc
+}
+
+// SetNillableGridStep sets the "gridStep" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableGridStep(d *decimal.Decimal) *StrategyCreate {
+	if d != nil {
+		sc.SetGridStep(*d)
+	}
+	return sc
+}
+
 // SetInitialOrderSize sets the "initialOrderSize" field.
 func (sc *StrategyCreate) SetInitialOrderSize(d decimal.Decimal) *StrategyCreate {
 	sc.mutation.SetInitialOrderSize(d)
@@ -229,6 +299,20 @@ func (sc *StrategyCreate) SetNillableDynamicStopLoss(b *bool) *StrategyCreate {
 	return sc
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (sc *StrategyCreate) SetPaperTrading(b bool) *StrategyCreate {
+	sc.mutation.SetPaperTrading(b)
+	return sc
+}
+
+// SetNillablePaperTrading sets the "paperTrading" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillablePaperTrading(b *bool) *StrategyCreate {
+	if b != nil {
+		sc.SetPaperTrading(*b)
+	}
+	return sc
+}
+
 // SetDropOn sets the "dropOn" field.
 func (sc *StrategyCreate) SetDropOn(b bool) *StrategyCreate {
 	sc.mutation.SetDropOn(b)
@@ -271,6 +355,188 @@ func (sc *StrategyCreate) SetNillableDropThreshold(d *decimal.Decimal) *Strategy
 	return sc
 }
 
+// SetTrailingOn sets the "trailingOn" field.
+func (sc *StrategyCreate) SetTrailingOn(b bool) *StrategyCreate {
+	sc.mutation.SetTrailingOn(b)
+	return sc
+}
+
+// SetNillableTrailingOn sets the "trailingOn" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableTrailingOn(b *bool) *StrategyCreate {
+	if b != nil {
+		sc.SetTrailingOn(*b)
+	}
+	return sc
+}
+
+// SetTrailingCandles sets the "trailingCandles" field.
+func (sc *StrategyCreate) SetTrailingCandles(i int) *StrategyCreate {
+	sc.mutation.SetTrailingCandles(i)
+	return sc
+}
+
+// SetNillableTrailingCandles sets the "trailingCandles" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableTrailingCandles(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetTrailingCandles(*i)
+	}
+	return sc
+}
+
+// SetTrailingMaxShifts sets the "trailingMaxShifts" field.
+func (sc *StrategyCreate) SetTrailingMaxShifts(i int) *StrategyCreate {
+	sc.mutation.SetTrailingMaxShifts(i)
+	return sc
+}
+
+// SetNillableTrailingMaxShifts sets the "trailingMaxShifts" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableTrailingMaxShifts(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetTrailingMaxShifts(*i)
+	}
+	return sc
+}
+
+// SetTrailingShifts sets the "trailingShifts" field.
+func (sc *StrategyCreate) SetTrailingShifts(i int) *StrategyCreate {
+	sc.mutation.SetTrailingShifts(i)
+	return sc
+}
+
+// SetNillableTrailingShifts sets the "trailingShifts" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableTrailingShifts(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetTrailingShifts(*i)
+	}
+	return sc
+}
+
+// SetBuyConditions sets the "buyConditions" field.
+func (sc *StrategyCreate) SetBuyConditions(s string) *StrategyCreate {
+	sc.mutation.SetBuyConditions(s)
+	return sc
+}
+
+// SetNillableBuyConditions sets the "buyConditions" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableBuyConditions(s *string) *StrategyCreate {
+	if s != nil {
+		sc.SetBuyConditions(*s)
+	}
+	return sc
+}
+
+// SetDcaInterval sets the "dcaInterval" field.
+func (sc *StrategyCreate) SetDcaInterval(i int) *StrategyCreate {
+	sc.mutation.SetDcaInterval(i)
+	return sc
+}
+
+// SetNillableDcaInterval sets the "dcaInterval" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaInterval(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetDcaInterval(*i)
+	}
+	return sc
+}
+
+// SetDcaDropRatio sets the "dcaDropRatio" field.
+func (sc *StrategyCreate) SetDcaDropRatio(d decimal.Decimal) *StrategyCreate {
+	sc.mutation.SetDcaDropRatio(d)
+	return sc
+}
+
+// SetNillableDcaDropRatio sets the "dcaDropRatio" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaDropRatio(d *decimal.Decimal) *StrategyCreate {
+	if d != nil {
+		sc.SetDcaDropRatio(*d)
+	}
+	return sc
+}
+
+// SetDcaMaxOrders sets the "dcaMaxOrders" field.
+func (sc *StrategyCreate) SetDcaMaxOrders(i int) *StrategyCreate {
+	sc.mutation.SetDcaMaxOrders(i)
+	return sc
+}
+
+// SetNillableDcaMaxOrders sets the "dcaMaxOrders" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaMaxOrders(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetDcaMaxOrders(*i)
+	}
+	return sc
+}
+
+// SetDcaSellInterval sets the "dcaSellInterval" field.
+func (sc *StrategyCreate) SetDcaSellInterval(i int) *StrategyCreate {
+	sc.mutation.SetDcaSellInterval(i)
+	return sc
+}
+
+// SetNillableDcaSellInterval sets the "dcaSellInterval" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaSellInterval(i *int) *StrategyCreate {
+	if i != nil {
+		sc.SetDcaSellInterval(*i)
+	}
+	return sc
+}
+
+// SetDcaSellProfitRatio sets the "dcaSellProfitRatio" field.
+func (sc *StrategyCreate) SetDcaSellProfitRatio(d decimal.Decimal) *StrategyCreate {
+	sc.mutation.SetDcaSellProfitRatio(d)
+	return sc
+}
+
+// SetNillableDcaSellProfitRatio sets the "dcaSellProfitRatio" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaSellProfitRatio(d *decimal.Decimal) *StrategyCreate {
+	if d != nil {
+		sc.SetDcaSellProfitRatio(*d)
+	}
+	return sc
+}
+
+// SetDcaLastBuyTime sets the "dcaLastBuyTime" field.
+func (sc *StrategyCreate) SetDcaLastBuyTime(t time.Time) *StrategyCreate {
+	sc.mutation.SetDcaLastBuyTime(t)
+	return sc
+}
+
+// SetNillableDcaLastBuyTime sets the "dcaLastBuyTime" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaLastBuyTime(t *time.Time) *StrategyCreate {
+	if t != nil {
+		sc.SetDcaLastBuyTime(*t)
+	}
+	return sc
+}
+
+// SetDcaLastBuyPrice sets the "dcaLastBuyPrice" field.
+func (sc *StrategyCreate) SetDcaLastBuyPrice(d decimal.Decimal) *StrategyCreate {
+	sc.mutation.SetDcaLastBuyPrice(d)
+	return sc
+}
+
+// SetNillableDcaLastBuyPrice sets the "dcaLastBuyPrice" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaLastBuyPrice(d *decimal.Decimal) *StrategyCreate {
+	if d != nil {
+		sc.SetDcaLastBuyPrice(*d)
+	}
+	return sc
+}
+
+// SetDcaLastSellTime sets the "dcaLastSellTime" field.
+func (sc *StrategyCreate) SetDcaLastSellTime(t time.Time) *StrategyCreate {
+	sc.mutation.SetDcaLastSellTime(t)
+	return sc
+}
+
+// SetNillableDcaLastSellTime sets the "dcaLastSellTime" field if the given value is not nil.
+func (sc *StrategyCreate) SetNillableDcaLastSellTime(t *time.Time) *StrategyCreate {
+	if t != nil {
+		sc.SetDcaLastSellTime(*t)
+	}
+	return sc
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (sc *StrategyCreate) SetEnableAutoBuy(b bool) *StrategyCreate {
 	sc.mutation.SetEnableAutoBuy(b)
@@ -386,10 +652,46 @@ func (sc *StrategyCreate) defaults() {
 		v := strategy.DefaultUpdateTime()
 		sc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		v := strategy.DefaultType
+		sc.mutation.SetType(v)
+	}
+	if _, ok := sc.mutation.GridMode(); !ok {
+		v := strategy.DefaultGridMode
+		sc.mutation.SetGridMode(v)
+	}
+	if _, ok := sc.mutation.GridCount(); !ok {
+		v := strategy.DefaultGridCount
+		sc.mutation.SetGridCount(v)
+	}
 	if _, ok := sc.mutation.CandlesToCheck(); !ok {
 		v := strategy.DefaultCandlesToCheck
 		sc.mutation.SetCandlesToCheck(v)
 	}
+	if _, ok := sc.mutation.TrailingCandles(); !ok {
+		v := strategy.DefaultTrailingCandles
+		sc.mutation.SetTrailingCandles(v)
+	}
+	if _, ok := sc.mutation.TrailingMaxShifts(); !ok {
+		v := strategy.DefaultTrailingMaxShifts
+		sc.mutation.SetTrailingMaxShifts(v)
+	}
+	if _, ok := sc.mutation.TrailingShifts(); !ok {
+		v := strategy.DefaultTrailingShifts
+		sc.mutation.SetTrailingShifts(v)
+	}
+	if _, ok := sc.mutation.DcaInterval(); !ok {
+		v := strategy.DefaultDcaInterval
+		sc.mutation.SetDcaInterval(v)
+	}
+	if _, ok := sc.mutation.DcaMaxOrders(); !ok {
+		v := strategy.DefaultDcaMaxOrders
+		sc.mutation.SetDcaMaxOrders(v)
+	}
+	if _, ok := sc.mutation.DcaSellInterval(); !ok {
+		v := strategy.DefaultDcaSellInterval
+		sc.mutation.SetDcaSellInterval(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -411,6 +713,11 @@ func (sc *StrategyCreate) check() error {
 	if _, ok := sc.mutation.UserId(); !ok {
 		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "Strategy.userId"`)}
 	}
+	if v, ok := sc.mutation.Account(); ok {
+		if err := strategy.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "Strategy.account": %w`, err)}
+		}
+	}
 	if _, ok := sc.mutation.Token(); !ok {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Strategy.token"`)}
 	}
@@ -427,6 +734,14 @@ func (sc *StrategyCreate) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Strategy.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := strategy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Strategy.type": %w`, err)}
+		}
+	}
 	if _, ok := sc.mutation.MartinFactor(); !ok {
 		return &ValidationError{Name: "martinFactor", err: errors.New(`ent: missing required field "Strategy.martinFactor"`)}
 	}
@@ -449,6 +764,14 @@ func (sc *StrategyCreate) check() error {
 	if _, ok := sc.mutation.LowerPriceBound(); !ok {
 		return &ValidationError{Name: "lowerPriceBound", err: errors.New(`ent: missing required field "Strategy.lowerPriceBound"`)}
 	}
+	if _, ok := sc.mutation.GridMode(); !ok {
+		return &ValidationError{Name: "gridMode", err: errors.New(`ent: missing required field "Strategy.gridMode"`)}
+	}
+	if v, ok := sc.mutation.GridMode(); ok {
+		if err := strategy.GridModeValidator(v); err != nil {
+			return &ValidationError{Name: "gridMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridMode": %w`, err)}
+		}
+	}
 	if _, ok := sc.mutation.InitialOrderSize(); !ok {
 		return &ValidationError{Name: "initialOrderSize", err: errors.New(`ent: missing required field "Strategy.initialOrderSize"`)}
 	}
@@ -514,6 +837,10 @@ func (sc *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldUserId, field.TypeInt64, value)
 		_node.UserId = value
 	}
+	if value, ok := sc.mutation.Account(); ok {
+		_spec.SetField(strategy.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
 	if value, ok := sc.mutation.Token(); ok {
 		_spec.SetField(strategy.FieldToken, field.TypeString, value)
 		_node.Token = value
@@ -522,6 +849,10 @@ func (sc *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
 		_node.Symbol = value
 	}
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.SetField(strategy.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
 	if value, ok := sc.mutation.MartinFactor(); ok {
 		_spec.SetField(strategy.FieldMartinFactor, field.TypeFloat64, value)
 		_node.MartinFactor = value
@@ -542,6 +873,18 @@ func (sc *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldLowerPriceBound, field.TypeString, value)
 		_node.LowerPriceBound = value
 	}
+	if value, ok := sc.mutation.GridMode(); ok {
+		_spec.SetField(strategy.FieldGridMode, field.TypeEnum, value)
+		_node.GridMode = value
+	}
+	if value, ok := sc.mutation.GridCount(); ok {
+		_spec.SetField(strategy.FieldGridCount, field.TypeInt, value)
+		_node.GridCount = value
+	}
+	if value, ok := sc.mutation.GridStep(); ok {
+		_spec.SetField(strategy.FieldGridStep, field.TypeString, value)
+		_node.GridStep = &value
+	}
 	if value, ok := sc.mutation.InitialOrderSize(); ok {
 		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
 		_node.InitialOrderSize = value
@@ -578,6 +921,10 @@ func (sc *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldDynamicStopLoss, field.TypeBool, value)
 		_node.DynamicStopLoss = value
 	}
+	if value, ok := sc.mutation.PaperTrading(); ok {
+		_spec.SetField(strategy.FieldPaperTrading, field.TypeBool, value)
+		_node.PaperTrading = value
+	}
 	if value, ok := sc.mutation.DropOn(); ok {
 		_spec.SetField(strategy.FieldDropOn, field.TypeBool, value)
 		_node.DropOn = value
@@ -590,6 +937,58 @@ func (sc *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldDropThreshold, field.TypeString, value)
 		_node.DropThreshold = &value
 	}
+	if value, ok := sc.mutation.TrailingOn(); ok {
+		_spec.SetField(strategy.FieldTrailingOn, field.TypeBool, value)
+		_node.TrailingOn = value
+	}
+	if value, ok := sc.mutation.TrailingCandles(); ok {
+		_spec.SetField(strategy.FieldTrailingCandles, field.TypeInt, value)
+		_node.TrailingCandles = value
+	}
+	if value, ok := sc.mutation.TrailingMaxShifts(); ok {
+		_spec.SetField(strategy.FieldTrailingMaxShifts, field.TypeInt, value)
+		_node.TrailingMaxShifts = value
+	}
+	if value, ok := sc.mutation.TrailingShifts(); ok {
+		_spec.SetField(strategy.FieldTrailingShifts, field.TypeInt, value)
+		_node.TrailingShifts = value
+	}
+	if value, ok := sc.mutation.BuyConditions(); ok {
+		_spec.SetField(strategy.FieldBuyConditions, field.TypeString, value)
+		_node.BuyConditions = value
+	}
+	if value, ok := sc.mutation.DcaInterval(); ok {
+		_spec.SetField(strategy.FieldDcaInterval, field.TypeInt, value)
+		_node.DcaInterval = value
+	}
+	if value, ok := sc.mutation.DcaDropRatio(); ok {
+		_spec.SetField(strategy.FieldDcaDropRatio, field.TypeString, value)
+		_node.DcaDropRatio = &value
+	}
+	if value, ok := sc.mutation.DcaMaxOrders(); ok {
+		_spec.SetField(strategy.FieldDcaMaxOrders, field.TypeInt, value)
+		_node.DcaMaxOrders = value
+	}
+	if value, ok := sc.mutation.DcaSellInterval(); ok {
+		_spec.SetField(strategy.FieldDcaSellInterval, field.TypeInt, value)
+		_node.DcaSellInterval = value
+	}
+	if value, ok := sc.mutation.DcaSellProfitRatio(); ok {
+		_spec.SetField(strategy.FieldDcaSellProfitRatio, field.TypeString, value)
+		_node.DcaSellProfitRatio = &value
+	}
+	if value, ok := sc.mutation.DcaLastBuyTime(); ok {
+		_spec.SetField(strategy.FieldDcaLastBuyTime, field.TypeTime, value)
+		_node.DcaLastBuyTime = &value
+	}
+	if value, ok := sc.mutation.DcaLastBuyPrice(); ok {
+		_spec.SetField(strategy.FieldDcaLastBuyPrice, field.TypeString, value)
+		_node.DcaLastBuyPrice = &value
+	}
+	if value, ok := sc.mutation.DcaLastSellTime(); ok {
+		_spec.SetField(strategy.FieldDcaLastSellTime, field.TypeTime, value)
+		_node.DcaLastSellTime = &value
+	}
 	if value, ok := sc.mutation.EnableAutoBuy(); ok {
 		_spec.SetField(strategy.FieldEnableAutoBuy, field.TypeBool, value)
 		_node.EnableAutoBuy = value
@@ -70,6 +70,26 @@ func (su *StrategyUpdate) AddUserId(i int64) *StrategyUpdate {
 	return su
 }
 
+// SetAccount sets the "account" field.
+func (su *StrategyUpdate) SetAccount(s string) *StrategyUpdate {
+	su.mutation.SetAccount(s)
+	return su
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableAccount(s *string) *StrategyUpdate {
+	if s != nil {
+		su.SetAccount(*s)
+	}
+	return su
+}
+
+// ClearAccount clears the value of the "account" field.
+func (su *StrategyUpdate) ClearAccount() *StrategyUpdate {
+	su.mutation.ClearAccount()
+	return su
+}
+
 // SetToken sets the "token" field.
 func (su *StrategyUpdate) SetToken(s string) *StrategyUpdate {
 	su.mutation.SetToken(s)
@@ -98,6 +118,20 @@ func (su *StrategyUpdate) SetNillableSymbol(s *string) *StrategyUpdate {
 	return su
 }
 
+// SetType sets the "type" field.
+func (su *StrategyUpdate) SetType(s strategy.Type) *StrategyUpdate {
+	su.mutation.SetType(s)
+	return su
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableType(s *strategy.Type) *StrategyUpdate {
+	if s != nil {
+		su.SetType(*s)
+	}
+	return su
+}
+
 // SetMartinFactor sets the "martinFactor" field.
 func (su *StrategyUpdate) SetMartinFactor(f float64) *StrategyUpdate {
 	su.mutation.ResetMartinFactor()
@@ -188,6 +222,67 @@ func (su *StrategyUpdate) SetNillableLowerPriceBound(d *decimal.Decimal) *Strate
 	return su
 }
 
+// SetGridMode sets the "gridMode" field.
+func (su *StrategyUpdate) SetGridMode(sm strategy.GridMode) *StrategyUpdate {
+	su.mutation.SetGridMode(sm)
+	return su
+}
+
+// SetNillableGridMode sets the "gridMode" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableGridMode(sm *strategy.GridMode) *StrategyUpdate {
+	if sm != nil {
+		su.SetGridMode(*sm)
+	}
+	return su
+}
+
+// SetGridCount sets the "gridCount" field.
+func (su *StrategyUpdate) SetGridCount(i int) *StrategyUpdate {
+	su.mutation.ResetGridCount()
+	su.mutation.SetGridCount(i)
+	return su
+}
+
+// SetNillableGridCount sets the "gridCount" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableGridCount(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetGridCount(*i)
+	}
+	return su
+}
+
+// AddGridCount adds i to the "gridCount" field.
+func (su *StrategyUpdate) AddGridCount(i int) *StrategyUpdate {
+	su.mutation.AddGridCount(i)
+	return su
+}
+
+// ClearGridCount clears the value of the "gridCount" field.
+func (su *StrategyUpdate) ClearGridCount() *StrategyUpdate {
+	su.mutation.ClearGridCount()
+	return su
+}
+
+// SetGridStep sets the "gridStep" field.
+func (su *StrategyUpdate) SetGridStep(d decimal.Decimal) *StrategyUpdate {
+	su.mutation.SetGridStep(d)
+	return su
+}
+
+// SetNillableGridStep sets the "gridStep" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableGridStep(d *decimal.Decimal) *StrategyUpdate {
+	if d != nil {
+		su.SetGridStep(*d)
+	}
+	return su
+}
+
+// ClearGridStep clears the value of the "gridStep" field.
+func (su *StrategyUpdate) ClearGridStep() *StrategyUpdate {
+	su.mutation.ClearGridStep()
+	return su
+}
+
 // SetInitialOrderSize sets the "initialOrderSize" field.
 func (su *StrategyUpdate) SetInitialOrderSize(d decimal.Decimal) *StrategyUpdate {
 	su.mutation.SetInitialOrderSize(d)
@@ -369,6 +464,26 @@ func (su *StrategyUpdate) ClearDynamicStopLoss() *StrategyUpdate {
 	return su
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (su *StrategyUpdate) SetPaperTrading(b bool) *StrategyUpdate {
+	su.mutation.SetPaperTrading(b)
+	return su
+}
+
+// SetNillablePaperTrading sets the "paperTrading" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillablePaperTrading(b *bool) *StrategyUpdate {
+	if b != nil {
+		su.SetPaperTrading(*b)
+	}
+	return su
+}
+
+// ClearPaperTrading clears the value of the "paperTrading" field.
+func (su *StrategyUpdate) ClearPaperTrading() *StrategyUpdate {
+	su.mutation.ClearPaperTrading()
+	return su
+}
+
 // SetDropOn sets the "dropOn" field.
 func (su *StrategyUpdate) SetDropOn(b bool) *StrategyUpdate {
 	su.mutation.SetDropOn(b)
@@ -436,6 +551,308 @@ func (su *StrategyUpdate) ClearDropThreshold() *StrategyUpdate {
 	return su
 }
 
+// SetTrailingOn sets the "trailingOn" field.
+func (su *StrategyUpdate) SetTrailingOn(b bool) *StrategyUpdate {
+	su.mutation.SetTrailingOn(b)
+	return su
+}
+
+// SetNillableTrailingOn sets the "trailingOn" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableTrailingOn(b *bool) *StrategyUpdate {
+	if b != nil {
+		su.SetTrailingOn(*b)
+	}
+	return su
+}
+
+// ClearTrailingOn clears the value of the "trailingOn" field.
+func (su *StrategyUpdate) ClearTrailingOn() *StrategyUpdate {
+	su.mutation.ClearTrailingOn()
+	return su
+}
+
+// SetTrailingCandles sets the "trailingCandles" field.
+func (su *StrategyUpdate) SetTrailingCandles(i int) *StrategyUpdate {
+	su.mutation.ResetTrailingCandles()
+	su.mutation.SetTrailingCandles(i)
+	return su
+}
+
+// SetNillableTrailingCandles sets the "trailingCandles" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableTrailingCandles(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetTrailingCandles(*i)
+	}
+	return su
+}
+
+// AddTrailingCandles adds i to the "trailingCandles" field.
+func (su *StrategyUpdate) AddTrailingCandles(i int) *StrategyUpdate {
+	su.mutation.AddTrailingCandles(i)
+	return su
+}
+
+// ClearTrailingCandles clears the value of the "trailingCandles" field.
+func (su *StrategyUpdate) ClearTrailingCandles() *StrategyUpdate {
+	su.mutation.ClearTrailingCandles()
+	return su
+}
+
+// SetTrailingMaxShifts sets the "trailingMaxShifts" field.
+func (su *StrategyUpdate) SetTrailingMaxShifts(i int) *StrategyUpdate {
+	su.mutation.ResetTrailingMaxShifts()
+	su.mutation.SetTrailingMaxShifts(i)
+	return su
+}
+
+// SetNillableTrailingMaxShifts sets the "trailingMaxShifts" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableTrailingMaxShifts(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetTrailingMaxShifts(*i)
+	}
+	return su
+}
+
+// AddTrailingMaxShifts adds i to the "trailingMaxShifts" field.
+func (su *StrategyUpdate) AddTrailingMaxShifts(i int) *StrategyUpdate {
+	su.mutation.AddTrailingMaxShifts(i)
+	return su
+}
+
+// ClearTrailingMaxShifts clears the value of the "trailingMaxShifts" field.
+func (su *StrategyUpdate) ClearTrailingMaxShifts() *StrategyUpdate {
+	su.mutation.ClearTrailingMaxShifts()
+	return su
+}
+
+// SetTrailingShifts sets the "trailingShifts" field.
+func (su *StrategyUpdate) SetTrailingShifts(i int) *StrategyUpdate {
+	su.mutation.ResetTrailingShifts()
+	su.mutation.SetTrailingShifts(i)
+	return su
+}
+
+// SetNillableTrailingShifts sets the "trailingShifts" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableTrailingShifts(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetTrailingShifts(*i)
+	}
+	return su
+}
+
+// AddTrailingShifts adds i to the "trailingShifts" field.
+func (su *StrategyUpdate) AddTrailingShifts(i int) *StrategyUpdate {
+	su.mutation.AddTrailingShifts(i)
+	return su
+}
+
+// ClearTrailingShifts clears the value of the "trailingShifts" field.
+func (su *StrategyUpdate) ClearTrailingShifts() *StrategyUpdate {
+	su.mutation.ClearTrailingShifts()
+	return su
+}
+
+// SetBuyConditions sets the "buyConditions" field.
+func (su *StrategyUpdate) SetBuyConditions(s string) *StrategyUpdate {
+	su.mutation.SetBuyConditions(s)
+	return su
+}
+
+// SetNillableBuyConditions sets the "buyConditions" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableBuyConditions(s *string) *StrategyUpdate {
+	if s != nil {
+		su.SetBuyConditions(*s)
+	}
+	return su
+}
+
+// ClearBuyConditions clears the value of the "buyConditions" field.
+func (su *StrategyUpdate) ClearBuyConditions() *StrategyUpdate {
+	su.mutation.ClearBuyConditions()
+	return su
+}
+
+// SetDcaInterval sets the "dcaInterval" field.
+func (su *StrategyUpdate) SetDcaInterval(i int) *StrategyUpdate {
+	su.mutation.ResetDcaInterval()
+	su.mutation.SetDcaInterval(i)
+	return su
+}
+
+// SetNillableDcaInterval sets the "dcaInterval" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaInterval(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetDcaInterval(*i)
+	}
+	return su
+}
+
+// AddDcaInterval adds i to the "dcaInterval" field.
+func (su *StrategyUpdate) AddDcaInterval(i int) *StrategyUpdate {
+	su.mutation.AddDcaInterval(i)
+	return su
+}
+
+// ClearDcaInterval clears the value of the "dcaInterval" field.
+func (su *StrategyUpdate) ClearDcaInterval() *StrategyUpdate {
+	su.mutation.ClearDcaInterval()
+	return su
+}
+
+// SetDcaDropRatio sets the "dcaDropRatio" field.
+func (su *StrategyUpdate) SetDcaDropRatio(d decimal.Decimal) *StrategyUpdate {
+	su.mutation.SetDcaDropRatio(d)
+	return su
+}
+
+// SetNillableDcaDropRatio sets the "dcaDropRatio" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaDropRatio(d *decimal.Decimal) *StrategyUpdate {
+	if d != nil {
+		su.SetDcaDropRatio(*d)
+	}
+	return su
+}
+
+// ClearDcaDropRatio clears the value of the "dcaDropRatio" field.
+func (su *StrategyUpdate) ClearDcaDropRatio() *StrategyUpdate {
+	su.mutation.ClearDcaDropRatio()
+	return su
+}
+
+// SetDcaMaxOrders sets the "dcaMaxOrders" field.
+func (su *StrategyUpdate) SetDcaMaxOrders(i int) *StrategyUpdate {
+	su.mutation.ResetDcaMaxOrders()
+	su.mutation.SetDcaMaxOrders(i)
+	return su
+}
+
+// SetNillableDcaMaxOrders sets the "dcaMaxOrders" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaMaxOrders(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetDcaMaxOrders(*i)
+	}
+	return su
+}
+
+// AddDcaMaxOrders adds i to the "dcaMaxOrders" field.
+func (su *StrategyUpdate) AddDcaMaxOrders(i int) *StrategyUpdate {
+	su.mutation.AddDcaMaxOrders(i)
+	return su
+}
+
+// ClearDcaMaxOrders clears the value of the "dcaMaxOrders" field.
+func (su *StrategyUpdate) ClearDcaMaxOrders() *StrategyUpdate {
+	su.mutation.ClearDcaMaxOrders()
+	return su
+}
+
+// SetDcaSellInterval sets the "dcaSellInterval" field.
+func (su *StrategyUpdate) SetDcaSellInterval(i int) *StrategyUpdate {
+	su.mutation.ResetDcaSellInterval()
+	su.mutation.SetDcaSellInterval(i)
+	return su
+}
+
+// SetNillableDcaSellInterval sets the "dcaSellInterval" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaSellInterval(i *int) *StrategyUpdate {
+	if i != nil {
+		su.SetDcaSellInterval(*i)
+	}
+	return su
+}
+
+// AddDcaSellInterval adds i to the "dcaSellInterval" field.
+func (su *StrategyUpdate) AddDcaSellInterval(i int) *StrategyUpdate {
+	su.mutation.AddDcaSellInterval(i)
+	return su
+}
+
+// ClearDcaSellInterval clears the value of the "dcaSellInterval" field.
+func (su *StrategyUpdate) ClearDcaSellInterval() *StrategyUpdate {
+	su.mutation.ClearDcaSellInterval()
+	return su
+}
+
+// SetDcaSellProfitRatio sets the "dcaSellProfitRatio" field.
+func (su *StrategyUpdate) SetDcaSellProfitRatio(d decimal.Decimal) *StrategyUpdate {
+	su.mutation.SetDcaSellProfitRatio(d)
+	return su
+}
+
+// SetNillableDcaSellProfitRatio sets the "dcaSellProfitRatio" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaSellProfitRatio(d *decimal.Decimal) *StrategyUpdate {
+	if d != nil {
+		su.SetDcaSellProfitRatio(*d)
+	}
+	return su
+}
+
+// ClearDcaSellProfitRatio clears the value of the "dcaSellProfitRatio" field.
+func (su *StrategyUpdate) ClearDcaSellProfitRatio() *StrategyUpdate {
+	su.mutation.ClearDcaSellProfitRatio()
+	return su
+}
+
+// SetDcaLastBuyTime sets the "dcaLastBuyTime" field.
+func (su *StrategyUpdate) SetDcaLastBuyTime(t time.Time) *StrategyUpdate {
+	su.mutation.SetDcaLastBuyTime(t)
+	return su
+}
+
+// SetNillableDcaLastBuyTime sets the "dcaLastBuyTime" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaLastBuyTime(t *time.Time) *StrategyUpdate {
+	if t != nil {
+		su.SetDcaLastBuyTime(*t)
+	}
+	return su
+}
+
+// ClearDcaLastBuyTime clears the value of the "dcaLastBuyTime" field.
+func (su *StrategyUpdate) ClearDcaLastBuyTime() *StrategyUpdate {
+	su.mutation.ClearDcaLastBuyTime()
+	return su
+}
+
+// SetDcaLastBuyPrice sets the "dcaLastBuyPrice" field.
+func (su *StrategyUpdate) SetDcaLastBuyPrice(d decimal.Decimal) *StrategyUpdate {
+	su.mutation.SetDcaLastBuyPrice(d)
+	return su
+}
+
+// SetNillableDcaLastBuyPrice sets the "dcaLastBuyPrice" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaLastBuyPrice(d *decimal.Decimal) *StrategyUpdate {
+	if d != nil {
+		su.SetDcaLastBuyPrice(*d)
+	}
+	return su
+}
+
+// ClearDcaLastBuyPrice clears the value of the "dcaLastBuyPrice" field.
+func (su *StrategyUpdate) ClearDcaLastBuyPrice() *StrategyUpdate {
+	su.mutation.ClearDcaLastBuyPrice()
+	return su
+}
+
+// SetDcaLastSellTime sets the "dcaLastSellTime" field.
+func (su *StrategyUpdate) SetDcaLastSellTime(t time.Time) *StrategyUpdate {
+	su.mutation.SetDcaLastSellTime(t)
+	return su
+}
+
+// SetNillableDcaLastSellTime sets the "dcaLastSellTime" field if the given value is not nil.
+func (su *StrategyUpdate) SetNillableDcaLastSellTime(t *time.Time) *StrategyUpdate {
+	if t != nil {
+		su.SetDcaLastSellTime(*t)
+	}
+	return su
+}
+
+// ClearDcaLastSellTime clears the value of the "dcaLastSellTime" field.
+func (su *StrategyUpdate) ClearDcaLastSellTime() *StrategyUpdate {
+	su.mutation.ClearDcaLastSellTime()
+	return su
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (su *StrategyUpdate) SetEnableAutoBuy(b bool) *StrategyUpdate {
 	su.mutation.SetEnableAutoBuy(b)
@@ -614,6 +1031,11 @@ func (su *StrategyUpdate) check() error {
 			return &ValidationError{Name: "guid", err: fmt.Errorf(`ent: validator failed for field "Strategy.guid": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.Account(); ok {
+		if err := strategy.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "Strategy.account": %w`, err)}
+		}
+	}
 	if v, ok := su.mutation.Token(); ok {
 		if err := strategy.TokenValidator(v); err != nil {
 			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Strategy.token": %w`, err)}
@@ -624,6 +1046,11 @@ func (su *StrategyUpdate) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.GetType(); ok {
+		if err := strategy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Strategy.type": %w`, err)}
+		}
+	}
 	if v, ok := su.mutation.MartinFactor(); ok {
 		if err := strategy.MartinFactorValidator(v); err != nil {
 			return &ValidationError{Name: "martinFactor", err: fmt.Errorf(`ent: validator failed for field "Strategy.martinFactor": %w`, err)}
@@ -634,6 +1061,11 @@ func (su *StrategyUpdate) check() error {
 			return &ValidationError{Name: "maxGridLimit", err: fmt.Errorf(`ent: validator failed for field "Strategy.maxGridLimit": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.GridMode(); ok {
+		if err := strategy.GridModeValidator(v); err != nil {
+			return &ValidationError{Name: "gridMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridMode": %w`, err)}
+		}
+	}
 	if v, ok := su.mutation.Status(); ok {
 		if err := strategy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
@@ -666,12 +1098,21 @@ func (su *StrategyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.AddedUserId(); ok {
 		_spec.AddField(strategy.FieldUserId, field.TypeInt64, value)
 	}
+	if value, ok := su.mutation.Account(); ok {
+		_spec.SetField(strategy.FieldAccount, field.TypeString, value)
+	}
+	if su.mutation.AccountCleared() {
+		_spec.ClearField(strategy.FieldAccount, field.TypeString)
+	}
 	if value, ok := su.mutation.Token(); ok {
 		_spec.SetField(strategy.FieldToken, field.TypeString, value)
 	}
 	if value, ok := su.mutation.Symbol(); ok {
 		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
 	}
+	if value, ok := su.mutation.GetType(); ok {
+		_spec.SetField(strategy.FieldType, field.TypeEnum, value)
+	}
 	if value, ok := su.mutation.MartinFactor(); ok {
 		_spec.SetField(strategy.FieldMartinFactor, field.TypeFloat64, value)
 	}
@@ -696,6 +1137,24 @@ func (su *StrategyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.LowerPriceBound(); ok {
 		_spec.SetField(strategy.FieldLowerPriceBound, field.TypeString, value)
 	}
+	if value, ok := su.mutation.GridMode(); ok {
+		_spec.SetField(strategy.FieldGridMode, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.GridCount(); ok {
+		_spec.SetField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedGridCount(); ok {
+		_spec.AddField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if su.mutation.GridCountCleared() {
+		_spec.ClearField(strategy.FieldGridCount, field.TypeInt)
+	}
+	if value, ok := su.mutation.GridStep(); ok {
+		_spec.SetField(strategy.FieldGridStep, field.TypeString, value)
+	}
+	if su.mutation.GridStepCleared() {
+		_spec.ClearField(strategy.FieldGridStep, field.TypeString)
+	}
 	if value, ok := su.mutation.InitialOrderSize(); ok {
 		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
 	}
@@ -750,6 +1209,12 @@ func (su *StrategyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.DynamicStopLossCleared() {
 		_spec.ClearField(strategy.FieldDynamicStopLoss, field.TypeBool)
 	}
+	if value, ok := su.mutation.PaperTrading(); ok {
+		_spec.SetField(strategy.FieldPaperTrading, field.TypeBool, value)
+	}
+	if su.mutation.PaperTradingCleared() {
+		_spec.ClearField(strategy.FieldPaperTrading, field.TypeBool)
+	}
 	if value, ok := su.mutation.DropOn(); ok {
 		_spec.SetField(strategy.FieldDropOn, field.TypeBool, value)
 	}
@@ -771,6 +1236,102 @@ func (su *StrategyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.DropThresholdCleared() {
 		_spec.ClearField(strategy.FieldDropThreshold, field.TypeString)
 	}
+	if value, ok := su.mutation.TrailingOn(); ok {
+		_spec.SetField(strategy.FieldTrailingOn, field.TypeBool, value)
+	}
+	if su.mutation.TrailingOnCleared() {
+		_spec.ClearField(strategy.FieldTrailingOn, field.TypeBool)
+	}
+	if value, ok := su.mutation.TrailingCandles(); ok {
+		_spec.SetField(strategy.FieldTrailingCandles, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedTrailingCandles(); ok {
+		_spec.AddField(strategy.FieldTrailingCandles, field.TypeInt, value)
+	}
+	if su.mutation.TrailingCandlesCleared() {
+		_spec.ClearField(strategy.FieldTrailingCandles, field.TypeInt)
+	}
+	if value, ok := su.mutation.TrailingMaxShifts(); ok {
+		_spec.SetField(strategy.FieldTrailingMaxShifts, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedTrailingMaxShifts(); ok {
+		_spec.AddField(strategy.FieldTrailingMaxShifts, field.TypeInt, value)
+	}
+	if su.mutation.TrailingMaxShiftsCleared() {
+		_spec.ClearField(strategy.FieldTrailingMaxShifts, field.TypeInt)
+	}
+	if value, ok := su.mutation.TrailingShifts(); ok {
+		_spec.SetField(strategy.FieldTrailingShifts, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedTrailingShifts(); ok {
+		_spec.AddField(strategy.FieldTrailingShifts, field.TypeInt, value)
+	}
+	if su.mutation.TrailingShiftsCleared() {
+		_spec.ClearField(strategy.FieldTrailingShifts, field.TypeInt)
+	}
+	if value, ok := su.mutation.BuyConditions(); ok {
+		_spec.SetField(strategy.FieldBuyConditions, field.TypeString, value)
+	}
+	if su.mutation.BuyConditionsCleared() {
+		_spec.ClearField(strategy.FieldBuyConditions, field.TypeString)
+	}
+	if value, ok := su.mutation.DcaInterval(); ok {
+		_spec.SetField(strategy.FieldDcaInterval, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDcaInterval(); ok {
+		_spec.AddField(strategy.FieldDcaInterval, field.TypeInt, value)
+	}
+	if su.mutation.DcaIntervalCleared() {
+		_spec.ClearField(strategy.FieldDcaInterval, field.TypeInt)
+	}
+	if value, ok := su.mutation.DcaDropRatio(); ok {
+		_spec.SetField(strategy.FieldDcaDropRatio, field.TypeString, value)
+	}
+	if su.mutation.DcaDropRatioCleared() {
+		_spec.ClearField(strategy.FieldDcaDropRatio, field.TypeString)
+	}
+	if value, ok := su.mutation.DcaMaxOrders(); ok {
+		_spec.SetField(strategy.FieldDcaMaxOrders, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDcaMaxOrders(); ok {
+		_spec.AddField(strategy.FieldDcaMaxOrders, field.TypeInt, value)
+	}
+	if su.mutation.DcaMaxOrdersCleared() {
+		_spec.ClearField(strategy.FieldDcaMaxOrders, field.TypeInt)
+	}
+	if value, ok := su.mutation.DcaSellInterval(); ok {
+		_spec.SetField(strategy.FieldDcaSellInterval, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDcaSellInterval(); ok {
+		_spec.AddField(strategy.FieldDcaSellInterval, field.TypeInt, value)
+	}
+	if su.mutation.DcaSellIntervalCleared() {
+		_spec.ClearField(strategy.FieldDcaSellInterval, field.TypeInt)
+	}
+	if value, ok := su.mutation.DcaSellProfitRatio(); ok {
+		_spec.SetField(strategy.FieldDcaSellProfitRatio, field.TypeString, value)
+	}
+	if su.mutation.DcaSellProfitRatioCleared() {
+		_spec.ClearField(strategy.FieldDcaSellProfitRatio, field.TypeString)
+	}
+	if value, ok := su.mutation.DcaLastBuyTime(); ok {
+		_spec.SetField(strategy.FieldDcaLastBuyTime, field.TypeTime, value)
+	}
+	if su.mutation.DcaLastBuyTimeCleared() {
+		_spec.ClearField(strategy.FieldDcaLastBuyTime, field.TypeTime)
+	}
+	if value, ok := su.mutation.DcaLastBuyPrice(); ok {
+		_spec.SetField(strategy.FieldDcaLastBuyPrice, field.TypeString, value)
+	}
+	if su.mutation.DcaLastBuyPriceCleared() {
+		_spec.ClearField(strategy.FieldDcaLastBuyPrice, field.TypeString)
+	}
+	if value, ok := su.mutation.DcaLastSellTime(); ok {
+		_spec.SetField(strategy.FieldDcaLastSellTime, field.TypeTime, value)
+	}
+	if su.mutation.DcaLastSellTimeCleared() {
+		_spec.ClearField(strategy.FieldDcaLastSellTime, field.TypeTime)
+	}
 	if value, ok := su.mutation.EnableAutoBuy(); ok {
 		_spec.SetField(strategy.FieldEnableAutoBuy, field.TypeBool, value)
 	}
@@ -865,6 +1426,26 @@ func (suo *StrategyUpdateOne) AddUserId(i int64) *StrategyUpdateOne {
 	return suo
 }
 
+// SetAccount sets the "account" field.
+func (suo *StrategyUpdateOne) SetAccount(s string) *StrategyUpdateOne {
+	suo.mutation.SetAccount(s)
+	return suo
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableAccount(s *string) *StrategyUpdateOne {
+	if s != nil {
+		suo.SetAccount(*s)
+	}
+	return suo
+}
+
+// ClearAccount clears the value of the "account" field.
+func (suo *StrategyUpdateOne) ClearAccount() *StrategyUpdateOne {
+	suo.mutation.ClearAccount()
+	return suo
+}
+
 // SetToken sets the "token" field.
 func (suo *StrategyUpdateOne) SetToken(s string) *StrategyUpdateOne {
 	suo.mutation.SetToken(s)
@@ -885,10 +1466,24 @@ func (suo *StrategyUpdateOne) SetSymbol(s string) *StrategyUpdateOne {
 	return suo
 }
 
-// SetNillableSymbol sets the "symbol" field if the given value is not nil.
-func (suo *StrategyUpdateOne) SetNillableSymbol(s *string) *StrategyUpdateOne {
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableSymbol(s *string) *StrategyUpdateOne {
+	if s != nil {
+		suo.SetSymbol(*s)
+	}
+	return suo
+}
+
+// SetType sets the "type" field.
+func (suo *StrategyUpdateOne) SetType(s strategy.Type) *StrategyUpdateOne {
+	suo.mutation.SetType(s)
+	return suo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableType(s *strategy.Type) *StrategyUpdateOne {
 	if s != nil {
-		suo.SetSymbol(*s)
+		suo.SetType(*s)
 	}
 	return suo
 }
@@ -983,6 +1578,67 @@ func (suo *StrategyUpdateOne) SetNillableLowerPriceBound(d *decimal.Decimal) *St
 	return suo
 }
 
+// SetGridMode sets the "gridMode" field.
+func (suo *StrategyUpdateOne) SetGridMode(sm strategy.GridMode) *StrategyUpdateOne {
+	suo.mutation.SetGridMode(sm)
+	return suo
+}
+
+// SetNillableGridMode sets the "gridMode" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableGridMode(sm *strategy.GridMode) *StrategyUpdateOne {
+	if sm != nil {
+		suo.SetGridMode(*sm)
+	}
+	return suo
+}
+
+// SetGridCount sets the "gridCount" field.
+func (suo *StrategyUpdateOne) SetGridCount(i int) *StrategyUpdateOne {
+	suo.mutation.ResetGridCount()
+	suo.mutation.SetGridCount(i)
+	return suo
+}
+
+// SetNillableGridCount sets the "gridCount" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableGridCount(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetGridCount(*i)
+	}
+	return suo
+}
+
+// AddGridCount adds i to the "gridCount" field.
+func (suo *StrategyUpdateOne) AddGridCount(i int) *StrategyUpdateOne {
+	suo.mutation.AddGridCount(i)
+	return suo
+}
+
+// ClearGridCount clears the value of the "gridCount" field.
+func (suo *StrategyUpdateOne) ClearGridCount() *StrategyUpdateOne {
+	suo.mutation.ClearGridCount()
+	return suo
+}
+
+// SetGridStep sets the "gridStep" field.
+func (suo *StrategyUpdateOne) SetGridStep(d decimal.Decimal) *StrategyUpdateOne {
+	suo.mutation.SetGridStep(d)
+	return suo
+}
+
+// SetNillableGridStep sets the "gridStep" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableGridStep(d *decimal.Decimal) *StrategyUpdateOne {
+	if d != nil {
+		suo.SetGridStep(*d)
+	}
+	return suo
+}
+
+// ClearGridStep clears the value of the "gridStep" field.
+func (suo *StrategyUpdateOne) ClearGridStep() *StrategyUpdateOne {
+	suo.mutation.ClearGridStep()
+	return suo
+}
+
 // SetInitialOrderSize sets the "initialOrderSize" field.
 func (suo *StrategyUpdateOne) SetInitialOrderSize(d decimal.Decimal) *StrategyUpdateOne {
 	suo.mutation.SetInitialOrderSize(d)
@@ -1164,6 +1820,26 @@ func (suo *StrategyUpdateOne) ClearDynamicStopLoss() *StrategyUpdateOne {
 	return suo
 }
 
+// SetPaperTrading sets the "paperTrading" field.
+func (suo *StrategyUpdateOne) SetPaperTrading(b bool) *StrategyUpdateOne {
+	suo.mutation.SetPaperTrading(b)
+	return suo
+}
+
+// SetNillablePaperTrading sets the "paperTrading" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillablePaperTrading(b *bool) *StrategyUpdateOne {
+	if b != nil {
+		suo.SetPaperTrading(*b)
+	}
+	return suo
+}
+
+// ClearPaperTrading clears the value of the "paperTrading" field.
+func (suo *StrategyUpdateOne) ClearPaperTrading() *StrategyUpdateOne {
+	suo.mutation.ClearPaperTrading()
+	return suo
+}
+
 // SetDropOn sets the "dropOn" field.
 func (suo *StrategyUpdateOne) SetDropOn(b bool) *StrategyUpdateOne {
 	suo.mutation.SetDropOn(b)
@@ -1231,6 +1907,308 @@ func (suo *StrategyUpdateOne) ClearDropThreshold() *StrategyUpdateOne {
 	return suo
 }
 
+// SetTrailingOn sets the "trailingOn" field.
+func (suo *StrategyUpdateOne) SetTrailingOn(b bool) *StrategyUpdateOne {
+	suo.mutation.SetTrailingOn(b)
+	return suo
+}
+
+// SetNillableTrailingOn sets the "trailingOn" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableTrailingOn(b *bool) *StrategyUpdateOne {
+	if b != nil {
+		suo.SetTrailingOn(*b)
+	}
+	return suo
+}
+
+// ClearTrailingOn clears the value of the "trailingOn" field.
+func (suo *StrategyUpdateOne) ClearTrailingOn() *StrategyUpdateOne {
+	suo.mutation.ClearTrailingOn()
+	return suo
+}
+
+// SetTrailingCandles sets the "trailingCandles" field.
+func (suo *StrategyUpdateOne) SetTrailingCandles(i int) *StrategyUpdateOne {
+	suo.mutation.ResetTrailingCandles()
+	suo.mutation.SetTrailingCandles(i)
+	return suo
+}
+
+// SetNillableTrailingCandles sets the "trailingCandles" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableTrailingCandles(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetTrailingCandles(*i)
+	}
+	return suo
+}
+
+// AddTrailingCandles adds i to the "trailingCandles" field.
+func (suo *StrategyUpdateOne) AddTrailingCandles(i int) *StrategyUpdateOne {
+	suo.mutation.AddTrailingCandles(i)
+	return suo
+}
+
+// ClearTrailingCandles clears the value of the "trailingCandles" field.
+func (suo *StrategyUpdateOne) ClearTrailingCandles() *StrategyUpdateOne {
+	suo.mutation.ClearTrailingCandles()
+	return suo
+}
+
+// SetTrailingMaxShifts sets the "trailingMaxShifts" field.
+func (suo *StrategyUpdateOne) SetTrailingMaxShifts(i int) *StrategyUpdateOne {
+	suo.mutation.ResetTrailingMaxShifts()
+	suo.mutation.SetTrailingMaxShifts(i)
+	return suo
+}
+
+// SetNillableTrailingMaxShifts sets the "trailingMaxShifts" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableTrailingMaxShifts(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetTrailingMaxShifts(*i)
+	}
+	return suo
+}
+
+// AddTrailingMaxShifts adds i to the "trailingMaxShifts" field.
+func (suo *StrategyUpdateOne) AddTrailingMaxShifts(i int) *StrategyUpdateOne {
+	suo.mutation.AddTrailingMaxShifts(i)
+	return suo
+}
+
+// ClearTrailingMaxShifts clears the value of the "trailingMaxShifts" field.
+func (suo *StrategyUpdateOne) ClearTrailingMaxShifts() *StrategyUpdateOne {
+	suo.mutation.ClearTrailingMaxShifts()
+	return suo
+}
+
+// SetTrailingShifts sets the "trailingShifts" field.
+func (suo *StrategyUpdateOne) SetTrailingShifts(i int) *StrategyUpdateOne {
+	suo.mutation.ResetTrailingShifts()
+	suo.mutation.SetTrailingShifts(i)
+	return suo
+}
+
+// SetNillableTrailingShifts sets the "trailingShifts" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableTrailingShifts(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetTrailingShifts(*i)
+	}
+	return suo
+}
+
+// AddTrailingShifts adds i to the "trailingShifts" field.
+func (suo *StrategyUpdateOne) AddTrailingShifts(i int) *StrategyUpdateOne {
+	suo.mutation.AddTrailingShifts(i)
+	return suo
+}
+
+// ClearTrailingShifts clears the value of the "trailingShifts" field.
+func (suo *StrategyUpdateOne) ClearTrailingShifts() *StrategyUpdateOne {
+	suo.mutation.ClearTrailingShifts()
+	return suo
+}
+
+// SetBuyConditions sets the "buyConditions" field.
+func (suo *StrategyUpdateOne) SetBuyConditions(s string) *StrategyUpdateOne {
+	suo.mutation.SetBuyConditions(s)
+	return suo
+}
+
+// SetNillableBuyConditions sets the "buyConditions" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableBuyConditions(s *string) *StrategyUpdateOne {
+	if s != nil {
+		suo.SetBuyConditions(*s)
+	}
+	return suo
+}
+
+// ClearBuyConditions clears the value of the "buyConditions" field.
+func (suo *StrategyUpdateOne) ClearBuyConditions() *StrategyUpdateOne {
+	suo.mutation.ClearBuyConditions()
+	return suo
+}
+
+// SetDcaInterval sets the "dcaInterval" field.
+func (suo *StrategyUpdateOne) SetDcaInterval(i int) *StrategyUpdateOne {
+	suo.mutation.ResetDcaInterval()
+	suo.mutation.SetDcaInterval(i)
+	return suo
+}
+
+// SetNillableDcaInterval sets the "dcaInterval" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaInterval(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetDcaInterval(*i)
+	}
+	return suo
+}
+
+// AddDcaInterval adds i to the "dcaInterval" field.
+func (suo *StrategyUpdateOne) AddDcaInterval(i int) *StrategyUpdateOne {
+	suo.mutation.AddDcaInterval(i)
+	return suo
+}
+
+// ClearDcaInterval clears the value of the "dcaInterval" field.
+func (suo *StrategyUpdateOne) ClearDcaInterval() *StrategyUpdateOne {
+	suo.mutation.ClearDcaInterval()
+	return suo
+}
+
+// SetDcaDropRatio sets the "dcaDropRatio" field.
+func (suo *StrategyUpdateOne) SetDcaDropRatio(d decimal.Decimal) *StrategyUpdateOne {
+	suo.mutation.SetDcaDropRatio(d)
+	return suo
+}
+
+// SetNillableDcaDropRatio sets the "dcaDropRatio" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaDropRatio(d *decimal.Decimal) *StrategyUpdateOne {
+	if d != nil {
+		suo.SetDcaDropRatio(*d)
+	}
+	return suo
+}
+
+// ClearDcaDropRatio clears the value of the "dcaDropRatio" field.
+func (suo *StrategyUpdateOne) ClearDcaDropRatio() *StrategyUpdateOne {
+	suo.mutation.ClearDcaDropRatio()
+	return suo
+}
+
+// SetDcaMaxOrders sets the "dcaMaxOrders" field.
+func (suo *StrategyUpdateOne) SetDcaMaxOrders(i int) *StrategyUpdateOne {
+	suo.mutation.ResetDcaMaxOrders()
+	suo.mutation.SetDcaMaxOrders(i)
+	return suo
+}
+
+// SetNillableDcaMaxOrders sets the "dcaMaxOrders" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaMaxOrders(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetDcaMaxOrders(*i)
+	}
+	return suo
+}
+
+// AddDcaMaxOrders adds i to the "dcaMaxOrders" field.
+func (suo *StrategyUpdateOne) AddDcaMaxOrders(i int) *StrategyUpdateOne {
+	suo.mutation.AddDcaMaxOrders(i)
+	return suo
+}
+
+// ClearDcaMaxOrders clears the value of the "dcaMaxOrders" field.
+func (suo *StrategyUpdateOne) ClearDcaMaxOrders() *StrategyUpdateOne {
+	suo.mutation.ClearDcaMaxOrders()
+	return suo
+}
+
+// SetDcaSellInterval sets the "dcaSellInterval" field.
+func (suo *StrategyUpdateOne) SetDcaSellInterval(i int) *StrategyUpdateOne {
+	suo.mutation.ResetDcaSellInterval()
+	suo.mutation.SetDcaSellInterval(i)
+	return suo
+}
+
+// SetNillableDcaSellInterval sets the "dcaSellInterval" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaSellInterval(i *int) *StrategyUpdateOne {
+	if i != nil {
+		suo.SetDcaSellInterval(*i)
+	}
+	return suo
+}
+
+// AddDcaSellInterval adds i to the "dcaSellInterval" field.
+func (suo *StrategyUpdateOne) AddDcaSellInterval(i int) *StrategyUpdateOne {
+	suo.mutation.AddDcaSellInterval(i)
+	return suo
+}
+
+// ClearDcaSellInterval clears the value of the "dcaSellInterval" field.
+func (suo *StrategyUpdateOne) ClearDcaSellInterval() *StrategyUpdateOne {
+	suo.mutation.ClearDcaSellInterval()
+	return suo
+}
+
+// SetDcaSellProfitRatio sets the "dcaSellProfitRatio" field.
+func (suo *StrategyUpdateOne) SetDcaSellProfitRatio(d decimal.Decimal) *StrategyUpdateOne {
+	suo.mutation.SetDcaSellProfitRatio(d)
+	return suo
+}
+
+// SetNillableDcaSellProfitRatio sets the "dcaSellProfitRatio" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaSellProfitRatio(d *decimal.Decimal) *StrategyUpdateOne {
+	if d != nil {
+		suo.SetDcaSellProfitRatio(*d)
+	}
+	return suo
+}
+
+// ClearDcaSellProfitRatio clears the value of the "dcaSellProfitRatio" field.
+func (suo *StrategyUpdateOne) ClearDcaSellProfitRatio() *StrategyUpdateOne {
+	suo.mutation.ClearDcaSellProfitRatio()
+	return suo
+}
+
+// SetDcaLastBuyTime sets the "dcaLastBuyTime" field.
+func (suo *StrategyUpdateOne) SetDcaLastBuyTime(t time.Time) *StrategyUpdateOne {
+	suo.mutation.SetDcaLastBuyTime(t)
+	return suo
+}
+
+// SetNillableDcaLastBuyTime sets the "dcaLastBuyTime" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaLastBuyTime(t *time.Time) *StrategyUpdateOne {
+	if t != nil {
+		suo.SetDcaLastBuyTime(*t)
+	}
+	return suo
+}
+
+// ClearDcaLastBuyTime clears the value of the "dcaLastBuyTime" field.
+func (suo *StrategyUpdateOne) ClearDcaLastBuyTime() *StrategyUpdateOne {
+	suo.mutation.ClearDcaLastBuyTime()
+	return suo
+}
+
+// SetDcaLastBuyPrice sets the "dcaLastBuyPrice" field.
+func (suo *StrategyUpdateOne) SetDcaLastBuyPrice(d decimal.Decimal) *StrategyUpdateOne {
+	suo.mutation.SetDcaLastBuyPrice(d)
+	return suo
+}
+
+// SetNillableDcaLastBuyPrice sets the "dcaLastBuyPrice" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaLastBuyPrice(d *decimal.Decimal) *StrategyUpdateOne {
+	if d != nil {
+		suo.SetDcaLastBuyPrice(*d)
+	}
+	return suo
+}
+
+// ClearDcaLastBuyPrice clears the value of the "dcaLastBuyPrice" field.
+func (suo *StrategyUpdateOne) ClearDcaLastBuyPrice() *StrategyUpdateOne {
+	suo.mutation.ClearDcaLastBuyPrice()
+	return suo
+}
+
+// SetDcaLastSellTime sets the "dcaLastSellTime" field.
+func (suo *StrategyUpdateOne) SetDcaLastSellTime(t time.Time) *StrategyUpdateOne {
+	suo.mutation.SetDcaLastSellTime(t)
+	return suo
+}
+
+// SetNillableDcaLastSellTime sets the "dcaLastSellTime" field if the given value is not nil.
+func (suo *StrategyUpdateOne) SetNillableDcaLastSellTime(t *time.Time) *StrategyUpdateOne {
+	if t != nil {
+		suo.SetDcaLastSellTime(*t)
+	}
+	return suo
+}
+
+// ClearDcaLastSellTime clears the value of the "dcaLastSellTime" field.
+func (suo *StrategyUpdateOne) ClearDcaLastSellTime() *StrategyUpdateOne {
+	suo.mutation.ClearDcaLastSellTime()
+	return suo
+}
+
 // SetEnableAutoBuy sets the "enableAutoBuy" field.
 func (suo *StrategyUpdateOne) SetEnableAutoBuy(b bool) *StrategyUpdateOne {
 	suo.mutation.SetEnableAutoBuy(b)
@@ -1422,6 +2400,11 @@ func (suo *StrategyUpdateOne) check() error {
 			return &ValidationError{Name: "guid", err: fmt.Errorf(`ent: validator failed for field "Strategy.guid": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.Account(); ok {
+		if err := strategy.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "Strategy.account": %w`, err)}
+		}
+	}
 	if v, ok := suo.mutation.Token(); ok {
 		if err := strategy.TokenValidator(v); err != nil {
 			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Strategy.token": %w`, err)}
@@ -1432,6 +2415,11 @@ func (suo *StrategyUpdateOne) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.GetType(); ok {
+		if err := strategy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Strategy.type": %w`, err)}
+		}
+	}
 	if v, ok := suo.mutation.MartinFactor(); ok {
 		if err := strategy.MartinFactorValidator(v); err != nil {
 			return &ValidationError{Name: "martinFactor", err: fmt.Errorf(`ent: validator failed for field "Strategy.martinFactor": %w`, err)}
@@ -1442,6 +2430,11 @@ func (suo *StrategyUpdateOne) check() error {
 			return &ValidationError{Name: "maxGridLimit", err: fmt.Errorf(`ent: validator failed for field "Strategy.maxGridLimit": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.GridMode(); ok {
+		if err := strategy.GridModeValidator(v); err != nil {
+			return &ValidationError{Name: "gridMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridMode": %w`, err)}
+		}
+	}
 	if v, ok := suo.mutation.Status(); ok {
 		if err := strategy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
@@ -1491,12 +2484,21 @@ func (suo *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if value, ok := suo.mutation.AddedUserId(); ok {
 		_spec.AddField(strategy.FieldUserId, field.TypeInt64, value)
 	}
+	if value, ok := suo.mutation.Account(); ok {
+		_spec.SetField(strategy.FieldAccount, field.TypeString, value)
+	}
+	if suo.mutation.AccountCleared() {
+		_spec.ClearField(strategy.FieldAccount, field.TypeString)
+	}
 	if value, ok := suo.mutation.Token(); ok {
 		_spec.SetField(strategy.FieldToken, field.TypeString, value)
 	}
 	if value, ok := suo.mutation.Symbol(); ok {
 		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.GetType(); ok {
+		_spec.SetField(strategy.FieldType, field.TypeEnum, value)
+	}
 	if value, ok := suo.mutation.MartinFactor(); ok {
 		_spec.SetField(strategy.FieldMartinFactor, field.TypeFloat64, value)
 	}
@@ -1521,6 +2523,24 @@ func (suo *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if value, ok := suo.mutation.LowerPriceBound(); ok {
 		_spec.SetField(strategy.FieldLowerPriceBound, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.GridMode(); ok {
+		_spec.SetField(strategy.FieldGridMode, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.GridCount(); ok {
+		_spec.SetField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedGridCount(); ok {
+		_spec.AddField(strategy.FieldGridCount, field.TypeInt, value)
+	}
+	if suo.mutation.GridCountCleared() {
+		_spec.ClearField(strategy.FieldGridCount, field.TypeInt)
+	}
+	if value, ok := suo.mutation.GridStep(); ok {
+		_spec.SetField(strategy.FieldGridStep, field.TypeString, value)
+	}
+	if suo.mutation.GridStepCleared() {
+		_spec.ClearField(strategy.FieldGridStep, field.TypeString)
+	}
 	if value, ok := suo.mutation.InitialOrderSize(); ok {
 		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
 	}
@@ -1575,6 +2595,12 @@ func (suo *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if suo.mutation.DynamicStopLossCleared() {
 		_spec.ClearField(strategy.FieldDynamicStopLoss, field.TypeBool)
 	}
+	if value, ok := suo.mutation.PaperTrading(); ok {
+		_spec.SetField(strategy.FieldPaperTrading, field.TypeBool, value)
+	}
+	if suo.mutation.PaperTradingCleared() {
+		_spec.ClearField(strategy.FieldPaperTrading, field.TypeBool)
+	}
 	if value, ok := suo.mutation.DropOn(); ok {
 		_spec.SetField(strategy.FieldDropOn, field.TypeBool, value)
 	}
@@ -1596,6 +2622,102 @@ func (suo *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if suo.mutation.DropThresholdCleared() {
 		_spec.ClearField(strategy.FieldDropThreshold, field.TypeString)
 	}
+	if value, ok := suo.mutation.TrailingOn(); ok {
+		_spec.SetField(strategy.FieldTrailingOn, field.TypeBool, value)
+	}
+	if suo.mutation.TrailingOnCleared() {
+		_spec.ClearField(strategy.FieldTrailingOn, field.TypeBool)
+	}
+	if value, ok := suo.mutation.TrailingCandles(); ok {
+		_spec.SetField(strategy.FieldTrailingCandles, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedTrailingCandles(); ok {
+		_spec.AddField(strategy.FieldTrailingCandles, field.TypeInt, value)
+	}
+	if suo.mutation.TrailingCandlesCleared() {
+		_spec.ClearField(strategy.FieldTrailingCandles, field.TypeInt)
+	}
+	if value, ok := suo.mutation.TrailingMaxShifts(); ok {
+		_spec.SetField(strategy.FieldTrailingMaxShifts, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedTrailingMaxShifts(); ok {
+		_spec.AddField(strategy.FieldTrailingMaxShifts, field.TypeInt, value)
+	}
+	if suo.mutation.TrailingMaxShiftsCleared() {
+		_spec.ClearField(strategy.FieldTrailingMaxShifts, field.TypeInt)
+	}
+	if value, ok := suo.mutation.TrailingShifts(); ok {
+		_spec.SetField(strategy.FieldTrailingShifts, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedTrailingShifts(); ok {
+		_spec.AddField(strategy.FieldTrailingShifts, field.TypeInt, value)
+	}
+	if suo.mutation.TrailingShiftsCleared() {
+		_spec.ClearField(strategy.FieldTrailingShifts, field.TypeInt)
+	}
+	if value, ok := suo.mutation.BuyConditions(); ok {
+		_spec.SetField(strategy.FieldBuyConditions, field.TypeString, value)
+	}
+	if suo.mutation.BuyConditionsCleared() {
+		_spec.ClearField(strategy.FieldBuyConditions, field.TypeString)
+	}
+	if value, ok := suo.mutation.DcaInterval(); ok {
+		_spec.SetField(strategy.FieldDcaInterval, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDcaInterval(); ok {
+		_spec.AddField(strategy.FieldDcaInterval, field.TypeInt, value)
+	}
+	if suo.mutation.DcaIntervalCleared() {
+		_spec.ClearField(strategy.FieldDcaInterval, field.TypeInt)
+	}
+	if value, ok := suo.mutation.DcaDropRatio(); ok {
+		_spec.SetField(strategy.FieldDcaDropRatio, field.TypeString, value)
+	}
+	if suo.mutation.DcaDropRatioCleared() {
+		_spec.ClearField(strategy.FieldDcaDropRatio, field.TypeString)
+	}
+	if value, ok := suo.mutation.DcaMaxOrders(); ok {
+		_spec.SetField(strategy.FieldDcaMaxOrders, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDcaMaxOrders(); ok {
+		_spec.AddField(strategy.FieldDcaMaxOrders, field.TypeInt, value)
+	}
+	if suo.mutation.DcaMaxOrdersCleared() {
+		_spec.ClearField(strategy.FieldDcaMaxOrders, field.TypeInt)
+	}
+	if value, ok := suo.mutation.DcaSellInterval(); ok {
+		_spec.SetField(strategy.FieldDcaSellInterval, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDcaSellInterval(); ok {
+		_spec.AddField(strategy.FieldDcaSellInterval, field.TypeInt, value)
+	}
+	if suo.mutation.DcaSellIntervalCleared() {
+		_spec.ClearField(strategy.FieldDcaSellInterval, field.TypeInt)
+	}
+	if value, ok := suo.mutation.DcaSellProfitRatio(); ok {
+		_spec.SetField(strategy.FieldDcaSellProfitRatio, field.TypeString, value)
+	}
+	if suo.mutation.DcaSellProfitRatioCleared() {
+		_spec.ClearField(strategy.FieldDcaSellProfitRatio, field.TypeString)
+	}
+	if value, ok := suo.mutation.DcaLastBuyTime(); ok {
+		_spec.SetField(strategy.FieldDcaLastBuyTime, field.TypeTime, value)
+	}
+	if suo.mutation.DcaLastBuyTimeCleared() {
+		_spec.ClearField(strategy.FieldDcaLastBuyTime, field.TypeTime)
+	}
+	if value, ok := suo.mutation.DcaLastBuyPrice(); ok {
+		_spec.SetField(strategy.FieldDcaLastBuyPrice, field.TypeString, value)
+	}
+	if suo.mutation.DcaLastBuyPriceCleared() {
+		_spec.ClearField(strategy.FieldDcaLastBuyPrice, field.TypeString)
+	}
+	if value, ok := suo.mutation.DcaLastSellTime(); ok {
+		_spec.SetField(strategy.FieldDcaLastSellTime, field.TypeTime, value)
+	}
+	if suo.mutation.DcaLastSellTimeCleared() {
+		_spec.ClearField(strategy.FieldDcaLastSellTime, field.TypeTime)
+	}
 	if value, ok := suo.mutation.EnableAutoBuy(); ok {
 		_spec.SetField(strategy.FieldEnableAutoBuy, field.TypeBool, value)
 	}
@@ -22,6 +22,10 @@ type Tx struct {
 	Strategy *StrategyClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// WithdrawAddress is the client for interacting with the WithdrawAddress builders.
+	WithdrawAddress *WithdrawAddressClient
+	// Withdrawal is the client for interacting with the Withdrawal builders.
+	Withdrawal *WithdrawalClient
 
 	// lazily loaded.
 	client     *Client
@@ -158,6 +162,8 @@ func (tx *Tx) init() {
 	tx.Settings = NewSettingsClient(tx.config)
 	tx.Strategy = NewStrategyClient(tx.config)
 	tx.Wallet = NewWalletClient(tx.config)
+	tx.WithdrawAddress = NewWithdrawAddressClient(tx.config)
+	tx.Withdrawal = NewWithdrawalClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
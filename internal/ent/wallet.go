@@ -28,7 +28,11 @@ type Wallet struct {
 	// Password holds the value of the "password" field.
 	Password string `json:"password,omitempty"`
 	// PrivateKey holds the value of the "privateKey" field.
-	PrivateKey   string `json:"privateKey,omitempty"`
+	PrivateKey string `json:"privateKey,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// IsDefault holds the value of the "isDefault" field.
+	IsDefault    bool `json:"isDefault,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -37,9 +41,11 @@ func (*Wallet) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case wallet.FieldIsDefault:
+			values[i] = new(sql.NullBool)
 		case wallet.FieldID, wallet.FieldUserId:
 			values[i] = new(sql.NullInt64)
-		case wallet.FieldAccount, wallet.FieldPassword, wallet.FieldPrivateKey:
+		case wallet.FieldAccount, wallet.FieldPassword, wallet.FieldPrivateKey, wallet.FieldName:
 			values[i] = new(sql.NullString)
 		case wallet.FieldCreateTime, wallet.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -100,6 +106,18 @@ func (w *Wallet) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				w.PrivateKey = value.String
 			}
+		case wallet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				w.Name = value.String
+			}
+		case wallet.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isDefault", values[i])
+			} else if value.Valid {
+				w.IsDefault = value.Bool
+			}
 		default:
 			w.selectValues.Set(columns[i], values[i])
 		}
@@ -153,6 +171,12 @@ func (w *Wallet) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("privateKey=")
 	builder.WriteString(w.PrivateKey)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(w.Name)
+	builder.WriteString(", ")
+	builder.WriteString("isDefault=")
+	builder.WriteString(fmt.Sprintf("%v", w.IsDefault))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,6 +25,10 @@ const (
 	FieldPassword = "password"
 	// FieldPrivateKey holds the string denoting the privatekey field in the database.
 	FieldPrivateKey = "private_key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldIsDefault holds the string denoting the isdefault field in the database.
+	FieldIsDefault = "is_default"
 	// Table holds the table name of the wallet in the database.
 	Table = "wallets"
 )
@@ -38,6 +42,8 @@ var Columns = []string{
 	FieldAccount,
 	FieldPassword,
 	FieldPrivateKey,
+	FieldName,
+	FieldIsDefault,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,6 +69,8 @@ var (
 	PasswordValidator func(string) error
 	// PrivateKeyValidator is a validator for the "privateKey" field. It is called by the builders before save.
 	PrivateKeyValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Wallet queries.
@@ -102,3 +110,13 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 func ByPrivateKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrivateKey, opts...).ToFunc()
 }
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the isDefault field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
@@ -84,6 +84,16 @@ func PrivateKey(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldPrivateKey, v))
 }
 
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldName, v))
+}
+
+// IsDefault applies equality check predicate on the "isDefault" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldIsDefault, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Wallet {
 	return predicate.Wallet(sql.FieldEQ(FieldCreateTime, v))
@@ -399,6 +409,101 @@ func PrivateKeyContainsFold(v string) predicate.Wallet {
 	return predicate.Wallet(sql.FieldContainsFold(FieldPrivateKey, v))
 }
 
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldName, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "isDefault" field.
+func IsDefaultEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "isDefault" field.
+func IsDefaultNEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// IsDefaultIsNil applies the IsNil predicate on the "isDefault" field.
+func IsDefaultIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldIsDefault))
+}
+
+// IsDefaultNotNil applies the NotNil predicate on the "isDefault" field.
+func IsDefaultNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldIsDefault))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Wallet) predicate.Wallet {
 	return predicate.Wallet(sql.AndPredicates(predicates...))
@@ -72,6 +72,34 @@ func (wc *WalletCreate) SetPrivateKey(s string) *WalletCreate {
 	return wc
 }
 
+// SetName sets the "name" field.
+func (wc *WalletCreate) SetName(s string) *WalletCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableName(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetName(*s)
+	}
+	return wc
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (wc *WalletCreate) SetIsDefault(b bool) *WalletCreate {
+	wc.mutation.SetIsDefault(b)
+	return wc
+}
+
+// SetNillableIsDefault sets the "isDefault" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableIsDefault(b *bool) *WalletCreate {
+	if b != nil {
+		wc.SetIsDefault(*b)
+	}
+	return wc
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wc *WalletCreate) Mutation() *WalletMutation {
 	return wc.mutation
@@ -152,6 +180,11 @@ func (wc *WalletCreate) check() error {
 			return &ValidationError{Name: "privateKey", err: fmt.Errorf(`ent: validator failed for field "Wallet.privateKey": %w`, err)}
 		}
 	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := wallet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wallet.name": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -202,6 +235,14 @@ func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 		_spec.SetField(wallet.FieldPrivateKey, field.TypeString, value)
 		_node.PrivateKey = value
 	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.SetField(wallet.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.IsDefault(); ok {
+		_spec.SetField(wallet.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
 	return _node, _spec
 }
 
@@ -97,6 +97,46 @@ func (wu *WalletUpdate) SetNillablePrivateKey(s *string) *WalletUpdate {
 	return wu
 }
 
+// SetName sets the "name" field.
+func (wu *WalletUpdate) SetName(s string) *WalletUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableName(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetName(*s)
+	}
+	return wu
+}
+
+// ClearName clears the value of the "name" field.
+func (wu *WalletUpdate) ClearName() *WalletUpdate {
+	wu.mutation.ClearName()
+	return wu
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (wu *WalletUpdate) SetIsDefault(b bool) *WalletUpdate {
+	wu.mutation.SetIsDefault(b)
+	return wu
+}
+
+// SetNillableIsDefault sets the "isDefault" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableIsDefault(b *bool) *WalletUpdate {
+	if b != nil {
+		wu.SetIsDefault(*b)
+	}
+	return wu
+}
+
+// ClearIsDefault clears the value of the "isDefault" field.
+func (wu *WalletUpdate) ClearIsDefault() *WalletUpdate {
+	wu.mutation.ClearIsDefault()
+	return wu
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wu *WalletUpdate) Mutation() *WalletMutation {
 	return wu.mutation
@@ -155,6 +195,11 @@ func (wu *WalletUpdate) check() error {
 			return &ValidationError{Name: "privateKey", err: fmt.Errorf(`ent: validator failed for field "Wallet.privateKey": %w`, err)}
 		}
 	}
+	if v, ok := wu.mutation.Name(); ok {
+		if err := wallet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wallet.name": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -188,6 +233,18 @@ func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := wu.mutation.PrivateKey(); ok {
 		_spec.SetField(wallet.FieldPrivateKey, field.TypeString, value)
 	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.SetField(wallet.FieldName, field.TypeString, value)
+	}
+	if wu.mutation.NameCleared() {
+		_spec.ClearField(wallet.FieldName, field.TypeString)
+	}
+	if value, ok := wu.mutation.IsDefault(); ok {
+		_spec.SetField(wallet.FieldIsDefault, field.TypeBool, value)
+	}
+	if wu.mutation.IsDefaultCleared() {
+		_spec.ClearField(wallet.FieldIsDefault, field.TypeBool)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{wallet.Label}
@@ -277,6 +334,46 @@ func (wuo *WalletUpdateOne) SetNillablePrivateKey(s *string) *WalletUpdateOne {
 	return wuo
 }
 
+// SetName sets the "name" field.
+func (wuo *WalletUpdateOne) SetName(s string) *WalletUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableName(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetName(*s)
+	}
+	return wuo
+}
+
+// ClearName clears the value of the "name" field.
+func (wuo *WalletUpdateOne) ClearName() *WalletUpdateOne {
+	wuo.mutation.ClearName()
+	return wuo
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (wuo *WalletUpdateOne) SetIsDefault(b bool) *WalletUpdateOne {
+	wuo.mutation.SetIsDefault(b)
+	return wuo
+}
+
+// SetNillableIsDefault sets the "isDefault" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableIsDefault(b *bool) *WalletUpdateOne {
+	if b != nil {
+		wuo.SetIsDefault(*b)
+	}
+	return wuo
+}
+
+// ClearIsDefault clears the value of the "isDefault" field.
+func (wuo *WalletUpdateOne) ClearIsDefault() *WalletUpdateOne {
+	wuo.mutation.ClearIsDefault()
+	return wuo
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wuo *WalletUpdateOne) Mutation() *WalletMutation {
 	return wuo.mutation
@@ -348,6 +445,11 @@ func (wuo *WalletUpdateOne) check() error {
 			return &ValidationError{Name: "privateKey", err: fmt.Errorf(`ent: validator failed for field "Wallet.privateKey": %w`, err)}
 		}
 	}
+	if v, ok := wuo.mutation.Name(); ok {
+		if err := wallet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wallet.name": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -398,6 +500,18 @@ func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err err
 	if value, ok := wuo.mutation.PrivateKey(); ok {
 		_spec.SetField(wallet.FieldPrivateKey, field.TypeString, value)
 	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.SetField(wallet.FieldName, field.TypeString, value)
+	}
+	if wuo.mutation.NameCleared() {
+		_spec.ClearField(wallet.FieldName, field.TypeString)
+	}
+	if value, ok := wuo.mutation.IsDefault(); ok {
+		_spec.SetField(wallet.FieldIsDefault, field.TypeBool, value)
+	}
+	if wuo.mutation.IsDefaultCleared() {
+		_spec.ClearField(wallet.FieldIsDefault, field.TypeBool)
+	}
 	_node = &Wallet{config: wuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,209 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	closeAccountConfirmTimeout  = time.Minute     // 等待关闭账户交易确认的超时时间
+	closeAccountPollingInterval = time.Second * 2 // 查询关闭账户交易状态的间隔
+)
+
+var ErrCloseAccountsRunning = errors.New("close accounts is running")
+
+// 同一钱包同一时间只允许一个关闭账户任务
+var closeAccountsRunning sync.Map
+
+// CloseAccountsReport 关闭空代币账户结果, 金额单位均为 lamports
+type CloseAccountsReport struct {
+	Account  string
+	Closed   int      // 已确认关闭的账户数量
+	Lamports uint64   // 回收的租金
+	Fee      uint64   // 交易费用
+	Pending  []string // 超时未确认的交易
+	Failed   []string // 执行失败的交易
+}
+
+func (r *CloseAccountsReport) String() string {
+	text := fmt.Sprintf("🧹 清理空代币账户完成\n\n💳 钱包: `%s`", r.Account)
+	if r.Closed == 0 && len(r.Pending) == 0 && len(r.Failed) == 0 {
+		return text + "\n\n✅ 没有可以关闭的空代币账户"
+	}
+
+	recovered := decimal.New(int64(r.Lamports), -solanautil.SOLDecimals)
+	fee := decimal.New(int64(r.Fee), -solanautil.SOLDecimals)
+	text = text + fmt.Sprintf("\n\n✅ 已关闭 %d 个账户, 回收 %s SOL ⛽ 费用: %s SOL", r.Closed, recovered, fee)
+	for _, hash := range r.Pending {
+		text = text + fmt.Sprintf("\n⏳ 交易未确认, 请稍后查看 [>>](https://solscan.io/tx/%s)", hash)
+	}
+	for _, hash := range r.Failed {
+		text = text + fmt.Sprintf("\n❌ 交易执行失败 [>>](https://solscan.io/tx/%s)", hash)
+	}
+	return text
+}
+
+// AccountCloser 关闭钱包中余额为零的代币账户, 回收账户租金
+type AccountCloser struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewAccountCloser(svcCtx *svc.ServiceContext) *AccountCloser {
+	return &AccountCloser{svcCtx: svcCtx}
+}
+
+// Close 关闭钱包中的空代币账户并等待交易确认, mints 不为空时只关闭指定代币的账户
+// USDC 账户、运行中策略以及存在未确认订单或提现的代币账户不会被关闭
+func (c *AccountCloser) Close(ctx context.Context, w *ent.Wallet, mints ...string) (*CloseAccountsReport, error) {
+	if _, loaded := closeAccountsRunning.LoadOrStore(w.Account, struct{}{}); loaded {
+		return nil, ErrCloseAccountsRunning
+	}
+	defer closeAccountsRunning.Delete(w.Account)
+
+	accounts, err := solanautil.GetEmptyTokenAccounts(ctx, c.svcCtx.SolanaRpc, w.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	excludes, err := c.activeTokens(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	excludes[solanautil.USDC] = true
+
+	closable := make([]solanautil.TokenAccount, 0, len(accounts))
+	for _, account := range accounts {
+		if excludes[account.Mint] {
+			continue
+		}
+		if len(mints) > 0 && !slices.Contains(mints, account.Mint) {
+			continue
+		}
+		closable = append(closable, account)
+	}
+
+	report := &CloseAccountsReport{Account: w.Account}
+	if len(closable) == 0 {
+		return report, nil
+	}
+
+	logger.Infof("[AccountCloser] 开始关闭空代币账户, account: %s, count: %d", w.Account, len(closable))
+
+	txs, err := swap.NewSwapServiceWithAccount(c.svcCtx, w.UserId, w.Account).CloseTokenAccounts(ctx, closable)
+	if err != nil {
+		if len(txs) == 0 {
+			return nil, err
+		}
+		logger.Errorf("[AccountCloser] 关闭空代币账户失败, account: %s, sent: %d, %v", w.Account, len(txs), err)
+	}
+
+	c.waitForConfirmation(ctx, w, txs, report)
+
+	logger.Infof("[AccountCloser] 关闭空代币账户完成, account: %s, closed: %d, lamports: %d, pending: %d, failed: %d",
+		w.Account, report.Closed, report.Lamports, len(report.Pending), len(report.Failed))
+	return report, nil
+}
+
+// activeTokens 查询钱包中运行中真实交易策略的代币, 以及存在未确认订单或提现的代币
+func (c *AccountCloser) activeTokens(ctx context.Context, w *ent.Wallet) (map[string]bool, error) {
+	data, _, err := c.svcCtx.StrategyModel.FindByUserId(ctx, w.UserId, 0, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]bool)
+	for _, item := range data {
+		if item.Status != entstrategy.StatusActive || strategy.IsPaperTrading(c.svcCtx, item) {
+			continue
+		}
+		if item.Account == w.Account || (item.Account == "" && w.IsDefault) {
+			tokens[item.Token] = true
+		}
+	}
+
+	// 未确认的交易可能仍需要使用代币账户
+	pendingOrders, err := c.svcCtx.OrderModel.FindPendingTokens(ctx, w.Account)
+	if err != nil {
+		return nil, err
+	}
+	pendingWithdrawals, err := c.svcCtx.WithdrawalModel.FindPendingTokens(ctx, w.Account)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range append(pendingOrders, pendingWithdrawals...) {
+		tokens[token] = true
+	}
+	return tokens, nil
+}
+
+// waitForConfirmation 等待关闭账户交易确认, 只统计执行成功的交易
+func (c *AccountCloser) waitForConfirmation(ctx context.Context, w *ent.Wallet, txs []swap.CloseAccountsTx, report *CloseAccountsReport) {
+	pending := slices.Clone(txs)
+	deadline := time.Now().Add(closeAccountConfirmTimeout)
+	for len(pending) > 0 && time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			deadline = time.Now()
+			continue
+		case <-time.After(closeAccountPollingInterval):
+		}
+
+		hashes := make([]string, 0, len(pending))
+		for _, item := range pending {
+			hashes = append(hashes, item.Hash)
+		}
+		confirmed, err := solanautil.GetConfirmedSignatures(ctx, c.svcCtx.SolanaRpc, hashes)
+		if err != nil {
+			logger.Warnf("[AccountCloser] 批量查询交易状态失败, %v", err)
+			continue
+		}
+
+		remaining := pending[:0]
+		for _, item := range pending {
+			if !confirmed[item.Hash] {
+				remaining = append(remaining, item)
+				continue
+			}
+
+			_, err = solanautil.GetTokenBalanceChanges(ctx, c.svcCtx.SolanaRpc, item.Hash, w.Account)
+			if err != nil {
+				if solanautil.IsProgramError(err) {
+					report.Failed = append(report.Failed, item.Hash)
+					continue
+				}
+				remaining = append(remaining, item)
+				continue
+			}
+
+			report.Closed += item.Closed
+			report.Lamports += item.Lamports
+
+			// 读取实际交易费用, 失败时使用预估费用
+			fee, err := solanautil.GetTransactionFee(ctx, c.svcCtx.SolanaRpc, item.Hash, w.Account)
+			if err != nil {
+				report.Fee += item.Fee
+			} else {
+				report.Fee += uint64(fee.BaseFee + fee.PriorityFee)
+			}
+		}
+		pending = remaining
+	}
+
+	for _, item := range pending {
+		report.Pending = append(report.Pending, item.Hash)
+	}
+}
@@ -0,0 +1,92 @@
+package job
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestAccountCloserActiveTokens(t *testing.T) {
+	ctx := context.Background()
+	svcCtx := newTestServiceContext(t)
+
+	strategies := []struct {
+		token   string
+		account string
+		status  entstrategy.Status
+		paper   bool
+	}{
+		{token: "active", account: testAccount, status: entstrategy.StatusActive},             // 指定钱包
+		{token: "default", status: entstrategy.StatusActive},                                  // 使用默认钱包
+		{token: "other", account: "other", status: entstrategy.StatusActive},                  // 其他钱包
+		{token: "paper", account: testAccount, status: entstrategy.StatusActive, paper: true}, // 模拟交易
+		{token: "inactive", account: testAccount, status: entstrategy.StatusInactive},         // 已停止
+	}
+	for _, item := range strategies {
+		_, err := svcCtx.StrategyModel.Save(ctx, ent.Strategy{
+			GUID:         uuid.NewString(),
+			UserId:       1,
+			Account:      item.account,
+			Token:        item.token,
+			Symbol:       "TOKEN",
+			MartinFactor: 1,
+			Status:       item.status,
+			PaperTrading: item.paper,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 未确认的订单和提现
+	saveTestOrder(t, svcCtx, uuid.NewString(), 1, order.TypeBuy, order.StatusPending)
+	saveTestOrder(t, svcCtx, uuid.NewString(), 1, order.TypeSell, order.StatusClosed)
+	for _, status := range []withdrawal.Status{withdrawal.StatusPending, withdrawal.StatusClosed} {
+		_, err := svcCtx.WithdrawalModel.Save(ctx, ent.Withdrawal{
+			UserId:      1,
+			Account:     testAccount,
+			Token:       "withdrawal-" + string(status),
+			Symbol:      "TOKEN",
+			Destination: "destination",
+			Amount:      decimal.NewFromInt(1),
+			Status:      status,
+			TxHash:      uuid.NewString(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		isDefault bool
+		expected  []string
+	}{
+		{name: "默认钱包", isDefault: true, expected: []string{"active", "default", "token", "withdrawal-pending"}},
+		{name: "非默认钱包", expected: []string{"active", "token", "withdrawal-pending"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &ent.Wallet{UserId: 1, Account: testAccount, IsDefault: tt.isDefault}
+			tokens, err := NewAccountCloser(svcCtx).activeTokens(ctx, w)
+			if err != nil {
+				t.Fatalf("activeTokens() 返回错误: %v", err)
+			}
+
+			got := slices.Sorted(maps.Keys(tokens))
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("activeTokens() = %v, 期望 %v", got, tt.expected)
+			}
+		})
+	}
+}
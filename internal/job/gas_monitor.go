@@ -0,0 +1,236 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+)
+
+const (
+	gasAlertInterval = time.Hour        // 余额不足提醒间隔
+	gasTopUpCooldown = time.Minute * 10 // 自动兑换后的冷却时间
+)
+
+// 兑换 SOL 订单的原因
+const orderReasonGasTopUp = "补充SOL"
+
+// GasMonitor 监控有运行策略的钱包 SOL 余额, 余额不足时提醒用户并按配置自动兑换 SOL
+type GasMonitor struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	stopChan  chan struct{}
+	svcCtx    *svc.ServiceContext
+	lastAlert map[string]time.Time
+	lastTopUp map[string]time.Time
+}
+
+func NewGasMonitor(svcCtx *svc.ServiceContext) *GasMonitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &GasMonitor{
+		ctx:       ctx,
+		cancel:    cancel,
+		svcCtx:    svcCtx,
+		lastAlert: make(map[string]time.Time),
+		lastTopUp: make(map[string]time.Time),
+	}
+}
+
+func (m *GasMonitor) Stop() {
+	if m.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[GasMonitor] 准备停止服务")
+
+	m.cancel()
+
+	<-m.stopChan
+	close(m.stopChan)
+	m.stopChan = nil
+
+	logger.Infof("[GasMonitor] 服务已经停止")
+}
+
+func (m *GasMonitor) Start() {
+	if m.stopChan != nil || !m.svcCtx.Config.GasMonitor.Enable {
+		return
+	}
+
+	m.stopChan = make(chan struct{})
+	logger.Infof("[GasMonitor] 开始运行服务")
+	go m.run()
+}
+
+func (m *GasMonitor) run() {
+	interval := time.Duration(m.svcCtx.Config.GasMonitor.CheckInterval) * time.Second
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			m.handleCheck()
+			timer.Reset(interval)
+		case <-m.ctx.Done():
+			m.stopChan <- struct{}{}
+			return
+		}
+	}
+}
+
+// activeWallets 查询有运行中真实交易策略的钱包
+func (m *GasMonitor) activeWallets() ([]*ent.Wallet, error) {
+	offset := 0
+	const limit = 100
+
+	wallets := make([]*ent.Wallet, 0)
+	seen := make(map[string]bool)
+	for {
+		data, err := m.svcCtx.StrategyModel.FindAllActive(m.ctx, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			break
+		}
+
+		for _, item := range data {
+			if strategy.IsPaperTrading(m.svcCtx, item) {
+				continue
+			}
+
+			w, err := strategy.GetStrategyWallet(m.ctx, m.svcCtx, item)
+			if err != nil {
+				logger.Errorf("[GasMonitor] 查询策略钱包失败, strategy: %s, %v", item.GUID, err)
+				continue
+			}
+			if seen[w.Account] {
+				continue
+			}
+			seen[w.Account] = true
+			wallets = append(wallets, w)
+		}
+
+		offset = offset + len(data)
+	}
+	return wallets, nil
+}
+
+func (m *GasMonitor) handleCheck() {
+	wallets, err := m.activeWallets()
+	if err != nil {
+		logger.Errorf("[GasMonitor] 查询运行中的策略失败, %v", err)
+		return
+	}
+
+	c := m.svcCtx.Config.GasMonitor
+	for _, w := range wallets {
+		balance, err := solanautil.GetBalance(m.ctx, m.svcCtx.SolanaRpc, w.Account)
+		if err != nil {
+			logger.Warnf("[GasMonitor] 查询 SOL 余额失败, account: %s, %v", w.Account, err)
+			continue
+		}
+
+		uiBalance := solanautil.ParseSOL(balance)
+		if uiBalance.GreaterThanOrEqual(c.MinBalance) {
+			delete(m.lastAlert, w.Account)
+			continue
+		}
+
+		logger.Warnf("[GasMonitor] SOL 余额不足, account: %s, balance: %s, min: %s", w.Account, uiBalance, c.MinBalance)
+
+		if c.AutoTopUp && time.Since(m.lastTopUp[w.Account]) > gasTopUpCooldown {
+			m.lastTopUp[w.Account] = time.Now()
+			if m.handleTopUp(w) {
+				continue
+			}
+		}
+
+		if time.Since(m.lastAlert[w.Account]) > gasAlertInterval {
+			m.lastAlert[w.Account] = time.Now()
+
+			text := "⛽ 钱包 SOL 余额不足!\n\n💳 钱包: `%s`\n💰 SOL余额: `%s` (最低要求: %s)\n\n⚠️ SOL 不足将导致交易因手续费或账户租金不足而失败, 请及时充值!"
+			text = fmt.Sprintf(text, w.Account, uiBalance.Truncate(5), c.MinBalance)
+			m.sendNotification(w, text)
+		}
+	}
+}
+
+// handleTopUp 使用 USDC 兑换 SOL 并保存为不属于任何策略的订单
+func (m *GasMonitor) handleTopUp(w *ent.Wallet) bool {
+	c := m.svcCtx.Config.GasMonitor
+
+	// 获取报价
+	amount := solanautil.FormatUnits(c.TopUpAmount, solanautil.USDCDecimals)
+	swapService := swap.NewSwapServiceWithAccount(m.svcCtx, w.UserId, w.Account)
+	tx, err := swapService.Quote(m.ctx, solanautil.USDC, solanautil.WSOL, amount)
+	if err != nil {
+		logger.Errorf("[GasMonitor] 获取报价失败, in: USDC, out: SOL, amount: %s, %v", c.TopUpAmount, err)
+		return false
+	}
+
+	// 发送交易
+	uiOutAmount := solanautil.ParseUnits(tx.OutAmount(), solanautil.SOLDecimals)
+	quotePrice := c.TopUpAmount.Div(uiOutAmount)
+	hash, err := tx.Swap(m.ctx)
+	if err != nil {
+		logger.Errorf("[GasMonitor] 兑换 SOL - 发送交易失败, user: %d, inputAmount: %s, outAmount: %s, hash: %s, %v",
+			w.UserId, c.TopUpAmount, uiOutAmount, hash, err)
+
+		// 已经广播的交易仍可能上链, 保存订单等待确认
+		if hash == "" {
+			return false
+		}
+	} else {
+		logger.Infof("[GasMonitor] 兑换 SOL - 提交交易成功, user: %d, inputAmount: %s, outAmount: %s, hash: %s",
+			w.UserId, c.TopUpAmount, uiOutAmount, hash)
+	}
+
+	// 保存订单记录
+	orderArgs := ent.Order{
+		Account:    tx.Signer(),
+		Token:      solanautil.WSOL,
+		Symbol:     "SOL",
+		StrategyId: "",
+		Type:       order.TypeBuy,
+		Price:      quotePrice,
+		FinalPrice: quotePrice,
+		InAmount:   c.TopUpAmount,
+		OutAmount:  uiOutAmount,
+		Status:     order.StatusPending,
+		TxHash:     hash,
+		JitoTip:    int64(m.svcCtx.TxSender.Tip(hash)),
+		Reason:     orderReasonGasTopUp,
+		Aggregator: tx.Aggregator(),
+	}
+
+	_, err = m.svcCtx.OrderModel.Save(m.ctx, orderArgs)
+	if err != nil {
+		logger.Errorf("[GasMonitor] 兑换 SOL - 保存订单失败, order: %+v, %v", orderArgs, err)
+	}
+
+	text := "⛽ 钱包 SOL 余额不足, 已提交自动兑换 SOL 交易\n\n💳 钱包: `%s`\n💵 花费: %s USDC\n💰 预计获得: %s SOL [>>](https://solscan.io/tx/%s)"
+	text = fmt.Sprintf(text, w.Account, c.TopUpAmount, uiOutAmount.Truncate(5), hash)
+	m.sendNotification(w, text)
+	return true
+}
+
+func (m *GasMonitor) sendNotification(w *ent.Wallet, text string) {
+	if w.UserId == 0 {
+		return
+	}
+
+	_, err := utils.SendMessage(m.svcCtx.BotApi, w.UserId, text)
+	if err != nil {
+		logger.Warnf("[GasMonitor] 发送电报通知失败, userId: %d, text: %s, %v", w.UserId, text, err)
+	}
+}
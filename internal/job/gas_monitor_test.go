@@ -0,0 +1,71 @@
+package job
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+
+	"github.com/google/uuid"
+)
+
+func TestGasMonitorActiveWallets(t *testing.T) {
+	ctx := context.Background()
+	svcCtx := newTestServiceContext(t)
+
+	wallets := []ent.Wallet{
+		{UserId: 1, Account: "default", IsDefault: true},
+		{UserId: 1, Account: "second"},
+		{UserId: 2, Account: "paper", IsDefault: true},
+		{UserId: 3, Account: "inactive", IsDefault: true},
+	}
+	for _, w := range wallets {
+		w.Password, w.PrivateKey = "password", "privateKey"
+		if _, err := svcCtx.WalletModel.Save(ctx, w); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	strategies := []struct {
+		userId  int64
+		account string
+		status  entstrategy.Status
+		paper   bool
+	}{
+		{userId: 1, status: entstrategy.StatusActive},                    // 使用默认钱包
+		{userId: 1, account: "second", status: entstrategy.StatusActive}, // 指定钱包
+		{userId: 1, account: "second", status: entstrategy.StatusActive}, // 同一钱包只返回一次
+		{userId: 2, status: entstrategy.StatusActive, paper: true},       // 模拟交易
+		{userId: 3, status: entstrategy.StatusInactive},                  // 已停止
+	}
+	for _, item := range strategies {
+		_, err := svcCtx.StrategyModel.Save(ctx, ent.Strategy{
+			GUID:         uuid.NewString(),
+			UserId:       item.userId,
+			Account:      item.account,
+			Token:        uuid.NewString(),
+			Symbol:       "TOKEN",
+			MartinFactor: 1,
+			Status:       item.status,
+			PaperTrading: item.paper,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := NewGasMonitor(svcCtx).activeWallets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accounts := make([]string, 0, len(got))
+	for _, w := range got {
+		accounts = append(accounts, w.Account)
+	}
+	if !slices.Equal(accounts, []string{"default", "second"}) {
+		t.Errorf("activeWallets() = %v, 期望 [default second]", accounts)
+	}
+}
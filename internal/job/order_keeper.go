@@ -8,10 +8,12 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
 	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/model"
 	"github.com/fachebot/sol-grid-bot/internal/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
 	"github.com/fachebot/sol-grid-bot/internal/utils/format"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
@@ -19,19 +21,42 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const (
+	pollingInterval         = time.Millisecond * 1000 // 轮询间隔
+	fallbackPollingInterval = time.Second * 10        // WebSocket 可用时的兜底轮询间隔
+	orderTimeout            = time.Minute * 2         // 未被发送器跟踪的交易的最长等待时间
+	pendingTimeout          = time.Minute * 10        // 发送器仍在重新广播的交易的最长等待时间, 约为区块哈希有效期的10倍
+)
+
+const (
+	rejectReasonTimeout = "timeout"
+	rejectReasonExpired = "expired"
+)
+
 type OrderKeeper struct {
 	ctx      context.Context
 	cancel   context.CancelFunc
 	stopChan chan struct{}
 	svcCtx   *svc.ServiceContext
+	executor strategy.Executor
+	watcher  *SignatureWatcher
 }
 
 func NewOrderKeeper(svcCtx *svc.ServiceContext) *OrderKeeper {
+	keeper := NewOrderKeeperWithExecutor(svcCtx, strategy.NewLiveExecutor(svcCtx))
+	if svcCtx.Config.Solana.WsUrl != "" {
+		keeper.watcher = NewSignatureWatcher(svcCtx.Config.Solana.WsUrl)
+	}
+	return keeper
+}
+
+func NewOrderKeeperWithExecutor(svcCtx *svc.ServiceContext, executor strategy.Executor) *OrderKeeper {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &OrderKeeper{
-		ctx:    ctx,
-		cancel: cancel,
-		svcCtx: svcCtx,
+		ctx:      ctx,
+		cancel:   cancel,
+		svcCtx:   svcCtx,
+		executor: executor,
 	}
 }
 
@@ -43,6 +68,9 @@ func (keeper *OrderKeeper) Stop() {
 	logger.Infof("[OrderKeeper] 准备停止服务")
 
 	keeper.cancel()
+	if keeper.watcher != nil {
+		keeper.watcher.Stop()
+	}
 
 	<-keeper.stopChan
 	close(keeper.stopChan)
@@ -58,19 +86,44 @@ func (keeper *OrderKeeper) Start() {
 
 	keeper.stopChan = make(chan struct{})
 	logger.Infof("[OrderKeeper] 开始运行服务")
+	if keeper.watcher != nil {
+		keeper.watcher.Start()
+	}
 	go keeper.run()
 }
 
+// Poll 立即检查一次待确认订单
+func (keeper *OrderKeeper) Poll() {
+	keeper.handlePolling()
+}
+
+func (keeper *OrderKeeper) getExecutor(ord *ent.Order) strategy.Executor {
+	if ord.Paper {
+		return strategy.NewPaperExecutor(keeper.svcCtx)
+	}
+	return keeper.executor
+}
+
 func (keeper *OrderKeeper) run() {
 	timer := time.NewTimer(0)
 	defer timer.Stop()
 
+	var confirmed <-chan string
+	if keeper.watcher != nil {
+		confirmed = keeper.watcher.Confirmed()
+	}
+
 	for {
 		select {
 		case <-timer.C:
 			keeper.handlePolling()
-			duration := time.Millisecond * 1000
+			duration := pollingInterval
+			if keeper.watcher != nil && keeper.watcher.Connected() {
+				duration = fallbackPollingInterval
+			}
 			timer.Reset(duration)
+		case hash := <-confirmed:
+			keeper.handleConfirmed(hash)
 		case <-keeper.ctx.Done():
 			keeper.stopChan <- struct{}{}
 			return
@@ -121,7 +174,7 @@ func (keeper *OrderKeeper) handleRetryExit(ord *ent.Order) {
 	keeper.sendNotification(ord, fmt.Sprintf("♻️ 正在尝试重新清仓 *%s* 代币失败", ord.Symbol), true)
 
 	// 卖出代币
-	orderArgs, err := strategy.SellToken(keeper.ctx, keeper.svcCtx, record, "重新清仓", &ord.InAmount, nil, true)
+	orderArgs, err := strategy.SellTokenWithExecutor(keeper.ctx, keeper.svcCtx, keeper.getExecutor(ord), record, "重新清仓", &ord.InAmount, nil, true)
 	if err != nil {
 		logger.Errorf("[OrderKeeper] 尝试重新清仓失败, strategy: %s, token: %s, %v", ord.StrategyId, ord.Symbol, err)
 		keeper.sendNotification(ord, fmt.Sprintf("❌ 尝试重新清仓 *%s* 代币失败，请手动清仓", ord.Symbol), true)
@@ -139,7 +192,59 @@ func (keeper *OrderKeeper) handleRetryExit(ord *ent.Order) {
 	}
 }
 
+// handleOrderFee 读取交易费用并保存到订单, 返回折算后的 USD 费用
+func (keeper *OrderKeeper) handleOrderFee(ord *ent.Order) decimal.Decimal {
+	fee, err := keeper.getExecutor(ord).GetTransactionFee(keeper.ctx, ord.TxHash, ord.Account)
+	if err != nil {
+		logger.Warnf("[OrderKeeper] 获取交易费用失败, hash: %s, %v", ord.TxHash, err)
+		return decimal.Zero
+	}
+
+	// 小费在发送交易时保存到订单, 旧订单从发送器读取
+	jitoTip := ord.JitoTip
+	if jitoTip == 0 && !ord.Paper {
+		jitoTip = int64(keeper.svcCtx.TxSender.Tip(ord.TxHash))
+	}
+
+	lamports := fee.BaseFee + fee.PriorityFee + fee.RentFee + jitoTip
+	if lamports == 0 && fee.ComputeUnits == 0 {
+		return decimal.Zero
+	}
+
+	// 按 SOL 价格折算费用
+	feeUsd := decimal.Zero
+	if keeper.svcCtx.SolPriceCache != nil {
+		solPrice, err := keeper.svcCtx.SolPriceCache.GetPrice(keeper.ctx)
+		if err == nil {
+			feeUsd = decimal.New(lamports, -solanautil.SOLDecimals).Mul(solPrice)
+		} else {
+			logger.Warnf("[OrderKeeper] 获取 SOL 价格失败, hash: %s, %v", ord.TxHash, err)
+		}
+	}
+
+	args := model.OrderFee{
+		BaseFee:      fee.BaseFee,
+		PriorityFee:  fee.PriorityFee,
+		ComputeUnits: fee.ComputeUnits,
+		JitoTip:      jitoTip,
+		RentFee:      fee.RentFee,
+		FeeUsd:       feeUsd,
+	}
+	err = keeper.svcCtx.OrderModel.UpdateFee(keeper.ctx, ord.ID, args)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 保存交易费用失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+		return feeUsd
+	}
+
+	logger.Debugf("[OrderKeeper] 保存交易费用, id: %d, baseFee: %d, priorityFee: %d, computeUnits: %d, jitoTip: %d, rentFee: %d, feeUsd: %s",
+		ord.ID, fee.BaseFee, fee.PriorityFee, fee.ComputeUnits, jitoTip, fee.RentFee, feeUsd)
+	return feeUsd
+}
+
 func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges map[string]solanautil.TokenBalanceChange) {
+	// 保存交易费用
+	feeUsd := keeper.handleOrderFee(ord)
+
 	// 计算最终价格
 	cost := decimal.Zero
 	var finalPrice, outAmount decimal.Decimal
@@ -150,6 +255,11 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 			finalPrice = ord.InAmount.Div(v.Change)
 		}
 		outAmount = v.Change
+
+		// 兑换 SOL 时 WSOL 账户会被关闭, 没有代币余额变化时使用报价数量
+		if !ok && ord.GridNumber == nil {
+			finalPrice, outAmount = ord.Price, ord.OutAmount
+		}
 	case order.TypeSell:
 		v, ok := tokenBalanceChanges[solanautil.USDC]
 		if ok && !ord.InAmount.Equal(decimal.Zero) {
@@ -194,8 +304,7 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 		}
 
 		if !cost.IsZero() {
-			profit := outAmount.Sub(cost)
-			err = model.NewOrderModel(tx.Order).UpdateProfit(keeper.ctx, ord.ID, profit)
+			err = model.NewOrderModel(tx.Order).UpdateProfit(keeper.ctx, ord.ID, outAmount.Sub(cost))
 			if err != nil {
 				return err
 			}
@@ -224,14 +333,25 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 		ord.ID, ord.Type, finalPrice, outAmount, ord.TxHash)
 
 	// 发送电报通知
+	if ord.Paper {
+		keeper.sendPaperNotification(ord, finalPrice, outAmount)
+		return
+	}
+
 	switch ord.Type {
 	case order.TypeBuy:
 		usdcChange, ok := tokenBalanceChanges[solanautil.USDC]
 		if !ok {
 			usdcChange = solanautil.TokenBalanceChange{}
 		}
-		text := fmt.Sprintf("🟢 网格 `#%d` 买入 %sU [%s](https://gmgn.ai/sol/token/%s) 💰 余额: %sU [>>](https://solscan.io/tx/%s)",
-			*ord.GridNumber, usdcChange.Change.Abs().Truncate(2), ord.Symbol, ord.Token, usdcChange.Post.Truncate(2), ord.TxHash)
+		if ord.GridNumber == nil {
+			text := fmt.Sprintf("⛽ 兑换 %s SOL 成功, 💰 花费: %sU ⛽ 费用: %sU [>>](https://solscan.io/tx/%s)",
+				outAmount.Truncate(5), ord.InAmount.Truncate(2), feeUsd.Truncate(4), ord.TxHash)
+			keeper.sendNotification(ord, text, true)
+			return
+		}
+		text := fmt.Sprintf("🟢 网格 `#%d` 买入 %sU [%s](https://gmgn.ai/sol/token/%s) 💰 余额: %sU ⛽ 费用: %sU [>>](https://solscan.io/tx/%s)",
+			*ord.GridNumber, usdcChange.Change.Abs().Truncate(2), ord.Symbol, ord.Token, usdcChange.Post.Truncate(2), feeUsd.Truncate(4), ord.TxHash)
 		keeper.sendNotification(ord, text, false)
 	case order.TypeSell:
 		if ord.GridId != nil {
@@ -241,10 +361,89 @@ func (keeper *OrderKeeper) handleCloseOrder(ord *ent.Order, tokenBalanceChanges
 			}
 			text := fmt.Sprintf("🔴 网格 `#%d` 卖出 %sU [%s](https://gmgn.ai/sol/token/%s) 💰 余额: %sU [>>](https://solscan.io/tx/%s)",
 				*ord.GridNumber, usdcChange.Change.Abs().Truncate(2), ord.Symbol, ord.Token, usdcChange.Post.Truncate(2), ord.TxHash)
+			if !cost.IsZero() {
+				gross := outAmount.Sub(cost)
+				net := keeper.gridNetProfit(ord, gross, feeUsd)
+				text = text + fmt.Sprintf("\n📈 盈亏: %sU, 扣除费用后: %sU", gross.Truncate(4), net.Truncate(4))
+			}
 			keeper.sendNotification(ord, text, false)
 		} else {
-			text := fmt.Sprintf("✅ 清仓 *%s* 代币成功, 成交价格: %s, 💰 金额: %sU [>>](https://solscan.io/tx/%s)",
-				ord.Symbol, format.Price(finalPrice, 5), outAmount.Truncate(2), ord.TxHash)
+			text := fmt.Sprintf("✅ 清仓 *%s* 代币成功, 成交价格: %s, 💰 金额: %sU ⛽ 费用: %sU [>>](https://solscan.io/tx/%s)",
+				ord.Symbol, format.Price(finalPrice, 5), outAmount.Truncate(2), feeUsd.Truncate(4), ord.TxHash)
+			keeper.sendNotification(ord, text, true)
+
+			if keeper.svcCtx.Config.TokenAccount.AutoClose && ord.StrategyId != "" {
+				go keeper.handleCloseTokenAccount(ord)
+			}
+		}
+	}
+}
+
+// handleCloseTokenAccount 策略清仓并停止后关闭代币账户回收租金
+func (keeper *OrderKeeper) handleCloseTokenAccount(ord *ent.Order) {
+	record, err := keeper.svcCtx.StrategyModel.FindByGUID(keeper.ctx, ord.StrategyId)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 查询策略信息失败, account: %s, strategy: %s, %v", ord.Account, ord.StrategyId, err)
+		return
+	}
+	if record.Status == entstrategy.StatusActive {
+		return
+	}
+
+	w, err := keeper.svcCtx.WalletModel.FindByAccount(keeper.ctx, ord.Account)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 查询钱包信息失败, account: %s, %v", ord.Account, err)
+		return
+	}
+
+	report, err := NewAccountCloser(keeper.svcCtx).Close(keeper.ctx, w, ord.Token)
+	if err != nil {
+		logger.Errorf("[OrderKeeper] 关闭代币账户失败, account: %s, token: %s, %v", ord.Account, ord.Token, err)
+		return
+	}
+	if report.Closed == 0 {
+		return
+	}
+
+	recovered := decimal.New(int64(report.Lamports), -solanautil.SOLDecimals)
+	text := fmt.Sprintf("🧹 已关闭 *%s* 代币账户, 回收 %s SOL", ord.Symbol, recovered)
+	keeper.sendNotification(ord, text, false)
+}
+
+// gridNetProfit 计算网格卖出扣除该网格所有订单费用后的净利润
+func (keeper *OrderKeeper) gridNetProfit(ord *ent.Order, gross, feeUsd decimal.Decimal) decimal.Decimal {
+	if ord.GridId == nil {
+		return gross.Sub(feeUsd)
+	}
+
+	totalFee, err := keeper.svcCtx.OrderModel.TotalFeeByGridId(keeper.ctx, *ord.GridId)
+	if err != nil {
+		logger.Warnf("[OrderKeeper] 查询网格交易费用失败, gridId: %s, %v", *ord.GridId, err)
+		return gross.Sub(feeUsd)
+	}
+	return gross.Sub(totalFee)
+}
+
+func (keeper *OrderKeeper) sendPaperNotification(ord *ent.Order, finalPrice, outAmount decimal.Decimal) {
+	switch ord.Type {
+	case order.TypeBuy:
+		if ord.GridNumber == nil {
+			text := fmt.Sprintf("📝 [模拟] 🟢 买入 %sU [%s](https://gmgn.ai/sol/token/%s), 价格: %s",
+				ord.InAmount.Truncate(2), ord.Symbol, ord.Token, format.Price(finalPrice, 5))
+			keeper.sendNotification(ord, text, true)
+			return
+		}
+		text := fmt.Sprintf("📝 [模拟] 🟢 网格 `#%d` 买入 %sU [%s](https://gmgn.ai/sol/token/%s), 价格: %s",
+			*ord.GridNumber, ord.InAmount.Truncate(2), ord.Symbol, ord.Token, format.Price(finalPrice, 5))
+		keeper.sendNotification(ord, text, false)
+	case order.TypeSell:
+		if ord.GridNumber != nil {
+			text := fmt.Sprintf("📝 [模拟] 🔴 网格 `#%d` 卖出 %sU [%s](https://gmgn.ai/sol/token/%s), 价格: %s",
+				*ord.GridNumber, outAmount.Truncate(2), ord.Symbol, ord.Token, format.Price(finalPrice, 5))
+			keeper.sendNotification(ord, text, false)
+		} else {
+			text := fmt.Sprintf("📝 [模拟] ✅ 清仓 *%s* 代币成功, 成交价格: %s, 💰 金额: %sU",
+				ord.Symbol, format.Price(finalPrice, 5), outAmount.Truncate(2))
 			keeper.sendNotification(ord, text, true)
 		}
 	}
@@ -275,16 +474,25 @@ func (keeper *OrderKeeper) handleRejectOrder(ord *ent.Order, _ map[string]solana
 	logger.Infof("[OrderKeeper] 设置订单 rejected 状态, id: %d, hash: %s, reason: %s", ord.ID, ord.TxHash, reason)
 
 	// 发送失败通知
+	reasonText := "流动性不足或者滑点问题"
+	if reason == rejectReasonExpired {
+		reasonText = "交易过期未上链"
+	}
+
 	switch ord.Type {
 	case order.TypeBuy:
-		keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 买入 %sU [%s](https://gmgn.ai/sol/token/%s), 原因: 流动性不足或者滑点问题 [>>](https://solscan.io/tx/%s)",
-			*ord.GridNumber, ord.InAmount.Truncate(2), ord.Symbol, ord.Token, ord.TxHash), false)
+		if ord.GridNumber == nil {
+			keeper.sendNotification(ord, fmt.Sprintf("❌ 兑换 SOL 失败, 原因: %s [>>](https://solscan.io/tx/%s)", reasonText, ord.TxHash), true)
+			break
+		}
+		keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 买入 %sU [%s](https://gmgn.ai/sol/token/%s), 原因: %s [>>](https://solscan.io/tx/%s)",
+			*ord.GridNumber, ord.InAmount.Truncate(2), ord.Symbol, ord.Token, reasonText, ord.TxHash), false)
 	case order.TypeSell:
 		if ord.GridId != nil {
-			keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 卖出 %s [%s](https://gmgn.ai/sol/token/%s) 失败, 原因: 流动性不足或者滑点问题 [>>](https://solscan.io/tx/%s)",
-				*ord.GridNumber, ord.InAmount, ord.Symbol, ord.Token, ord.TxHash), false)
+			keeper.sendNotification(ord, fmt.Sprintf("❌ 网格 `#%d` 卖出 %s [%s](https://gmgn.ai/sol/token/%s) 失败, 原因: %s [>>](https://solscan.io/tx/%s)",
+				*ord.GridNumber, ord.InAmount, ord.Symbol, ord.Token, reasonText, ord.TxHash), false)
 		} else {
-			keeper.sendNotification(ord, fmt.Sprintf("❌ 清仓 *%s* 代币失败, 原因: 流动性不足或者滑点问题 [>>](https://solscan.io/tx/%s)", ord.Symbol, ord.TxHash), true)
+			keeper.sendNotification(ord, fmt.Sprintf("❌ 清仓 *%s* 代币失败, 原因: %s [>>](https://solscan.io/tx/%s)", ord.Symbol, reasonText, ord.TxHash), true)
 		}
 	}
 
@@ -294,6 +502,52 @@ func (keeper *OrderKeeper) handleRejectOrder(ord *ent.Order, _ map[string]solana
 	}
 }
 
+func (keeper *OrderKeeper) handleConfirmed(hash string) {
+	ord, err := keeper.svcCtx.OrderModel.FindByTxHash(keeper.ctx, hash)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Errorf("[OrderKeeper] 查询订单失败, hash: %s, %v", hash, err)
+		}
+		return
+	}
+	if ord.Status != order.StatusPending {
+		return
+	}
+
+	logger.Debugf("[OrderKeeper] 收到交易确认通知, id: %d, hash: %s", ord.ID, hash)
+	keeper.checkOrders([]*ent.Order{ord}, map[string]bool{hash: true})
+}
+
+// getConfirmedSignatures 批量查询交易状态, 查询失败时视为全部已确认, 逐笔获取交易详情
+func (keeper *OrderKeeper) getConfirmedSignatures(orders []*ent.Order) map[string]bool {
+	groups := make(map[bool][]string)
+	for _, item := range orders {
+		groups[item.Paper] = append(groups[item.Paper], item.TxHash)
+	}
+
+	confirmed := make(map[string]bool)
+	for paper, hashes := range groups {
+		executor := keeper.executor
+		if paper {
+			executor = strategy.NewPaperExecutor(keeper.svcCtx)
+		}
+
+		result, err := executor.GetConfirmedSignatures(keeper.ctx, hashes)
+		if err != nil {
+			logger.Warnf("[OrderKeeper] 批量查询交易状态失败, %v", err)
+			for _, hash := range hashes {
+				confirmed[hash] = true
+			}
+			continue
+		}
+
+		for hash := range result {
+			confirmed[hash] = true
+		}
+	}
+	return confirmed
+}
+
 func (keeper *OrderKeeper) handlePolling() {
 	// 获取订单列表
 	orders, err := keeper.svcCtx.OrderModel.FindPendingOrders(keeper.ctx, 100)
@@ -304,26 +558,69 @@ func (keeper *OrderKeeper) handlePolling() {
 		return
 	}
 
+	// 订阅交易签名
+	if keeper.watcher != nil {
+		for _, item := range orders {
+			if !item.Paper {
+				keeper.watcher.Watch(item.TxHash)
+			}
+		}
+	}
+
+	keeper.checkOrders(orders, keeper.getConfirmedSignatures(orders))
+}
+
+// handleUnconfirmed 处理未确认订单, 发送器判定区块哈希过期后拒绝订单, 发送器无法判定时按超时处理
+func (keeper *OrderKeeper) handleUnconfirmed(ord *ent.Order, now time.Time) {
+	state := txsender.TxStateUnknown
+	if keeper.svcCtx.TxSender != nil && !ord.Paper {
+		state = keeper.svcCtx.TxSender.State(ord.TxHash)
+	}
+
+	switch state {
+	case txsender.TxStateExpired:
+		keeper.handleRejectOrder(ord, nil, rejectReasonExpired)
+	case txsender.TxStateConfirmed:
+		// 等待交易详情
+	case txsender.TxStatePending:
+		// 发送器仍在重新广播, 以区块哈希过期作为最终判定, 无法获取区块高度时超时作为兜底
+		if now.Sub(ord.CreateTime) > pendingTimeout {
+			keeper.svcCtx.TxSender.Abandon(ord.TxHash)
+			keeper.handleRejectOrder(ord, nil, rejectReasonTimeout)
+		}
+	default:
+		// 发送器未跟踪的交易(例如程序重启)无法判定区块哈希是否过期, 按超时处理
+		if now.Sub(ord.CreateTime) > orderTimeout {
+			keeper.handleRejectOrder(ord, nil, rejectReasonTimeout)
+		}
+	}
+}
+
+func (keeper *OrderKeeper) checkOrders(orders []*ent.Order, confirmed map[string]bool) {
 	// 检查交易状态
 	now := time.Now()
 	openOrders := make([]*ent.Order, 0)
 	tokenBalanceChanges := make(map[int]map[string]solanautil.TokenBalanceChange)
 
 	for _, item := range orders {
-		changes, err := solanautil.GetTokenBalanceChanges(
-			keeper.ctx, keeper.svcCtx.SolanaRpc, item.TxHash, item.Account)
+		// 交易未确认
+		if !confirmed[item.TxHash] {
+			keeper.handleUnconfirmed(item, now)
+			continue
+		}
+
+		changes, err := keeper.getExecutor(item).GetTokenBalanceChanges(keeper.ctx, item.TxHash, item.Account)
 		if err != nil {
-			// 交易是否失败
+			// 交易是否失败, 失败的交易同样收取费用
 			if solanautil.IsProgramError(err) {
+				keeper.handleOrderFee(item)
 				keeper.handleRejectOrder(item, changes, err.Error())
 				continue
 			}
 
 			// 交易是否超时
 			if err == solanautil.ErrTxNotFound {
-				if now.Sub(item.CreateTime) > time.Minute*2 {
-					keeper.handleRejectOrder(item, changes, "timeout")
-				}
+				keeper.handleUnconfirmed(item, now)
 				continue
 			}
 
@@ -0,0 +1,54 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestSendPaperNotification(t *testing.T) {
+	ctx := context.Background()
+	svcCtx := newTestServiceContext(t)
+
+	// 未绑定Telegram账号的钱包, 通知不会实际发送
+	_, err := svcCtx.WalletModel.Save(ctx, ent.Wallet{Account: testAccount, Password: "password", PrivateKey: "privateKey"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gridId, gridNumber := uuid.NewString(), 2
+	tests := []struct {
+		name       string
+		typ        order.Type
+		gridId     *string
+		gridNumber *int
+	}{
+		{name: "网格买入", typ: order.TypeBuy, gridId: &gridId, gridNumber: &gridNumber},
+		{name: "没有网格编号的买入", typ: order.TypeBuy},
+		{name: "网格卖出", typ: order.TypeSell, gridId: &gridId, gridNumber: &gridNumber},
+		{name: "清仓卖出", typ: order.TypeSell},
+	}
+
+	keeper := NewOrderKeeperWithExecutor(svcCtx, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ord := &ent.Order{
+				Account:    testAccount,
+				Token:      "token",
+				Symbol:     "TOKEN",
+				GridId:     tt.gridId,
+				GridNumber: tt.gridNumber,
+				Type:       tt.typ,
+				InAmount:   decimal.NewFromInt(10),
+				OutAmount:  decimal.NewFromInt(9),
+				Paper:      true,
+			}
+			keeper.sendPaperNotification(ord, decimal.NewFromInt(1), ord.OutAmount)
+		})
+	}
+}
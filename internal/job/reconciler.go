@@ -0,0 +1,446 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	reconcileSignatureLimit = 50             // 检查的最近交易签名数量
+	reconcileLookback       = 24 * time.Hour // 检查被拒绝订单的时间范围
+)
+
+// 钱包余额与网格持仓允许的误差比例
+var reconcileBalanceTolerance = decimal.NewFromFloat(0.01)
+
+var ErrReconcileRunning = errors.New("reconcile is running")
+
+// 同一时间只允许一个对账任务
+var reconcileMutex sync.Mutex
+
+// ReconcileReport 对账结果
+type ReconcileReport struct {
+	Account  string
+	Repaired []string // 已自动修复的问题
+	Flagged  []string // 需要人工处理的问题
+}
+
+func (r *ReconcileReport) HasIssues() bool {
+	return len(r.Repaired) > 0 || len(r.Flagged) > 0
+}
+
+func (r *ReconcileReport) String() string {
+	text := fmt.Sprintf("🔍 对账完成\n\n💳 钱包: `%s`", r.Account)
+	if !r.HasIssues() {
+		return text + "\n\n✅ 网格和订单与链上数据一致"
+	}
+
+	if len(r.Repaired) > 0 {
+		text = text + "\n\n🛠 已自动修复:\n• " + strings.Join(r.Repaired, "\n• ")
+	}
+	if len(r.Flagged) > 0 {
+		text = text + "\n\n⚠️ 需要人工处理:\n• " + strings.Join(r.Flagged, "\n• ")
+	}
+	return text
+}
+
+// Reconciler 比较数据库中的网格和订单与链上钱包余额和交易记录, 修复或标记不一致的数据
+type Reconciler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewReconciler(svcCtx *svc.ServiceContext) *Reconciler {
+	return &Reconciler{svcCtx: svcCtx}
+}
+
+// ReconcileAll 对账所有钱包, 发现问题时通知用户
+func (r *Reconciler) ReconcileAll(ctx context.Context) {
+	wallets, err := r.svcCtx.WalletModel.FindAll(ctx)
+	if err != nil {
+		logger.Errorf("[Reconciler] 查询钱包列表失败, %v", err)
+		return
+	}
+
+	for _, w := range wallets {
+		report, err := r.Reconcile(ctx, w)
+		if err != nil {
+			logger.Errorf("[Reconciler] 对账失败, account: %s, %v", w.Account, err)
+			continue
+		}
+		if !report.HasIssues() || w.UserId == 0 {
+			continue
+		}
+
+		text := report.String()
+		_, err = utils.SendMessage(r.svcCtx.BotApi, w.UserId, text)
+		if err != nil {
+			logger.Warnf("[Reconciler] 发送电报通知失败, userId: %d, text: %s, %v", w.UserId, text, err)
+		}
+	}
+}
+
+// Reconcile 对账指定钱包
+func (r *Reconciler) Reconcile(ctx context.Context, w *ent.Wallet) (*ReconcileReport, error) {
+	if !reconcileMutex.TryLock() {
+		return nil, ErrReconcileRunning
+	}
+	defer reconcileMutex.Unlock()
+
+	logger.Infof("[Reconciler] 开始对账, account: %s", w.Account)
+
+	strategies, err := r.walletStrategies(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReconcileReport{Account: w.Account}
+	if err = r.reconcileRejectedOrders(ctx, w, report); err != nil {
+		return nil, err
+	}
+	if err = r.reconcileGrids(ctx, w, strategies, report); err != nil {
+		return nil, err
+	}
+	if err = r.reconcileSignatures(ctx, w, strategies, report); err != nil {
+		return nil, err
+	}
+	if err = r.reconcileBalances(ctx, w, strategies, report); err != nil {
+		return nil, err
+	}
+
+	logger.Infof("[Reconciler] 对账完成, account: %s, repaired: %d, flagged: %d", w.Account, len(report.Repaired), len(report.Flagged))
+	return report, nil
+}
+
+// reconcileRejectedOrders 超时或过期被拒绝但实际已上链的订单, 恢复网格后重新打开订单等待确认
+// walletStrategies 查询使用该钱包真实交易的策略, 未指定钱包的策略使用默认钱包
+func (r *Reconciler) walletStrategies(ctx context.Context, w *ent.Wallet) (map[string]*ent.Strategy, error) {
+	offset := 0
+	const limit = 100
+
+	strategies := make(map[string]*ent.Strategy)
+	for {
+		data, _, err := r.svcCtx.StrategyModel.FindByUserId(ctx, w.UserId, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			break
+		}
+
+		for _, item := range data {
+			if strategy.IsPaperTrading(r.svcCtx, item) {
+				continue
+			}
+			if item.Account == w.Account || (item.Account == "" && w.IsDefault) {
+				strategies[item.GUID] = item
+			}
+		}
+
+		offset = offset + len(data)
+	}
+	return strategies, nil
+}
+
+func (r *Reconciler) reconcileRejectedOrders(ctx context.Context, w *ent.Wallet, report *ReconcileReport) error {
+	orders, err := r.svcCtx.OrderModel.FindRejectedOrders(
+		ctx, w.Account, []string{rejectReasonTimeout, rejectReasonExpired}, time.Now().Add(-reconcileLookback))
+	if err != nil {
+		return err
+	}
+	if len(orders) == 0 {
+		return nil
+	}
+
+	hashes := make([]string, 0, len(orders))
+	for _, item := range orders {
+		hashes = append(hashes, item.TxHash)
+	}
+	confirmed, err := solanautil.GetConfirmedSignatures(ctx, r.svcCtx.SolanaRpc, hashes)
+	if err != nil {
+		return err
+	}
+
+	for _, ord := range orders {
+		if !confirmed[ord.TxHash] {
+			continue
+		}
+
+		// 交易执行失败
+		_, err = solanautil.GetTokenBalanceChanges(ctx, r.svcCtx.SolanaRpc, ord.TxHash, ord.Account)
+		if err != nil {
+			if !solanautil.IsProgramError(err) {
+				logger.Warnf("[Reconciler] 获取交易详情失败, hash: %s, %v", ord.TxHash, err)
+			}
+			continue
+		}
+
+		ok, err := r.reopenOrder(ctx, ord)
+		if err != nil {
+			logger.Errorf("[Reconciler] 重新打开订单失败, id: %d, hash: %s, %v", ord.ID, ord.TxHash, err)
+			continue
+		}
+		if !ok {
+			report.Flagged = append(report.Flagged, fmt.Sprintf("*%s* %s订单已上链但网格状态已变化 [>>](https://solscan.io/tx/%s)",
+				ord.Symbol, lo.Ternary(ord.Type == order.TypeBuy, "买入", "卖出"), ord.TxHash))
+			continue
+		}
+
+		logger.Infof("[Reconciler] 订单已上链但被拒绝, 重新打开订单, id: %d, hash: %s", ord.ID, ord.TxHash)
+		report.Repaired = append(report.Repaired, fmt.Sprintf("*%s* %s订单已上链但被标记为失败, 已重新确认 [>>](https://solscan.io/tx/%s)",
+			ord.Symbol, lo.Ternary(ord.Type == order.TypeBuy, "买入", "卖出"), ord.TxHash))
+	}
+	return nil
+}
+
+func (r *Reconciler) reopenOrder(ctx context.Context, ord *ent.Order) (bool, error) {
+	ok := true
+	err := utils.Tx(ctx, r.svcCtx.DbClient, func(tx *ent.Tx) error {
+		if ord.GridId != nil && ord.GridNumber != nil {
+			gridModel := model.NewGridModel(tx.Grid)
+			g, err := gridModel.FindByGuid(ctx, *ord.GridId)
+			if err != nil && !ent.IsNotFound(err) {
+				return err
+			}
+
+			switch ord.Type {
+			case order.TypeBuy:
+				// 拒绝买入订单时网格已被删除, 按订单信息恢复
+				if ent.IsNotFound(err) {
+					// 网格区间上移后该网格已低于价格下限
+					if *ord.GridNumber < 0 {
+						ok = false
+						return nil
+					}
+
+					// 策略已经重新买入该网格
+					_, err = gridModel.FindByStrategyIdGridNumber(ctx, ord.StrategyId, *ord.GridNumber)
+					if err == nil {
+						ok = false
+						return nil
+					}
+					if !ent.IsNotFound(err) {
+						return err
+					}
+
+					args := ent.Grid{
+						GUID:       *ord.GridId,
+						Account:    ord.Account,
+						Token:      ord.Token,
+						Symbol:     ord.Symbol,
+						StrategyId: ord.StrategyId,
+						GridNumber: *ord.GridNumber,
+						OrderPrice: ord.Price,
+						FinalPrice: ord.FinalPrice,
+						Amount:     ord.InAmount,
+						Quantity:   ord.OutAmount,
+						Status:     grid.StatusBuying,
+					}
+					if _, err = gridModel.Save(ctx, args); err != nil {
+						return err
+					}
+				} else if err = gridModel.UpdateStatusByGuid(ctx, *ord.GridId, grid.StatusBuying); err != nil {
+					return err
+				}
+			case order.TypeSell:
+				// 网格已被删除或者已经重新卖出
+				if ent.IsNotFound(err) || g.Status != grid.StatusBought {
+					ok = false
+					return nil
+				}
+				if err = gridModel.SetSellingStatus(ctx, *ord.GridId); err != nil {
+					return err
+				}
+			}
+		}
+
+		return model.NewOrderModel(tx.Order).SetOrderPendingStatus(ctx, ord.ID)
+	})
+	return ok, err
+}
+
+// reconcileGrids 修复长时间处于买入中或卖出中, 但没有待确认订单的网格
+func (r *Reconciler) reconcileGrids(ctx context.Context, w *ent.Wallet, strategies map[string]*ent.Strategy, report *ReconcileReport) error {
+	grids, err := r.svcCtx.GridModel.FindByAccount(ctx, w.Account)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range grids {
+		if _, ok := strategies[g.StrategyId]; !ok {
+			continue
+		}
+		if g.Status == grid.StatusBought || time.Since(g.UpdateTime) < orderTimeout {
+			continue
+		}
+
+		typ := lo.Ternary(g.Status == grid.StatusBuying, order.TypeBuy, order.TypeSell)
+		ord, err := r.svcCtx.OrderModel.FindLatestByGridId(ctx, g.GUID, typ)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if ord != nil && ord.Status == order.StatusPending {
+			continue
+		}
+
+		var text string
+		closed := ord != nil && ord.Status == order.StatusClosed
+		switch {
+		case g.Status == grid.StatusBuying && closed:
+			err = r.svcCtx.GridModel.SetBoughtStatus(ctx, g.GUID, ord.FinalPrice, ord.OutAmount)
+			text = fmt.Sprintf("*%s* 网格 `#%d` 买入订单已成交, 状态已修复为已买入", g.Symbol, g.GridNumber)
+		case g.Status == grid.StatusBuying:
+			_, err = r.svcCtx.GridModel.DeleteByGuid(ctx, g.GUID)
+			text = fmt.Sprintf("*%s* 网格 `#%d` 没有有效的买入订单, 已删除网格", g.Symbol, g.GridNumber)
+		case closed:
+			_, err = r.svcCtx.GridModel.DeleteByGuid(ctx, g.GUID)
+			text = fmt.Sprintf("*%s* 网格 `#%d` 卖出订单已成交, 已删除网格", g.Symbol, g.GridNumber)
+		default:
+			err = r.svcCtx.GridModel.UpdateStatusByGuid(ctx, g.GUID, grid.StatusBought)
+			text = fmt.Sprintf("*%s* 网格 `#%d` 没有有效的卖出订单, 状态已恢复为已买入", g.Symbol, g.GridNumber)
+		}
+		if err != nil {
+			logger.Errorf("[Reconciler] 修复网格状态失败, guid: %s, status: %s, %v", g.GUID, g.Status, err)
+			continue
+		}
+
+		logger.Infof("[Reconciler] 修复网格状态, guid: %s, status: %s", g.GUID, g.Status)
+		report.Repaired = append(report.Repaired, text)
+	}
+	return nil
+}
+
+// reconcileSignatures 查找钱包最近交易中未记录到订单或提现的策略代币交易
+func (r *Reconciler) reconcileSignatures(ctx context.Context, w *ent.Wallet, strategies map[string]*ent.Strategy, report *ReconcileReport) error {
+	if len(strategies) == 0 {
+		return nil
+	}
+
+	tokens := make(map[string]string)
+	for _, item := range strategies {
+		tokens[item.Token] = item.Symbol
+	}
+
+	hashes, err := solanautil.GetRecentSignatures(ctx, r.svcCtx.SolanaRpc, w.Account, reconcileSignatureLimit)
+	if err != nil {
+		return err
+	}
+
+	// 提现交易不属于任何订单
+	withdrawals, err := r.svcCtx.WithdrawalModel.FindTxHashes(ctx, w.Account, hashes)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		if lo.Contains(withdrawals, hash) {
+			continue
+		}
+
+		_, err = r.svcCtx.OrderModel.FindByTxHash(ctx, hash)
+		if err == nil {
+			continue
+		}
+		if !ent.IsNotFound(err) {
+			return err
+		}
+
+		changes, err := solanautil.GetTokenBalanceChanges(ctx, r.svcCtx.SolanaRpc, hash, w.Account)
+		if err != nil {
+			logger.Debugf("[Reconciler] 获取交易详情失败, hash: %s, %v", hash, err)
+			continue
+		}
+
+		for token, change := range changes {
+			symbol, ok := tokens[token]
+			if !ok || change.Change.IsZero() {
+				continue
+			}
+
+			logger.Warnf("[Reconciler] 发现未记录的交易, account: %s, token: %s, change: %s, hash: %s", w.Account, token, change.Change, hash)
+			report.Flagged = append(report.Flagged, fmt.Sprintf("发现未记录的 *%s* 交易, 数量变化: %s [>>](https://solscan.io/tx/%s)",
+				symbol, change.Change, hash))
+		}
+	}
+	return nil
+}
+
+// reconcileBalances 比较钱包代币余额与已买入网格的持仓数量
+func (r *Reconciler) reconcileBalances(ctx context.Context, w *ent.Wallet, strategies map[string]*ent.Strategy, report *ReconcileReport) error {
+	if len(strategies) == 0 {
+		return nil
+	}
+
+	grids, err := r.svcCtx.GridModel.FindByAccount(ctx, w.Account)
+	if err != nil {
+		return err
+	}
+
+	// 统计网格持仓, 存在未完成订单的代币跳过检查
+	expected := make(map[string]decimal.Decimal)
+	busy := make(map[string]bool)
+	for _, item := range strategies {
+		expected[item.Token] = decimal.Zero
+	}
+	for _, g := range grids {
+		if _, ok := strategies[g.StrategyId]; !ok {
+			continue
+		}
+		switch g.Status {
+		case grid.StatusBuying, grid.StatusSelling:
+			busy[g.Token] = true
+		default:
+			expected[g.Token] = expected[g.Token].Add(g.Quantity)
+		}
+	}
+
+	pendingOrders, err := r.svcCtx.OrderModel.FindPendingOrdersByAccount(ctx, w.Account)
+	if err != nil {
+		return err
+	}
+	for _, item := range pendingOrders {
+		busy[item.Token] = true
+	}
+
+	for _, item := range strategies {
+		quantity, ok := expected[item.Token]
+		if !ok || busy[item.Token] {
+			continue
+		}
+		delete(expected, item.Token)
+
+		balance, decimals, err := solanautil.GetTokenBalance(ctx, r.svcCtx.SolanaRpc, item.Token, w.Account)
+		if err != nil {
+			logger.Warnf("[Reconciler] 获取代币余额失败, token: %s, account: %s, %v", item.Token, w.Account, err)
+			continue
+		}
+
+		uiBalance := solanautil.ParseUnits(balance, decimals)
+		diff := uiBalance.Sub(quantity)
+		if diff.Abs().LessThanOrEqual(quantity.Mul(reconcileBalanceTolerance)) || (quantity.IsZero() && uiBalance.IsZero()) {
+			continue
+		}
+
+		logger.Warnf("[Reconciler] 钱包余额与网格持仓不一致, account: %s, token: %s, balance: %s, quantity: %s",
+			w.Account, item.Token, uiBalance, quantity)
+		report.Flagged = append(report.Flagged, fmt.Sprintf("*%s* 钱包余额 %s 与网格持仓 %s 不一致, 差额: %s",
+			item.Symbol, uiBalance, quantity, diff))
+	}
+	return nil
+}
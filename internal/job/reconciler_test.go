@@ -0,0 +1,269 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const testAccount = "account"
+
+func newTestServiceContext(t *testing.T) *svc.ServiceContext {
+	dsn := fmt.Sprintf("file:reconciler-%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return &svc.ServiceContext{
+		Config:          &config.Config{},
+		DbClient:        client,
+		GridModel:       model.NewGridModel(client.Grid),
+		OrderModel:      model.NewOrderModel(client.Order),
+		StrategyModel:   model.NewStrategyModel(client.Strategy),
+		WalletModel:     model.NewWalletModel(client.Wallet),
+		WithdrawalModel: model.NewWithdrawalModel(client.Withdrawal),
+	}
+}
+
+func saveTestGrid(t *testing.T, svcCtx *svc.ServiceContext, strategyId string, gridNumber int, status grid.Status, age time.Duration) *ent.Grid {
+	ctx := context.Background()
+	g, err := svcCtx.GridModel.Save(ctx, ent.Grid{
+		GUID:       uuid.NewString(),
+		Account:    testAccount,
+		Token:      "token",
+		Symbol:     "TOKEN",
+		StrategyId: strategyId,
+		GridNumber: gridNumber,
+		OrderPrice: decimal.NewFromInt(1),
+		FinalPrice: decimal.NewFromInt(1),
+		Amount:     decimal.NewFromInt(10),
+		Quantity:   decimal.NewFromInt(10),
+		Status:     status,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, err = svcCtx.DbClient.Grid.UpdateOne(g).SetUpdateTime(time.Now().Add(-age)).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func saveTestOrder(t *testing.T, svcCtx *svc.ServiceContext, gridId string, gridNumber int, typ order.Type, status order.Status) *ent.Order {
+	ord, err := svcCtx.OrderModel.Save(context.Background(), ent.Order{
+		Account:    testAccount,
+		Token:      "token",
+		Symbol:     "TOKEN",
+		GridId:     &gridId,
+		GridNumber: &gridNumber,
+		StrategyId: "strategy",
+		Type:       typ,
+		Price:      decimal.NewFromInt(1),
+		FinalPrice: decimal.RequireFromString("1.1"),
+		InAmount:   decimal.NewFromInt(10),
+		OutAmount:  decimal.NewFromInt(9),
+		Status:     status,
+		TxHash:     uuid.NewString(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ord
+}
+
+func TestReopenOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		typ          order.Type
+		gridStatus   grid.Status // 为空时网格已被删除
+		rebought     bool        // 网格被删除后策略重新买入了同一编号的网格
+		gridNumber   int         // 订单的网格编号, 为 0 时使用 2
+		expectedOk   bool
+		expectedGrid grid.Status // 为空时网格不存在
+	}{
+		{name: "买入订单恢复已删除的网格", typ: order.TypeBuy, expectedOk: true, expectedGrid: grid.StatusBuying},
+		{name: "买入订单网格已重新买入", typ: order.TypeBuy, rebought: true, expectedOk: false},
+		{name: "买入订单网格已移出区间", typ: order.TypeBuy, gridNumber: -1, expectedOk: false},
+		{name: "买入订单更新网格状态", typ: order.TypeBuy, gridStatus: grid.StatusBought, expectedOk: true, expectedGrid: grid.StatusBuying},
+		{name: "卖出订单恢复卖出中", typ: order.TypeSell, gridStatus: grid.StatusBought, expectedOk: true, expectedGrid: grid.StatusSelling},
+		{name: "卖出订单网格已删除", typ: order.TypeSell, expectedOk: false},
+		{name: "卖出订单网格已重新卖出", typ: order.TypeSell, gridStatus: grid.StatusSelling, expectedOk: false, expectedGrid: grid.StatusSelling},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svcCtx := newTestServiceContext(t)
+
+			gridId := uuid.NewString()
+			if tt.gridStatus != "" {
+				gridId = saveTestGrid(t, svcCtx, "strategy", 2, tt.gridStatus, 0).GUID
+			}
+			if tt.rebought {
+				saveTestGrid(t, svcCtx, "strategy", 2, grid.StatusBought, 0)
+			}
+			ord := saveTestOrder(t, svcCtx, gridId, lo.Ternary(tt.gridNumber != 0, tt.gridNumber, 2), tt.typ, order.StatusRejected)
+
+			ok, err := NewReconciler(svcCtx).reopenOrder(ctx, ord)
+			if err != nil {
+				t.Fatalf("reopenOrder() 返回错误: %v", err)
+			}
+			if ok != tt.expectedOk {
+				t.Fatalf("reopenOrder() = %v, 期望 %v", ok, tt.expectedOk)
+			}
+
+			// 订单状态
+			ord, err = svcCtx.DbClient.Order.Get(ctx, ord.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectedStatus := order.StatusRejected
+			if tt.expectedOk {
+				expectedStatus = order.StatusPending
+			}
+			if ord.Status != expectedStatus {
+				t.Errorf("订单状态 = %s, 期望 %s", ord.Status, expectedStatus)
+			}
+
+			// 网格状态
+			g, err := svcCtx.GridModel.FindByGuid(ctx, gridId)
+			if tt.expectedGrid == "" {
+				if !ent.IsNotFound(err) {
+					t.Errorf("网格不应该存在, %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g.Status != tt.expectedGrid {
+				t.Errorf("网格状态 = %s, 期望 %s", g.Status, tt.expectedGrid)
+			}
+			if g.GridNumber != 2 {
+				t.Errorf("网格编号 = %d, 期望 2", g.GridNumber)
+			}
+		})
+	}
+}
+
+func TestReconcileGrids(t *testing.T) {
+	tests := []struct {
+		name        string
+		gridStatus  grid.Status
+		age         time.Duration
+		strategyId  string
+		orderType   order.Type // 为空时没有订单
+		orderStatus order.Status
+		expected    grid.Status // 为空时网格被删除
+		repaired    bool
+	}{
+		{name: "买入订单已成交", gridStatus: grid.StatusBuying, age: time.Hour, orderType: order.TypeBuy, orderStatus: order.StatusClosed, expected: grid.StatusBought, repaired: true},
+		{name: "没有买入订单", gridStatus: grid.StatusBuying, age: time.Hour, repaired: true},
+		{name: "买入订单被拒绝", gridStatus: grid.StatusBuying, age: time.Hour, orderType: order.TypeBuy, orderStatus: order.StatusRejected, repaired: true},
+		{name: "卖出订单已成交", gridStatus: grid.StatusSelling, age: time.Hour, orderType: order.TypeSell, orderStatus: order.StatusClosed, repaired: true},
+		{name: "没有卖出订单", gridStatus: grid.StatusSelling, age: time.Hour, expected: grid.StatusBought, repaired: true},
+		{name: "订单等待确认", gridStatus: grid.StatusSelling, age: time.Hour, orderType: order.TypeSell, orderStatus: order.StatusPending, expected: grid.StatusSelling},
+		{name: "网格刚更新", gridStatus: grid.StatusBuying, expected: grid.StatusBuying},
+		{name: "已买入的网格", gridStatus: grid.StatusBought, age: time.Hour, expected: grid.StatusBought},
+		{name: "其他钱包的策略", gridStatus: grid.StatusBuying, age: time.Hour, strategyId: "other", expected: grid.StatusBuying},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svcCtx := newTestServiceContext(t)
+
+			strategyId := "strategy"
+			if tt.strategyId != "" {
+				strategyId = tt.strategyId
+			}
+			g := saveTestGrid(t, svcCtx, strategyId, 2, tt.gridStatus, tt.age)
+			if tt.orderType != "" {
+				saveTestOrder(t, svcCtx, g.GUID, 2, tt.orderType, tt.orderStatus)
+			}
+
+			w := &ent.Wallet{Account: testAccount}
+			strategies := map[string]*ent.Strategy{"strategy": {GUID: "strategy", Account: testAccount}}
+			report := &ReconcileReport{Account: testAccount}
+			if err := NewReconciler(svcCtx).reconcileGrids(ctx, w, strategies, report); err != nil {
+				t.Fatalf("reconcileGrids() 返回错误: %v", err)
+			}
+
+			if repaired := len(report.Repaired) > 0; repaired != tt.repaired {
+				t.Errorf("修复 = %v, 期望 %v, %v", repaired, tt.repaired, report.Repaired)
+			}
+
+			got, err := svcCtx.GridModel.FindByGuid(ctx, g.GUID)
+			if tt.expected == "" {
+				if !ent.IsNotFound(err) {
+					t.Errorf("网格应该被删除, %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tt.expected {
+				t.Errorf("网格状态 = %s, 期望 %s", got.Status, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWalletStrategies(t *testing.T) {
+	ctx := context.Background()
+	svcCtx := newTestServiceContext(t)
+
+	// 策略数量超过单页数量, 需要分页查询
+	const count = 150
+	for i := range count + 2 {
+		item := ent.Strategy{
+			GUID:         uuid.NewString(),
+			UserId:       1,
+			Token:        uuid.NewString(),
+			Symbol:       "TOKEN",
+			MartinFactor: 1,
+			Status:       entstrategy.StatusActive,
+		}
+		switch i {
+		case count:
+			item.Account = "other" // 使用其他钱包
+		case count + 1:
+			item.PaperTrading = true // 模拟交易
+		}
+		if _, err := svcCtx.StrategyModel.Save(ctx, item); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w := &ent.Wallet{UserId: 1, Account: testAccount, IsDefault: true}
+	got, err := NewReconciler(svcCtx).walletStrategies(ctx, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != count {
+		t.Errorf("walletStrategies() 返回 %d 个策略, 期望 %d", len(got), count)
+	}
+}
@@ -0,0 +1,276 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	watcherReconnectInitial = 1 * time.Second  // 初始重连间隔
+	watcherReconnectMax     = 30 * time.Second // 最大重连间隔
+	watcherPingInterval     = 30 * time.Second // 心跳间隔
+	watcherPongWait         = 60 * time.Second // 读取超时, 收到消息或心跳响应后重新计时
+	watcherWriteWait        = 10 * time.Second // 写入超时
+)
+
+type rpcMessage struct {
+	ID     *uint64         `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+	Method string `json:"method"`
+	Params *struct {
+		Subscription uint64 `json:"subscription"`
+	} `json:"params"`
+}
+
+// SignatureWatcher 通过 Solana RPC WebSocket 订阅交易签名状态
+// 交易确认后通知 OrderKeeper 立即检查订单, 连接断开期间由轮询兜底
+type SignatureWatcher struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	stopChan      chan struct{}
+	url           string
+	mutex         sync.Mutex
+	conn          *websocket.Conn
+	requestId     uint64
+	requests      map[uint64]string   // 请求ID -> 交易哈希
+	subscriptions map[uint64]string   // 订阅ID -> 交易哈希
+	watching      map[string]struct{} // 已订阅的交易哈希
+	confirmed     chan string
+}
+
+func NewSignatureWatcher(url string) *SignatureWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &SignatureWatcher{
+		ctx:           ctx,
+		cancel:        cancel,
+		url:           url,
+		requests:      make(map[uint64]string),
+		subscriptions: make(map[uint64]string),
+		watching:      make(map[string]struct{}),
+		confirmed:     make(chan string, 100),
+	}
+}
+
+func (w *SignatureWatcher) Stop() {
+	if w.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[SignatureWatcher] 准备停止服务")
+
+	w.cancel()
+
+	w.mutex.Lock()
+	if w.conn != nil {
+		w.conn.Close()
+	}
+	w.mutex.Unlock()
+
+	<-w.stopChan
+	close(w.stopChan)
+	w.stopChan = nil
+
+	logger.Infof("[SignatureWatcher] 服务已经停止")
+}
+
+func (w *SignatureWatcher) Start() {
+	if w.stopChan != nil {
+		return
+	}
+
+	w.stopChan = make(chan struct{})
+	logger.Infof("[SignatureWatcher] 开始运行服务")
+	go w.run()
+}
+
+// Connected 连接是否可用
+func (w *SignatureWatcher) Connected() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.conn != nil
+}
+
+// Confirmed 已确认交易哈希通道
+func (w *SignatureWatcher) Confirmed() <-chan string {
+	return w.confirmed
+}
+
+// Watch 订阅交易签名, 未连接或已订阅时忽略
+func (w *SignatureWatcher) Watch(hash string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.conn == nil {
+		return
+	}
+	if _, ok := w.watching[hash]; ok {
+		return
+	}
+
+	w.requestId++
+	req := map[string]any{
+		"jsonrpc": "2.0",
+		"id":      w.requestId,
+		"method":  "signatureSubscribe",
+		"params":  []any{hash, map[string]string{"commitment": "confirmed"}},
+	}
+
+	w.conn.SetWriteDeadline(time.Now().Add(watcherWriteWait))
+	if err := w.conn.WriteJSON(req); err != nil {
+		logger.Warnf("[SignatureWatcher] 订阅交易签名失败, hash: %s, %v", hash, err)
+		w.conn.Close()
+		return
+	}
+
+	w.requests[w.requestId] = hash
+	w.watching[hash] = struct{}{}
+}
+
+func (w *SignatureWatcher) setConn(conn *websocket.Conn) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.conn = conn
+	w.requests = make(map[uint64]string)
+	w.subscriptions = make(map[uint64]string)
+	w.watching = make(map[string]struct{})
+}
+
+func (w *SignatureWatcher) run() {
+	delay := watcherReconnectInitial
+	for {
+		conn, _, err := websocket.DefaultDialer.DialContext(w.ctx, w.url, nil)
+		if err != nil {
+			if w.ctx.Err() != nil {
+				w.stopChan <- struct{}{}
+				return
+			}
+
+			logger.Warnf("[SignatureWatcher] 连接失败, 将在 %s 后重试, %v", delay, err)
+			select {
+			case <-time.After(delay):
+			case <-w.ctx.Done():
+				w.stopChan <- struct{}{}
+				return
+			}
+			delay = min(delay*2, watcherReconnectMax)
+			continue
+		}
+
+		delay = watcherReconnectInitial
+		logger.Infof("[SignatureWatcher] 连接成功")
+
+		w.setConn(conn)
+		err = w.readLoop(conn)
+		w.setConn(nil)
+		conn.Close()
+
+		if w.ctx.Err() != nil {
+			w.stopChan <- struct{}{}
+			return
+		}
+		logger.Warnf("[SignatureWatcher] 连接断开, %v", err)
+	}
+}
+
+func (w *SignatureWatcher) keepalive(conn *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(watcherPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.mutex.Lock()
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(watcherWriteWait))
+			w.mutex.Unlock()
+			if err != nil {
+				conn.Close()
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (w *SignatureWatcher) readLoop(conn *websocket.Conn) error {
+	done := make(chan struct{})
+	defer close(done)
+	go w.keepalive(conn, done)
+
+	// 没有订阅通知时只会收到心跳响应, 需要同样延长读取超时
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(watcherPongWait))
+	})
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(watcherPongWait))
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		var msg rpcMessage
+		if err = json.Unmarshal(data, &msg); err != nil {
+			logger.Debugf("[SignatureWatcher] 解析消息失败, message: %s, %v", data, err)
+			continue
+		}
+		w.handleMessage(&msg)
+	}
+}
+
+func (w *SignatureWatcher) handleMessage(msg *rpcMessage) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	// 订阅结果
+	if msg.ID != nil {
+		hash, ok := w.requests[*msg.ID]
+		if !ok {
+			return
+		}
+		delete(w.requests, *msg.ID)
+
+		var subscription uint64
+		err := errors.New("empty result")
+		if msg.Error != nil {
+			err = errors.New(msg.Error.Message)
+		} else if len(msg.Result) > 0 {
+			err = json.Unmarshal(msg.Result, &subscription)
+		}
+		if err != nil {
+			delete(w.watching, hash)
+			logger.Warnf("[SignatureWatcher] 订阅交易签名失败, hash: %s, %v", hash, err)
+			return
+		}
+		w.subscriptions[subscription] = hash
+		return
+	}
+
+	// 签名通知, 通知后服务端自动取消订阅
+	if msg.Method == "signatureNotification" && msg.Params != nil {
+		hash, ok := w.subscriptions[msg.Params.Subscription]
+		if !ok {
+			return
+		}
+		delete(w.subscriptions, msg.Params.Subscription)
+		delete(w.watching, hash)
+
+		select {
+		case w.confirmed <- hash:
+		default:
+			logger.Warnf("[SignatureWatcher] 通知队列已满, 等待轮询确认, hash: %s", hash)
+		}
+	}
+}
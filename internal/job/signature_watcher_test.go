@@ -0,0 +1,56 @@
+package job
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func parseTestMessage(t *testing.T, data string) *rpcMessage {
+	var msg rpcMessage
+	if err := json.Unmarshal([]byte(data), &msg); err != nil {
+		t.Fatal(err)
+	}
+	return &msg
+}
+
+func TestSignatureWatcherHandleMessage(t *testing.T) {
+	w := NewSignatureWatcher("")
+	w.requests[1] = "hash1"
+	w.requests[2] = "hash2"
+	w.watching["hash1"] = struct{}{}
+	w.watching["hash2"] = struct{}{}
+
+	// 订阅成功
+	w.handleMessage(parseTestMessage(t, `{"jsonrpc":"2.0","result":100,"id":1}`))
+	if w.subscriptions[100] != "hash1" {
+		t.Fatalf("订阅结果未记录, subscriptions: %v", w.subscriptions)
+	}
+
+	// 订阅失败后允许重新订阅
+	w.handleMessage(parseTestMessage(t, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":2}`))
+	if _, ok := w.watching["hash2"]; ok {
+		t.Error("订阅失败的交易哈希应该从订阅列表中移除")
+	}
+
+	// 未知订阅的通知
+	w.handleMessage(parseTestMessage(t, `{"jsonrpc":"2.0","method":"signatureNotification","params":{"result":{"context":{"slot":1},"value":{"err":null}},"subscription":999}}`))
+	select {
+	case hash := <-w.Confirmed():
+		t.Fatalf("未知订阅不应该通知, hash: %s", hash)
+	default:
+	}
+
+	// 签名通知
+	w.handleMessage(parseTestMessage(t, `{"jsonrpc":"2.0","method":"signatureNotification","params":{"result":{"context":{"slot":1},"value":{"err":null}},"subscription":100}}`))
+	select {
+	case hash := <-w.Confirmed():
+		if hash != "hash1" {
+			t.Errorf("Confirmed() = %s, 期望 hash1", hash)
+		}
+	default:
+		t.Fatal("签名通知后没有收到已确认交易")
+	}
+	if len(w.requests) != 0 || len(w.subscriptions) != 0 || len(w.watching) != 0 {
+		t.Errorf("通知后应该清理订阅, requests: %v, subscriptions: %v, watching: %v", w.requests, w.subscriptions, w.watching)
+	}
+}
@@ -0,0 +1,217 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/format"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+const withdrawPollingInterval = time.Second * 3 // 提现交易轮询间隔
+
+// WithdrawKeeper 跟踪提现交易的确认状态, 与订单相同按发送器状态和超时时间判定失败
+type WithdrawKeeper struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+	svcCtx   *svc.ServiceContext
+}
+
+func NewWithdrawKeeper(svcCtx *svc.ServiceContext) *WithdrawKeeper {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WithdrawKeeper{
+		ctx:    ctx,
+		cancel: cancel,
+		svcCtx: svcCtx,
+	}
+}
+
+func (keeper *WithdrawKeeper) Stop() {
+	if keeper.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[WithdrawKeeper] 准备停止服务")
+
+	keeper.cancel()
+
+	<-keeper.stopChan
+	close(keeper.stopChan)
+	keeper.stopChan = nil
+
+	logger.Infof("[WithdrawKeeper] 服务已经停止")
+}
+
+func (keeper *WithdrawKeeper) Start() {
+	if keeper.stopChan != nil {
+		return
+	}
+
+	keeper.stopChan = make(chan struct{})
+	logger.Infof("[WithdrawKeeper] 开始运行服务")
+	go keeper.run()
+}
+
+func (keeper *WithdrawKeeper) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			keeper.handlePolling()
+			timer.Reset(withdrawPollingInterval)
+		case <-keeper.ctx.Done():
+			keeper.stopChan <- struct{}{}
+			return
+		}
+	}
+}
+
+func (keeper *WithdrawKeeper) handlePolling() {
+	// 获取提现列表
+	withdrawals, err := keeper.svcCtx.WithdrawalModel.FindPendingWithdrawals(keeper.ctx, 100)
+	if err != nil {
+		logger.Errorf("[WithdrawKeeper] 获取提现列表失败, %v", err)
+		return
+	}
+	if len(withdrawals) == 0 {
+		return
+	}
+
+	hashes := make([]string, 0, len(withdrawals))
+	for _, item := range withdrawals {
+		hashes = append(hashes, item.TxHash)
+	}
+
+	// 查询失败时视为全部已确认, 逐笔获取交易详情
+	confirmed, err := solanautil.GetConfirmedSignatures(keeper.ctx, keeper.svcCtx.SolanaRpc, hashes)
+	if err != nil {
+		logger.Warnf("[WithdrawKeeper] 批量查询交易状态失败, %v", err)
+		confirmed = make(map[string]bool)
+		for _, hash := range hashes {
+			confirmed[hash] = true
+		}
+	}
+
+	now := time.Now()
+	for _, item := range withdrawals {
+		if !confirmed[item.TxHash] {
+			keeper.handleUnconfirmed(item, now)
+			continue
+		}
+
+		_, err = solanautil.GetTokenBalanceChanges(keeper.ctx, keeper.svcCtx.SolanaRpc, item.TxHash, item.Account)
+		if err != nil {
+			if solanautil.IsProgramError(err) {
+				keeper.handleReject(item, err.Error())
+				continue
+			}
+
+			if err == solanautil.ErrTxNotFound {
+				keeper.handleUnconfirmed(item, now)
+				continue
+			}
+
+			logger.Errorf("[WithdrawKeeper] 获取交易详情失败, hash: %s, %v", item.TxHash, err)
+			continue
+		}
+
+		keeper.handleClose(item)
+	}
+}
+
+// handleUnconfirmed 发送器判定区块哈希过期后拒绝提现, 发送器无法判定时按超时处理
+func (keeper *WithdrawKeeper) handleUnconfirmed(item *ent.Withdrawal, now time.Time) {
+	state := txsender.TxStateUnknown
+	if keeper.svcCtx.TxSender != nil {
+		state = keeper.svcCtx.TxSender.State(item.TxHash)
+	}
+
+	switch state {
+	case txsender.TxStateExpired:
+		keeper.handleReject(item, rejectReasonExpired)
+	case txsender.TxStateConfirmed:
+		// 等待交易详情
+	case txsender.TxStatePending:
+		// 发送器仍在重新广播, 以区块哈希过期作为最终判定, 无法获取区块高度时超时作为兜底
+		if now.Sub(item.CreateTime) > pendingTimeout {
+			keeper.svcCtx.TxSender.Abandon(item.TxHash)
+			keeper.handleReject(item, rejectReasonTimeout)
+		}
+	default:
+		// 发送器未跟踪的交易(例如程序重启)无法判定区块哈希是否过期, 按超时处理
+		if now.Sub(item.CreateTime) > orderTimeout {
+			keeper.handleReject(item, rejectReasonTimeout)
+		}
+	}
+}
+
+func (keeper *WithdrawKeeper) handleClose(item *ent.Withdrawal) {
+	// 读取交易费用
+	var fee model.WithdrawalFee
+	txFee, err := solanautil.GetTransactionFee(keeper.ctx, keeper.svcCtx.SolanaRpc, item.TxHash, item.Account)
+	if err == nil {
+		fee = model.WithdrawalFee{
+			BaseFee:     txFee.BaseFee,
+			PriorityFee: txFee.PriorityFee,
+			RentFee:     txFee.RentFee,
+		}
+	} else {
+		logger.Warnf("[WithdrawKeeper] 获取交易费用失败, hash: %s, %v", item.TxHash, err)
+	}
+
+	err = keeper.svcCtx.WithdrawalModel.SetWithdrawalClosedStatus(keeper.ctx, item.ID, fee)
+	if err != nil {
+		logger.Errorf("[WithdrawKeeper] 设置提现 closed 状态失败, id: %d, hash: %s, %v", item.ID, item.TxHash, err)
+		return
+	}
+	logger.Infof("[WithdrawKeeper] 设置提现 closed 状态, id: %d, token: %s, amount: %s, destination: %s, hash: %s",
+		item.ID, item.Symbol, item.Amount, item.Destination, item.TxHash)
+
+	feeSol := decimal.New(fee.BaseFee+fee.PriorityFee+fee.RentFee, -solanautil.SOLDecimals)
+	text := fmt.Sprintf("✅ 提现 %s %s 到 `%s` 成功 ⛽ 费用: %s SOL [>>](https://solscan.io/tx/%s)",
+		item.Amount, item.Symbol, format.ShortAddress(item.Destination), feeSol, item.TxHash)
+	keeper.sendNotification(item, text)
+}
+
+func (keeper *WithdrawKeeper) handleReject(item *ent.Withdrawal, reason string) {
+	err := keeper.svcCtx.WithdrawalModel.SetWithdrawalRejectedStatus(keeper.ctx, item.ID, reason)
+	if err != nil {
+		logger.Errorf("[WithdrawKeeper] 设置提现 rejected 状态失败, id: %d, hash: %s, %v", item.ID, item.TxHash, err)
+		return
+	}
+	logger.Infof("[WithdrawKeeper] 设置提现 rejected 状态, id: %d, hash: %s, reason: %s", item.ID, item.TxHash, reason)
+
+	reasonText := reason
+	switch reason {
+	case rejectReasonExpired:
+		reasonText = "交易过期未上链"
+	case rejectReasonTimeout:
+		reasonText = "交易超时未确认"
+	}
+	text := fmt.Sprintf("❌ 提现 %s %s 到 `%s` 失败, 原因: %s [>>](https://solscan.io/tx/%s)",
+		item.Amount, item.Symbol, format.ShortAddress(item.Destination), reasonText, item.TxHash)
+	keeper.sendNotification(item, text)
+}
+
+func (keeper *WithdrawKeeper) sendNotification(item *ent.Withdrawal, text string) {
+	if item.UserId == 0 {
+		return
+	}
+
+	_, err := utils.SendMessage(keeper.svcCtx.BotApi, item.UserId, text)
+	if err != nil {
+		logger.Warnf("[WithdrawKeeper] 发送电报通知失败, userId: %d, text: %s, %v", item.UserId, text, err)
+	}
+}
@@ -1,8 +1,8 @@
 package logger
 
 import (
+	"io"
 	"os"
-	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -33,27 +33,25 @@ func init() {
 	})
 	fileLogger.SetLevel(logrus.InfoLevel)
 
-	// 创建日志目录
-	logDir := "logs"
-	if err := os.MkdirAll(logDir, 0755); err != nil {
-		consoleLogger.Errorf("无法创建日志目录: %v", err)
+	// 未设置日志文件时不输出文件日志
+	fileLogger.SetOutput(io.Discard)
+
+	defaultLogger = &Logger{
+		Logger:     consoleLogger,
+		fileLogger: fileLogger,
 	}
+}
 
-	// 使用lumberjack进行日志轮转
+// SetLogFile 设置日志文件, 使用lumberjack进行日志轮转, 首次写入时创建日志目录
+func SetLogFile(filename string) {
 	logFile := &lumberjack.Logger{
-		Filename:   filepath.Join(logDir, "gridbot.log"),
+		Filename:   filename,
 		MaxSize:    10,
 		MaxBackups: 10,
 		MaxAge:     30,
 		Compress:   true,
 	}
-
-	fileLogger.SetOutput(logFile)
-
-	defaultLogger = &Logger{
-		Logger:     consoleLogger,
-		fileLogger: fileLogger,
-	}
+	defaultLogger.fileLogger.SetOutput(logFile)
 }
 
 func Infof(format string, args ...any) {
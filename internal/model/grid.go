@@ -47,6 +47,19 @@ func (model *GridModel) FindByStrategyId(ctx context.Context, strategyId string)
 		All(ctx)
 }
 
+func (model *GridModel) FindByStrategyIdGridNumber(ctx context.Context, strategyId string, gridNumber int) (*ent.Grid, error) {
+	return model.client.Query().
+		Where(grid.StrategyIdEQ(strategyId), grid.GridNumberEQ(gridNumber)).
+		First(ctx)
+}
+
+func (model *GridModel) FindByAccount(ctx context.Context, account string) ([]*ent.Grid, error) {
+	return model.client.Query().
+		Where(grid.AccountEQ(account)).
+		Order(grid.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
 func (model *GridModel) SetSellingStatus(ctx context.Context, guid string) error {
 	return model.client.Update().
 		Where(grid.GUIDEQ(guid)).
@@ -70,6 +83,13 @@ func (model *GridModel) UpdateStatusByGuid(ctx context.Context, guid string, sta
 		Exec(ctx)
 }
 
+func (model *GridModel) AddGridNumberByStrategyId(ctx context.Context, strategyId string, offset int) error {
+	return model.client.Update().
+		Where(grid.StrategyIdEQ(strategyId)).
+		AddGridNumber(offset).
+		Exec(ctx)
+}
+
 func (model *GridModel) DeleteByGuid(ctx context.Context, guid string) (int, error) {
 	return model.client.Delete().Where(grid.GUIDEQ(guid)).Exec(ctx)
 }
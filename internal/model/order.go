@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/ent/order"
@@ -14,6 +15,16 @@ type OrderModel struct {
 	client *ent.OrderClient
 }
 
+// OrderFee 订单交易费用, 除 FeeUsd 外单位均为 lamports
+type OrderFee struct {
+	BaseFee      int64
+	PriorityFee  int64
+	ComputeUnits int64
+	JitoTip      int64
+	RentFee      int64
+	FeeUsd       decimal.Decimal
+}
+
 func NewOrderModel(client *ent.OrderClient) *OrderModel {
 	return &OrderModel{client: client}
 }
@@ -36,25 +47,49 @@ func (model *OrderModel) Save(ctx context.Context, args ent.Order) (*ent.Order,
 		SetTxHash(args.TxHash).
 		SetReason(args.Reason).
 		SetNillableProfit(args.Profit).
+		SetPaper(args.Paper).
+		SetAggregator(args.Aggregator).
+		SetJitoTip(args.JitoTip).
 		Save(ctx)
 }
 
-func (model *OrderModel) TotalProfit(ctx context.Context, strategyId string, firstOrderId int) (decimal.Decimal, error) {
+// TotalProfit 统计已实现盈亏, 返回毛利润和扣除交易费用后的净利润
+func (model *OrderModel) TotalProfit(ctx context.Context, strategyId string, firstOrderId int) (gross, net decimal.Decimal, err error) {
 	orders, err := model.client.Query().
 		Where(order.StrategyIdEQ(strategyId), order.IDGTE(firstOrderId)).
 		All(ctx)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	var totalFee decimal.Decimal
+	for _, ord := range orders {
+		if ord.Profit != nil {
+			gross = gross.Add(*ord.Profit)
+		}
+		if ord.FeeUsd != nil {
+			totalFee = totalFee.Add(*ord.FeeUsd)
+		}
+	}
+	return gross, gross.Sub(totalFee), nil
+}
+
+// TotalFeeByGridId 统计网格所有订单的交易费用(USD)
+func (model *OrderModel) TotalFeeByGridId(ctx context.Context, gridId string) (decimal.Decimal, error) {
+	orders, err := model.client.Query().
+		Where(order.GridIdEQ(gridId)).
+		All(ctx)
 	if err != nil {
 		return decimal.Zero, err
 	}
 
-	var totalProfit decimal.Decimal
+	var totalFee decimal.Decimal
 	for _, ord := range orders {
-		if ord.Profit == nil {
-			continue
+		if ord.FeeUsd != nil {
+			totalFee = totalFee.Add(*ord.FeeUsd)
 		}
-		totalProfit = totalProfit.Add(*ord.Profit)
 	}
-	return totalProfit, nil
+	return totalFee, nil
 }
 
 func (model *OrderModel) FindPendingOrders(ctx context.Context, limit int) ([]*ent.Order, error) {
@@ -65,6 +100,100 @@ func (model *OrderModel) FindPendingOrders(ctx context.Context, limit int) ([]*e
 		All(ctx)
 }
 
+// FindPendingOrdersByAccount 查询钱包的未确认订单
+func (model *OrderModel) FindPendingOrdersByAccount(ctx context.Context, account string) ([]*ent.Order, error) {
+	return model.client.Query().
+		Where(order.AccountEQ(account), order.StatusEQ(order.StatusPending)).
+		Order(order.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (model *OrderModel) FindByTxHash(ctx context.Context, txHash string) (*ent.Order, error) {
+	return model.client.Query().
+		Where(order.TxHashEQ(txHash)).
+		First(ctx)
+}
+
+func (model *OrderModel) FindLatestByGridId(ctx context.Context, gridId string, typ order.Type) (*ent.Order, error) {
+	return model.client.Query().
+		Where(order.GridIdEQ(gridId), order.TypeEQ(typ)).
+		Order(order.ByID(sql.OrderDesc())).
+		First(ctx)
+}
+
+// HasPendingOrder 指定网格编号是否存在未确认的订单
+func (model *OrderModel) HasPendingOrder(ctx context.Context, strategyId string, gridNumber int) (bool, error) {
+	return model.client.Query().
+		Where(
+			order.StrategyIdEQ(strategyId),
+			order.GridNumberEQ(gridNumber),
+			order.StatusEQ(order.StatusPending),
+		).
+		Exist(ctx)
+}
+
+// AddGridNumberByStrategyId 网格区间移动时同步平移未确认和已拒绝订单的网格编号
+func (model *OrderModel) AddGridNumberByStrategyId(ctx context.Context, strategyId string, offset int) error {
+	return model.client.Update().
+		Where(
+			order.StrategyIdEQ(strategyId),
+			order.GridNumberNotNil(),
+			order.StatusIn(order.StatusPending, order.StatusRejected),
+		).
+		AddGridNumber(offset).
+		Exec(ctx)
+}
+
+// FindPendingTokens 查询账户中存在未确认真实订单的代币
+func (model *OrderModel) FindPendingTokens(ctx context.Context, account string) ([]string, error) {
+	return model.client.Query().
+		Where(
+			order.AccountEQ(account),
+			order.StatusEQ(order.StatusPending),
+			order.PaperEQ(false),
+		).
+		Unique(true).
+		Select(order.FieldToken).
+		Strings(ctx)
+}
+
+// FindRejectedOrders 查询指定时间后因指定原因被拒绝的真实订单
+func (model *OrderModel) FindRejectedOrders(ctx context.Context, account string, reasons []string, since time.Time) ([]*ent.Order, error) {
+	return model.client.Query().
+		Where(
+			order.AccountEQ(account),
+			order.StatusEQ(order.StatusRejected),
+			order.ReasonIn(reasons...),
+			order.PaperEQ(false),
+			order.CreateTimeGTE(since),
+		).
+		Order(order.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (model *OrderModel) PaperTokenBalance(ctx context.Context, account, token string) (decimal.Decimal, error) {
+	orders, err := model.client.Query().
+		Where(order.AccountEQ(account), order.TokenEQ(token), order.PaperEQ(true), order.StatusNEQ(order.StatusRejected)).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// 模拟余额 = 已成交买入数量 - 卖出数量(含待确认)
+	var balance decimal.Decimal
+	for _, ord := range orders {
+		switch ord.Type {
+		case order.TypeBuy:
+			if ord.Status == order.StatusClosed {
+				balance = balance.Add(ord.OutAmount)
+			}
+		case order.TypeSell:
+			balance = balance.Sub(ord.InAmount)
+		}
+	}
+	return decimal.Max(balance, decimal.Zero), nil
+}
+
 func (model *OrderModel) FindOrdersByStrategyId(ctx context.Context, strategyId string, offset, limit int) ([]*ent.Order, int, error) {
 	q := model.client.Query().
 		Where(order.StrategyIdEQ(strategyId))
@@ -85,10 +214,25 @@ func (model *OrderModel) UpdateProfit(ctx context.Context, id int, profit decima
 	return model.client.UpdateOneID(id).SetProfit(profit).Exec(ctx)
 }
 
+func (model *OrderModel) UpdateFee(ctx context.Context, id int, fee OrderFee) error {
+	return model.client.UpdateOneID(id).
+		SetBaseFee(fee.BaseFee).
+		SetPriorityFee(fee.PriorityFee).
+		SetComputeUnits(fee.ComputeUnits).
+		SetJitoTip(fee.JitoTip).
+		SetRentFee(fee.RentFee).
+		SetFeeUsd(fee.FeeUsd).
+		Exec(ctx)
+}
+
 func (model *OrderModel) SetOrderRejectedStatus(ctx context.Context, id int, reason string) error {
 	return model.client.UpdateOneID(id).SetStatus(order.StatusRejected).SetReason(reason).Exec(ctx)
 }
 
+func (model *OrderModel) SetOrderPendingStatus(ctx context.Context, id int) error {
+	return model.client.UpdateOneID(id).SetStatus(order.StatusPending).Exec(ctx)
+}
+
 func (model *OrderModel) SetOrderClosedStatus(ctx context.Context, id int, finalPrice, outAmount decimal.Decimal) error {
 	return model.client.UpdateOneID(id).SetStatus(order.StatusClosed).SetFinalPrice(finalPrice).SetOutAmount(outAmount).Exec(ctx)
 }
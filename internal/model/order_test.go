@@ -0,0 +1,160 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+)
+
+func newTestClient(t *testing.T) *ent.Client {
+	dsn := fmt.Sprintf("file:model-%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestPaperTokenBalance(t *testing.T) {
+	ctx := context.Background()
+	model := NewOrderModel(newTestClient(t).Order)
+
+	orders := []struct {
+		account string
+		token   string
+		typ     order.Type
+		status  order.Status
+		paper   bool
+		in      string
+		out     string
+	}{
+		{account: "account", token: "token", typ: order.TypeBuy, status: order.StatusClosed, paper: true, in: "10", out: "100"},
+		{account: "account", token: "token", typ: order.TypeBuy, status: order.StatusClosed, paper: true, in: "10", out: "50"},
+		{account: "account", token: "token", typ: order.TypeSell, status: order.StatusClosed, paper: true, in: "30", out: "4"},
+		{account: "account", token: "token", typ: order.TypeSell, status: order.StatusPending, paper: true, in: "20", out: "3"},   // 待确认卖出
+		{account: "account", token: "token", typ: order.TypeBuy, status: order.StatusPending, paper: true, in: "10", out: "1000"}, // 待确认买入
+		{account: "account", token: "token", typ: order.TypeSell, status: order.StatusRejected, paper: true, in: "50", out: "5"},  // 被拒绝
+		{account: "account", token: "token", typ: order.TypeBuy, status: order.StatusClosed, in: "10", out: "1000"},               // 真实订单
+		{account: "other", token: "token", typ: order.TypeBuy, status: order.StatusClosed, paper: true, in: "10", out: "1000"},    // 其他钱包
+		{account: "account", token: "other", typ: order.TypeBuy, status: order.StatusClosed, paper: true, in: "10", out: "1000"},  // 其他代币
+	}
+	for _, item := range orders {
+		_, err := model.Save(ctx, ent.Order{
+			Account:    item.account,
+			Token:      item.token,
+			Symbol:     "TOKEN",
+			StrategyId: "strategy",
+			Type:       item.typ,
+			Price:      decimal.NewFromInt(1),
+			FinalPrice: decimal.NewFromInt(1),
+			InAmount:   decimal.RequireFromString(item.in),
+			OutAmount:  decimal.RequireFromString(item.out),
+			Status:     item.status,
+			TxHash:     uuid.NewString(),
+			Paper:      item.paper,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	balance, err := model.PaperTokenBalance(ctx, "account", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !balance.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("PaperTokenBalance() = %s, 期望 100", balance)
+	}
+
+	// 没有模拟订单
+	balance, err = model.PaperTokenBalance(ctx, "empty", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !balance.IsZero() {
+		t.Errorf("PaperTokenBalance() = %s, 期望 0", balance)
+	}
+}
+
+func TestAddGridNumberByStrategyId(t *testing.T) {
+	ctx := context.Background()
+	model := NewOrderModel(newTestClient(t).Order)
+
+	orders := []struct {
+		strategyId string
+		status     order.Status
+		gridNumber int
+		expected   int
+	}{
+		{strategyId: "strategy", status: order.StatusPending, gridNumber: 5, expected: 3},
+		{strategyId: "strategy", status: order.StatusRejected, gridNumber: 1, expected: -1},
+		{strategyId: "strategy", status: order.StatusClosed, gridNumber: 5, expected: 5}, // 历史订单
+		{strategyId: "other", status: order.StatusPending, gridNumber: 5, expected: 5},   // 其他策略
+	}
+	ids := make([]int, 0, len(orders))
+	for _, item := range orders {
+		gridNumber := item.gridNumber
+		ord, err := model.Save(ctx, ent.Order{
+			Account:    "account",
+			Token:      "token",
+			Symbol:     "TOKEN",
+			GridNumber: &gridNumber,
+			StrategyId: item.strategyId,
+			Type:       order.TypeBuy,
+			Price:      decimal.NewFromInt(1),
+			FinalPrice: decimal.NewFromInt(1),
+			InAmount:   decimal.NewFromInt(10),
+			OutAmount:  decimal.NewFromInt(10),
+			Status:     item.status,
+			TxHash:     uuid.NewString(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, ord.ID)
+	}
+
+	// 没有网格编号的订单不受影响
+	_, err := model.Save(ctx, ent.Order{
+		Account:    "account",
+		Token:      "token",
+		Symbol:     "TOKEN",
+		StrategyId: "strategy",
+		Type:       order.TypeSell,
+		Price:      decimal.NewFromInt(1),
+		FinalPrice: decimal.NewFromInt(1),
+		InAmount:   decimal.NewFromInt(10),
+		OutAmount:  decimal.NewFromInt(10),
+		Status:     order.StatusPending,
+		TxHash:     uuid.NewString(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = model.AddGridNumberByStrategyId(ctx, "strategy", -2); err != nil {
+		t.Fatalf("AddGridNumberByStrategyId() 返回错误: %v", err)
+	}
+
+	for idx, item := range orders {
+		ord, err := model.client.Get(ctx, ids[idx])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ord.GridNumber == nil || *ord.GridNumber != item.expected {
+			t.Errorf("订单 %d 网格编号 = %v, 期望 %d", idx, ord.GridNumber, item.expected)
+		}
+	}
+}
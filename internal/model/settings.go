@@ -25,6 +25,12 @@ func (model *SettingsModel) Save(ctx context.Context, args ent.Settings) (*ent.S
 		SetMaxLamports(args.MaxLamports).
 		SetPriorityLevel(args.PriorityLevel).
 		SetDexAggregator(args.DexAggregator).
+		SetAggregatorPriority(args.AggregatorPriority).
+		SetTxSender(args.TxSender).
+		SetNillableJitoTip(args.JitoTip).
+		SetNillableExitJitoTip(args.ExitJitoTip).
+		SetSimulateTx(args.SimulateTx).
+		SetSkipExitSimulation(args.SkipExitSimulation).
 		Save(ctx)
 }
 
@@ -75,3 +81,39 @@ func (model *SettingsModel) UpdateDexAggregator(ctx context.Context, id int, dex
 		SetDexAggregator(dexAggregator).
 		Exec(ctx)
 }
+
+func (model *SettingsModel) UpdateTxSender(ctx context.Context, id int, newValue settings.TxSender) error {
+	return model.client.UpdateOneID(id).
+		SetTxSender(newValue).
+		Exec(ctx)
+}
+
+func (model *SettingsModel) UpdateJitoTip(ctx context.Context, id int, newValue int64) error {
+	return model.client.UpdateOneID(id).
+		SetJitoTip(newValue).
+		Exec(ctx)
+}
+
+func (model *SettingsModel) UpdateExitJitoTip(ctx context.Context, id int, newValue int64) error {
+	return model.client.UpdateOneID(id).
+		SetExitJitoTip(newValue).
+		Exec(ctx)
+}
+
+func (model *SettingsModel) UpdateSimulateTx(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).
+		SetSimulateTx(newValue).
+		Exec(ctx)
+}
+
+func (model *SettingsModel) UpdateSkipExitSimulation(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).
+		SetSkipExitSimulation(newValue).
+		Exec(ctx)
+}
+
+func (model *SettingsModel) UpdateAggregatorPriority(ctx context.Context, id int, newValue string) error {
+	return model.client.UpdateOneID(id).
+		SetAggregatorPriority(newValue).
+		Exec(ctx)
+}
@@ -20,15 +20,29 @@ func NewStrategyModel(client *ent.StrategyClient) *StrategyModel {
 }
 
 func (model *StrategyModel) Save(ctx context.Context, args ent.Strategy) (*ent.Strategy, error) {
+	gridMode := args.GridMode
+	if gridMode == "" {
+		gridMode = strategy.DefaultGridMode
+	}
+	strategyType := args.Type
+	if strategyType == "" {
+		strategyType = strategy.DefaultType
+	}
+
 	return model.client.Create().
 		SetGUID(args.GUID).
 		SetUserId(args.UserId).
+		SetAccount(args.Account).
 		SetToken(args.Token).
 		SetSymbol(args.Symbol).
+		SetType(strategyType).
 		SetMartinFactor(args.MartinFactor).
 		SetNillableMaxGridLimit(args.MaxGridLimit).
 		SetTakeProfitRatio(args.TakeProfitRatio).
 		SetLowerPriceBound(args.LowerPriceBound).
+		SetGridMode(gridMode).
+		SetGridCount(args.GridCount).
+		SetNillableGridStep(args.GridStep).
 		SetUpperPriceBound(args.UpperPriceBound).
 		SetInitialOrderSize(args.InitialOrderSize).
 		SetNillableFirstOrderId(args.FirstOrderId).
@@ -41,6 +55,16 @@ func (model *StrategyModel) Save(ctx context.Context, args ent.Strategy) (*ent.S
 		SetDropOn(args.DropOn).
 		SetCandlesToCheck(args.CandlesToCheck).
 		SetNillableDropThreshold(args.DropThreshold).
+		SetTrailingOn(args.TrailingOn).
+		SetTrailingCandles(args.TrailingCandles).
+		SetTrailingMaxShifts(args.TrailingMaxShifts).
+		SetBuyConditions(args.BuyConditions).
+		SetDcaInterval(args.DcaInterval).
+		SetNillableDcaDropRatio(args.DcaDropRatio).
+		SetDcaMaxOrders(args.DcaMaxOrders).
+		SetDcaSellInterval(args.DcaSellInterval).
+		SetNillableDcaSellProfitRatio(args.DcaSellProfitRatio).
+		SetPaperTrading(args.PaperTrading).
 		SetEnableAutoBuy(args.EnableAutoBuy).
 		SetEnableAutoSell(args.EnableAutoSell).
 		SetEnableAutoExit(args.EnableAutoExit).
@@ -58,6 +82,14 @@ func (model *StrategyModel) FindByGUID(ctx context.Context, guid string) (*ent.S
 		First(ctx)
 }
 
+func (model *StrategyModel) FindAll(ctx context.Context, offset, limit int) ([]*ent.Strategy, error) {
+	return model.client.Query().
+		Order(strategy.ByID(sql.OrderAsc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
 func (model *StrategyModel) FindAllActive(ctx context.Context, offset, limit int) ([]*ent.Strategy, error) {
 	return model.client.Query().
 		Where(strategy.StatusEQ(strategy.StatusActive)).
@@ -67,6 +99,12 @@ func (model *StrategyModel) FindAllActive(ctx context.Context, offset, limit int
 		All(ctx)
 }
 
+func (model *StrategyModel) FindByAccount(ctx context.Context, account string) ([]*ent.Strategy, error) {
+	return model.client.Query().
+		Where(strategy.AccountEQ(account)).
+		All(ctx)
+}
+
 func (model *StrategyModel) FindByUserIdGUID(ctx context.Context, userId int64, guid string) (*ent.Strategy, error) {
 	return model.client.Query().
 		Where(strategy.UserIdEQ(userId), strategy.GUIDEQ(guid)).
@@ -111,6 +149,10 @@ func (model *StrategyModel) UpdateDynamicStopLoss(ctx context.Context, id int, n
 	return model.client.UpdateOneID(id).SetDynamicStopLoss(newValue).Exec(ctx)
 }
 
+func (model *StrategyModel) UpdatePaperTrading(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetPaperTrading(newValue).Exec(ctx)
+}
+
 func (model *StrategyModel) UpdateGridTrend(ctx context.Context, id int, trending string) error {
 	return model.client.UpdateOneID(id).SetGridTrend(trending).Exec(ctx)
 }
@@ -127,6 +169,90 @@ func (model *StrategyModel) UpdateInitialOrderSize(ctx context.Context, id int,
 	return model.client.UpdateOneID(id).SetInitialOrderSize(newValue).Exec(ctx)
 }
 
+func (model *StrategyModel) UpdateMartinFactor(ctx context.Context, id int, newValue float64) error {
+	return model.client.UpdateOneID(id).SetMartinFactor(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateAccount(ctx context.Context, id int, newValue string) error {
+	return model.client.UpdateOneID(id).SetAccount(newValue).Exec(ctx)
+}
+
+// FillEmptyAccount 为未指定钱包的策略设置钱包
+func (model *StrategyModel) FillEmptyAccount(ctx context.Context, userId int64, account string) (int, error) {
+	return model.client.Update().
+		Where(strategy.UserIdEQ(userId), strategy.Or(strategy.AccountIsNil(), strategy.AccountEQ(""))).
+		SetAccount(account).
+		Save(ctx)
+}
+
+func (model *StrategyModel) UpdateGridMode(ctx context.Context, id int, newValue strategy.GridMode) error {
+	return model.client.UpdateOneID(id).SetGridMode(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateGridCount(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetGridCount(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateGridStep(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetGridStep(newValue).SetGridCount(0).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingOn(ctx context.Context, id int, newValue bool) error {
+	return model.client.UpdateOneID(id).SetTrailingOn(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingCandles(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetTrailingCandles(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingMaxShifts(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetTrailingMaxShifts(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateTrailingShifts(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetTrailingShifts(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateBuyConditions(ctx context.Context, id int, newValue string) error {
+	return model.client.UpdateOneID(id).SetBuyConditions(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaInterval(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetDcaInterval(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaDropRatio(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetDcaDropRatio(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaMaxOrders(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetDcaMaxOrders(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaSellInterval(ctx context.Context, id int, newValue int) error {
+	return model.client.UpdateOneID(id).SetDcaSellInterval(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaSellProfitRatio(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetDcaSellProfitRatio(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaLastBuy(ctx context.Context, id int, buyTime time.Time, buyPrice decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetDcaLastBuyTime(buyTime).SetDcaLastBuyPrice(buyPrice).Exec(ctx)
+}
+
+func (model *StrategyModel) UpdateDcaLastSellTime(ctx context.Context, id int, newValue time.Time) error {
+	return model.client.UpdateOneID(id).SetDcaLastSellTime(newValue).Exec(ctx)
+}
+
+func (model *StrategyModel) ClearDcaState(ctx context.Context, id int) error {
+	return model.client.UpdateOneID(id).ClearDcaLastBuyTime().ClearDcaLastBuyPrice().ClearDcaLastSellTime().Exec(ctx)
+}
+
+func (model *StrategyModel) UpdatePriceBounds(ctx context.Context, id int, lowerPriceBound, upperPriceBound decimal.Decimal) error {
+	return model.client.UpdateOneID(id).SetLowerPriceBound(lowerPriceBound).SetUpperPriceBound(upperPriceBound).Exec(ctx)
+}
+
 func (model *StrategyModel) UpdateMaxGridLimit(ctx context.Context, id int, newValue int) error {
 	return model.client.UpdateOneID(id).SetMaxGridLimit(newValue).Exec(ctx)
 }
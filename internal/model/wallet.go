@@ -5,6 +5,8 @@ import (
 
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/ent/wallet"
+
+	"entgo.io/ent/dialect/sql"
 )
 
 type WalletModel struct {
@@ -21,24 +23,82 @@ func (model *WalletModel) Save(ctx context.Context, args ent.Wallet) (*ent.Walle
 		SetAccount(args.Account).
 		SetPassword(args.Password).
 		SetPrivateKey(args.PrivateKey).
+		SetName(args.Name).
+		SetIsDefault(args.IsDefault).
 		Save(ctx)
 }
 
-func (model *WalletModel) FindByUserId(ctx context.Context, userId int64) (*ent.Wallet, error) {
+func (model *WalletModel) FindAll(ctx context.Context) ([]*ent.Wallet, error) {
+	return model.client.Query().All(ctx)
+}
+
+// FindDefaultByUserId 查询用户的默认钱包, 未设置默认钱包时返回最早创建的钱包
+func (model *WalletModel) FindDefaultByUserId(ctx context.Context, userId int64) (*ent.Wallet, error) {
+	return model.client.Query().
+		Where(wallet.UserIdEQ(userId)).
+		Order(wallet.ByIsDefault(sql.OrderDesc()), wallet.ByID(sql.OrderAsc())).
+		First(ctx)
+}
+
+func (model *WalletModel) FindAllByUserId(ctx context.Context, userId int64) ([]*ent.Wallet, error) {
 	return model.client.Query().
 		Where(wallet.UserIdEQ(userId)).
+		Order(wallet.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (model *WalletModel) FindByUserIdAccount(ctx context.Context, userId int64, account string) (*ent.Wallet, error) {
+	return model.client.Query().
+		Where(wallet.UserIdEQ(userId), wallet.AccountEQ(account)).
 		First(ctx)
 }
 
+func (model *WalletModel) CountByUserId(ctx context.Context, userId int64) (int, error) {
+	return model.client.Query().
+		Where(wallet.UserIdEQ(userId)).
+		Count(ctx)
+}
+
 func (model *WalletModel) FindByAccount(ctx context.Context, account string) (*ent.Wallet, error) {
 	return model.client.Query().
 		Where(wallet.AccountEQ(account)).
 		First(ctx)
 }
 
+func (model *WalletModel) UpdateName(ctx context.Context, account, name string) error {
+	return model.client.Update().
+		Where(wallet.AccountEQ(account)).
+		SetName(name).
+		Exec(ctx)
+}
+
+// SetDefault 将指定钱包设为用户的默认钱包
+// 两次更新需要在同一事务中执行, 调用方通过 utils.Tx 传入事务的 WalletClient, 避免出现没有默认钱包或多个默认钱包
+func (model *WalletModel) SetDefault(ctx context.Context, userId int64, account string) error {
+	err := model.client.Update().
+		Where(wallet.UserIdEQ(userId), wallet.AccountNEQ(account)).
+		SetIsDefault(false).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return model.client.Update().
+		Where(wallet.UserIdEQ(userId), wallet.AccountEQ(account)).
+		SetIsDefault(true).
+		Exec(ctx)
+}
+
 func (model *WalletModel) UpdatePassword(ctx context.Context, account, password string) error {
 	return model.client.Update().
 		Where(wallet.AccountEQ(account)).
 		SetPassword(password).
 		Exec(ctx)
 }
+
+func (model *WalletModel) UpdatePrivateKey(ctx context.Context, account, privateKey string) error {
+	return model.client.Update().
+		Where(wallet.AccountEQ(account)).
+		SetPrivateKey(privateKey).
+		Exec(ctx)
+}
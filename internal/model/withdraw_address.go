@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawaddress"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type WithdrawAddressModel struct {
+	client *ent.WithdrawAddressClient
+}
+
+func NewWithdrawAddressModel(client *ent.WithdrawAddressClient) *WithdrawAddressModel {
+	return &WithdrawAddressModel{client: client}
+}
+
+func (model *WithdrawAddressModel) Save(ctx context.Context, args ent.WithdrawAddress) (*ent.WithdrawAddress, error) {
+	return model.client.Create().
+		SetUserId(args.UserId).
+		SetAddress(args.Address).
+		SetName(args.Name).
+		Save(ctx)
+}
+
+func (model *WithdrawAddressModel) FindAllByUserId(ctx context.Context, userId int64) ([]*ent.WithdrawAddress, error) {
+	return model.client.Query().
+		Where(withdrawaddress.UserIdEQ(userId)).
+		Order(withdrawaddress.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (model *WithdrawAddressModel) FindByUserIdID(ctx context.Context, userId int64, id int) (*ent.WithdrawAddress, error) {
+	return model.client.Query().
+		Where(withdrawaddress.UserIdEQ(userId), withdrawaddress.IDEQ(id)).
+		First(ctx)
+}
+
+func (model *WithdrawAddressModel) CountByUserId(ctx context.Context, userId int64) (int, error) {
+	return model.client.Query().
+		Where(withdrawaddress.UserIdEQ(userId)).
+		Count(ctx)
+}
+
+func (model *WithdrawAddressModel) ExistsByUserIdAddress(ctx context.Context, userId int64, address string) (bool, error) {
+	return model.client.Query().
+		Where(withdrawaddress.UserIdEQ(userId), withdrawaddress.AddressEQ(address)).
+		Exist(ctx)
+}
+
+func (model *WithdrawAddressModel) DeleteByUserIdID(ctx context.Context, userId int64, id int) (int, error) {
+	return model.client.Delete().
+		Where(withdrawaddress.UserIdEQ(userId), withdrawaddress.IDEQ(id)).
+		Exec(ctx)
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type WithdrawalModel struct {
+	client *ent.WithdrawalClient
+}
+
+// WithdrawalFee 提现交易费用, 单位均为 lamports
+type WithdrawalFee struct {
+	BaseFee     int64
+	PriorityFee int64
+	RentFee     int64
+}
+
+func NewWithdrawalModel(client *ent.WithdrawalClient) *WithdrawalModel {
+	return &WithdrawalModel{client: client}
+}
+
+func (model *WithdrawalModel) Save(ctx context.Context, args ent.Withdrawal) (*ent.Withdrawal, error) {
+	return model.client.Create().
+		SetUserId(args.UserId).
+		SetAccount(args.Account).
+		SetToken(args.Token).
+		SetSymbol(args.Symbol).
+		SetDestination(args.Destination).
+		SetAmount(args.Amount).
+		SetStatus(args.Status).
+		SetTxHash(args.TxHash).
+		SetReason(args.Reason).
+		SetBaseFee(args.BaseFee).
+		SetPriorityFee(args.PriorityFee).
+		SetRentFee(args.RentFee).
+		Save(ctx)
+}
+
+func (model *WithdrawalModel) FindPendingWithdrawals(ctx context.Context, limit int) ([]*ent.Withdrawal, error) {
+	return model.client.Query().
+		Where(withdrawal.StatusEQ(withdrawal.StatusPending)).
+		Order(withdrawal.ByID(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// FindPendingTokens 查询账户中存在未确认提现的代币
+func (model *WithdrawalModel) FindPendingTokens(ctx context.Context, account string) ([]string, error) {
+	return model.client.Query().
+		Where(withdrawal.AccountEQ(account), withdrawal.StatusEQ(withdrawal.StatusPending)).
+		Unique(true).
+		Select(withdrawal.FieldToken).
+		Strings(ctx)
+}
+
+// FindTxHashes 查询给定交易哈希中属于账户提现的交易哈希
+func (model *WithdrawalModel) FindTxHashes(ctx context.Context, account string, hashes []string) ([]string, error) {
+	return model.client.Query().
+		Where(withdrawal.AccountEQ(account), withdrawal.TxHashIn(hashes...)).
+		Select(withdrawal.FieldTxHash).
+		Strings(ctx)
+}
+
+func (model *WithdrawalModel) FindRecentByUserId(ctx context.Context, userId int64, limit int) ([]*ent.Withdrawal, error) {
+	return model.client.Query().
+		Where(withdrawal.UserIdEQ(userId)).
+		Order(withdrawal.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+}
+
+func (model *WithdrawalModel) SetWithdrawalClosedStatus(ctx context.Context, id int, fee WithdrawalFee) error {
+	return model.client.UpdateOneID(id).
+		SetStatus(withdrawal.StatusClosed).
+		SetBaseFee(fee.BaseFee).
+		SetPriorityFee(fee.PriorityFee).
+		SetRentFee(fee.RentFee).
+		Exec(ctx)
+}
+
+func (model *WithdrawalModel) SetWithdrawalRejectedStatus(ctx context.Context, id int, reason string) error {
+	return model.client.UpdateOneID(id).SetStatus(withdrawal.StatusRejected).SetReason(reason).Exec(ctx)
+}
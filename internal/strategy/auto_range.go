@@ -0,0 +1,109 @@
+package strategy
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/config"
+
+	"github.com/shopspring/decimal"
+)
+
+// RangeProposal 根据历史K线建议的网格参数
+type RangeProposal struct {
+	Method          string
+	Price           decimal.Decimal
+	Atr             decimal.Decimal
+	LowerPriceBound decimal.Decimal
+	UpperPriceBound decimal.Decimal
+	TakeProfitRatio decimal.Decimal
+}
+
+// ProposeRange 根据波动率计算建议的价格区间和止盈比例
+func ProposeRange(ohlcs []charts.Ohlc, c config.AutoRange, defaultTakeProfitRatio decimal.Decimal) (RangeProposal, error) {
+	if len(ohlcs) == 0 {
+		return RangeProposal{}, errors.New("no ohlc data")
+	}
+
+	price := ohlcs[len(ohlcs)-1].Close
+	if price.LessThanOrEqual(decimal.Zero) {
+		return RangeProposal{}, errors.New("invalid latest price")
+	}
+
+	// 计算ATR
+	atr := decimal.Zero
+	if len(ohlcs) > c.AtrPeriod {
+		values := charts.CalculateATR(ohlcs, c.AtrPeriod)
+		if v := values[len(values)-1]; !math.IsNaN(v) && v > 0 {
+			atr = decimal.NewFromFloat(v)
+		}
+	}
+
+	// 计算价格区间
+	var lowerPriceBound, upperPriceBound decimal.Decimal
+	hundred := decimal.NewFromInt(100)
+	switch c.Method {
+	case "atr":
+		if atr.IsZero() {
+			return RangeProposal{}, errors.New("not enough ohlc data to calculate atr")
+		}
+		width := atr.Mul(c.AtrMultiplier)
+		lowerPriceBound = price.Sub(width)
+		upperPriceBound = price.Add(width)
+	case "percentile":
+		lowerPriceBound = percentile(ohlcs, c.LowerPercentile, func(item charts.Ohlc) decimal.Decimal { return item.Low })
+		upperPriceBound = percentile(ohlcs, c.UpperPercentile, func(item charts.Ohlc) decimal.Decimal { return item.High })
+		lowerPriceBound = decimal.Min(lowerPriceBound, price.Sub(atr))
+		upperPriceBound = decimal.Max(upperPriceBound, price.Add(atr))
+	default:
+		lowerPriceBound = price.Sub(price.Mul(c.LowerPercent).Div(hundred))
+		upperPriceBound = price.Add(price.Mul(c.UpperPercent).Div(hundred))
+	}
+
+	// 下限最低为当前价格的10%
+	lowerPriceBound = decimal.Max(lowerPriceBound, price.Div(decimal.NewFromInt(10)))
+	lowerPriceBound = roundSignificant(lowerPriceBound, 4)
+	upperPriceBound = roundSignificant(upperPriceBound, 4)
+	if !lowerPriceBound.LessThan(price) || !upperPriceBound.GreaterThan(price) {
+		return RangeProposal{}, errors.New("price range too narrow")
+	}
+
+	// 计算止盈比例
+	takeProfitRatio := defaultTakeProfitRatio
+	if c.TakeProfitAtrMultiplier.GreaterThan(decimal.Zero) && atr.GreaterThan(decimal.Zero) {
+		takeProfitRatio = atr.Div(price).Mul(hundred).Mul(c.TakeProfitAtrMultiplier)
+		takeProfitRatio = decimal.Max(takeProfitRatio, c.MinTakeProfitRatio)
+		takeProfitRatio = decimal.Min(takeProfitRatio, c.MaxTakeProfitRatio)
+		takeProfitRatio = takeProfitRatio.Round(2)
+	}
+
+	return RangeProposal{
+		Method:          c.Method,
+		Price:           price,
+		Atr:             atr,
+		LowerPriceBound: lowerPriceBound,
+		UpperPriceBound: upperPriceBound,
+		TakeProfitRatio: takeProfitRatio,
+	}, nil
+}
+
+func percentile(ohlcs []charts.Ohlc, p decimal.Decimal, value func(charts.Ohlc) decimal.Decimal) decimal.Decimal {
+	values := make([]decimal.Decimal, 0, len(ohlcs))
+	for _, item := range ohlcs {
+		values = append(values, value(item))
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].LessThan(values[j]) })
+
+	idx := p.Mul(decimal.NewFromInt(int64(len(values) - 1))).Div(decimal.NewFromInt(100)).Round(0).IntPart()
+	return values[idx]
+}
+
+func roundSignificant(d decimal.Decimal, digits int32) decimal.Decimal {
+	if d.IsZero() {
+		return d
+	}
+	integerDigits := int32(len(d.Coefficient().String())) + d.Exponent()
+	return d.Round(digits - integerDigits)
+}
@@ -0,0 +1,156 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/config"
+
+	"github.com/shopspring/decimal"
+)
+
+// newTestOhlcs 生成收盘价为 1 的K线, 第 i 根K线的最低价和最高价为 lows[i] 和 highs[i]
+func newTestOhlcs(lows, highs []string) []charts.Ohlc {
+	ohlcs := make([]charts.Ohlc, 0, len(lows))
+	for idx := range lows {
+		ohlcs = append(ohlcs, charts.Ohlc{
+			Open:  decimal.NewFromInt(1),
+			Close: decimal.NewFromInt(1),
+			High:  decimal.RequireFromString(highs[idx]),
+			Low:   decimal.RequireFromString(lows[idx]),
+		})
+	}
+	return ohlcs
+}
+
+func TestProposeRange(t *testing.T) {
+	// 每根K线的真实波幅都是 0.5, ATR 为 0.5
+	flat := newTestOhlcs(
+		[]string{"0.75", "0.75", "0.75", "0.75", "0.75", "0.75", "0.75", "0.75", "0.75", "0.75"},
+		[]string{"1.25", "1.25", "1.25", "1.25", "1.25", "1.25", "1.25", "1.25", "1.25", "1.25"},
+	)
+	spread := newTestOhlcs(
+		[]string{"0.5", "0.55", "0.6", "0.65", "0.7", "0.75", "0.8", "0.85", "0.9", "0.95"},
+		[]string{"1.05", "1.1", "1.15", "1.2", "1.25", "1.3", "1.35", "1.4", "1.45", "1.5"},
+	)
+
+	tests := []struct {
+		name       string
+		ohlcs      []charts.Ohlc
+		c          config.AutoRange
+		lower      string
+		upper      string
+		takeProfit string
+		wantErr    bool
+	}{
+		{
+			name:       "ATR 区间",
+			ohlcs:      flat,
+			c:          config.AutoRange{Method: "atr", AtrPeriod: 3, AtrMultiplier: decimal.NewFromInt(1)},
+			lower:      "0.5",
+			upper:      "1.5",
+			takeProfit: "5",
+		},
+		{
+			name:       "ATR 止盈比例",
+			ohlcs:      flat,
+			c:          config.AutoRange{Method: "atr", AtrPeriod: 3, AtrMultiplier: decimal.NewFromInt(1), TakeProfitAtrMultiplier: decimal.RequireFromString("0.12"), MinTakeProfitRatio: decimal.NewFromInt(1), MaxTakeProfitRatio: decimal.NewFromInt(10)},
+			lower:      "0.5",
+			upper:      "1.5",
+			takeProfit: "6",
+		},
+		{
+			name:       "止盈比例不超过上限",
+			ohlcs:      flat,
+			c:          config.AutoRange{Method: "atr", AtrPeriod: 3, AtrMultiplier: decimal.NewFromInt(1), TakeProfitAtrMultiplier: decimal.NewFromInt(1), MinTakeProfitRatio: decimal.NewFromInt(1), MaxTakeProfitRatio: decimal.NewFromInt(10)},
+			lower:      "0.5",
+			upper:      "1.5",
+			takeProfit: "10",
+		},
+		{
+			name:       "下限最低为当前价格的10%",
+			ohlcs:      flat,
+			c:          config.AutoRange{Method: "atr", AtrPeriod: 3, AtrMultiplier: decimal.NewFromInt(3)},
+			lower:      "0.1",
+			upper:      "2.5",
+			takeProfit: "5",
+		},
+		{
+			name:    "K线不足无法计算 ATR",
+			ohlcs:   flat[:3],
+			c:       config.AutoRange{Method: "atr", AtrPeriod: 3, AtrMultiplier: decimal.NewFromInt(1)},
+			wantErr: true,
+		},
+		{
+			name:       "百分位区间",
+			ohlcs:      spread,
+			c:          config.AutoRange{Method: "percentile", AtrPeriod: 20, LowerPercentile: decimal.NewFromInt(10), UpperPercentile: decimal.NewFromInt(90)},
+			lower:      "0.55",
+			upper:      "1.45",
+			takeProfit: "5",
+		},
+		{
+			name:       "固定百分比区间",
+			ohlcs:      spread,
+			c:          config.AutoRange{Method: "percent", AtrPeriod: 20, LowerPercent: decimal.NewFromInt(20), UpperPercent: decimal.NewFromInt(30)},
+			lower:      "0.8",
+			upper:      "1.3",
+			takeProfit: "5",
+		},
+		{
+			name:    "区间过窄",
+			ohlcs:   spread,
+			c:       config.AutoRange{Method: "percent", AtrPeriod: 20},
+			wantErr: true,
+		},
+		{
+			name:    "没有K线",
+			c:       config.AutoRange{Method: "percent", AtrPeriod: 20, LowerPercent: decimal.NewFromInt(20), UpperPercent: decimal.NewFromInt(30)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProposeRange(tt.ohlcs, tt.c, decimal.NewFromInt(5))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ProposeRange() 应该返回错误, 结果: %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ProposeRange() 返回错误: %v", err)
+			}
+			if !got.LowerPriceBound.Equal(decimal.RequireFromString(tt.lower)) || !got.UpperPriceBound.Equal(decimal.RequireFromString(tt.upper)) {
+				t.Errorf("ProposeRange() 区间 = %s - %s, 期望 %s - %s", got.LowerPriceBound, got.UpperPriceBound, tt.lower, tt.upper)
+			}
+			if !got.TakeProfitRatio.Equal(decimal.RequireFromString(tt.takeProfit)) {
+				t.Errorf("ProposeRange() 止盈比例 = %s, 期望 %s", got.TakeProfitRatio, tt.takeProfit)
+			}
+		})
+	}
+}
+
+func TestRoundSignificant(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "1.23456", expected: "1.235"},
+		{value: "123456", expected: "123500"},
+		{value: "0.000123456", expected: "0.0001235"},
+		{value: "9.99999", expected: "10"},
+		{value: "0.5", expected: "0.5"},
+		{value: "0", expected: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := roundSignificant(decimal.RequireFromString(tt.value), 4)
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("roundSignificant(%s, 4) = %s, 期望 %s", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
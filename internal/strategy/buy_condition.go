@@ -0,0 +1,292 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+)
+
+var (
+	conditionSeparator = regexp.MustCompile(`&&|;|\n`)
+	conditionPattern   = regexp.MustCompile(`^(.+?)\s*(<=|>=|<|>|\sABOVE\s|\sBELOW\s)\s*(.+)$`)
+	operandPattern     = regexp.MustCompile(`^([A-Z_]+)(?:\(([^)]*)\))?$`)
+)
+
+// indicatorSpec 指标参数个数及默认值
+type indicatorSpec struct {
+	defaults []float64
+}
+
+var indicatorSpecs = map[string]indicatorSpec{
+	"OPEN":        {},
+	"HIGH":        {},
+	"LOW":         {},
+	"CLOSE":       {},
+	"VWAP":        {},
+	"RSI":         {defaults: []float64{14}},
+	"SMA":         {defaults: []float64{20}},
+	"EMA":         {defaults: []float64{20}},
+	"ATR":         {defaults: []float64{14}},
+	"BB_UPPER":    {defaults: []float64{20, 2}},
+	"BB_MIDDLE":   {defaults: []float64{20, 2}},
+	"BB_LOWER":    {defaults: []float64{20, 2}},
+	"MACD":        {defaults: []float64{12, 26, 9}},
+	"MACD_SIGNAL": {defaults: []float64{12, 26, 9}},
+	"MACD_HIST":   {defaults: []float64{12, 26, 9}},
+}
+
+type conditionOperand struct {
+	name  string
+	args  []float64
+	value float64
+}
+
+func (o conditionOperand) String() string {
+	if o.name == "" {
+		return strconv.FormatFloat(o.value, 'f', -1, 64)
+	}
+	if len(o.args) == 0 {
+		return o.name
+	}
+
+	args := make([]string, 0, len(o.args))
+	for _, arg := range o.args {
+		args = append(args, strconv.FormatFloat(arg, 'f', -1, 64))
+	}
+	return fmt.Sprintf("%s(%s)", o.name, strings.Join(args, ","))
+}
+
+// lookback 计算指标所需的最少K线数量
+func (o conditionOperand) lookback() int {
+	switch o.name {
+	case "RSI", "ATR":
+		return int(o.args[0]) + 1
+	case "SMA", "EMA", "BB_UPPER", "BB_MIDDLE", "BB_LOWER":
+		return int(o.args[0])
+	case "MACD", "MACD_SIGNAL", "MACD_HIST":
+		return int(o.args[1]) + int(o.args[2])
+	default:
+		return 1
+	}
+}
+
+// validate 检查指标周期, 周期必须为整数且不小于 talib 要求的最小值, 否则计算时会越界
+func (o conditionOperand) validate() error {
+	var periods []float64
+	var minimums []float64
+	switch o.name {
+	case "RSI", "SMA", "EMA", "BB_UPPER", "BB_MIDDLE", "BB_LOWER":
+		periods, minimums = o.args[:1], []float64{2}
+	case "ATR":
+		periods, minimums = o.args[:1], []float64{1}
+	case "MACD", "MACD_SIGNAL", "MACD_HIST":
+		periods, minimums = o.args, []float64{2, 2, 1}
+	}
+
+	for idx, period := range periods {
+		if period != math.Trunc(period) {
+			return fmt.Errorf("period of %s must be an integer: %s", o.name, strconv.FormatFloat(period, 'f', -1, 64))
+		}
+		if period < minimums[idx] {
+			return fmt.Errorf("period of %s must be at least %d", o.name, int(minimums[idx]))
+		}
+	}
+
+	if len(periods) == 3 && periods[0] >= periods[1] {
+		return fmt.Errorf("fast period of %s must be less than slow period", o.name)
+	}
+	return nil
+}
+
+func (o conditionOperand) evaluate(ohlcs []charts.Ohlc) (float64, error) {
+	if o.name == "" {
+		return o.value, nil
+	}
+	if len(ohlcs) < o.lookback() {
+		return 0, fmt.Errorf("not enough ohlc data for %s, require: %d, actual: %d", o, o.lookback(), len(ohlcs))
+	}
+
+	var values []float64
+	latest := ohlcs[len(ohlcs)-1]
+	switch o.name {
+	case "OPEN":
+		return latest.Open.InexactFloat64(), nil
+	case "HIGH":
+		return latest.High.InexactFloat64(), nil
+	case "LOW":
+		return latest.Low.InexactFloat64(), nil
+	case "CLOSE":
+		return latest.Close.InexactFloat64(), nil
+	case "VWAP":
+		values = charts.CalculateVWAP(ohlcs)
+	case "RSI":
+		values = charts.CalculateRSI(ohlcs, int(o.args[0]))
+	case "SMA":
+		values = charts.CalculateSMA(ohlcs, int(o.args[0]))
+	case "EMA":
+		values = charts.CalculateEMA(ohlcs, int(o.args[0]))
+	case "ATR":
+		values = charts.CalculateATR(ohlcs, int(o.args[0]))
+	case "BB_UPPER", "BB_MIDDLE", "BB_LOWER":
+		upper, middle, lower := charts.CalculateBollingerBands(ohlcs, int(o.args[0]), o.args[1])
+		values = map[string][]float64{"BB_UPPER": upper, "BB_MIDDLE": middle, "BB_LOWER": lower}[o.name]
+	case "MACD", "MACD_SIGNAL", "MACD_HIST":
+		macd, signal, hist := charts.CalculateMACD(ohlcs, int(o.args[0]), int(o.args[1]), int(o.args[2]))
+		values = map[string][]float64{"MACD": macd, "MACD_SIGNAL": signal, "MACD_HIST": hist}[o.name]
+	}
+
+	if len(values) == 0 || math.IsNaN(values[len(values)-1]) {
+		return 0, fmt.Errorf("failed to calculate %s", o)
+	}
+	return values[len(values)-1], nil
+}
+
+// BuyCondition 网格买入前需要满足的指标条件
+type BuyCondition struct {
+	left  conditionOperand
+	op    string
+	right conditionOperand
+}
+
+func (c BuyCondition) String() string {
+	return fmt.Sprintf("%s %s %s", c.left, c.op, c.right)
+}
+
+// Check 检查最新K线是否满足条件
+func (c BuyCondition) Check(ohlcs []charts.Ohlc) (bool, error) {
+	left, err := c.left.evaluate(ohlcs)
+	if err != nil {
+		return false, err
+	}
+	right, err := c.right.evaluate(ohlcs)
+	if err != nil {
+		return false, err
+	}
+
+	switch c.op {
+	case "<":
+		return left < right, nil
+	case "<=":
+		return left <= right, nil
+	case ">":
+		return left > right, nil
+	default:
+		return left >= right, nil
+	}
+}
+
+func parseConditionOperand(text string) (conditionOperand, error) {
+	if value, err := strconv.ParseFloat(text, 64); err == nil {
+		return conditionOperand{value: value}, nil
+	}
+
+	matches := operandPattern.FindStringSubmatch(strings.ReplaceAll(text, " ", ""))
+	if matches == nil {
+		return conditionOperand{}, fmt.Errorf("invalid operand: %s", text)
+	}
+
+	name := matches[1]
+	spec, ok := indicatorSpecs[name]
+	if !ok {
+		return conditionOperand{}, fmt.Errorf("unsupported indicator: %s", name)
+	}
+
+	var args []float64
+	if matches[2] != "" {
+		for _, item := range strings.Split(matches[2], ",") {
+			arg, err := strconv.ParseFloat(item, 64)
+			if err != nil || arg <= 0 {
+				return conditionOperand{}, fmt.Errorf("invalid argument of %s: %s", name, item)
+			}
+			args = append(args, arg)
+		}
+	}
+	if len(args) > len(spec.defaults) {
+		return conditionOperand{}, fmt.Errorf("too many arguments of %s", name)
+	}
+	args = append(args, spec.defaults[len(args):]...)
+
+	operand := conditionOperand{name: name, args: args}
+	if err := operand.validate(); err != nil {
+		return conditionOperand{}, err
+	}
+	if operand.lookback() > 1000 {
+		return conditionOperand{}, fmt.Errorf("period of %s too large", name)
+	}
+	return operand, nil
+}
+
+// ParseBuyConditions 解析买入条件, 多个条件使用 && 或 ; 分隔, 例如: RSI(14) < 35 && CLOSE > EMA(50)
+func ParseBuyConditions(expr string) ([]BuyCondition, error) {
+	var conditions []BuyCondition
+	for _, item := range conditionSeparator.Split(strings.ToUpper(expr), -1) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		matches := conditionPattern.FindStringSubmatch(item)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid condition: %s", item)
+		}
+
+		left, err := parseConditionOperand(strings.TrimSpace(matches[1]))
+		if err != nil {
+			return nil, err
+		}
+		right, err := parseConditionOperand(strings.TrimSpace(matches[3]))
+		if err != nil {
+			return nil, err
+		}
+		if left.name == "" && right.name == "" {
+			return nil, fmt.Errorf("condition without indicator: %s", item)
+		}
+
+		op := strings.TrimSpace(matches[2])
+		switch op {
+		case "ABOVE":
+			op = ">"
+		case "BELOW":
+			op = "<"
+		}
+		conditions = append(conditions, BuyCondition{left: left, op: op, right: right})
+	}
+
+	if len(conditions) == 0 {
+		return nil, errors.New("empty buy conditions")
+	}
+	return conditions, nil
+}
+
+// FormatBuyConditions 将买入条件格式化为统一的文本
+func FormatBuyConditions(conditions []BuyCondition) string {
+	items := make([]string, 0, len(conditions))
+	for _, item := range conditions {
+		items = append(items, item.String())
+	}
+	return strings.Join(items, " && ")
+}
+
+// checkBuyConditions 检查所有买入条件, 返回第一个不满足的条件
+func checkBuyConditions(expr string, ohlcs []charts.Ohlc) (bool, string, error) {
+	conditions, err := ParseBuyConditions(expr)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, item := range conditions {
+		ok, err := item.Check(ohlcs)
+		if err != nil {
+			return false, item.String(), err
+		}
+		if !ok {
+			return false, item.String(), nil
+		}
+	}
+	return true, "", nil
+}
@@ -0,0 +1,143 @@
+package strategy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+
+	"github.com/shopspring/decimal"
+)
+
+// testOhlcs 生成价格上下波动的K线数据
+func testOhlcs(count int) []charts.Ohlc {
+	ohlcs := make([]charts.Ohlc, 0, count)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < count; i++ {
+		price := decimal.NewFromInt(int64(100 + i%7*3 - i%3*2))
+		ohlcs = append(ohlcs, charts.Ohlc{
+			Open:   price.Sub(decimal.NewFromInt(1)),
+			Close:  price,
+			High:   price.Add(decimal.NewFromInt(2)),
+			Low:    price.Sub(decimal.NewFromInt(2)),
+			Time:   start.Add(time.Duration(i) * time.Minute),
+			Volume: decimal.NewFromInt(int64(1000 + i)),
+		})
+	}
+	return ohlcs
+}
+
+func TestParseBuyConditions(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected string
+		wantErr  bool
+	}{
+		{name: "默认参数", expr: "rsi < 35", expected: "RSI(14) < 35"},
+		{name: "多个条件", expr: "RSI(14) < 35 && CLOSE ABOVE EMA(50)", expected: "RSI(14) < 35 && CLOSE > EMA(50)"},
+		{name: "分号分隔", expr: "CLOSE > SMA(2); MACD_HIST(2,3,1) >= 0", expected: "CLOSE > SMA(2) && MACD_HIST(2,3,1) >= 0"},
+		{name: "布林带小数倍数", expr: "BB_LOWER(20,1.5) < CLOSE", expected: "BB_LOWER(20,1.5) < CLOSE"},
+		{name: "ATR最小周期", expr: "ATR(1) > 0", expected: "ATR(1) > 0"},
+		{name: "空条件", expr: " ", wantErr: true},
+		{name: "没有指标", expr: "1 < 2", wantErr: true},
+		{name: "不支持的指标", expr: "KDJ(9) > 50", wantErr: true},
+		{name: "参数过多", expr: "SMA(20,2) > 0", wantErr: true},
+		{name: "周期过大", expr: "SMA(1001) > 0", wantErr: true},
+		{name: "负数周期", expr: "SMA(-5) > 0", wantErr: true},
+		{name: "MACD周期为1", expr: "MACD(1,1,1) > 0", wantErr: true},
+		{name: "小数周期", expr: "CLOSE > SMA(0.5)", wantErr: true},
+		{name: "布林带小数周期", expr: "BB_LOWER(0.9) < CLOSE", wantErr: true},
+		{name: "MACD信号小数周期", expr: "MACD_HIST(12,26,0.5) > 0", wantErr: true},
+		{name: "SMA周期为1", expr: "CLOSE > SMA(1)", wantErr: true},
+		{name: "EMA周期为1", expr: "CLOSE > EMA(1)", wantErr: true},
+		{name: "RSI周期为1", expr: "RSI(1) < 30", wantErr: true},
+		{name: "布林带周期为1", expr: "BB_UPPER(1) > CLOSE", wantErr: true},
+		{name: "MACD快线不小于慢线", expr: "MACD(26,12,9) > 0", wantErr: true},
+		{name: "MACD快线等于慢线", expr: "MACD(12,12,9) > 0", wantErr: true},
+		{name: "ATR小数周期", expr: "ATR(1.5) > 0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, err := ParseBuyConditions(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseBuyConditions(%q) 应该返回错误, 结果: %s", tt.expr, FormatBuyConditions(conditions))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBuyConditions(%q) 返回错误: %v", tt.expr, err)
+			}
+			if got := FormatBuyConditions(conditions); got != tt.expected {
+				t.Errorf("ParseBuyConditions(%q) = %q, 期望 %q", tt.expr, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuyConditionCheck(t *testing.T) {
+	// 各指标使用允许的最小周期, 并且只提供刚好满足 lookback 的K线数量
+	exprs := []string{
+		"OPEN > 0",
+		"HIGH > LOW",
+		"CLOSE > 0",
+		"VWAP > 0",
+		"RSI(2) >= 0",
+		"SMA(2) > 0",
+		"EMA(2) > 0",
+		"ATR(1) >= 0",
+		"BB_UPPER(2) >= BB_LOWER(2)",
+		"BB_MIDDLE(2,0.5) > 0",
+		"MACD(2,3,1) > -1000",
+		"MACD_SIGNAL(2,3,1) > -1000",
+		"MACD_HIST(2,3,1) > -1000",
+		"RSI(14) >= 0",
+		"MACD_HIST(12,26,9) > -1000",
+	}
+
+	for _, expr := range exprs {
+		t.Run(expr, func(t *testing.T) {
+			conditions, err := ParseBuyConditions(expr)
+			if err != nil {
+				t.Fatalf("ParseBuyConditions(%q) 返回错误: %v", expr, err)
+			}
+
+			for _, condition := range conditions {
+				count := max(condition.left.lookback(), condition.right.lookback())
+				ok, err := condition.Check(testOhlcs(count))
+				if err != nil {
+					t.Fatalf("%s 计算失败: %v", condition, err)
+				}
+				if !ok {
+					t.Errorf("%s 应该满足条件", condition)
+				}
+
+				// K线数量不足时返回错误而不是越界
+				if count > 1 {
+					if _, err = condition.Check(testOhlcs(count - 1)); err == nil {
+						t.Errorf("%s K线不足时应该返回错误", condition)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCheckBuyConditions(t *testing.T) {
+	ohlcs := testOhlcs(60)
+
+	ok, failed, err := checkBuyConditions("CLOSE > 0 && CLOSE < 0", ohlcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok || failed != "CLOSE < 0" {
+		t.Errorf("应该返回第一个不满足的条件, ok: %v, failed: %s", ok, failed)
+	}
+
+	ok, _, err = checkBuyConditions("CLOSE > SMA(0.5)", ohlcs)
+	if err == nil || ok {
+		t.Errorf("非法周期应该返回错误")
+	}
+}
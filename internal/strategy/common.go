@@ -8,9 +8,11 @@ import (
 
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
 	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/samber/lo"
@@ -74,6 +76,115 @@ func updateGridTrend(trending []int, gridNumber int) []int {
 	return trending
 }
 
+// CalculateGridStep 计算等差网格的价格间隔, 网格数量优先于固定间隔
+func CalculateGridStep(strategyRecord *ent.Strategy) (decimal.Decimal, error) {
+	if strategyRecord.GridCount > 0 {
+		return utils.CalculateArithmeticStep(strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.GridCount)
+	}
+	if strategyRecord.GridStep != nil && strategyRecord.GridStep.GreaterThan(decimal.Zero) {
+		return *strategyRecord.GridStep, nil
+	}
+	return decimal.Zero, errors.New("grid step or grid count is required")
+}
+
+// GenerateGridList 根据网格模式生成网格列表
+func GenerateGridList(strategyRecord *ent.Strategy) ([]decimal.Decimal, error) {
+	if strategyRecord.GridMode == entstrategy.GridModeArithmetic {
+		step, err := CalculateGridStep(strategyRecord)
+		if err != nil {
+			return nil, err
+		}
+		return utils.GenerateArithmeticGrid(strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, step)
+	}
+
+	takeProfitRatio := strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100))
+	return utils.GenerateGrid(strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, takeProfitRatio)
+}
+
+// calculateTakeProfitPrice 计算网格止盈价格, 等比网格按止盈比例, 等差网格卖在上一格
+func calculateTakeProfitPrice(strategyRecord *ent.Strategy, gridRecord *ent.Grid) (decimal.Decimal, error) {
+	if strategyRecord.GridMode == entstrategy.GridModeArithmetic {
+		step, err := CalculateGridStep(strategyRecord)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		nextGridPrice := strategyRecord.LowerPriceBound.Add(step.Mul(decimal.NewFromInt(int64(gridRecord.GridNumber + 1))))
+		return decimal.Max(nextGridPrice, gridRecord.FinalPrice.Add(step)), nil
+	}
+
+	profit := gridRecord.FinalPrice.Mul(strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+	return gridRecord.FinalPrice.Add(profit), nil
+}
+
+// calculateExitPrice 计算跌破清仓价格, 即网格底部再向下一格
+func calculateExitPrice(strategyRecord *ent.Strategy) (decimal.Decimal, error) {
+	if strategyRecord.GridMode == entstrategy.GridModeArithmetic {
+		step, err := CalculateGridStep(strategyRecord)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return strategyRecord.LowerPriceBound.Sub(step), nil
+	}
+
+	takeProfitRatio := strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100))
+	return strategyRecord.LowerPriceBound.Sub(strategyRecord.LowerPriceBound.Mul(takeProfitRatio)), nil
+}
+
+// nextGridLevel 返回计算相邻上一格价格的函数
+func nextGridLevel(strategyRecord *ent.Strategy) (func(decimal.Decimal) decimal.Decimal, error) {
+	if strategyRecord.GridMode == entstrategy.GridModeArithmetic {
+		step, err := CalculateGridStep(strategyRecord)
+		if err != nil {
+			return nil, err
+		}
+		return func(level decimal.Decimal) decimal.Decimal { return level.Add(step) }, nil
+	}
+
+	takeProfitRatio := strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100))
+	return func(level decimal.Decimal) decimal.Decimal { return level.Add(level.Mul(takeProfitRatio)) }, nil
+}
+
+// calculateTrailingShift 计算网格区间需要上移的格数, 使当前价格回到区间中部
+func calculateTrailingShift(strategyRecord *ent.Strategy, gridList []decimal.Decimal, latestPrice decimal.Decimal) (int, error) {
+	if len(gridList) == 0 {
+		return 0, errors.New("grid list is empty")
+	}
+
+	next, err := nextGridLevel(strategyRecord)
+	if err != nil {
+		return 0, err
+	}
+
+	const maxLevels = 10000
+	pos := len(gridList) - 1
+	level := gridList[pos]
+	for range maxLevels {
+		nextLevel := next(level)
+		if nextLevel.GreaterThan(latestPrice) {
+			break
+		}
+		level = nextLevel
+		pos++
+	}
+
+	return max(pos-len(gridList)/2, 1), nil
+}
+
+// shiftPriceBounds 将价格区间整体上移若干格, 新区间的网格与原网格对齐
+func shiftPriceBounds(strategyRecord *ent.Strategy, shift int) (decimal.Decimal, decimal.Decimal, error) {
+	next, err := nextGridLevel(strategyRecord)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	lowerPriceBound, upperPriceBound := strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound
+	for range shift {
+		lowerPriceBound = next(lowerPriceBound)
+		upperPriceBound = next(upperPriceBound)
+	}
+	return lowerPriceBound, upperPriceBound, nil
+}
+
 func isMinGridNumber(gridRecords []*ent.Grid, gridNumber int) bool {
 	for _, item := range gridRecords {
 		if item.GridNumber < gridNumber {
@@ -83,16 +194,28 @@ func isMinGridNumber(gridRecords []*ent.Grid, gridNumber int) bool {
 	return true
 }
 
+// GetStrategyWallet 获取策略使用的钱包, 未指定钱包时使用用户默认钱包
+func GetStrategyWallet(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy) (*ent.Wallet, error) {
+	if strategyRecord.Account == "" {
+		return svcCtx.WalletModel.FindDefaultByUserId(ctx, strategyRecord.UserId)
+	}
+	return svcCtx.WalletModel.FindByUserIdAccount(ctx, strategyRecord.UserId, strategyRecord.Account)
+}
+
 func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy, title string, uiSellAmount, minSellPrice *decimal.Decimal, exit bool) (ent.Order, error) {
-	// 获取用户钱包
-	w, err := svcCtx.WalletModel.FindByUserId(ctx, strategyRecord.UserId)
+	return SellTokenWithExecutor(ctx, svcCtx, NewExecutor(svcCtx, strategyRecord), strategyRecord, title, uiSellAmount, minSellPrice, exit)
+}
+
+func SellTokenWithExecutor(ctx context.Context, svcCtx *svc.ServiceContext, executor Executor, strategyRecord *ent.Strategy, title string, uiSellAmount, minSellPrice *decimal.Decimal, exit bool) (ent.Order, error) {
+	// 获取策略钱包
+	w, err := GetStrategyWallet(ctx, svcCtx, strategyRecord)
 	if err != nil {
 		logger.Errorf("[GridStrategy] %s - 获取用户钱包失败, userId: %d, %v", title, strategyRecord.UserId, err)
 		return ent.Order{}, err
 	}
 
 	// 获取代币余额
-	tokenBalance, decimals, err := solanautil.GetTokenBalance(ctx, svcCtx.SolanaRpc, strategyRecord.Token, w.Account)
+	tokenBalance, decimals, err := executor.GetTokenBalance(ctx, strategyRecord.Token, w.Account)
 	if err != nil {
 		logger.Debugf("[GridStrategy] %s - 获取代币余额失败, token: %s, %v", title, strategyRecord.Token, err)
 		return ent.Order{}, err
@@ -108,8 +231,7 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 
 	// 获取报价
 	sellAmount := solanautil.FormatUnits(*uiSellAmount, decimals)
-	swapService := swap.NewSwapService(svcCtx, w.UserId)
-	tx, err := swapService.Quote(ctx, strategyRecord.Token, solanautil.USDC, sellAmount, exit)
+	tx, err := executor.Quote(ctx, w.UserId, w.Account, strategyRecord.Token, solanautil.USDC, sellAmount, exit)
 	if err != nil {
 		logger.Errorf("[GridStrategy] %s - 获取报价失败, in: %s, out: USDC, amount: %s, %v", title, strategyRecord.Symbol, uiSellAmount, err)
 		return ent.Order{}, err
@@ -127,13 +249,18 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 	if err != nil {
 		logger.Errorf("[GridStrategy] %s - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
 			title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, hash, err)
-		return ent.Order{}, err
-	}
 
-	logger.Infof("[GridStrategy] %s - 提交交易成功, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s",
-		title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, hash)
+		// 已经广播的交易仍可能上链, 返回订单记录由调用方保存并等待确认
+		if hash == "" {
+			return ent.Order{}, err
+		}
+	} else {
+		logger.Infof("[GridStrategy] %s - 提交交易成功, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s",
+			title, w.UserId, strategyRecord.Symbol, uiSellAmount, uiOutAmount, hash)
+	}
 
 	// 订单记录
+	_, paper := tx.(*swap.PaperSwapTransaction)
 	orderArgs := ent.Order{
 		Account:    tx.Signer(),
 		Token:      strategyRecord.Token,
@@ -146,6 +273,10 @@ func SellToken(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *
 		OutAmount:  uiOutAmount,
 		Status:     order.StatusPending,
 		TxHash:     hash,
+		JitoTip:    int64(svcCtx.TxSender.Tip(hash)),
+		Reason:     title,
+		Paper:      paper,
+		Aggregator: tx.Aggregator(),
 	}
 	return orderArgs, nil
 }
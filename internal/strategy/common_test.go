@@ -0,0 +1,194 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCalculateTakeProfitPrice(t *testing.T) {
+	step := decimal.RequireFromString("0.1")
+
+	tests := []struct {
+		name       string
+		strategy   *ent.Strategy
+		gridNumber int
+		finalPrice string
+		expected   string
+		wantErr    bool
+	}{
+		{
+			name:       "等比网格按止盈比例",
+			strategy:   &ent.Strategy{GridMode: entstrategy.GridModeGeometric, TakeProfitRatio: decimal.NewFromInt(5)},
+			finalPrice: "2",
+			expected:   "2.1",
+		},
+		{
+			name:       "等差网格卖在上一格",
+			strategy:   &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridStep: &step},
+			gridNumber: 2,
+			finalPrice: "1.18",
+			expected:   "1.3",
+		},
+		{
+			name:       "等差网格成交价偏高时至少上涨一格",
+			strategy:   &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridStep: &step},
+			gridNumber: 2,
+			finalPrice: "1.25",
+			expected:   "1.35",
+		},
+		{
+			name:       "等差网格数量优先于间隔",
+			strategy:   &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridCount: 4, GridStep: &step},
+			gridNumber: 1,
+			finalPrice: "1.2",
+			expected:   "1.5",
+		},
+		{
+			name:     "等差网格没有间隔和数量",
+			strategy: &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2)},
+			wantErr:  true,
+		},
+		{
+			name:     "等差网格数量超过上限",
+			strategy: &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridCount: 100000},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gridRecord := &ent.Grid{GridNumber: tt.gridNumber}
+			if tt.finalPrice != "" {
+				gridRecord.FinalPrice = decimal.RequireFromString(tt.finalPrice)
+			}
+
+			got, err := calculateTakeProfitPrice(tt.strategy, gridRecord)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("calculateTakeProfitPrice() 应该返回错误, 结果: %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("calculateTakeProfitPrice() 返回错误: %v", err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("calculateTakeProfitPrice() = %s, 期望 %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateTrailingShift(t *testing.T) {
+	step := decimal.RequireFromString("0.25")
+	arithmetic := &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridStep: &step}
+	geometric := &ent.Strategy{GridMode: entstrategy.GridModeGeometric, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), TakeProfitRatio: decimal.NewFromInt(10)}
+
+	tests := []struct {
+		name        string
+		strategy    *ent.Strategy
+		latestPrice string
+		expected    int
+		wantErr     bool
+	}{
+		{name: "等差网格刚突破上限", strategy: arithmetic, latestPrice: "1.8", expected: 1},
+		{name: "等差网格回到区间中部", strategy: arithmetic, latestPrice: "2.6", expected: 4},
+		{name: "等差网格价格在网格线上", strategy: arithmetic, latestPrice: "3", expected: 6},
+		{name: "等比网格回到区间中部", strategy: geometric, latestPrice: "2.5", expected: 5},
+		{name: "等差网格没有间隔", strategy: &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2)}, latestPrice: "3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gridList, err := GenerateGridList(tt.strategy)
+			if err != nil && !tt.wantErr {
+				t.Fatalf("GenerateGridList() 返回错误: %v", err)
+			}
+
+			got, err := calculateTrailingShift(tt.strategy, gridList, decimal.RequireFromString(tt.latestPrice))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("calculateTrailingShift() 应该返回错误, 结果: %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("calculateTrailingShift() 返回错误: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("calculateTrailingShift() = %d, 期望 %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestShiftPriceBounds(t *testing.T) {
+	step := decimal.RequireFromString("0.25")
+
+	tests := []struct {
+		name     string
+		strategy *ent.Strategy
+		shift    int
+		lower    string
+		upper    string
+		wantErr  bool
+	}{
+		{
+			name:     "等差网格上移",
+			strategy: &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridStep: &step},
+			shift:    4,
+			lower:    "2",
+			upper:    "3",
+		},
+		{
+			name:     "等比网格上移",
+			strategy: &ent.Strategy{GridMode: entstrategy.GridModeGeometric, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), TakeProfitRatio: decimal.NewFromInt(10)},
+			shift:    2,
+			lower:    "1.21",
+			upper:    "2.42",
+		},
+		{
+			name:     "不移动",
+			strategy: &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2), GridStep: &step},
+			lower:    "1",
+			upper:    "2",
+		},
+		{
+			name:     "等差网格没有间隔",
+			strategy: &ent.Strategy{GridMode: entstrategy.GridModeArithmetic, LowerPriceBound: decimal.NewFromInt(1), UpperPriceBound: decimal.NewFromInt(2)},
+			shift:    1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper, err := shiftPriceBounds(tt.strategy, tt.shift)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("shiftPriceBounds() 应该返回错误, 结果: %s - %s", lower, upper)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("shiftPriceBounds() 返回错误: %v", err)
+			}
+			if !lower.Equal(decimal.RequireFromString(tt.lower)) || !upper.Equal(decimal.RequireFromString(tt.upper)) {
+				t.Errorf("shiftPriceBounds() = %s - %s, 期望 %s - %s", lower, upper, tt.lower, tt.upper)
+			}
+
+			// 新区间的网格与原网格对齐
+			gridList, err := GenerateGridList(tt.strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.shift > 0 && tt.shift < len(gridList) && !gridList[tt.shift].Equal(lower) {
+				t.Errorf("新区间下限 %s 没有对齐原网格 %s", lower, gridList[tt.shift])
+			}
+		})
+	}
+}
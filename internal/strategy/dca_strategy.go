@@ -0,0 +1,382 @@
+package strategy
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/engine"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// NewStrategy 根据策略类型创建对应的策略实现
+func NewStrategy(svcCtx *svc.ServiceContext, s *ent.Strategy) engine.Strategy {
+	if s.Type == entstrategy.TypeDca {
+		return NewDCAStrategy(svcCtx, s)
+	}
+	return NewGridStrategy(svcCtx, s)
+}
+
+// NewStrategyWithExecutor 根据策略类型创建使用指定执行器的策略实现
+func NewStrategyWithExecutor(svcCtx *svc.ServiceContext, executor Executor, s *ent.Strategy) engine.Strategy {
+	if s.Type == entstrategy.TypeDca {
+		return NewDCAStrategyWithExecutor(svcCtx, executor, s)
+	}
+	return NewGridStrategyWithExecutor(svcCtx, executor, s)
+}
+
+// DCAStrategy 定投策略, 按时间间隔或价格跌幅买入固定金额, 可选按计划分批卖出
+type DCAStrategy struct {
+	svcCtx       *svc.ServiceContext
+	executor     Executor
+	strategyId   string
+	tokenAddress string
+}
+
+func NewDCAStrategy(svcCtx *svc.ServiceContext, s *ent.Strategy) *DCAStrategy {
+	return NewDCAStrategyWithExecutor(svcCtx, NewExecutor(svcCtx, s), s)
+}
+
+func NewDCAStrategyWithExecutor(svcCtx *svc.ServiceContext, executor Executor, s *ent.Strategy) *DCAStrategy {
+	return &DCAStrategy{
+		svcCtx:       svcCtx,
+		executor:     executor,
+		strategyId:   s.GUID,
+		tokenAddress: s.Token,
+	}
+}
+
+func (s *DCAStrategy) ID() string {
+	return s.strategyId
+}
+
+func (s *DCAStrategy) TokenAddress() string {
+	return s.tokenAddress
+}
+
+func (s *DCAStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
+	if len(ohlcs) == 0 {
+		return nil
+	}
+
+	// 获取策略信息
+	strategyRecord, err := s.svcCtx.StrategyModel.FindByGUID(ctx, s.strategyId)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 查询策略记录失败, strategy: %v, %v", s.strategyId, err)
+		return err
+	}
+
+	if strategyRecord.Status != entstrategy.StatusActive {
+		logger.Debugf("[DCAStrategy] 策略已停止, strategy: %v", s.strategyId)
+		return nil
+	}
+
+	// 获取持仓列表
+	gridRecords, err := s.svcCtx.GridModel.FindByStrategyId(ctx, s.strategyId)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 查询持仓列表失败, strategy: %v, %v", s.strategyId, err)
+		return err
+	}
+
+	// 有未确认的订单
+	for _, item := range gridRecords {
+		if item.Status == grid.StatusBuying || item.Status == grid.StatusSelling {
+			logger.Debugf("[DCAStrategy] 存在未确认的订单, strategy: %v, number: %d, status: %s",
+				s.strategyId, item.GridNumber, item.Status)
+			return nil
+		}
+	}
+
+	// 处理退场条件
+	latest := ohlcs[len(ohlcs)-1]
+	success, err := s.handleExit(ctx, strategyRecord, gridRecords, latest.Close)
+	if success {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// 处理分批卖出
+	if s.handleSellOff(ctx, strategyRecord, gridRecords, latest) {
+		return nil
+	}
+
+	// 处理定投买入
+	s.handleBuy(ctx, strategyRecord, gridRecords, ohlcs)
+
+	return nil
+}
+
+// handleExit 处理突破退场价格、达到盈利目标和亏损达到预设金额, 与网格策略使用相同的清仓逻辑
+func (s *DCAStrategy) handleExit(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, latestPrice decimal.Decimal) (bool, error) {
+	exit := NewGridStrategyWithExecutor(s.svcCtx, s.executor, strategyRecord)
+
+	// 突破退场价格
+	success, err := exit.handleUpperBoundExit(ctx, strategyRecord, gridRecords, latestPrice)
+	if success || err != nil {
+		return success, err
+	}
+
+	// 获取累计盈利
+	totalProfit, err := calculateTotalProfit(ctx, s.svcCtx, strategyRecord, gridRecords, latestPrice)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 计算策略总利润失败, strategy: %v, %v", s.strategyId, err)
+		return false, err
+	}
+
+	// 达到盈利目标
+	success, err = exit.handleTakeProfitAtTarget(ctx, strategyRecord, gridRecords, totalProfit, latestPrice)
+	if success || err != nil {
+		return success, err
+	}
+
+	// 达到亏损阈值
+	return exit.handleStopLossAtThreshold(ctx, strategyRecord, gridRecords, totalProfit, latestPrice)
+}
+
+// shouldBuy 是否满足定投买入条件
+func (s *DCAStrategy) shouldBuy(strategyRecord *ent.Strategy, now time.Time, latestPrice decimal.Decimal) (bool, string) {
+	if strategyRecord.DcaLastBuyTime == nil || strategyRecord.DcaLastBuyPrice == nil {
+		return true, "首次买入"
+	}
+
+	if strategyRecord.DcaInterval > 0 &&
+		now.Sub(*strategyRecord.DcaLastBuyTime) >= time.Duration(strategyRecord.DcaInterval)*time.Minute {
+		return true, "定时买入"
+	}
+
+	if strategyRecord.DcaDropRatio != nil && strategyRecord.DcaDropRatio.GreaterThan(decimal.Zero) {
+		ratio := decimal.NewFromInt(1).Sub(strategyRecord.DcaDropRatio.Div(decimal.NewFromInt(100)))
+		if latestPrice.LessThanOrEqual(strategyRecord.DcaLastBuyPrice.Mul(ratio)) {
+			return true, "下跌买入"
+		}
+	}
+
+	return false, ""
+}
+
+func (s *DCAStrategy) handleBuy(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, ohlcs []charts.Ohlc) {
+	if !strategyRecord.EnableAutoBuy {
+		return
+	}
+
+	// 是否超过上限
+	if strategyRecord.DcaMaxOrders > 0 && len(gridRecords) >= strategyRecord.DcaMaxOrders {
+		return
+	}
+
+	latest := ohlcs[len(ohlcs)-1]
+	ok, reason := s.shouldBuy(strategyRecord, latest.Time, latest.Close)
+	if !ok {
+		return
+	}
+
+	// 检查指标条件
+	if strategyRecord.BuyConditions != "" {
+		ok, condition, err := checkBuyConditions(strategyRecord.BuyConditions, ohlcs)
+		if err != nil {
+			logger.Warnf("[DCAStrategy] 取消定投买入, 检查指标条件失败, strategy: %s, condition: %s, %v",
+				strategyRecord.GUID, condition, err)
+			return
+		}
+		if !ok {
+			logger.Debugf("[DCAStrategy] 取消定投买入, 指标条件不满足, strategy: %s, condition: %s",
+				strategyRecord.GUID, condition)
+			return
+		}
+	}
+
+	guid, err := uuid.NewRandom()
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 生成 GUID 失败, %v", err)
+		return
+	}
+
+	tokenMeta, err := s.svcCtx.TokenMetaCache.GetTokenMeta(ctx, strategyRecord.Token)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 获取Token元信息失败, token: %s, %v", strategyRecord.Token, err)
+		return
+	}
+
+	// 获取报价
+	orderSize := strategyRecord.InitialOrderSize
+	amount := solanautil.FormatUnits(orderSize, solanautil.USDCDecimals)
+	tx, err := s.executor.Quote(ctx, strategyRecord.UserId, strategyRecord.Account, solanautil.USDC, strategyRecord.Token, amount, false)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 获取报价失败, in: USDC, out: %s, amount: %s, %v", strategyRecord.Symbol, orderSize, err)
+		return
+	}
+
+	uiOutAmount := solanautil.ParseUnits(tx.OutAmount(), tokenMeta.Decimals)
+	quotePrice := orderSize.Div(uiOutAmount)
+	logger.Debugf("[DCAStrategy] %s, token: %s, latestPrice: %s, quotePrice: %s",
+		reason, strategyRecord.Symbol, latest.Close, quotePrice)
+
+	// 计算持仓编号
+	gridNumber := 0
+	for _, item := range gridRecords {
+		gridNumber = max(gridNumber, item.GridNumber+1)
+	}
+
+	// 同一持仓编号只允许一个未完成的订单
+	ok, err = acquireGridOrder(ctx, s.svcCtx, strategyRecord.GUID, gridNumber)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] %s - 查询未确认订单失败, strategy: %s, number: %d, %v", reason, strategyRecord.GUID, gridNumber, err)
+		return
+	}
+	if !ok {
+		logger.Debugf("[DCAStrategy] %s - 存在未完成的订单, 取消交易, strategy: %s, number: %d", reason, strategyRecord.GUID, gridNumber)
+		return
+	}
+	defer releaseGridOrder(strategyRecord.GUID, gridNumber)
+
+	// 发送交易
+	hash, err := tx.Swap(ctx)
+	if err != nil {
+		logger.Errorf("[DCAStrategy] %s - 发送交易失败, user: %d, inputAmount: %s, outToken: %s, outAmount: %s, hash: %s, %v",
+			reason, strategyRecord.UserId, orderSize, strategyRecord.Symbol, uiOutAmount, hash, err)
+
+		// 已经广播的交易仍可能上链, 保存持仓和订单等待确认
+		if hash == "" {
+			return
+		}
+	} else {
+		logger.Infof("[DCAStrategy] %s - 提交交易成功, user: %d, strategy: %s, number: %d, hash: %s",
+			reason, strategyRecord.UserId, strategyRecord.GUID, gridNumber, hash)
+	}
+
+	// 保存持仓和订单
+	_, paper := tx.(*swap.PaperSwapTransaction)
+	gridArgs := ent.Grid{
+		GUID:       guid.String(),
+		Account:    tx.Signer(),
+		Token:      strategyRecord.Token,
+		Symbol:     strategyRecord.Symbol,
+		StrategyId: strategyRecord.GUID,
+		GridNumber: gridNumber,
+		OrderPrice: quotePrice,
+		FinalPrice: quotePrice,
+		Amount:     orderSize,
+		Quantity:   uiOutAmount,
+		Status:     grid.StatusBuying,
+	}
+
+	orderArgs := ent.Order{
+		Account:    gridArgs.Account,
+		Token:      gridArgs.Token,
+		Symbol:     gridArgs.Symbol,
+		GridId:     &gridArgs.GUID,
+		GridNumber: &gridArgs.GridNumber,
+		StrategyId: gridArgs.StrategyId,
+		Type:       order.TypeBuy,
+		Price:      gridArgs.OrderPrice,
+		FinalPrice: gridArgs.FinalPrice,
+		InAmount:   gridArgs.Amount,
+		OutAmount:  gridArgs.Quantity,
+		Status:     order.StatusPending,
+		TxHash:     hash,
+		JitoTip:    int64(s.svcCtx.TxSender.Tip(hash)),
+		Reason:     reason,
+		Paper:      paper,
+		Aggregator: tx.Aggregator(),
+	}
+
+	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
+		_, err = model.NewGridModel(tx.Grid).Save(ctx, gridArgs)
+		if err != nil {
+			return err
+		}
+
+		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
+		if err != nil {
+			return err
+		}
+
+		return model.NewStrategyModel(tx.Strategy).UpdateDcaLastBuy(ctx, strategyRecord.ID, latest.Time, quotePrice)
+	})
+	if err != nil {
+		logger.Errorf("[DCAStrategy] %s - 保存持仓和订单失败, grid: %+v, order: %+v, %v", reason, gridArgs, orderArgs, err)
+		return
+	}
+}
+
+func (s *DCAStrategy) handleSellOff(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, latest charts.Ohlc) bool {
+	if !strategyRecord.EnableAutoSell || strategyRecord.DcaSellInterval <= 0 {
+		return false
+	}
+
+	// 是否到达卖出时间
+	if strategyRecord.DcaLastSellTime != nil &&
+		latest.Time.Sub(*strategyRecord.DcaLastSellTime) < time.Duration(strategyRecord.DcaSellInterval)*time.Minute {
+		return false
+	}
+
+	// 选择成本最低的持仓
+	var gridRecord *ent.Grid
+	for _, item := range gridRecords {
+		if item.Status != grid.StatusBought {
+			continue
+		}
+		if gridRecord == nil || item.FinalPrice.LessThan(gridRecord.FinalPrice) {
+			gridRecord = item
+		}
+	}
+	if gridRecord == nil {
+		return false
+	}
+
+	// 计算卖出底价
+	bottomPrice := gridRecord.FinalPrice
+	if strategyRecord.DcaSellProfitRatio != nil {
+		bottomPrice = bottomPrice.Mul(decimal.NewFromInt(1).Add(strategyRecord.DcaSellProfitRatio.Div(decimal.NewFromInt(100))))
+	}
+	if latest.Close.LessThan(bottomPrice) {
+		return false
+	}
+
+	// 卖出代币
+	orderArgs, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "定投分批卖出", &gridRecord.Quantity, &bottomPrice, false)
+	if err != nil {
+		return false
+	}
+	orderArgs.GridId = &gridRecord.GUID
+	orderArgs.GridNumber = &gridRecord.GridNumber
+	orderArgs.GridBuyCost = &gridRecord.Amount
+
+	// 更新数据状态
+	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
+		err = model.NewGridModel(tx.Grid).SetSellingStatus(ctx, gridRecord.GUID)
+		if err != nil {
+			return err
+		}
+
+		_, err = model.NewOrderModel(tx.Order).Save(ctx, orderArgs)
+		if err != nil {
+			return err
+		}
+
+		return model.NewStrategyModel(tx.Strategy).UpdateDcaLastSellTime(ctx, strategyRecord.ID, latest.Time)
+	})
+	if err != nil {
+		logger.Errorf("[DCAStrategy] 定投分批卖出 - 更新持仓和订单失败, strategy: %s, number: %d, gridGuid: %s, order: %+v, %v",
+			strategyRecord.GUID, gridRecord.GridNumber, gridRecord.GUID, orderArgs, err)
+		return false
+	}
+
+	// 更新持仓状态
+	gridRecord.Status = grid.StatusSelling
+	return true
+}
@@ -0,0 +1,180 @@
+package strategy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/charts"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/sol-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// fakeSwap 测试用交易, 发送后返回随机哈希
+type fakeSwap struct {
+	*fakeQuote
+}
+
+func (tx *fakeSwap) Swap(ctx context.Context) (string, error) {
+	return uuid.NewString(), nil
+}
+
+// fakeSwapExecutor 测试用执行器, 以固定价格卖出代币
+type fakeSwapExecutor struct {
+	Executor
+	price   decimal.Decimal
+	balance decimal.Decimal
+}
+
+func (e *fakeSwapExecutor) GetTokenBalance(ctx context.Context, tokenAddress, ownerAddress string) (*big.Int, uint8, error) {
+	return solanautil.FormatUnits(e.balance, 6), 6, nil
+}
+
+func (e *fakeSwapExecutor) Quote(ctx context.Context, userId int64, account string, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	uiOutAmount := solanautil.ParseUnits(amount, 6).Mul(e.price)
+	return &fakeSwap{&fakeQuote{outAmount: solanautil.FormatUnits(uiOutAmount, solanautil.USDCDecimals)}}, nil
+}
+
+func TestDCAShouldBuy(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastBuyPrice := decimal.NewFromInt(100)
+	dropRatio := decimal.NewFromInt(10)
+
+	tests := []struct {
+		name        string
+		lastBuy     time.Duration // 距离上次买入的时间, 为 0 时没有买入记录
+		interval    int
+		dropRatio   *decimal.Decimal
+		latestPrice string
+		expected    bool
+		reason      string
+	}{
+		{name: "首次买入", latestPrice: "100", expected: true, reason: "首次买入"},
+		{name: "到达定投间隔", lastBuy: time.Hour, interval: 60, latestPrice: "100", expected: true, reason: "定时买入"},
+		{name: "未到定投间隔", lastBuy: 59 * time.Minute, interval: 60, latestPrice: "100"},
+		{name: "没有定投间隔", lastBuy: 24 * time.Hour, latestPrice: "100"},
+		{name: "跌幅达到比例", lastBuy: time.Minute, interval: 60, dropRatio: &dropRatio, latestPrice: "90", expected: true, reason: "下跌买入"},
+		{name: "跌幅不足", lastBuy: time.Minute, interval: 60, dropRatio: &dropRatio, latestPrice: "90.01"},
+		{name: "价格上涨", lastBuy: time.Minute, dropRatio: &dropRatio, latestPrice: "120"},
+	}
+
+	s := &DCAStrategy{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{DcaInterval: tt.interval, DcaDropRatio: tt.dropRatio}
+			if tt.lastBuy > 0 {
+				lastBuyTime := now.Add(-tt.lastBuy)
+				record.DcaLastBuyTime = &lastBuyTime
+				record.DcaLastBuyPrice = &lastBuyPrice
+			}
+
+			ok, reason := s.shouldBuy(record, now, decimal.RequireFromString(tt.latestPrice))
+			if ok != tt.expected || reason != tt.reason {
+				t.Errorf("shouldBuy() = %v, %q, 期望 %v, %q", ok, reason, tt.expected, tt.reason)
+			}
+		})
+	}
+}
+
+func TestDCAHandleSellOff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		lastSell    time.Duration // 距离上次卖出的时间, 为 0 时没有卖出记录
+		latestPrice string
+		expected    bool
+	}{
+		{name: "卖出成本最低的持仓", latestPrice: "1.1", expected: true},
+		{name: "到达卖出间隔", lastSell: time.Hour, latestPrice: "1.1", expected: true},
+		{name: "未到卖出间隔", lastSell: 59 * time.Minute, latestPrice: "1.1"},
+		{name: "未达到卖出盈利", latestPrice: "1.09"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svcCtx := newTestServiceContext(t)
+
+			_, err := svcCtx.WalletModel.Save(ctx, ent.Wallet{UserId: 1, Account: "account", IsDefault: true, Password: "password", PrivateKey: "privateKey"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sellProfitRatio := decimal.NewFromInt(10)
+			args := ent.Strategy{
+				GUID:               uuid.NewString(),
+				UserId:             1,
+				Token:              "token",
+				Symbol:             "TOKEN",
+				Type:               entstrategy.TypeDca,
+				MartinFactor:       1,
+				EnableAutoSell:     true,
+				DcaSellInterval:    60,
+				DcaSellProfitRatio: &sellProfitRatio,
+				Status:             entstrategy.StatusActive,
+			}
+			strategyRecord, err := svcCtx.StrategyModel.Save(ctx, args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.lastSell > 0 {
+				lastSellTime := now.Add(-tt.lastSell)
+				if err = svcCtx.StrategyModel.UpdateDcaLastSellTime(ctx, strategyRecord.ID, lastSellTime); err != nil {
+					t.Fatal(err)
+				}
+				strategyRecord.DcaLastSellTime = &lastSellTime
+			}
+
+			// 持仓成本分别为 1.2, 1, 1.1
+			gridRecords := []*ent.Grid{
+				saveTestGrid(t, svcCtx, strategyRecord.GUID, 0, "12", "10"),
+				saveTestGrid(t, svcCtx, strategyRecord.GUID, 1, "10", "10"),
+				saveTestGrid(t, svcCtx, strategyRecord.GUID, 2, "11", "10"),
+			}
+			cheapest := gridRecords[1]
+
+			executor := &fakeSwapExecutor{price: decimal.RequireFromString(tt.latestPrice), balance: decimal.NewFromInt(30)}
+			s := NewDCAStrategyWithExecutor(svcCtx, executor, strategyRecord)
+			latest := charts.Ohlc{Close: decimal.RequireFromString(tt.latestPrice), Time: now}
+			if ok := s.handleSellOff(ctx, strategyRecord, gridRecords, latest); ok != tt.expected {
+				t.Fatalf("handleSellOff() = %v, 期望 %v", ok, tt.expected)
+			}
+			if !tt.expected {
+				return
+			}
+
+			g, err := svcCtx.GridModel.FindByGuid(ctx, cheapest.GUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g.Status != grid.StatusSelling {
+				t.Errorf("成本最低的持仓状态 = %s, 期望 %s", g.Status, grid.StatusSelling)
+			}
+
+			ord, err := svcCtx.OrderModel.FindLatestByGridId(ctx, cheapest.GUID, order.TypeSell)
+			if err != nil {
+				t.Fatalf("查询卖出订单失败: %v", err)
+			}
+			if !ord.InAmount.Equal(cheapest.Quantity) || !ord.GridBuyCost.Equal(cheapest.Amount) {
+				t.Errorf("卖出订单 InAmount: %s, GridBuyCost: %s, 期望 %s, %s", ord.InAmount, ord.GridBuyCost, cheapest.Quantity, cheapest.Amount)
+			}
+
+			record, err := svcCtx.StrategyModel.FindByGUID(ctx, strategyRecord.GUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if record.DcaLastSellTime == nil || !record.DcaLastSellTime.Equal(now) {
+				t.Errorf("DcaLastSellTime = %v, 期望 %s", record.DcaLastSellTime, now)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+)
+
+// Executor 策略与链上交互的接口, 回测时替换为模拟实现
+type Executor interface {
+	// Quote 使用用户指定钱包获取兑换报价, account 为空时使用默认钱包
+	Quote(ctx context.Context, userId int64, account string, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error)
+
+	// GetTokenBalance 获取代币余额
+	GetTokenBalance(ctx context.Context, tokenAddress, ownerAddress string) (*big.Int, uint8, error)
+
+	// GetTokenBalanceChanges 获取交易的代币余额变化
+	GetTokenBalanceChanges(ctx context.Context, hash, ownerAddress string) (map[string]solanautil.TokenBalanceChange, error)
+
+	// GetTransactionFee 获取交易的网络费用
+	GetTransactionFee(ctx context.Context, hash, ownerAddress string) (solanautil.TransactionFee, error)
+
+	// GetConfirmedSignatures 批量查询已经确认的交易
+	GetConfirmedSignatures(ctx context.Context, hashes []string) (map[string]bool, error)
+
+	// GetMintRisk 查询代币的权限和扩展信息
+	GetMintRisk(ctx context.Context, tokenAddress string) (solanautil.MintRisk, error)
+}
+
+// IsPaperTrading 策略是否运行在模拟交易模式
+func IsPaperTrading(svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy) bool {
+	return svcCtx.Config.PaperTrading.Enable || strategyRecord.PaperTrading
+}
+
+// HasLiveGrids 策略是否持有真实买入的网格, 模拟交易模式下无法卖出这些持仓
+func HasLiveGrids(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy) (bool, error) {
+	grids, err := svcCtx.GridModel.FindByStrategyId(ctx, strategyRecord.GUID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, item := range grids {
+		ord, err := svcCtx.OrderModel.FindLatestByGridId(ctx, item.GUID, order.TypeBuy)
+		if ent.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !ord.Paper {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CheckPaperTrading 开启全局模拟交易时, 检查没有策略持有真实买入的网格
+func CheckPaperTrading(ctx context.Context, svcCtx *svc.ServiceContext) error {
+	if !svcCtx.Config.PaperTrading.Enable {
+		return nil
+	}
+
+	offset := 0
+	const limit = 100
+	for {
+		data, err := svcCtx.StrategyModel.FindAll(ctx, offset, limit)
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return nil
+		}
+
+		for _, item := range data {
+			if item.PaperTrading {
+				continue
+			}
+
+			live, err := HasLiveGrids(ctx, svcCtx, item)
+			if err != nil {
+				return err
+			}
+			if live {
+				return fmt.Errorf("策略 %s(%s) 持有真实买入的网格, 请先清仓再开启全局模拟交易", item.Symbol, item.GUID)
+			}
+		}
+
+		offset = offset + len(data)
+	}
+}
+
+// NewExecutor 根据策略的交易模式创建执行器
+func NewExecutor(svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy) Executor {
+	if IsPaperTrading(svcCtx, strategyRecord) {
+		return NewPaperExecutor(svcCtx)
+	}
+	return NewLiveExecutor(svcCtx)
+}
+
+type LiveExecutor struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewLiveExecutor(svcCtx *svc.ServiceContext) *LiveExecutor {
+	return &LiveExecutor{svcCtx: svcCtx}
+}
+
+func (e *LiveExecutor) Quote(ctx context.Context, userId int64, account string, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	return swap.NewSwapServiceWithAccount(e.svcCtx, userId, account).Quote(ctx, inputToken, outputToken, amount, exit)
+}
+
+func (e *LiveExecutor) GetTokenBalance(ctx context.Context, tokenAddress, ownerAddress string) (*big.Int, uint8, error) {
+	return solanautil.GetTokenBalance(ctx, e.svcCtx.SolanaRpc, tokenAddress, ownerAddress)
+}
+
+func (e *LiveExecutor) GetTokenBalanceChanges(ctx context.Context, hash, ownerAddress string) (map[string]solanautil.TokenBalanceChange, error) {
+	return solanautil.GetTokenBalanceChanges(ctx, e.svcCtx.SolanaRpc, hash, ownerAddress)
+}
+
+func (e *LiveExecutor) GetTransactionFee(ctx context.Context, hash, ownerAddress string) (solanautil.TransactionFee, error) {
+	return solanautil.GetTransactionFee(ctx, e.svcCtx.SolanaRpc, hash, ownerAddress)
+}
+
+func (e *LiveExecutor) GetConfirmedSignatures(ctx context.Context, hashes []string) (map[string]bool, error) {
+	return solanautil.GetConfirmedSignatures(ctx, e.svcCtx.SolanaRpc, hashes)
+}
+
+func (e *LiveExecutor) GetMintRisk(ctx context.Context, tokenAddress string) (solanautil.MintRisk, error) {
+	return solanautil.GetMintRisk(ctx, e.svcCtx.SolanaRpc, tokenAddress)
+}
+
+// PaperExecutor 使用真实报价模拟成交, 代币余额由模拟订单推算
+type PaperExecutor struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewPaperExecutor(svcCtx *svc.ServiceContext) *PaperExecutor {
+	return &PaperExecutor{svcCtx: svcCtx}
+}
+
+func (e *PaperExecutor) Quote(ctx context.Context, userId int64, account string, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	tx, err := swap.NewSwapServiceWithAccount(e.svcCtx, userId, account).Quote(ctx, inputToken, outputToken, amount, exit)
+	if err != nil {
+		return nil, err
+	}
+	return swap.NewPaperSwapTransaction(tx, e.svcCtx.Config.PaperTrading.SlippageBps), nil
+}
+
+func (e *PaperExecutor) GetTokenBalance(ctx context.Context, tokenAddress, ownerAddress string) (*big.Int, uint8, error) {
+	tokenMeta, err := e.svcCtx.TokenMetaCache.GetTokenMeta(ctx, tokenAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	balance, err := e.svcCtx.OrderModel.PaperTokenBalance(ctx, ownerAddress, tokenAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+	return solanautil.FormatUnits(balance, tokenMeta.Decimals), tokenMeta.Decimals, nil
+}
+
+func (e *PaperExecutor) GetTokenBalanceChanges(ctx context.Context, hash, ownerAddress string) (map[string]solanautil.TokenBalanceChange, error) {
+	ord, err := e.svcCtx.OrderModel.FindByTxHash(ctx, hash)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, solanautil.ErrTxNotFound
+		}
+		return nil, err
+	}
+
+	// 模拟订单按提交时的报价成交
+	changes := make(map[string]solanautil.TokenBalanceChange)
+	switch ord.Type {
+	case order.TypeBuy:
+		changes[ord.Token] = solanautil.TokenBalanceChange{Change: ord.OutAmount}
+		changes[solanautil.USDC] = solanautil.TokenBalanceChange{Change: ord.InAmount.Neg()}
+	case order.TypeSell:
+		changes[ord.Token] = solanautil.TokenBalanceChange{Change: ord.InAmount.Neg()}
+		changes[solanautil.USDC] = solanautil.TokenBalanceChange{Change: ord.OutAmount}
+	}
+	return changes, nil
+}
+
+func (e *PaperExecutor) GetTransactionFee(ctx context.Context, hash, ownerAddress string) (solanautil.TransactionFee, error) {
+	// 模拟订单不产生网络费用
+	return solanautil.TransactionFee{}, nil
+}
+
+func (e *PaperExecutor) GetConfirmedSignatures(ctx context.Context, hashes []string) (map[string]bool, error) {
+	// 模拟订单提交后立即成交
+	confirmed := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		confirmed[hash] = true
+	}
+	return confirmed, nil
+}
+
+func (e *PaperExecutor) GetMintRisk(ctx context.Context, tokenAddress string) (solanautil.MintRisk, error) {
+	return solanautil.GetMintRisk(ctx, e.svcCtx.SolanaRpc, tokenAddress)
+}
@@ -0,0 +1,116 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/order"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+)
+
+func newTestServiceContext(t *testing.T) *svc.ServiceContext {
+	dsn := fmt.Sprintf("file:strategy-%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return &svc.ServiceContext{
+		Config:        &config.Config{},
+		DbClient:      client,
+		GridModel:     model.NewGridModel(client.Grid),
+		OrderModel:    model.NewOrderModel(client.Order),
+		StrategyModel: model.NewStrategyModel(client.Strategy),
+		WalletModel:   model.NewWalletModel(client.Wallet),
+	}
+}
+
+func saveTestGrid(t *testing.T, svcCtx *svc.ServiceContext, strategyId string, gridNumber int, amount, quantity string) *ent.Grid {
+	g, err := svcCtx.GridModel.Save(context.Background(), ent.Grid{
+		GUID:       uuid.NewString(),
+		Account:    "account",
+		Token:      "token",
+		Symbol:     "TOKEN",
+		StrategyId: strategyId,
+		GridNumber: gridNumber,
+		OrderPrice: decimal.RequireFromString(amount).Div(decimal.RequireFromString(quantity)),
+		FinalPrice: decimal.RequireFromString(amount).Div(decimal.RequireFromString(quantity)),
+		Amount:     decimal.RequireFromString(amount),
+		Quantity:   decimal.RequireFromString(quantity),
+		Status:     grid.StatusBought,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestHasLiveGrids(t *testing.T) {
+	tests := []struct {
+		name     string
+		grid     bool
+		order    bool // 网格是否有买入订单
+		paper    bool
+		expected bool
+	}{
+		{name: "没有网格", expected: false},
+		{name: "模拟买入的网格", grid: true, order: true, paper: true, expected: false},
+		{name: "真实买入的网格", grid: true, order: true, expected: true},
+		{name: "没有买入订单的网格", grid: true, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svcCtx := newTestServiceContext(t)
+			strategyRecord := &ent.Strategy{GUID: uuid.NewString()}
+
+			if tt.grid {
+				g := saveTestGrid(t, svcCtx, strategyRecord.GUID, 1, "10", "10")
+				if tt.order {
+					_, err := svcCtx.OrderModel.Save(ctx, ent.Order{
+						Account:    g.Account,
+						Token:      g.Token,
+						Symbol:     g.Symbol,
+						GridId:     &g.GUID,
+						GridNumber: &g.GridNumber,
+						StrategyId: g.StrategyId,
+						Type:       order.TypeBuy,
+						Price:      g.OrderPrice,
+						FinalPrice: g.FinalPrice,
+						InAmount:   g.Amount,
+						OutAmount:  g.Quantity,
+						Status:     order.StatusClosed,
+						TxHash:     uuid.NewString(),
+						Paper:      tt.paper,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			live, err := HasLiveGrids(ctx, svcCtx, strategyRecord)
+			if err != nil {
+				t.Fatalf("HasLiveGrids() 返回错误: %v", err)
+			}
+			if live != tt.expected {
+				t.Errorf("HasLiveGrids() = %v, 期望 %v", live, tt.expected)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/fachebot/sol-grid-bot/internal/charts"
@@ -26,24 +27,31 @@ import (
 
 type GridStrategy struct {
 	svcCtx       *svc.ServiceContext
+	executor     Executor
 	strategyId   string
 	tokenAddress string
+	sellability  sellabilityState
 }
 
 func NewGridStrategy(svcCtx *svc.ServiceContext, s *ent.Strategy) *GridStrategy {
+	return NewGridStrategyWithExecutor(svcCtx, NewExecutor(svcCtx, s), s)
+}
+
+func NewGridStrategyWithExecutor(svcCtx *svc.ServiceContext, executor Executor, s *ent.Strategy) *GridStrategy {
 	return &GridStrategy{
 		svcCtx:       svcCtx,
+		executor:     executor,
 		strategyId:   s.GUID,
 		tokenAddress: s.Token,
 	}
 }
 
 func calculateTotalProfit(ctx context.Context, svcCtx *svc.ServiceContext, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, latestPrice decimal.Decimal) (decimal.Decimal, error) {
-	// 获取累计盈利
+	// 获取累计盈利, 扣除交易费用
 	var err error
 	var realizedProfit decimal.Decimal
 	if strategyRecord.FirstOrderId != nil {
-		realizedProfit, err = svcCtx.OrderModel.TotalProfit(ctx, strategyRecord.GUID, *strategyRecord.FirstOrderId)
+		_, realizedProfit, err = svcCtx.OrderModel.TotalProfit(ctx, strategyRecord.GUID, *strategyRecord.FirstOrderId)
 		if err != nil {
 			return decimal.Zero, nil
 		}
@@ -161,11 +169,10 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 	}
 
 	// 生成网格列表
-	gridList, err := utils.GenerateGrid(
-		strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+	gridList, err := GenerateGridList(strategyRecord)
 	if err != nil {
-		logger.Errorf("[GridStrategy] 生成网格列表失败, strategy: %v, lowerPriceBound: %v, upperPriceBound: %v, takeProfitRatio: %v, %v",
-			s.strategyId, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.TakeProfitRatio, err)
+		logger.Errorf("[GridStrategy] 生成网格列表失败, strategy: %v, gridMode: %s, lowerPriceBound: %v, upperPriceBound: %v, takeProfitRatio: %v, %v",
+			s.strategyId, strategyRecord.GridMode, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound, strategyRecord.TakeProfitRatio, err)
 		return err
 	}
 
@@ -192,14 +199,19 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 			s.strategyId, latestPrice, strategyRecord.LowerPriceBound, strategyRecord.UpperPriceBound)
 
 		if latestPrice.GreaterThan(strategyRecord.UpperPriceBound) {
+			if s.handleTrailingGrid(ctx, strategyRecord, gridRecords, gridList, ohlcs) {
+				return nil
+			}
 			s.sendUpperThresholdAlert(ctx, strategyRecord, latestPrice)
 		}
 		return nil
 	}
 
 	// 处理网格交易
-	takeProfitRatio := strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100))
-	exitPrice := strategyRecord.LowerPriceBound.Sub(strategyRecord.LowerPriceBound.Mul(takeProfitRatio))
+	exitPrice, err := calculateExitPrice(strategyRecord)
+	if err != nil {
+		return err
+	}
 	if gridNumber == 0 && latestPrice.LessThan(exitPrice) {
 		s.handlepriceRangeStopLoss(ctx, strategyRecord, gridRecords, latestPrice)
 		return nil
@@ -233,7 +245,8 @@ func (s *GridStrategy) OnTick(ctx context.Context, ohlcs []charts.Ohlc) error {
 			return nil
 		}
 
-		s.handleGridBuy(ctx, strategyRecord, ohlcs, gridRecords, gridNumber, gridList[gridNumber])
+		orderSize := utils.CalculateGridOrderSize(strategyRecord.InitialOrderSize, strategyRecord.MartinFactor, len(gridList), gridNumber)
+		s.handleGridBuy(ctx, strategyRecord, ohlcs, gridRecords, gridNumber, gridList[gridNumber], orderSize)
 	}
 
 	return nil
@@ -291,7 +304,7 @@ func (s *GridStrategy) sendLowerThresholdAlert(ctx context.Context, strategyReco
 	}
 }
 
-func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.Strategy, ohlcs []charts.Ohlc, gridList []*ent.Grid, gridNumber int, gridPrice decimal.Decimal) {
+func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.Strategy, ohlcs []charts.Ohlc, gridList []*ent.Grid, gridNumber int, gridPrice, orderSize decimal.Decimal) {
 	if !strategyRecord.EnableAutoBuy {
 		return
 	}
@@ -335,6 +348,21 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		}
 	}
 
+	// 检查指标条件
+	if strategyRecord.BuyConditions != "" {
+		ok, condition, err := checkBuyConditions(strategyRecord.BuyConditions, ohlcs)
+		if err != nil {
+			logger.Warnf("[GridStrategy] 取消网格买入, 检查指标条件失败, strategy: %s, condition: %s, %v",
+				strategyRecord.GUID, condition, err)
+			return
+		}
+		if !ok {
+			logger.Debugf("[GridStrategy] 取消网格买入, 指标条件不满足, strategy: %s, condition: %s",
+				strategyRecord.GUID, condition)
+			return
+		}
+	}
+
 	tokenMeta, err := s.svcCtx.TokenMetaCache.GetTokenMeta(ctx, strategyRecord.Token)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 获取Token元信息失败, token: %s, %v", strategyRecord.Token, err)
@@ -342,17 +370,16 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 	}
 
 	// 获取报价
-	amount := solanautil.FormatUnits(strategyRecord.InitialOrderSize, solanautil.USDCDecimals)
-	swapService := swap.NewSwapService(s.svcCtx, strategyRecord.UserId)
-	tx, err := swapService.Quote(ctx, solanautil.USDC, strategyRecord.Token, amount)
+	amount := solanautil.FormatUnits(orderSize, solanautil.USDCDecimals)
+	tx, err := s.executor.Quote(ctx, strategyRecord.UserId, strategyRecord.Account, solanautil.USDC, strategyRecord.Token, amount, false)
 	if err != nil {
-		logger.Errorf("[GridStrategy] 获取报价失败, in: USDC, out: %s, amount: %s, %v", strategyRecord.Symbol, strategyRecord.InitialOrderSize, err)
+		logger.Errorf("[GridStrategy] 获取报价失败, in: USDC, out: %s, amount: %s, %v", strategyRecord.Symbol, orderSize, err)
 		return
 	}
 
 	bottomPrice := gridPrice
 	uiOutAmount := solanautil.ParseUnits(tx.OutAmount(), tokenMeta.Decimals)
-	quotePrice := strategyRecord.InitialOrderSize.Div(uiOutAmount)
+	quotePrice := orderSize.Div(uiOutAmount)
 	logger.Debugf("[GridStrategy] 买入网格, token: %s, latestPrice: %s, gridPrice: %s, quotePrice: %s, bottomPrice: %s",
 		strategyRecord.Symbol, latestPrice, gridPrice, quotePrice, bottomPrice)
 
@@ -361,18 +388,40 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		return
 	}
 
+	// 首次买入前检查代币是否可以卖出
+	if len(gridList) == 0 && !s.checkSellability(ctx, strategyRecord, orderSize, tx.OutAmount()) {
+		return
+	}
+
+	// 同一网格只允许一个未完成的订单
+	ok, err := acquireGridOrder(ctx, s.svcCtx, strategyRecord.GUID, gridNumber)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 买入网格 - 查询未确认订单失败, strategy: %s, gridNumber: %d, %v", strategyRecord.GUID, gridNumber, err)
+		return
+	}
+	if !ok {
+		logger.Debugf("[GridStrategy] 买入网格 - 存在未完成的订单, 取消交易, strategy: %s, gridNumber: %d", strategyRecord.GUID, gridNumber)
+		return
+	}
+	defer releaseGridOrder(strategyRecord.GUID, gridNumber)
+
 	// 发送交易
 	hash, err := tx.Swap(ctx)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 买入网格 - 发送交易失败, user: %d, inputAmount: %s, outToken: %s, outAmount: %s, hash: %s, %v",
-			strategyRecord.UserId, strategyRecord.InitialOrderSize, strategyRecord.Symbol, uiOutAmount, hash, err)
-		return
-	}
+			strategyRecord.UserId, orderSize, strategyRecord.Symbol, uiOutAmount, hash, err)
 
-	logger.Infof("[GridStrategy] 买入网格 - 提交交易成功, user: %d, strategy: %s, gridNumber: %d, hash: %s",
-		strategyRecord.UserId, strategyRecord.GUID, gridNumber, hash)
+		// 已经广播的交易仍可能上链, 保存网格和订单等待确认
+		if hash == "" {
+			return
+		}
+	} else {
+		logger.Infof("[GridStrategy] 买入网格 - 提交交易成功, user: %d, strategy: %s, gridNumber: %d, hash: %s",
+			strategyRecord.UserId, strategyRecord.GUID, gridNumber, hash)
+	}
 
 	// 保存网格和订单
+	_, paper := tx.(*swap.PaperSwapTransaction)
 	gridArgs := ent.Grid{
 		GUID:       guid.String(),
 		Account:    tx.Signer(),
@@ -382,7 +431,7 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		GridNumber: gridNumber,
 		OrderPrice: quotePrice,
 		FinalPrice: quotePrice,
-		Amount:     strategyRecord.InitialOrderSize,
+		Amount:     orderSize,
 		Quantity:   uiOutAmount,
 		Status:     grid.StatusBuying,
 	}
@@ -401,6 +450,9 @@ func (s *GridStrategy) handleGridBuy(ctx context.Context, strategyRecord *ent.St
 		OutAmount:  gridArgs.Quantity,
 		Status:     order.StatusPending,
 		TxHash:     hash,
+		JitoTip:    int64(s.svcCtx.TxSender.Tip(hash)),
+		Paper:      paper,
+		Aggregator: tx.Aggregator(),
 	}
 
 	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
@@ -426,15 +478,18 @@ func (s *GridStrategy) handleTakeProfit(ctx context.Context, latestPrice decimal
 		return
 	}
 
-	// 计算利润
-	profit := gridRecord.FinalPrice.Mul(strategyRecord.TakeProfitRatio.Div(decimal.NewFromInt(100)))
-	if latestPrice.LessThan(gridRecord.FinalPrice.Add(profit)) {
+	// 计算止盈价格
+	bottomPrice, err := calculateTakeProfitPrice(strategyRecord, gridRecord)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 计算止盈价格失败, strategy: %s, gridNumber: %d, %v", strategyRecord.GUID, gridRecord.GridNumber, err)
+		return
+	}
+	if latestPrice.LessThan(bottomPrice) {
 		return
 	}
 
 	// 卖出代币
-	bottomPrice := gridRecord.FinalPrice.Add(profit)
-	orderArgs, err := SellToken(ctx, s.svcCtx, strategyRecord, "止盈网格", &gridRecord.Quantity, &bottomPrice, false)
+	orderArgs, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "止盈网格", &gridRecord.Quantity, &bottomPrice, false)
 	if err != nil {
 		return
 	}
@@ -505,7 +560,7 @@ func (s *GridStrategy) handlepriceRangeStopLoss(ctx context.Context, strategyRec
 
 	// 卖出所有代币
 	minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-	orderArgs, err := SellToken(ctx, s.svcCtx, strategyRecord, "跌破清仓", nil, &minSellPrice, true)
+	orderArgs, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "跌破清仓", nil, &minSellPrice, true)
 	if err != nil {
 		return
 	}
@@ -593,7 +648,7 @@ func (s *GridStrategy) handleWaterfallDrop(ctx context.Context, strategyRecord *
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "防瀑布机制", nil, &minSellPrice, true)
+		ord, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "防瀑布机制", nil, &minSellPrice, true)
 		if err != nil {
 			return false, err
 		}
@@ -648,6 +703,110 @@ func (s *GridStrategy) handleWaterfallDrop(ctx context.Context, strategyRecord *
 	return true, nil
 }
 
+// handleTrailingGrid 价格持续突破上限时上移网格区间
+// 跌破下限时不下移区间, 继续由区间止损和跌破清仓处理, 避免在下跌中不断加仓
+func (s *GridStrategy) handleTrailingGrid(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, gridList []decimal.Decimal, ohlcs []charts.Ohlc) bool {
+	if !strategyRecord.TrailingOn {
+		return false
+	}
+
+	if strategyRecord.TrailingMaxShifts > 0 && strategyRecord.TrailingShifts >= strategyRecord.TrailingMaxShifts {
+		return false
+	}
+
+	// 是否持续突破上限
+	candles := max(strategyRecord.TrailingCandles, 1)
+	if len(ohlcs) < candles {
+		return false
+	}
+	for _, item := range ohlcs[len(ohlcs)-candles:] {
+		if item.Close.LessThanOrEqual(strategyRecord.UpperPriceBound) {
+			return false
+		}
+	}
+
+	// 计算移动格数
+	latestPrice := ohlcs[len(ohlcs)-1].Close
+	shift, err := calculateTrailingShift(strategyRecord, gridList, latestPrice)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 计算网格移动格数失败, strategy: %v, price: %v, %v", s.strategyId, latestPrice, err)
+		return false
+	}
+	for _, item := range gridRecords {
+		shift = min(shift, item.GridNumber)
+	}
+	if shift <= 0 {
+		logger.Debugf("[GridStrategy] 底部网格持仓中, 暂不移动网格, strategy: %v, price: %v", s.strategyId, latestPrice)
+		return false
+	}
+
+	lowerPriceBound, upperPriceBound, err := shiftPriceBounds(strategyRecord, shift)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 计算网格区间失败, strategy: %v, shift: %d, %v", s.strategyId, shift, err)
+		return false
+	}
+
+	// 更新数据状态
+	trailingShifts := strategyRecord.TrailingShifts + 1
+	err = utils.Tx(ctx, s.svcCtx.DbClient, func(tx *ent.Tx) error {
+		err := model.NewGridModel(tx.Grid).AddGridNumberByStrategyId(ctx, strategyRecord.GUID, -shift)
+		if err != nil {
+			return err
+		}
+
+		// 未确认订单占用网格编号, 已拒绝订单可能被对账恢复网格, 需要与网格保持一致
+		err = model.NewOrderModel(tx.Order).AddGridNumberByStrategyId(ctx, strategyRecord.GUID, -shift)
+		if err != nil {
+			return err
+		}
+
+		strategyModel := model.NewStrategyModel(tx.Strategy)
+		err = strategyModel.UpdatePriceBounds(ctx, strategyRecord.ID, lowerPriceBound, upperPriceBound)
+		if err != nil {
+			return err
+		}
+
+		err = strategyModel.UpdateTrailingShifts(ctx, strategyRecord.ID, trailingShifts)
+		if err != nil {
+			return err
+		}
+
+		err = strategyModel.UpdateGridTrend(ctx, strategyRecord.ID, "")
+		if err != nil {
+			return err
+		}
+
+		return strategyModel.ClearLastUpperThresholdAlertTime(ctx, strategyRecord.ID)
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategy] 移动网格区间失败, strategy: %v, shift: %d, %v", s.strategyId, shift, err)
+		return false
+	}
+
+	logger.Infof("[GridStrategy] 移动网格区间, strategy: %v, token: %s, price: %v, shift: %d, lowerPriceBound: %v -> %v, upperPriceBound: %v -> %v",
+		s.strategyId, strategyRecord.Symbol, latestPrice, shift, strategyRecord.LowerPriceBound, lowerPriceBound, strategyRecord.UpperPriceBound, upperPriceBound)
+
+	if !strategyRecord.EnablePushNotification {
+		return true
+	}
+
+	// 发送电报通知
+	maxShifts := "不限"
+	if strategyRecord.TrailingMaxShifts > 0 {
+		maxShifts = strconv.Itoa(strategyRecord.TrailingMaxShifts)
+	}
+	text := "🔁*%s* 网格区间已上移!\n\n`%s`\n\n💥 当前价格: %s\n📐 原区间: %s ~ %s\n🎯 新区间: %s ~ %s\n🔢 已移动次数: %d/%s\n\n✅ 现有持仓止盈不受影响!"
+	text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, format.Price(latestPrice, 5),
+		format.Price(strategyRecord.LowerPriceBound, 5), format.Price(strategyRecord.UpperPriceBound, 5),
+		format.Price(lowerPriceBound, 5), format.Price(upperPriceBound, 5), trailingShifts, maxShifts)
+	_, err = utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	if err != nil {
+		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
+	}
+
+	return true
+}
+
 func (s *GridStrategy) handleUpperBoundExit(ctx context.Context, strategyRecord *ent.Strategy, gridRecords []*ent.Grid, latestPrice decimal.Decimal) (bool, error) {
 	if !(strategyRecord.UpperBoundExit != nil &&
 		strategyRecord.UpperBoundExit.GreaterThan(decimal.Zero) &&
@@ -673,7 +832,7 @@ func (s *GridStrategy) handleUpperBoundExit(ctx context.Context, strategyRecord
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "突破退场目标价格", nil, &minSellPrice, true)
+		ord, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "突破退场目标价格", nil, &minSellPrice, true)
 		if err != nil {
 			return false, err
 		}
@@ -739,7 +898,7 @@ func (s *GridStrategy) handleDynamicStopLoss(ctx context.Context, strategyRecord
 	logger.Infof("[GridStrategy] 动态止损, strategy: %v, token: %s, price: %v, gridNumber: %d, currentGridNumber: %d",
 		s.strategyId, strategyRecord.Symbol, latestPrice, gridRecord.GridNumber, gridNumber)
 	minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-	orderArgs, err := SellToken(ctx, s.svcCtx, strategyRecord, "动态止损", &gridRecord.Quantity, &minSellPrice, true)
+	orderArgs, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "动态止损", &gridRecord.Quantity, &minSellPrice, true)
 	if err != nil {
 		return
 	}
@@ -808,7 +967,7 @@ func (s *GridStrategy) handleGlobalTakeProfit(ctx context.Context, strategyRecor
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "触发全局止盈", nil, &minSellPrice, true)
+		ord, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "触发全局止盈", nil, &minSellPrice, true)
 		if err != nil {
 			return false, err
 		}
@@ -888,7 +1047,7 @@ func (s *GridStrategy) handleTakeProfitAtTarget(ctx context.Context, strategyRec
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "达到盈利目标", nil, &minSellPrice, true)
+		ord, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "达到盈利目标", nil, &minSellPrice, true)
 		if err != nil {
 			return false, err
 		}
@@ -968,7 +1127,7 @@ func (s *GridStrategy) handleStopLossAtThreshold(ctx context.Context, strategyRe
 	var orderArgs *ent.Order
 	if len(gridRecords) > 0 && uiTotalQuantity.GreaterThan(decimal.Zero) {
 		minSellPrice := latestPrice.Sub(latestPrice.Mul(decimal.NewFromFloat(0.01)))
-		ord, err := SellToken(ctx, s.svcCtx, strategyRecord, "亏损达到预设金额", nil, &minSellPrice, true)
+		ord, err := SellTokenWithExecutor(ctx, s.svcCtx, s.executor, strategyRecord, "亏损达到预设金额", nil, &minSellPrice, true)
 		if err != nil {
 			return false, err
 		}
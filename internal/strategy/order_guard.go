@@ -0,0 +1,51 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+)
+
+// orderGuard 同一策略的同一网格编号同时只允许存在一个未完成的订单
+type orderGuard struct {
+	mutex    sync.Mutex
+	inflight map[string]struct{}
+}
+
+var guard = &orderGuard{inflight: make(map[string]struct{})}
+
+func orderGuardKey(strategyId string, gridNumber int) string {
+	return fmt.Sprintf("%s:%d", strategyId, gridNumber)
+}
+
+// acquireGridOrder 占用网格编号, 正在下单或存在未确认的订单时返回 false
+// 订单保存后由数据库中的未确认订单继续占用, 调用方应在保存订单后释放
+func acquireGridOrder(ctx context.Context, svcCtx *svc.ServiceContext, strategyId string, gridNumber int) (bool, error) {
+	key := orderGuardKey(strategyId, gridNumber)
+
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+
+	if _, ok := guard.inflight[key]; ok {
+		return false, nil
+	}
+
+	pending, err := svcCtx.OrderModel.HasPendingOrder(ctx, strategyId, gridNumber)
+	if err != nil {
+		return false, err
+	}
+	if pending {
+		return false, nil
+	}
+
+	guard.inflight[key] = struct{}{}
+	return true, nil
+}
+
+func releaseGridOrder(strategyId string, gridNumber int) {
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+	delete(guard.inflight, orderGuardKey(strategyId, gridNumber))
+}
@@ -0,0 +1,127 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+// 检查未通过后重新检查的间隔
+const sellabilityRecheckInterval = 30 * time.Minute
+
+var (
+	// 可能导致无法卖出的 Token-2022 扩展
+	blockedExtensions = []string{"transferHook", "permanentDelegate", "nonTransferable", "pausableConfig", "defaultAccountState"}
+
+	// 存在风险但不影响卖出的 Token-2022 扩展
+	warnedExtensions = []string{"transferFeeConfig"}
+)
+
+// sellabilityState 可卖出检查结果, 通过后不再重复检查
+type sellabilityState struct {
+	passed    bool
+	checkedAt time.Time
+}
+
+// checkSellability 首次买入前检查代币是否可以卖出, 未通过时拒绝买入
+func (s *GridStrategy) checkSellability(ctx context.Context, strategyRecord *ent.Strategy, orderSize decimal.Decimal, outAmount *big.Int) bool {
+	c := s.svcCtx.Config.Sellability
+	if !c.Enable || s.sellability.passed {
+		return true
+	}
+	if !s.sellability.checkedAt.IsZero() && time.Since(s.sellability.checkedAt) < sellabilityRecheckInterval {
+		return false
+	}
+
+	// 检查代币权限和扩展
+	risk, err := s.executor.GetMintRisk(ctx, strategyRecord.Token)
+	if err != nil {
+		logger.Warnf("[GridStrategy] 可卖出检查 - 查询代币权限失败, token: %s, %v", strategyRecord.Token, err)
+		return false
+	}
+
+	reasons := make([]string, 0)
+	warnings := make([]string, 0)
+	if risk.FreezeAuthority {
+		reasons = append(reasons, "代币存在冻结权限")
+	}
+	if risk.MintAuthority {
+		warnings = append(warnings, "代币存在增发权限")
+	}
+	for _, ext := range risk.Extensions {
+		if slices.Contains(blockedExtensions, ext) {
+			reasons = append(reasons, fmt.Sprintf("代币存在 %s 扩展", ext))
+		} else if slices.Contains(warnedExtensions, ext) {
+			warnings = append(warnings, fmt.Sprintf("代币存在 %s 扩展", ext))
+		}
+	}
+
+	// 按买入数量请求反向报价
+	tx, err := s.executor.Quote(ctx, strategyRecord.UserId, strategyRecord.Account, strategyRecord.Token, solanautil.USDC, outAmount, false)
+	if err != nil {
+		logger.Warnf("[GridStrategy] 可卖出检查 - 获取卖出报价失败, token: %s, %v", strategyRecord.Token, err)
+		reasons = append(reasons, "没有可用的卖出路由")
+	} else {
+		uiOutAmount := solanautil.ParseUnits(tx.OutAmount(), solanautil.USDCDecimals)
+		loss := orderSize.Sub(uiOutAmount).Div(orderSize).Mul(decimal.NewFromInt(100))
+		logger.Debugf("[GridStrategy] 可卖出检查 - 往返报价, token: %s, inAmount: %s, outAmount: %s, loss: %s%%",
+			strategyRecord.Symbol, orderSize, uiOutAmount, loss.Truncate(2))
+
+		if loss.GreaterThan(c.MaxRoundTripLoss) {
+			reasons = append(reasons, fmt.Sprintf("往返报价损耗 %s%% 超过上限 %s%%", loss.Truncate(2), c.MaxRoundTripLoss))
+		} else if loss.GreaterThan(c.WarnRoundTripLoss) {
+			warnings = append(warnings, fmt.Sprintf("往返报价损耗 %s%% 超过警告线 %s%%", loss.Truncate(2), c.WarnRoundTripLoss))
+		}
+	}
+
+	s.sellability.checkedAt = time.Now()
+	if len(reasons) > 0 {
+		logger.Warnf("[GridStrategy] 可卖出检查未通过, 取消买入, strategy: %s, token: %s, reasons: %v",
+			strategyRecord.GUID, strategyRecord.Token, reasons)
+
+		text := "🚫 *%s* 未通过可卖出检查, 已取消买入!\n\n`%s`\n\n%s\n\n⏳ 系统将在 %d 分钟后重新检查"
+		text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, formatSellabilityItems(append(reasons, warnings...)), int(sellabilityRecheckInterval.Minutes()))
+		s.sendSellabilityAlert(strategyRecord, text)
+		return false
+	}
+
+	s.sellability.passed = true
+	if len(warnings) > 0 {
+		logger.Infof("[GridStrategy] 可卖出检查存在风险, strategy: %s, token: %s, warnings: %v",
+			strategyRecord.GUID, strategyRecord.Token, warnings)
+
+		text := "⚠️ *%s* 可卖出检查存在风险!\n\n`%s`\n\n%s\n\n✅ 策略将继续买入, 请留意风险!"
+		text = fmt.Sprintf(text, strategyRecord.Symbol, strategyRecord.Token, formatSellabilityItems(warnings))
+		s.sendSellabilityAlert(strategyRecord, text)
+	}
+	return true
+}
+
+func (s *GridStrategy) sendSellabilityAlert(strategyRecord *ent.Strategy, text string) {
+	if !strategyRecord.EnablePushNotification {
+		return
+	}
+
+	_, err := utils.SendMessage(s.svcCtx.BotApi, strategyRecord.UserId, text)
+	if err != nil {
+		logger.Warnf("[GridStrategy] 发送电报通知失败, userId: %d, text: %s, %v", strategyRecord.UserId, text, err)
+	}
+}
+
+func formatSellabilityItems(items []string) string {
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, "• "+item)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,101 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+// fakeQuote 测试用报价
+type fakeQuote struct {
+	outAmount *big.Int
+}
+
+func (tx *fakeQuote) Aggregator() string  { return "fake" }
+func (tx *fakeQuote) Signer() string      { return "" }
+func (tx *fakeQuote) OutAmount() *big.Int { return tx.outAmount }
+func (tx *fakeQuote) SlippageBps() int    { return 0 }
+func (tx *fakeQuote) Swap(ctx context.Context) (string, error) {
+	return "", errors.New("not supported")
+}
+
+// fakeSellabilityExecutor 测试用执行器, 返回指定的代币权限和卖出报价
+type fakeSellabilityExecutor struct {
+	Executor
+	risk      solanautil.MintRisk
+	outAmount *big.Int // 为空时没有卖出路由
+	calls     int
+}
+
+func (e *fakeSellabilityExecutor) GetMintRisk(ctx context.Context, tokenAddress string) (solanautil.MintRisk, error) {
+	e.calls++
+	return e.risk, nil
+}
+
+func (e *fakeSellabilityExecutor) Quote(ctx context.Context, userId int64, account string, inputToken, outputToken string, amount *big.Int, exit bool) (swap.SwapTransaction, error) {
+	if e.outAmount == nil {
+		return nil, errors.New("no route")
+	}
+	return &fakeQuote{outAmount: e.outAmount}, nil
+}
+
+func TestCheckSellability(t *testing.T) {
+	tests := []struct {
+		name      string
+		disable   bool
+		risk      solanautil.MintRisk
+		outAmount int64 // 10 USDC 往返后得到的 USDC, 为 0 时没有卖出路由
+		expected  bool
+	}{
+		{name: "往返损耗正常", outAmount: 9_800_000, expected: true},
+		{name: "往返损耗超过警告线", outAmount: 9_500_000, expected: true},
+		{name: "往返损耗超过上限", outAmount: 8_000_000},
+		{name: "没有卖出路由"},
+		{name: "冻结权限", risk: solanautil.MintRisk{FreezeAuthority: true}, outAmount: 9_800_000},
+		{name: "增发权限只警告", risk: solanautil.MintRisk{MintAuthority: true}, outAmount: 9_800_000, expected: true},
+		{name: "转账钩子扩展", risk: solanautil.MintRisk{Extensions: []string{"transferHook"}}, outAmount: 9_800_000},
+		{name: "转账手续费扩展只警告", risk: solanautil.MintRisk{Extensions: []string{"transferFeeConfig"}}, outAmount: 9_800_000, expected: true},
+		{name: "未开启检查", disable: true, risk: solanautil.MintRisk{FreezeAuthority: true}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &config.Config{}
+			c.Sellability = config.Sellability{
+				Enable:            !tt.disable,
+				MaxRoundTripLoss:  decimal.NewFromInt(10),
+				WarnRoundTripLoss: decimal.NewFromInt(3),
+			}
+
+			executor := &fakeSellabilityExecutor{risk: tt.risk}
+			if tt.outAmount > 0 {
+				executor.outAmount = big.NewInt(tt.outAmount)
+			}
+			s := &GridStrategy{svcCtx: &svc.ServiceContext{Config: c}, executor: executor}
+			record := &ent.Strategy{GUID: "strategy", Token: "token", Symbol: "TOKEN"}
+
+			got := s.checkSellability(context.Background(), record, decimal.NewFromInt(10), big.NewInt(1000))
+			if got != tt.expected {
+				t.Fatalf("checkSellability() = %v, 期望 %v", got, tt.expected)
+			}
+
+			// 通过后不再检查, 未通过时在重新检查间隔内直接拒绝
+			calls := executor.calls
+			if again := s.checkSellability(context.Background(), record, decimal.NewFromInt(10), big.NewInt(1000)); again != tt.expected {
+				t.Errorf("再次检查 = %v, 期望 %v", again, tt.expected)
+			}
+			if executor.calls != calls {
+				t.Errorf("再次检查不应该重复查询代币权限")
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	"github.com/gagliardetto/solana-go"
+	"golang.org/x/term"
+)
+
+// 旧版 hashids 编码默认盐值, 仅用于迁移旧数据
+const legacyHashSalt = "8wKzxf51vQJT5n=bM6e?z)6B]XiDXcMdE]=>GiXm"
+
+// loadMasterPassword 从环境变量读取主密码, 未设置时在终端中提示输入
+func loadMasterPassword(confirm bool) (string, error) {
+	password := os.Getenv("GRIDBOT_MASTER_PASSWORD")
+	if password != "" {
+		return password, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("环境变量 GRIDBOT_MASTER_PASSWORD 未设置, 没有终端时无法输入主密码, 请通过环境变量或启动器提供主密码")
+	}
+
+	fmt.Print("请输入主密码: ")
+	data, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", errors.New("主密码不能为空")
+	}
+
+	if confirm {
+		fmt.Print("请再次输入主密码: ")
+		again, err := term.ReadPassword(fd)
+		fmt.Println()
+		if err != nil {
+			return "", err
+		}
+		if string(again) != string(data) {
+			return "", errors.New("两次输入的主密码不一致")
+		}
+	}
+
+	return string(data), nil
+}
+
+// newKeyCipher 创建私钥加密器, 并将旧版编码的私钥迁移为加密存储
+func newKeyCipher(ctx context.Context, client *ent.Client) (*utils.KeyCipher, error) {
+	wallets, err := model.NewWalletModel(client.Wallet).FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 首次设置主密码时需要再次确认
+	confirm := true
+	for _, w := range wallets {
+		if utils.IsKeyCipherText(w.PrivateKey) {
+			confirm = false
+			break
+		}
+	}
+
+	password, err := loadMasterPassword(confirm)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCipher, err := utils.NewKeyCipher(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = migratePrivateKeys(ctx, client, wallets, keyCipher); err != nil {
+		return nil, err
+	}
+	return keyCipher, nil
+}
+
+// migratePrivateKeys 校验所有私钥均可使用主密码解密, 并重新加密旧版 hashids 编码的私钥
+func migratePrivateKeys(ctx context.Context, client *ent.Client, wallets []*ent.Wallet, keyCipher *utils.KeyCipher) error {
+	var legacy *utils.HashEncoder
+	migrated := make(map[string]string)
+	for _, w := range wallets {
+		if utils.IsKeyCipherText(w.PrivateKey) {
+			pk, err := keyCipher.Decryption(w.PrivateKey)
+			if err != nil {
+				return fmt.Errorf("解密钱包私钥失败, 主密码错误或数据已损坏, account: %s, %w", w.Account, err)
+			}
+			if err = checkPrivateKey(w.Account, pk); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if legacy == nil {
+			salt := os.Getenv("GRIDBOT_HASH_SALT")
+			if salt == "" {
+				salt = legacyHashSalt
+			}
+
+			var err error
+			legacy, err = utils.NewHashEncoder(salt)
+			if err != nil {
+				return err
+			}
+		}
+
+		pk, err := legacy.Decryption(w.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("解码旧版钱包私钥失败, account: %s, %w", w.Account, err)
+		}
+		if err = checkPrivateKey(w.Account, pk); err != nil {
+			return err
+		}
+
+		encrypted, err := keyCipher.Encryption(pk)
+		if err != nil {
+			return err
+		}
+		migrated[w.Account] = encrypted
+	}
+
+	if len(migrated) == 0 {
+		return nil
+	}
+
+	err := utils.Tx(ctx, client, func(tx *ent.Tx) error {
+		walletModel := model.NewWalletModel(tx.Wallet)
+		for account, encrypted := range migrated {
+			if err := walletModel.UpdatePrivateKey(ctx, account, encrypted); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("[KeyCipher] 已将 %d 个钱包私钥迁移为加密存储", len(migrated))
+	return nil
+}
+
+func checkPrivateKey(account, privateKey string) error {
+	wallet, err := solana.WalletFromPrivateKeyBase58(privateKey)
+	if err != nil {
+		return fmt.Errorf("解析钱包私钥失败, account: %s, %w", account, err)
+	}
+	if wallet.PublicKey().String() != account {
+		return fmt.Errorf("钱包私钥与地址不匹配, account: %s", account)
+	}
+	return nil
+}
@@ -3,31 +3,37 @@ package svc
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
-	"os"
+	"time"
 
 	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/charts"
 	"github.com/fachebot/sol-grid-bot/internal/config"
 	"github.com/fachebot/sol-grid-bot/internal/datapi/gmgn"
 	"github.com/fachebot/sol-grid-bot/internal/datapi/jupag"
 	"github.com/fachebot/sol-grid-bot/internal/datapi/okxweb3"
 	"github.com/fachebot/sol-grid-bot/internal/engine"
 	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/migrate"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
 	"golang.org/x/net/proxy"
 )
 
 type ServiceContext struct {
 	Config           *config.Config
-	HashEncoder      *utils.HashEncoder
+	KeyCipher        *utils.KeyCipher
 	Engine           *engine.StrategyEngine
 	DbClient         *ent.Client
 	BotApi           *tgbotapi.BotAPI
@@ -39,34 +45,38 @@ type ServiceContext struct {
 	TransportProxy   *http.Transport
 	LookuptableCache *cache.LookuptableCache
 	MessageCache     *cache.MessageCache
+	PendingCache     *cache.PendingStrategyCache
+	WithdrawCache    *cache.PendingWithdrawCache
 	TokenMetaCache   *cache.TokenMetaCache
+	SolPriceCache    *cache.SolPriceCache
+	TxSender         *txsender.TxSender
 	GridModel        *model.GridModel
 	OrderModel       *model.OrderModel
 	SettingsModel    *model.SettingsModel
 	StrategyModel    *model.StrategyModel
 	WalletModel      *model.WalletModel
+	WithdrawalModel  *model.WithdrawalModel
+	AddressModel     *model.WithdrawAddressModel
 }
 
 func NewServiceContext(c *config.Config, strategyEngine *engine.StrategyEngine) *ServiceContext {
-	// 创建hash编码器
-	salt := os.Getenv("GRIDBOT_HASH_SALT")
-	if salt == "" {
-		salt = "8wKzxf51vQJT5n=bM6e?z)6B]XiDXcMdE]=>GiXm"
-		logger.Debugf("环境变量 GRIDBOT_HASH_SALT 未设置")
-	}
-	hashEncoder, err := utils.NewHashEncoder(salt)
-	if err != nil {
-		logger.Fatalf("创建Hash编码器失败, %v", err)
-	}
-
 	// 创建数据库连接
 	client, err := ent.Open("sqlite3", "file:data/sqlite.db?mode=rwc&_journal_mode=WAL&_fk=1")
 	if err != nil {
 		logger.Fatalf("打开数据库失败, %v", err)
 	}
-	if err := client.Schema.Create(context.Background()); err != nil {
+	if err := client.Schema.Create(context.Background(), migrate.WithDropIndex(true)); err != nil {
 		logger.Fatalf("创建数据库Schema失败, %v", err)
 	}
+	if err := fillStrategyAccounts(context.Background(), client); err != nil {
+		logger.Fatalf("设置策略钱包失败, %v", err)
+	}
+
+	// 创建私钥加密器
+	keyCipher, err := newKeyCipher(context.Background(), client)
+	if err != nil {
+		logger.Fatalf("创建私钥加密器失败, %v", err)
+	}
 
 	// 创建SOCKS5代理
 	var transportProxy *http.Transport
@@ -107,30 +117,94 @@ func NewServiceContext(c *config.Config, strategyEngine *engine.StrategyEngine)
 	opts := &jsonrpc.RPCClientOpts{HTTPClient: rpcHttpClient}
 	solanaRpc := rpc.NewWithCustomRPCClient(jsonrpc.NewClientWithOpts(c.Solana.RpcUrl, opts))
 
+	okxClient := okxweb3.NewClient(c.Sock5Proxy)
+	gmgnClient := gmgn.NewClient(c.Sock5Proxy)
+	jupagClient := jupag.NewClient(c.Sock5Proxy)
+
+	// 获取SOL价格
+	fetchSolPrice := func(ctx context.Context) (decimal.Decimal, error) {
+		var err error
+		var ohlcs []charts.Ohlc
+		switch c.Datapi {
+		case "okx":
+			ohlcs, err = okxClient.FetchTokenCandles(ctx, solanautil.WSOL, time.Now(), "1m", 1)
+		case "gmgn":
+			ohlcs, err = gmgnClient.FetchTokenCandles(ctx, solanautil.WSOL, time.Now(), "1m", 1)
+		default:
+			ohlcs, err = jupagClient.FetchTokenCandles(ctx, solanautil.WSOL, time.Now(), "1m", 1)
+		}
+		if err != nil {
+			return decimal.Zero, err
+		}
+		if len(ohlcs) == 0 {
+			return decimal.Zero, errors.New("sol price not found")
+		}
+		return ohlcs[len(ohlcs)-1].Close, nil
+	}
+
 	svcCtx := &ServiceContext{
 		Config:           c,
-		HashEncoder:      hashEncoder,
+		KeyCipher:        keyCipher,
 		Engine:           strategyEngine,
 		DbClient:         client,
 		BotApi:           botApi,
 		BotUserInfo:      &botUserInfo,
 		SolanaRpc:        solanaRpc,
-		OkxClient:        okxweb3.NewClient(c.Sock5Proxy),
-		GmgnClient:       gmgn.NewClient(c.Sock5Proxy),
-		JupagClient:      jupag.NewClient(c.Sock5Proxy),
+		OkxClient:        okxClient,
+		GmgnClient:       gmgnClient,
+		JupagClient:      jupagClient,
 		TransportProxy:   transportProxy,
 		LookuptableCache: cache.NewLookuptableCache(solanaRpc),
 		MessageCache:     cache.NewMessageCache(),
+		PendingCache:     cache.NewPendingStrategyCache(),
+		WithdrawCache:    cache.NewPendingWithdrawCache(),
 		TokenMetaCache:   cache.NewTokenMetaCache(solanaRpc),
+		SolPriceCache:    cache.NewSolPriceCache(fetchSolPrice),
+		TxSender:         txsender.NewTxSender(solanaRpc, c.Jito.Url, transportProxy),
 		GridModel:        model.NewGridModel(client.Grid),
 		OrderModel:       model.NewOrderModel(client.Order),
 		SettingsModel:    model.NewSettingsModel(client.Settings),
 		StrategyModel:    model.NewStrategyModel(client.Strategy),
 		WalletModel:      model.NewWalletModel(client.Wallet),
+		WithdrawalModel:  model.NewWithdrawalModel(client.Withdrawal),
+		AddressModel:     model.NewWithdrawAddressModel(client.WithdrawAddress),
 	}
 	return svcCtx
 }
 
+// fillStrategyAccounts 将未指定钱包的策略绑定到用户的默认钱包
+func fillStrategyAccounts(ctx context.Context, client *ent.Client) error {
+	walletModel := model.NewWalletModel(client.Wallet)
+	strategyModel := model.NewStrategyModel(client.Strategy)
+
+	wallets, err := walletModel.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	filled := make(map[int64]bool)
+	for _, w := range wallets {
+		if filled[w.UserId] {
+			continue
+		}
+		filled[w.UserId] = true
+
+		defaultWallet, err := walletModel.FindDefaultByUserId(ctx, w.UserId)
+		if err != nil {
+			return err
+		}
+
+		n, err := strategyModel.FillEmptyAccount(ctx, w.UserId, defaultWallet.Account)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logger.Infof("[ServiceContext] 已将 %d 个策略绑定到钱包, userId: %d, account: %s", n, w.UserId, defaultWallet.Account)
+		}
+	}
+	return nil
+}
+
 func (svcCtx *ServiceContext) Close() {
 	if err := svcCtx.DbClient.Close(); err != nil {
 		logger.Errorf("关闭数据库失败, %v", err)
@@ -0,0 +1,171 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+// routeCost 聚合器报价的费用估算
+type routeCost interface {
+	// 预估交易费用(USD), 未知时返回 false
+	estimatedFeeUsd() (decimal.Decimal, bool)
+	// 输出代币最小单位价格(USD), 未知时返回零
+	outUnitPriceUsd() decimal.Decimal
+}
+
+type routeQuote struct {
+	aggregator settings.DexAggregator
+	tx         SwapTransaction
+	err        error
+}
+
+// quoteBest 并行向所有聚合器询价, 选择扣除预估费用后输出最多的路由
+func (s *SwapService) quoteBest(ctx context.Context, request quoteRequest) (SwapTransaction, error) {
+	userSettings, err := s.getUserSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 跳过冷却中的聚合器, 全部冷却时仍然询价
+	order := s.failoverOrder("", userSettings)
+	aggregators := make([]settings.DexAggregator, 0, len(order))
+	for _, aggregator := range order {
+		if health.available(aggregator) {
+			aggregators = append(aggregators, aggregator)
+		}
+	}
+	if len(aggregators) == 0 {
+		aggregators = order
+	}
+
+	timeout := time.Duration(s.svcCtx.Config.Solana.QuoteTimeout) * time.Millisecond
+	quoteCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// 并行询价
+	ch := make(chan routeQuote, len(aggregators))
+	for _, aggregator := range aggregators {
+		go func() {
+			tx, err := s.quoteFrom(quoteCtx, aggregator, request)
+			ch <- routeQuote{aggregator: aggregator, tx: tx, err: err}
+		}()
+	}
+
+	quotes := make([]SwapTransaction, 0, len(aggregators))
+	for range aggregators {
+		q := <-ch
+		if q.err != nil {
+			s.reportFailure(q.aggregator)
+			logger.Warnf("[SwapService] 聚合器报价失败, aggregator: %s, inputToken: %s, outputToken: %s, %v",
+				q.aggregator, request.inputToken, request.outputToken, q.err)
+			continue
+		}
+		health.reportSuccess(q.aggregator)
+		quotes = append(quotes, q.tx)
+	}
+	if len(quotes) == 0 {
+		return nil, errors.New("all aggregators failed to quote")
+	}
+
+	// 选择最优路由
+	best, netValues := selectBestRoute(quotes, request.outputToken)
+	for idx, tx := range quotes {
+		logger.Debugf("[SwapService] 聚合器报价, aggregator: %s, outAmount: %s, netValue: %s",
+			tx.Aggregator(), tx.OutAmount(), netValues[idx])
+	}
+	logger.Infof("[SwapService] 选择最优路由, aggregator: %s, inputToken: %s, outputToken: %s, outAmount: %s, quotes: %d",
+		best.Aggregator(), request.inputToken, request.outputToken, best.OutAmount(), len(quotes))
+
+	// 发送失败时按优先级切换其他聚合器
+	fallbacks := s.failoverOrder(settings.DexAggregator(best.Aggregator()), userSettings)[1:]
+	return NewFailoverSwapTransaction(s, best, request, fallbacks), nil
+}
+
+// selectBestRoute 按扣除预估费用后的输出价值选择最优报价
+// 无法获取输出代币价格时, 直接比较输出数量
+func selectBestRoute(quotes []SwapTransaction, outputToken string) (SwapTransaction, []decimal.Decimal) {
+	// 输出代币单位价格
+	unitPrice := decimal.Zero
+	if outputToken == solanautil.USDC {
+		unitPrice = decimal.New(1, -solanautil.USDCDecimals)
+	} else {
+		for _, tx := range quotes {
+			if c, ok := tx.(routeCost); ok {
+				if price := c.outUnitPriceUsd(); price.IsPositive() {
+					unitPrice = price
+					break
+				}
+			}
+		}
+	}
+
+	// 未返回费用的报价按最高预估费用计算, 避免低估
+	fees := make([]decimal.Decimal, len(quotes))
+	known := make([]bool, len(quotes))
+	maxFee := decimal.Zero
+	for idx, tx := range quotes {
+		if c, ok := tx.(routeCost); ok {
+			fees[idx], known[idx] = c.estimatedFeeUsd()
+			if known[idx] {
+				maxFee = decimal.Max(maxFee, fees[idx])
+			}
+		}
+	}
+
+	bestIdx := 0
+	netValues := make([]decimal.Decimal, len(quotes))
+	for idx, tx := range quotes {
+		outAmount := decimal.NewFromBigInt(tx.OutAmount(), 0)
+		if unitPrice.IsZero() {
+			netValues[idx] = outAmount
+		} else {
+			fee := fees[idx]
+			if !known[idx] {
+				fee = maxFee
+			}
+			netValues[idx] = outAmount.Mul(unitPrice).Sub(fee)
+		}
+
+		if netValues[idx].GreaterThan(netValues[bestIdx]) {
+			bestIdx = idx
+		}
+	}
+
+	return quotes[bestIdx], netValues
+}
+
+func (tx *OkxSwapTransaction) estimatedFeeUsd() (decimal.Decimal, bool) {
+	return tx.quote.RouterResult.TradeFee, true
+}
+
+func (tx *OkxSwapTransaction) outUnitPriceUsd() decimal.Decimal {
+	token := tx.quote.RouterResult.ToToken
+	return token.TokenUnitPrice.Shift(-int32(token.Decimal.IntPart()))
+}
+
+func (tx *JupSwapTransaction) estimatedFeeUsd() (decimal.Decimal, bool) {
+	return decimal.Zero, false
+}
+
+func (tx *JupSwapTransaction) outUnitPriceUsd() decimal.Decimal {
+	return decimal.Zero
+}
+
+func (tx *RelaySwapTransaction) estimatedFeeUsd() (decimal.Decimal, bool) {
+	return tx.quote.Fees.Gas.AmountUsd, true
+}
+
+func (tx *RelaySwapTransaction) outUnitPriceUsd() decimal.Decimal {
+	currencyOut := tx.quote.Details.CurrencyOut
+	if currencyOut.Amount.IsZero() {
+		return decimal.Zero
+	}
+	return currencyOut.AmountUsd.Div(currencyOut.Amount)
+}
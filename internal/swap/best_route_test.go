@@ -0,0 +1,105 @@
+package swap
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/shopspring/decimal"
+)
+
+// fakeRouteQuote 测试用报价, 带有费用估算
+type fakeRouteQuote struct {
+	fakeSwapTransaction
+	fee       decimal.Decimal
+	feeKnown  bool
+	unitPrice decimal.Decimal
+}
+
+func (tx *fakeRouteQuote) estimatedFeeUsd() (decimal.Decimal, bool) { return tx.fee, tx.feeKnown }
+func (tx *fakeRouteQuote) outUnitPriceUsd() decimal.Decimal         { return tx.unitPrice }
+
+func newFakeRouteQuote(aggregator string, outAmount int64, fee string, unitPrice string) *fakeRouteQuote {
+	tx := &fakeRouteQuote{fakeSwapTransaction: fakeSwapTransaction{aggregator: aggregator, outAmount: big.NewInt(outAmount)}}
+	if fee != "" {
+		tx.fee, tx.feeKnown = decimal.RequireFromString(fee), true
+	}
+	if unitPrice != "" {
+		tx.unitPrice = decimal.RequireFromString(unitPrice)
+	}
+	return tx
+}
+
+func TestSelectBestRoute(t *testing.T) {
+	tests := []struct {
+		name        string
+		quotes      []SwapTransaction
+		outputToken string
+		expected    string
+	}{
+		{
+			name:        "单个报价",
+			quotes:      []SwapTransaction{newFakeRouteQuote("jup", 1000, "", "")},
+			outputToken: "token",
+			expected:    "jup",
+		},
+		{
+			name: "没有价格时比较输出数量",
+			quotes: []SwapTransaction{
+				newFakeRouteQuote("jup", 1000, "", ""),
+				newFakeRouteQuote("okx", 1200, "100", ""),
+			},
+			outputToken: "token",
+			expected:    "okx",
+		},
+		{
+			name: "USDC 输出扣除费用后选择",
+			quotes: []SwapTransaction{
+				newFakeRouteQuote("okx", 10_100_000, "0.2", ""),
+				newFakeRouteQuote("relay", 10_000_000, "0.05", ""),
+			},
+			outputToken: solanautil.USDC,
+			expected:    "relay",
+		},
+		{
+			name: "未知费用按最高费用计算",
+			quotes: []SwapTransaction{
+				newFakeRouteQuote("jup", 10_050_000, "", ""),
+				newFakeRouteQuote("okx", 10_100_000, "0.1", ""),
+			},
+			outputToken: solanautil.USDC,
+			expected:    "okx",
+		},
+		{
+			name: "使用报价中的输出代币价格",
+			quotes: []SwapTransaction{
+				newFakeRouteQuote("okx", 2000, "1", "0.01"),
+				newFakeRouteQuote("relay", 1950, "0.1", ""),
+			},
+			outputToken: "token",
+			expected:    "relay",
+		},
+		{
+			name: "净值相同时保留靠前的报价",
+			quotes: []SwapTransaction{
+				newFakeRouteQuote("jup", 1000, "", ""),
+				newFakeRouteQuote("okx", 1000, "", ""),
+			},
+			outputToken: "token",
+			expected:    "jup",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best, netValues := selectBestRoute(tt.quotes, tt.outputToken)
+			if len(netValues) != len(tt.quotes) {
+				t.Fatalf("selectBestRoute() 返回 %d 个净值, 期望 %d 个", len(netValues), len(tt.quotes))
+			}
+			if best.Aggregator() != tt.expected {
+				t.Errorf("selectBestRoute() = %s, 期望 %s, 净值: %v", best.Aggregator(), tt.expected, netValues)
+			}
+		})
+	}
+}
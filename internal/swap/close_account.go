@@ -0,0 +1,115 @@
+package swap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/token"
+)
+
+const (
+	closeAccountBatchSize    = 20   // 每笔交易最多关闭的代币账户数量, 受交易大小限制
+	closeAccountComputeUnits = 5000 // 每个关闭指令的计算单元上限
+)
+
+// CloseAccountsTx 关闭代币账户交易, 金额单位均为 lamports
+type CloseAccountsTx struct {
+	Hash     string
+	Closed   int
+	Lamports uint64 // 回收的租金
+	Fee      uint64 // 预估交易费用
+}
+
+// CloseTokenAccounts 在钱包队列中关闭代币账户并将租金退回钱包, 账户按批次合并到尽量少的交易中
+func (s *SwapService) CloseTokenAccounts(ctx context.Context, accounts []solanautil.TokenAccount) ([]CloseAccountsTx, error) {
+	var result []CloseAccountsTx
+	userWallet, err := s.getUserWallet(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	userSettings, err := s.getUserSettings(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	owner := userWallet.PublicKey()
+	request := quoteRequest{user: owner.String()}
+	for start := 0; start < len(accounts); start += closeAccountBatchSize {
+		batch := accounts[start:min(start+closeAccountBatchSize, len(accounts))]
+
+		var fee uint64
+		hash, err := s.execute(ctx, request, func() (string, error) {
+			computeUnitLimit := uint32(closeAccountComputeUnits * len(batch))
+			instructions := []solana.Instruction{
+				computebudget.NewSetComputeUnitLimitInstruction(computeUnitLimit).Build(),
+				computebudget.NewSetComputeUnitPriceInstruction(0).Build(),
+			}
+			for _, account := range batch {
+				// Token-2022 与 Token 程序的 CloseAccount 指令格式相同
+				closeAccount := token.NewCloseAccountInstruction(account.Address, owner, owner, nil).Build()
+				data, err := closeAccount.Data()
+				if err != nil {
+					return "", err
+				}
+				instructions = append(instructions, solana.NewInstruction(account.ProgramID, closeAccount.Accounts(), data))
+			}
+
+			latestBlockhash, err := s.svcCtx.SolanaRpc.GetLatestBlockhash(ctx, "")
+			if err != nil {
+				return "", fmt.Errorf("could not get latest blockhash: %w", err)
+			}
+
+			// 创建交易
+			tx, err := solana.NewTransaction(
+				instructions,
+				latestBlockhash.Value.Blockhash,
+				solana.TransactionPayer(owner),
+			)
+			if err != nil {
+				return "", fmt.Errorf("could not create close account transaction: %w", err)
+			}
+
+			// 设置优先费
+			price, err := solanautil.SetPriorityFee(ctx, s.svcCtx.SolanaRpc, tx, nil, string(userSettings.PriorityLevel), uint64(userSettings.MaxLamports))
+			if err != nil {
+				return "", fmt.Errorf("could not estimate priority fee: %w", err)
+			}
+			fee = lamportsPerSignature*uint64(tx.Message.Header.NumRequiredSignatures) + price*uint64(computeUnitLimit)/1000000
+
+			// 签名交易
+			signedTx, _, err := solanautil.SignTransaction(userWallet, tx)
+			if err != nil {
+				return "", fmt.Errorf("could not sign close account transaction: %w", err)
+			}
+
+			// 发送交易
+			hash, err := s.svcCtx.TxSender.Send(ctx, signedTx, txsender.SendOptions{
+				MinContextSlot:       latestBlockhash.Context.Slot,
+				LastValidBlockHeight: latestBlockhash.Value.LastValidBlockHeight,
+				MaxRetries:           uint(userSettings.MaxRetries),
+				Wallet:               userWallet,
+			})
+			if err != nil {
+				return hash, fmt.Errorf("could not send transaction: %w", err)
+			}
+			return hash, nil
+		})
+		if err != nil {
+			return result, err
+		}
+
+		item := CloseAccountsTx{Hash: hash, Closed: len(batch), Fee: fee}
+		for _, account := range batch {
+			item.Lamports += account.Lamports
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
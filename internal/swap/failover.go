@@ -0,0 +1,272 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+)
+
+// 模拟失败通知中保留的日志行数
+const simulationLogLines = 8
+
+// ErrFallbackQuoteTooLow 切换聚合器后的报价低于原报价扣除滑点后的数量
+var ErrFallbackQuoteTooLow = errors.New("fallback quote below original quote")
+
+type quoteRequest struct {
+	user        string
+	inputToken  string
+	outputToken string
+	amount      *big.Int
+	slippageBps int
+	exit        bool
+}
+
+// aggregatorHealth 聚合器健康状态, 连续失败次数达到阈值后冷却一段时间
+type aggregatorHealth struct {
+	mutex         sync.Mutex
+	failures      map[settings.DexAggregator]int
+	cooldownUntil map[settings.DexAggregator]time.Time
+}
+
+var health = &aggregatorHealth{
+	failures:      make(map[settings.DexAggregator]int),
+	cooldownUntil: make(map[settings.DexAggregator]time.Time),
+}
+
+func (h *aggregatorHealth) available(aggregator settings.DexAggregator) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return time.Now().After(h.cooldownUntil[aggregator])
+}
+
+func (h *aggregatorHealth) reportSuccess(aggregator settings.DexAggregator) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	delete(h.failures, aggregator)
+}
+
+func (h *aggregatorHealth) reportFailure(aggregator settings.DexAggregator, threshold int, cooldown time.Duration) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.failures[aggregator]++
+	if h.failures[aggregator] < threshold {
+		return
+	}
+
+	h.failures[aggregator] = 0
+	h.cooldownUntil[aggregator] = time.Now().Add(cooldown)
+	logger.Warnf("[SwapService] 聚合器连续失败, 暂停使用, aggregator: %s, cooldown: %s", aggregator, cooldown)
+}
+
+// ParseAggregatorPriority 解析聚合器优先级, 格式: jup,okx,relay
+func ParseAggregatorPriority(s string) ([]settings.DexAggregator, error) {
+	result := make([]settings.DexAggregator, 0, 3)
+	for item := range strings.SplitSeq(s, ",") {
+		aggregator := settings.DexAggregator(strings.TrimSpace(item))
+		if aggregator == "" {
+			continue
+		}
+		if aggregator == settings.DexAggregatorAuto || settings.DexAggregatorValidator(aggregator) != nil {
+			return nil, fmt.Errorf("invalid aggregator: %s", aggregator)
+		}
+		if !slices.Contains(result, aggregator) {
+			result = append(result, aggregator)
+		}
+	}
+	if len(result) == 0 {
+		return nil, errors.New("aggregator priority is empty")
+	}
+	return result, nil
+}
+
+func (s *SwapService) reportFailure(aggregator settings.DexAggregator) {
+	c := s.svcCtx.Config.Solana
+	health.reportFailure(aggregator, c.FailoverThreshold, time.Duration(c.FailoverCooldown)*time.Second)
+}
+
+// aggregatorPriority 用户设置的聚合器优先级, 未设置时使用全局配置
+func (s *SwapService) aggregatorPriority(userSettings *ent.Settings) []settings.DexAggregator {
+	if userSettings.AggregatorPriority != "" {
+		priority, err := ParseAggregatorPriority(userSettings.AggregatorPriority)
+		if err == nil {
+			return priority
+		}
+		logger.Warnf("[SwapService] 解析聚合器优先级失败, userId: %d, priority: %s, %v",
+			s.userId, userSettings.AggregatorPriority, err)
+	}
+
+	priority := make([]settings.DexAggregator, 0, len(s.svcCtx.Config.Solana.AggregatorPriority))
+	for _, item := range s.svcCtx.Config.Solana.AggregatorPriority {
+		priority = append(priority, settings.DexAggregator(item))
+	}
+	return priority
+}
+
+// failoverOrder 聚合器尝试顺序, 首选聚合器在前, 其余按优先级排列, 冷却中的聚合器排在最后
+func (s *SwapService) failoverOrder(primary settings.DexAggregator, userSettings *ent.Settings) []settings.DexAggregator {
+	candidates := make([]settings.DexAggregator, 0, 4)
+	if primary != "" {
+		candidates = append(candidates, primary)
+	}
+	for _, item := range s.aggregatorPriority(userSettings) {
+		if !slices.Contains(candidates, item) {
+			candidates = append(candidates, item)
+		}
+	}
+
+	order := make([]settings.DexAggregator, 0, len(candidates))
+	cooling := make([]settings.DexAggregator, 0)
+	for _, item := range candidates {
+		if health.available(item) {
+			order = append(order, item)
+		} else {
+			cooling = append(cooling, item)
+		}
+	}
+	return append(order, cooling...)
+}
+
+// quoteWithFailover 按顺序向聚合器询价, 直到有聚合器返回报价
+func (s *SwapService) quoteWithFailover(ctx context.Context, primary settings.DexAggregator, request quoteRequest) (SwapTransaction, error) {
+	userSettings, err := s.getUserSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order := s.failoverOrder(primary, userSettings)
+	for idx, aggregator := range order {
+		tx, quoteErr := s.quoteFrom(ctx, aggregator, request)
+		if quoteErr != nil {
+			err = quoteErr
+			s.reportFailure(aggregator)
+			logger.Warnf("[SwapService] 聚合器报价失败, aggregator: %s, inputToken: %s, outputToken: %s, %v",
+				aggregator, request.inputToken, request.outputToken, quoteErr)
+			continue
+		}
+
+		health.reportSuccess(aggregator)
+		if aggregator != primary {
+			logger.Infof("[SwapService] 切换聚合器报价成功, primary: %s, aggregator: %s, inputToken: %s, outputToken: %s",
+				primary, aggregator, request.inputToken, request.outputToken)
+		}
+		return NewFailoverSwapTransaction(s, tx, request, order[idx+1:]), nil
+	}
+
+	if err == nil {
+		err = errors.New("no aggregator available")
+	}
+	return nil, err
+}
+
+// FailoverSwapTransaction 交易未能发出时, 按优先级切换到下一个聚合器重新报价并发送
+// 发送失败但已生成交易哈希时不会切换, 避免重复成交
+// 调用方只检查了原报价的价格, 新报价输出低于原报价扣除滑点后的数量时不会发送
+type FailoverSwapTransaction struct {
+	SwapTransaction
+	service   *SwapService
+	request   quoteRequest
+	fallbacks []settings.DexAggregator
+	minOut    *big.Int
+	quoteFrom func(ctx context.Context, aggregator settings.DexAggregator, request quoteRequest) (SwapTransaction, error)
+}
+
+func NewFailoverSwapTransaction(service *SwapService, tx SwapTransaction, request quoteRequest, fallbacks []settings.DexAggregator) *FailoverSwapTransaction {
+	return &FailoverSwapTransaction{
+		SwapTransaction: tx,
+		service:         service,
+		request:         request,
+		fallbacks:       fallbacks,
+		minOut:          minFallbackOutAmount(tx.OutAmount(), request.slippageBps),
+		quoteFrom:       service.quoteFrom,
+	}
+}
+
+// minFallbackOutAmount 切换聚合器时允许的最少输出数量, 即原报价扣除滑点后的数量
+func minFallbackOutAmount(outAmount *big.Int, slippageBps int) *big.Int {
+	if outAmount == nil {
+		return big.NewInt(0)
+	}
+
+	minOut := new(big.Int).Mul(outAmount, big.NewInt(int64(10000-min(max(slippageBps, 0), 10000))))
+	return minOut.Div(minOut, big.NewInt(10000))
+}
+
+func (tx *FailoverSwapTransaction) Swap(ctx context.Context) (string, error) {
+	hash, err := tx.SwapTransaction.Swap(ctx)
+	current := settings.DexAggregator(tx.Aggregator())
+	for err != nil && hash == "" && len(tx.fallbacks) > 0 {
+		// 模拟失败和报价过低不计入聚合器健康状态
+		if !solanautil.IsSimulationError(err) && !errors.Is(err, ErrFallbackQuoteTooLow) {
+			tx.service.reportFailure(current)
+		}
+
+		next := tx.fallbacks[0]
+		tx.fallbacks = tx.fallbacks[1:]
+		logger.Warnf("[SwapService] 发送交易失败, 切换聚合器, from: %s, to: %s, inputToken: %s, outputToken: %s, %v",
+			current, next, tx.request.inputToken, tx.request.outputToken, err)
+
+		// 重新报价
+		current = next
+		quote, quoteErr := tx.quoteFrom(ctx, next, tx.request)
+		if quoteErr != nil {
+			err = quoteErr
+			logger.Warnf("[SwapService] 聚合器报价失败, aggregator: %s, inputToken: %s, outputToken: %s, %v",
+				next, tx.request.inputToken, tx.request.outputToken, quoteErr)
+			continue
+		}
+
+		// 新报价低于原报价扣除滑点后的数量时不发送
+		if quote.OutAmount() == nil || quote.OutAmount().Cmp(tx.minOut) < 0 {
+			err = ErrFallbackQuoteTooLow
+			logger.Warnf("[SwapService] 聚合器报价过低, 放弃切换, aggregator: %s, inputToken: %s, outputToken: %s, outAmount: %s, minOutAmount: %s",
+				next, tx.request.inputToken, tx.request.outputToken, quote.OutAmount(), tx.minOut)
+			continue
+		}
+
+		tx.SwapTransaction = quote
+		hash, err = quote.Swap(ctx)
+	}
+
+	if err != nil {
+		var simErr *solanautil.SimulationError
+		if errors.As(err, &simErr) {
+			tx.notifySimulationError(current, simErr)
+		} else if !errors.Is(err, ErrFallbackQuoteTooLow) {
+			tx.service.reportFailure(current)
+		}
+		return hash, err
+	}
+
+	health.reportSuccess(current)
+	return hash, nil
+}
+
+func (tx *FailoverSwapTransaction) notifySimulationError(aggregator settings.DexAggregator, simErr *solanautil.SimulationError) {
+	logs := simErr.Logs
+	if len(logs) > simulationLogLines {
+		logs = logs[len(logs)-simulationLogLines:]
+	}
+
+	text := fmt.Sprintf("⚠️ 交易模拟失败, 已取消发送\n\n聚合器: %s\n输入代币: `%s`\n输出代币: `%s`\n原因: `%s`",
+		aggregator, tx.request.inputToken, tx.request.outputToken, strings.ReplaceAll(simErr.Err, "`", "'"))
+	if len(logs) > 0 {
+		text += "\n\n```\n" + strings.ReplaceAll(strings.Join(logs, "\n"), "`", "'") + "\n```"
+	}
+
+	_, err := utils.SendMessage(tx.service.svcCtx.BotApi, tx.service.userId, text)
+	if err != nil {
+		logger.Warnf("[SwapService] 发送电报通知失败, userId: %d, text: %s, %v", tx.service.userId, text, err)
+	}
+}
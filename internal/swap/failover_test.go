@@ -0,0 +1,234 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/config"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+)
+
+// fakeSwapTransaction 测试用报价, 记录是否发送
+type fakeSwapTransaction struct {
+	aggregator string
+	outAmount  *big.Int
+	hash       string
+	err        error
+	swapped    bool
+}
+
+func (tx *fakeSwapTransaction) Aggregator() string  { return tx.aggregator }
+func (tx *fakeSwapTransaction) Signer() string      { return "" }
+func (tx *fakeSwapTransaction) OutAmount() *big.Int { return tx.outAmount }
+func (tx *fakeSwapTransaction) SlippageBps() int    { return 0 }
+
+func (tx *fakeSwapTransaction) Swap(ctx context.Context) (string, error) {
+	tx.swapped = true
+	return tx.hash, tx.err
+}
+
+func newTestSwapService(priority ...string) *SwapService {
+	c := &config.Config{}
+	c.Solana.AggregatorPriority = priority
+	c.Solana.FailoverThreshold = 1000
+	c.Solana.FailoverCooldown = 60
+	return &SwapService{svcCtx: &svc.ServiceContext{Config: c}, settings: &ent.Settings{}}
+}
+
+func TestParseAggregatorPriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []settings.DexAggregator
+		wantErr  bool
+	}{
+		{name: "正常顺序", input: "jup,okx,relay", expected: []settings.DexAggregator{"jup", "okx", "relay"}},
+		{name: "去除空格和重复", input: " okx , jup,okx,", expected: []settings.DexAggregator{"okx", "jup"}},
+		{name: "不允许auto", input: "jup,auto", wantErr: true},
+		{name: "未知聚合器", input: "jup,raydium", wantErr: true},
+		{name: "空字符串", input: " , ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAggregatorPriority(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAggregatorPriority(%q) 应该返回错误, 结果: %v", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAggregatorPriority(%q) 返回错误: %v", tt.input, err)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("ParseAggregatorPriority(%q) = %v, 期望 %v", tt.input, got, tt.expected)
+			}
+			for idx := range got {
+				if got[idx] != tt.expected[idx] {
+					t.Fatalf("ParseAggregatorPriority(%q) = %v, 期望 %v", tt.input, got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestFailoverOrder(t *testing.T) {
+	s := newTestSwapService("jup", "okx", "relay")
+
+	order := s.failoverOrder(settings.DexAggregatorOkx, s.settings)
+	expected := []settings.DexAggregator{"okx", "jup", "relay"}
+	for idx := range expected {
+		if order[idx] != expected[idx] {
+			t.Fatalf("首选聚合器应该排在最前, 结果: %v", order)
+		}
+	}
+
+	// 用户设置优先于全局配置
+	s.settings.AggregatorPriority = "relay,jup"
+	order = s.failoverOrder("", s.settings)
+	if len(order) != 2 || order[0] != "relay" || order[1] != "jup" {
+		t.Fatalf("应该使用用户设置的优先级, 结果: %v", order)
+	}
+}
+
+func TestAggregatorHealth(t *testing.T) {
+	h := &aggregatorHealth{
+		failures:      make(map[settings.DexAggregator]int),
+		cooldownUntil: make(map[settings.DexAggregator]time.Time),
+	}
+
+	h.reportFailure("jup", 2, time.Minute)
+	if !h.available("jup") {
+		t.Fatal("失败次数未达到阈值时应该可用")
+	}
+
+	h.reportSuccess("jup")
+	h.reportFailure("jup", 2, time.Minute)
+	if !h.available("jup") {
+		t.Fatal("成功后应该重置失败次数")
+	}
+
+	h.reportFailure("jup", 2, time.Minute)
+	if h.available("jup") {
+		t.Fatal("连续失败达到阈值后应该进入冷却")
+	}
+}
+
+func TestMinFallbackOutAmount(t *testing.T) {
+	tests := []struct {
+		name        string
+		outAmount   *big.Int
+		slippageBps int
+		expected    int64
+	}{
+		{name: "没有滑点", outAmount: big.NewInt(1000000), slippageBps: 0, expected: 1000000},
+		{name: "滑点1%", outAmount: big.NewInt(1000000), slippageBps: 100, expected: 990000},
+		{name: "向下取整", outAmount: big.NewInt(999), slippageBps: 250, expected: 974},
+		{name: "负数滑点按零处理", outAmount: big.NewInt(1000), slippageBps: -10, expected: 1000},
+		{name: "滑点超过100%", outAmount: big.NewInt(1000), slippageBps: 20000, expected: 0},
+		{name: "空报价", outAmount: nil, slippageBps: 100, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := minFallbackOutAmount(tt.outAmount, tt.slippageBps)
+			if got.Int64() != tt.expected {
+				t.Errorf("minFallbackOutAmount(%v, %d) = %s, 期望 %d", tt.outAmount, tt.slippageBps, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFailoverSwapTransaction(t *testing.T) {
+	errSend := errors.New("send failed")
+	request := quoteRequest{inputToken: "in", outputToken: "out", slippageBps: 100}
+
+	tests := []struct {
+		name      string
+		primary   *fakeSwapTransaction
+		fallbacks map[settings.DexAggregator]*fakeSwapTransaction
+		wantHash  string
+		wantErr   error
+		swapped   []settings.DexAggregator
+	}{
+		{
+			name:     "首选聚合器发送成功",
+			primary:  &fakeSwapTransaction{aggregator: "jup", outAmount: big.NewInt(1000), hash: "hash1"},
+			wantHash: "hash1",
+		},
+		{
+			name:      "已生成哈希时不切换",
+			primary:   &fakeSwapTransaction{aggregator: "jup", outAmount: big.NewInt(1000), hash: "hash1", err: errSend},
+			fallbacks: map[settings.DexAggregator]*fakeSwapTransaction{"okx": {aggregator: "okx", outAmount: big.NewInt(1000), hash: "hash2"}},
+			wantHash:  "hash1",
+			wantErr:   errSend,
+		},
+		{
+			name:      "切换后报价在滑点范围内",
+			primary:   &fakeSwapTransaction{aggregator: "jup", outAmount: big.NewInt(1000), err: errSend},
+			fallbacks: map[settings.DexAggregator]*fakeSwapTransaction{"okx": {aggregator: "okx", outAmount: big.NewInt(990), hash: "hash2"}},
+			wantHash:  "hash2",
+			swapped:   []settings.DexAggregator{"okx"},
+		},
+		{
+			name:      "切换后报价过低时不发送",
+			primary:   &fakeSwapTransaction{aggregator: "jup", outAmount: big.NewInt(1000), err: errSend},
+			fallbacks: map[settings.DexAggregator]*fakeSwapTransaction{"okx": {aggregator: "okx", outAmount: big.NewInt(989), hash: "hash2"}},
+			wantErr:   ErrFallbackQuoteTooLow,
+		},
+		{
+			name:    "跳过报价过低的聚合器",
+			primary: &fakeSwapTransaction{aggregator: "jup", outAmount: big.NewInt(1000), err: errSend},
+			fallbacks: map[settings.DexAggregator]*fakeSwapTransaction{
+				"okx":   {aggregator: "okx", outAmount: big.NewInt(500), hash: "hash2"},
+				"relay": {aggregator: "relay", outAmount: big.NewInt(1200), hash: "hash3"},
+			},
+			wantHash: "hash3",
+			swapped:  []settings.DexAggregator{"relay"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSwapService("jup", "okx", "relay")
+			fallbacks := make([]settings.DexAggregator, 0, len(tt.fallbacks))
+			for _, aggregator := range []settings.DexAggregator{"okx", "relay"} {
+				if _, ok := tt.fallbacks[aggregator]; ok {
+					fallbacks = append(fallbacks, aggregator)
+				}
+			}
+
+			tx := NewFailoverSwapTransaction(s, tt.primary, request, fallbacks)
+			tx.quoteFrom = func(ctx context.Context, aggregator settings.DexAggregator, request quoteRequest) (SwapTransaction, error) {
+				quote, ok := tt.fallbacks[aggregator]
+				if !ok {
+					return nil, errors.New("no quote")
+				}
+				return quote, nil
+			}
+
+			hash, err := tx.Swap(context.Background())
+			if hash != tt.wantHash {
+				t.Errorf("hash = %q, 期望 %q", hash, tt.wantHash)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, 期望 %v", err, tt.wantErr)
+			}
+			for aggregator, quote := range tt.fallbacks {
+				want := false
+				for _, item := range tt.swapped {
+					want = want || item == aggregator
+				}
+				if quote.swapped != want {
+					t.Errorf("聚合器 %s 发送状态 = %v, 期望 %v", aggregator, quote.swapped, want)
+				}
+			}
+		})
+	}
+}
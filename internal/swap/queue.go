@@ -0,0 +1,108 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+)
+
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+// walletLane 单个钱包的执行队列, 串行发送交易并记录未确认交易占用的 USDC
+type walletLane struct {
+	mutex    sync.Mutex
+	reserved map[string]*big.Int
+}
+
+// walletQueue 按钱包地址串行执行交易
+type walletQueue struct {
+	mutex sync.Mutex
+	lanes map[string]*walletLane
+}
+
+var queue = &walletQueue{lanes: make(map[string]*walletLane)}
+
+func (q *walletQueue) lane(account string) *walletLane {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	lane, ok := q.lanes[account]
+	if !ok {
+		lane = &walletLane{reserved: make(map[string]*big.Int)}
+		q.lanes[account] = lane
+	}
+	return lane
+}
+
+// execute 在钱包队列中执行交易, 花费 USDC 时先检查扣除未确认交易后的可用余额
+func (s *SwapService) execute(ctx context.Context, request quoteRequest, send func() (string, error)) (string, error) {
+	lane := queue.lane(request.user)
+	lane.mutex.Lock()
+	defer lane.mutex.Unlock()
+
+	spendUsdc := request.inputToken == solanautil.USDC
+	if spendUsdc {
+		if err := s.checkUsdcBalance(ctx, lane, request); err != nil {
+			return "", err
+		}
+	}
+
+	hash, err := send()
+	if hash != "" && spendUsdc {
+		lane.reserved[hash] = new(big.Int).Set(request.amount)
+	}
+	return hash, err
+}
+
+// reservedAmount 释放已确认或已过期交易占用的余额, 返回未确认交易占用的 USDC
+func (lane *walletLane) reservedAmount(state func(hash string) txsender.TxState) *big.Int {
+	reserved := big.NewInt(0)
+	for hash, amount := range lane.reserved {
+		if state(hash) != txsender.TxStatePending {
+			delete(lane.reserved, hash)
+			continue
+		}
+		reserved.Add(reserved, amount)
+	}
+	return reserved
+}
+
+// ReservedUsdc 查询钱包未确认交易占用的 USDC
+func ReservedUsdc(svcCtx *svc.ServiceContext, account string) *big.Int {
+	lane := queue.lane(account)
+	lane.mutex.Lock()
+	defer lane.mutex.Unlock()
+
+	return lane.reservedAmount(txStateFunc(svcCtx))
+}
+
+// txStateFunc 查询交易发送状态, 未配置发送器时所有交易状态未知
+func txStateFunc(svcCtx *svc.ServiceContext) func(hash string) txsender.TxState {
+	if svcCtx.TxSender == nil {
+		return func(string) txsender.TxState { return txsender.TxStateUnknown }
+	}
+	return svcCtx.TxSender.State
+}
+
+func (s *SwapService) checkUsdcBalance(ctx context.Context, lane *walletLane, request quoteRequest) error {
+	reserved := lane.reservedAmount(txStateFunc(s.svcCtx))
+
+	balance, _, err := solanautil.GetTokenBalance(ctx, s.svcCtx.SolanaRpc, solanautil.USDC, request.user)
+	if err != nil {
+		return err
+	}
+
+	available := new(big.Int).Sub(balance, reserved)
+	if available.Cmp(request.amount) < 0 {
+		logger.Warnf("[SwapService] USDC 余额不足, 取消交易, account: %s, balance: %s, reserved: %s, amount: %s",
+			request.user, balance, reserved, request.amount)
+		return ErrInsufficientBalance
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package swap
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
+)
+
+func TestWalletLaneReservedAmount(t *testing.T) {
+	lane := &walletLane{reserved: map[string]*big.Int{
+		"pending1":  big.NewInt(100),
+		"pending2":  big.NewInt(50),
+		"confirmed": big.NewInt(200),
+		"expired":   big.NewInt(400),
+		"unknown":   big.NewInt(800),
+	}}
+	states := map[string]txsender.TxState{
+		"pending1":  txsender.TxStatePending,
+		"pending2":  txsender.TxStatePending,
+		"confirmed": txsender.TxStateConfirmed,
+		"expired":   txsender.TxStateExpired,
+	}
+	state := func(hash string) txsender.TxState { return states[hash] }
+
+	got := lane.reservedAmount(state)
+	if got.Cmp(big.NewInt(150)) != 0 {
+		t.Errorf("reservedAmount() = %s, 期望 150", got)
+	}
+
+	// 已确认、已过期和未被跟踪的交易不再占用余额
+	if len(lane.reserved) != 2 || lane.reserved["pending1"] == nil || lane.reserved["pending2"] == nil {
+		t.Errorf("释放后剩余 %v, 期望只保留未确认交易", lane.reserved)
+	}
+
+	// 未确认交易上链后释放
+	states["pending1"] = txsender.TxStateConfirmed
+	if got = lane.reservedAmount(state); got.Cmp(big.NewInt(50)) != 0 {
+		t.Errorf("reservedAmount() = %s, 期望 50", got)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/gagliardetto/solana-go"
@@ -20,14 +21,21 @@ import (
 type SwapService struct {
 	svcCtx   *svc.ServiceContext
 	userId   int64
+	account  string
 	wallet   *solana.Wallet
 	settings *ent.Settings
 }
 
+// NewSwapService 使用用户默认钱包创建兑换服务
 func NewSwapService(svcCtx *svc.ServiceContext, userId int64) *SwapService {
 	return &SwapService{svcCtx: svcCtx, userId: userId}
 }
 
+// NewSwapServiceWithAccount 使用用户指定钱包创建兑换服务, account 为空时使用默认钱包
+func NewSwapServiceWithAccount(svcCtx *svc.ServiceContext, userId int64, account string) *SwapService {
+	return &SwapService{svcCtx: svcCtx, userId: userId, account: account}
+}
+
 func (s *SwapService) Quote(ctx context.Context, inputToken, outputToken string, amount *big.Int, exit ...bool) (SwapTransaction, error) {
 	userWallet, err := s.getUserWallet(ctx)
 	if err != nil {
@@ -48,47 +56,118 @@ func (s *SwapService) Quote(ctx context.Context, inputToken, outputToken string,
 		}
 	}
 
-	switch userSettings.DexAggregator {
+	request := quoteRequest{
+		user:        userWallet.PublicKey().String(),
+		inputToken:  inputToken,
+		outputToken: outputToken,
+		amount:      amount,
+		slippageBps: slippageBps,
+		exit:        len(exit) > 0 && exit[0],
+	}
+	if userSettings.DexAggregator == settings.DexAggregatorAuto {
+		return s.quoteBest(ctx, request)
+	}
+	return s.quoteWithFailover(ctx, userSettings.DexAggregator, request)
+}
+
+func (s *SwapService) quoteFrom(ctx context.Context, aggregator settings.DexAggregator, request quoteRequest) (SwapTransaction, error) {
+	switch aggregator {
 	case settings.DexAggregatorOkx:
-		okxClient := okxweb3.NewClient(
-			s.svcCtx.Config.OkxWeb3.Apikey,
-			s.svcCtx.Config.OkxWeb3.Secretkey,
-			s.svcCtx.Config.OkxWeb3.Passphrase,
-			s.svcCtx.TransportProxy,
-		)
-		quoteResponse, err := okxClient.Quote(
-			ctx,
-			okxweb3.SolanaChainIndex,
-			userWallet.PublicKey().String(),
-			inputToken,
-			outputToken,
-			amount,
-			slippageBps,
-		)
-		if err != nil {
-			return nil, err
-		}
-		return NewOkxSwapTransaction(s, quoteResponse, userWallet.PublicKey().String()), nil
+		return s.quoteOkx(ctx, request)
 	case settings.DexAggregatorJup:
-		jupConf := s.svcCtx.Config.Jupiter
-		jupClient := jupiter.NewJupiterClient(jupConf.Url, jupConf.Apikey, s.svcCtx.TransportProxy)
-		quoteResponse, err := jupClient.Quote(ctx, inputToken, outputToken, amount, slippageBps)
-		if err != nil {
-			return nil, err
-		}
-		return NewJupSwapTransaction(s, quoteResponse, userWallet.PublicKey().String()), nil
+		return s.quoteJup(ctx, request)
 	case settings.DexAggregatorRelay:
-		user := userWallet.PublicKey().String()
-		relaylinkClient := relaylink.NewRelaylinkClient(s.svcCtx.TransportProxy)
-		quoteResponse, err := relaylinkClient.Quote(ctx, relaylink.SolanaChainID, user, inputToken, outputToken, amount, slippageBps)
-		if err != nil {
-			return nil, err
-		}
-		return NewRelaySwapTransaction(s, quoteResponse, userWallet.PublicKey().String()), nil
+		return s.quoteRelay(ctx, request)
 	default:
 		return nil, errors.New("unsupported aggregator")
 	}
+}
+
+func (s *SwapService) quoteOkx(ctx context.Context, request quoteRequest) (SwapTransaction, error) {
+	okxClient := okxweb3.NewClient(
+		s.svcCtx.Config.OkxWeb3.Apikey,
+		s.svcCtx.Config.OkxWeb3.Secretkey,
+		s.svcCtx.Config.OkxWeb3.Passphrase,
+		s.svcCtx.TransportProxy,
+	)
+	quoteResponse, err := okxClient.Quote(
+		ctx,
+		okxweb3.SolanaChainIndex,
+		request.user,
+		request.inputToken,
+		request.outputToken,
+		request.amount,
+		request.slippageBps,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewOkxSwapTransaction(s, quoteResponse, request), nil
+}
+
+func (s *SwapService) quoteJup(ctx context.Context, request quoteRequest) (SwapTransaction, error) {
+	jupConf := s.svcCtx.Config.Jupiter
+	jupClient := jupiter.NewJupiterClient(jupConf.Url, jupConf.Apikey, s.svcCtx.TransportProxy)
+	quoteResponse, err := jupClient.Quote(ctx, request.inputToken, request.outputToken, request.amount, request.slippageBps)
+	if err != nil {
+		return nil, err
+	}
+	return NewJupSwapTransaction(s, quoteResponse, request), nil
+}
+
+func (s *SwapService) quoteRelay(ctx context.Context, request quoteRequest) (SwapTransaction, error) {
+	relaylinkClient := relaylink.NewRelaylinkClient(s.svcCtx.TransportProxy)
+	quoteResponse, err := relaylinkClient.Quote(ctx, relaylink.SolanaChainID, request.user, request.inputToken, request.outputToken, request.amount, request.slippageBps)
+	if err != nil {
+		return nil, err
+	}
+	return NewRelaySwapTransaction(s, quoteResponse, request), nil
+}
+
+// jitoTip 获取 Jito 小费(lamports), 通过 RPC 发送时返回 0
+func (s *SwapService) jitoTip(userSettings *ent.Settings, exit bool) uint64 {
+	if userSettings.TxSender != settings.TxSenderJito {
+		return 0
+	}
 
+	c := s.svcCtx.Config.Jito
+	tip := c.TipLamports
+	if userSettings.JitoTip != nil {
+		tip = *userSettings.JitoTip
+	}
+
+	if exit {
+		// 如果是清仓交易，使用清仓小费
+		if userSettings.ExitJitoTip != nil {
+			return uint64(*userSettings.ExitJitoTip)
+		}
+		return uint64(max(tip, c.ExitTipLamports))
+	}
+	return uint64(tip)
+}
+
+// simulation 获取交易模拟参数, 未开启模拟或清仓交易跳过模拟时返回 nil
+func (s *SwapService) simulation(userSettings *ent.Settings, request quoteRequest, outAmount *big.Int) *txsender.Simulation {
+	if !userSettings.SimulateTx {
+		return nil
+	}
+	if request.exit && userSettings.SkipExitSimulation {
+		return nil
+	}
+
+	outputMint, err := solana.PublicKeyFromBase58(request.outputToken)
+	if err != nil {
+		logger.Warnf("[SwapService] 解析输出代币地址失败, outputToken: %s, %v", request.outputToken, err)
+		return nil
+	}
+
+	// 按滑点计算最少输出数量
+	minOutAmount := new(big.Int).Mul(outAmount, big.NewInt(int64(10000-request.slippageBps)))
+	minOutAmount.Div(minOutAmount, big.NewInt(10000))
+	return &txsender.Simulation{
+		OutputMint:   outputMint,
+		MinOutAmount: minOutAmount.Uint64(),
+	}
 }
 
 func (s *SwapService) getUserWallet(ctx context.Context) (*solana.Wallet, error) {
@@ -96,13 +175,19 @@ func (s *SwapService) getUserWallet(ctx context.Context) (*solana.Wallet, error)
 		return s.wallet, nil
 	}
 
-	w, err := s.svcCtx.WalletModel.FindByUserId(ctx, s.userId)
+	var err error
+	var w *ent.Wallet
+	if s.account != "" {
+		w, err = s.svcCtx.WalletModel.FindByUserIdAccount(ctx, s.userId, s.account)
+	} else {
+		w, err = s.svcCtx.WalletModel.FindDefaultByUserId(ctx, s.userId)
+	}
 	if err != nil {
-		logger.Errorf("[SwapService] 查询用户钱包失败, userId: %d, %v", s.userId, err)
+		logger.Errorf("[SwapService] 查询用户钱包失败, userId: %d, account: %s, %v", s.userId, s.account, err)
 		return nil, err
 	}
 
-	pk, err := s.svcCtx.HashEncoder.Decryption(w.PrivateKey)
+	pk, err := s.svcCtx.KeyCipher.Decryption(w.PrivateKey)
 	if err != nil {
 		logger.Errorf("[SwapService] 解密用户私钥失败, userId: %d, %v", s.userId, err)
 		return nil, err
@@ -143,6 +228,9 @@ func (s *SwapService) getUserSettings(ctx context.Context) (*ent.Settings, error
 		MaxLamports:   int64(c.MaxLamports),
 		PriorityLevel: settings.PriorityLevel(c.PriorityLevel),
 		DexAggregator: settings.DexAggregator(c.DexAggregator),
+		TxSender:      settings.TxSenderRPC,
+
+		SkipExitSimulation: true,
 	}
 
 	s.settings = &ret
@@ -7,11 +7,14 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/dexagg/jupiter"
 	"github.com/fachebot/sol-grid-bot/internal/dexagg/okxweb3"
 	"github.com/fachebot/sol-grid-bot/internal/dexagg/relaylink"
+	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
 type SwapTransaction interface {
+	Aggregator() string
 	Signer() string
 	OutAmount() *big.Int
 	SlippageBps() int
@@ -21,19 +24,23 @@ type SwapTransaction interface {
 type OkxSwapTransaction struct {
 	quote   *okxweb3.SwapInstruction
 	service *SwapService
-	signer  string
+	request quoteRequest
 }
 
-func NewOkxSwapTransaction(service *SwapService, quoteResponse *okxweb3.SwapInstruction, signer string) *OkxSwapTransaction {
+func NewOkxSwapTransaction(service *SwapService, quoteResponse *okxweb3.SwapInstruction, request quoteRequest) *OkxSwapTransaction {
 	return &OkxSwapTransaction{
 		quote:   quoteResponse,
 		service: service,
-		signer:  signer,
+		request: request,
 	}
 }
 
+func (tx *OkxSwapTransaction) Aggregator() string {
+	return string(settings.DexAggregatorOkx)
+}
+
 func (tx *OkxSwapTransaction) Signer() string {
-	return tx.signer
+	return tx.request.user
 }
 
 func (tx *OkxSwapTransaction) OutAmount() *big.Int {
@@ -45,6 +52,12 @@ func (tx *OkxSwapTransaction) SlippageBps() int {
 }
 
 func (tx *OkxSwapTransaction) Swap(ctx context.Context) (string, error) {
+	return tx.service.execute(ctx, tx.request, func() (string, error) {
+		return tx.send(ctx)
+	})
+}
+
+func (tx *OkxSwapTransaction) send(ctx context.Context) (string, error) {
 	userWallet, err := tx.service.getUserWallet(ctx)
 	if err != nil {
 		return "", err
@@ -62,25 +75,31 @@ func (tx *OkxSwapTransaction) Swap(ctx context.Context) (string, error) {
 		svcCtx.Config.OkxWeb3.Passphrase,
 		svcCtx.TransportProxy,
 	)
-	return okxClient.SendSwapTransaction(ctx, svcCtx, userWallet, tx.quote, userSettings.PriorityLevel, uint(userSettings.MaxRetries))
+	jitoTip := tx.service.jitoTip(userSettings, tx.request.exit)
+	simulation := tx.service.simulation(userSettings, tx.request, tx.OutAmount())
+	return okxClient.SendSwapTransaction(ctx, svcCtx, userWallet, tx.quote, userSettings.PriorityLevel, userSettings.MaxLamports, uint(userSettings.MaxRetries), jitoTip, simulation)
 }
 
 type JupSwapTransaction struct {
 	quote   *jupiter.QuoteResponse
 	service *SwapService
-	signer  string
+	request quoteRequest
 }
 
-func NewJupSwapTransaction(service *SwapService, quoteResponse *jupiter.QuoteResponse, signer string) *JupSwapTransaction {
+func NewJupSwapTransaction(service *SwapService, quoteResponse *jupiter.QuoteResponse, request quoteRequest) *JupSwapTransaction {
 	return &JupSwapTransaction{
 		quote:   quoteResponse,
 		service: service,
-		signer:  signer,
+		request: request,
 	}
 }
 
+func (tx *JupSwapTransaction) Aggregator() string {
+	return string(settings.DexAggregatorJup)
+}
+
 func (tx *JupSwapTransaction) Signer() string {
-	return tx.signer
+	return tx.request.user
 }
 
 func (tx *JupSwapTransaction) OutAmount() *big.Int {
@@ -92,6 +111,12 @@ func (tx *JupSwapTransaction) SlippageBps() int {
 }
 
 func (tx *JupSwapTransaction) Swap(ctx context.Context) (string, error) {
+	return tx.service.execute(ctx, tx.request, func() (string, error) {
+		return tx.send(ctx)
+	})
+}
+
+func (tx *JupSwapTransaction) send(ctx context.Context) (string, error) {
 	userWallet, err := tx.service.getUserWallet(ctx)
 	if err != nil {
 		return "", err
@@ -104,30 +129,37 @@ func (tx *JupSwapTransaction) Swap(ctx context.Context) (string, error) {
 
 	jupConf := tx.service.svcCtx.Config.Jupiter
 	jupClient := jupiter.NewJupiterClient(jupConf.Url, jupConf.Apikey, tx.service.svcCtx.TransportProxy)
-	swapResponse, err := jupClient.Swap(ctx, userWallet.PublicKey().String(), tx.quote, jupiter.PriorityLevel(userSettings.PriorityLevel), userSettings.MaxLamports)
+	priorityLevel := jupiter.PriorityLevel(userSettings.PriorityLevel)
+	swapResponse, err := jupClient.Swap(ctx, userWallet.PublicKey().String(), tx.quote, priorityLevel, userSettings.MaxLamports)
 	if err != nil {
 		return "", err
 	}
 
-	return jupClient.SendSwapTransaction(ctx, tx.service.svcCtx, userWallet, swapResponse, uint(userSettings.MaxRetries))
+	jitoTip := tx.service.jitoTip(userSettings, tx.request.exit)
+	simulation := tx.service.simulation(userSettings, tx.request, tx.OutAmount())
+	return jupClient.SendSwapTransaction(ctx, tx.service.svcCtx, userWallet, swapResponse, priorityLevel, userSettings.MaxLamports, uint(userSettings.MaxRetries), jitoTip, simulation)
 }
 
 type RelaySwapTransaction struct {
 	quote   *relaylink.QuoteResponse
 	service *SwapService
-	signer  string
+	request quoteRequest
 }
 
-func NewRelaySwapTransaction(service *SwapService, quoteResponse *relaylink.QuoteResponse, signer string) *RelaySwapTransaction {
+func NewRelaySwapTransaction(service *SwapService, quoteResponse *relaylink.QuoteResponse, request quoteRequest) *RelaySwapTransaction {
 	return &RelaySwapTransaction{
 		quote:   quoteResponse,
 		service: service,
-		signer:  signer,
+		request: request,
 	}
 }
 
+func (tx *RelaySwapTransaction) Aggregator() string {
+	return string(settings.DexAggregatorRelay)
+}
+
 func (tx *RelaySwapTransaction) Signer() string {
-	return tx.signer
+	return tx.request.user
 }
 
 func (tx *RelaySwapTransaction) OutAmount() *big.Int {
@@ -139,6 +171,12 @@ func (tx *RelaySwapTransaction) SlippageBps() int {
 }
 
 func (tx *RelaySwapTransaction) Swap(ctx context.Context) (string, error) {
+	return tx.service.execute(ctx, tx.request, func() (string, error) {
+		return tx.send(ctx)
+	})
+}
+
+func (tx *RelaySwapTransaction) send(ctx context.Context) (string, error) {
 	userWallet, err := tx.service.getUserWallet(ctx)
 	if err != nil {
 		return "", err
@@ -150,5 +188,41 @@ func (tx *RelaySwapTransaction) Swap(ctx context.Context) (string, error) {
 	}
 
 	relayClient := relaylink.NewRelaylinkClient(tx.service.svcCtx.TransportProxy)
-	return relayClient.SendSwapTransaction(ctx, tx.service.svcCtx, userWallet, tx.quote, userSettings.PriorityLevel, uint(userSettings.MaxRetries))
+	jitoTip := tx.service.jitoTip(userSettings, tx.request.exit)
+	simulation := tx.service.simulation(userSettings, tx.request, tx.OutAmount())
+	return relayClient.SendSwapTransaction(ctx, tx.service.svcCtx, userWallet, tx.quote, userSettings.PriorityLevel, userSettings.MaxLamports, uint(userSettings.MaxRetries), jitoTip, simulation)
+}
+
+// PaperSwapTransaction 模拟交易, 按报价扣除滑点后成交, 不签名也不广播
+type PaperSwapTransaction struct {
+	quote       SwapTransaction
+	slippageBps int
+}
+
+func NewPaperSwapTransaction(quote SwapTransaction, slippageBps int) *PaperSwapTransaction {
+	return &PaperSwapTransaction{
+		quote:       quote,
+		slippageBps: slippageBps,
+	}
+}
+
+func (tx *PaperSwapTransaction) Aggregator() string {
+	return tx.quote.Aggregator()
+}
+
+func (tx *PaperSwapTransaction) Signer() string {
+	return tx.quote.Signer()
+}
+
+func (tx *PaperSwapTransaction) OutAmount() *big.Int {
+	outAmount := new(big.Int).Mul(tx.quote.OutAmount(), big.NewInt(int64(10000-tx.slippageBps)))
+	return outAmount.Div(outAmount, big.NewInt(10000))
+}
+
+func (tx *PaperSwapTransaction) SlippageBps() int {
+	return tx.slippageBps
+}
+
+func (tx *PaperSwapTransaction) Swap(ctx context.Context) (string, error) {
+	return "paper-" + uuid.NewString(), nil
 }
@@ -0,0 +1,43 @@
+package swap
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestPaperSwapTransaction(t *testing.T) {
+	tests := []struct {
+		name        string
+		outAmount   int64
+		slippageBps int
+		expected    int64
+	}{
+		{name: "扣除滑点", outAmount: 1_000_000, slippageBps: 50, expected: 995_000},
+		{name: "没有滑点", outAmount: 1_000_000, expected: 1_000_000},
+		{name: "向下取整", outAmount: 999, slippageBps: 100, expected: 989},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := &fakeSwapTransaction{aggregator: "jup", outAmount: big.NewInt(tt.outAmount)}
+			tx := NewPaperSwapTransaction(quote, tt.slippageBps)
+			if got := tx.OutAmount(); got.Cmp(big.NewInt(tt.expected)) != 0 {
+				t.Errorf("OutAmount() = %s, 期望 %d", got, tt.expected)
+			}
+			if tx.Aggregator() != "jup" {
+				t.Errorf("Aggregator() = %s, 期望 jup", tx.Aggregator())
+			}
+
+			// 模拟交易不发送报价中的交易
+			hash, err := tx.Swap(context.Background())
+			if err != nil || !strings.HasPrefix(hash, "paper-") {
+				t.Errorf("Swap() = %s, %v", hash, err)
+			}
+			if quote.swapped {
+				t.Error("模拟交易不应该发送报价交易")
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/fachebot/sol-grid-bot/internal/txsender"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	transferComputeUnitLimit = 100000 // 转账交易计算单元上限
+	tokenAccountSize         = 165    // Token 程序代币账户大小
+	token2022AccountSize     = 170    // Token-2022 程序代币账户大小(包含 ImmutableOwner 扩展)
+	lamportsPerSignature     = 5000   // 每个签名的基础费用
+)
+
+// TransferFee 转账交易费用, 单位均为 lamports
+type TransferFee struct {
+	BaseFee     uint64
+	PriorityFee uint64
+	RentFee     uint64 // 为目标地址创建代币账户的租金
+}
+
+func (fee TransferFee) Total() uint64 {
+	return fee.BaseFee + fee.PriorityFee + fee.RentFee
+}
+
+type transferTransaction struct {
+	tx              *solana.Transaction
+	fee             TransferFee
+	latestBlockhash *rpc.GetLatestBlockhashResult
+}
+
+// EstimateTransfer 估算转账交易费用, token 为 WSOL 时转账原生 SOL
+func (s *SwapService) EstimateTransfer(ctx context.Context, token, destination string, amount *big.Int) (TransferFee, error) {
+	userWallet, err := s.getUserWallet(ctx)
+	if err != nil {
+		return TransferFee{}, err
+	}
+
+	transfer, err := s.buildTransfer(ctx, userWallet, token, destination, amount)
+	if err != nil {
+		return TransferFee{}, err
+	}
+	return transfer.fee, nil
+}
+
+// Transfer 在钱包队列中签名并发送转账交易, token 为 WSOL 时转账原生 SOL
+func (s *SwapService) Transfer(ctx context.Context, token, destination string, amount *big.Int) (string, TransferFee, error) {
+	userWallet, err := s.getUserWallet(ctx)
+	if err != nil {
+		return "", TransferFee{}, err
+	}
+
+	userSettings, err := s.getUserSettings(ctx)
+	if err != nil {
+		return "", TransferFee{}, err
+	}
+
+	var fee TransferFee
+	request := quoteRequest{
+		user:       userWallet.PublicKey().String(),
+		inputToken: token,
+		amount:     amount,
+	}
+	hash, err := s.execute(ctx, request, func() (string, error) {
+		transfer, err := s.buildTransfer(ctx, userWallet, token, destination, amount)
+		if err != nil {
+			return "", err
+		}
+		fee = transfer.fee
+
+		// 签名交易
+		signedTx, _, err := solanautil.SignTransaction(userWallet, transfer.tx)
+		if err != nil {
+			return "", fmt.Errorf("could not sign transfer transaction: %w", err)
+		}
+
+		// 发送交易
+		hash, err := s.svcCtx.TxSender.Send(ctx, signedTx, txsender.SendOptions{
+			MinContextSlot:       transfer.latestBlockhash.Context.Slot,
+			LastValidBlockHeight: transfer.latestBlockhash.Value.LastValidBlockHeight,
+			MaxRetries:           uint(userSettings.MaxRetries),
+			Wallet:               userWallet,
+		})
+		if err != nil {
+			return hash, fmt.Errorf("could not send transaction: %w", err)
+		}
+		return hash, nil
+	})
+	return hash, fee, err
+}
+
+func (s *SwapService) buildTransfer(ctx context.Context, userWallet *solana.Wallet, tokenAddress, destination string, amount *big.Int) (*transferTransaction, error) {
+	if amount.Sign() <= 0 || !amount.IsUint64() {
+		return nil, errors.New("invalid transfer amount")
+	}
+
+	userSettings, err := s.getUserSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := solana.PublicKeyFromBase58(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	// 创建交易指令, 优先费在创建交易后根据可写账户估算
+	owner := userWallet.PublicKey()
+	instructions := []solana.Instruction{
+		computebudget.NewSetComputeUnitLimitInstruction(transferComputeUnitLimit).Build(),
+		computebudget.NewSetComputeUnitPriceInstruction(0).Build(),
+	}
+
+	var rentFee uint64
+	if tokenAddress == solanautil.WSOL {
+		instructions = append(instructions, system.NewTransferInstruction(amount.Uint64(), owner, to).Build())
+	} else {
+		tokenInstructions, fee, err := s.tokenTransferInstructions(ctx, owner, tokenAddress, to, amount.Uint64())
+		if err != nil {
+			return nil, err
+		}
+		rentFee = fee
+		instructions = append(instructions, tokenInstructions...)
+	}
+
+	latestBlockhash, err := s.svcCtx.SolanaRpc.GetLatestBlockhash(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest blockhash: %w", err)
+	}
+
+	// 创建交易
+	tx, err := solana.NewTransaction(
+		instructions,
+		latestBlockhash.Value.Blockhash,
+		solana.TransactionPayer(owner),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create transfer transaction: %w", err)
+	}
+
+	// 设置优先费
+	price, err := solanautil.SetPriorityFee(ctx, s.svcCtx.SolanaRpc, tx, nil, string(userSettings.PriorityLevel), uint64(userSettings.MaxLamports))
+	if err != nil {
+		return nil, fmt.Errorf("could not estimate priority fee: %w", err)
+	}
+
+	// 优先费按计算单元上限收取, 不足 1 lamport 的部分向上取整
+	fee := TransferFee{
+		BaseFee:     lamportsPerSignature * uint64(tx.Message.Header.NumRequiredSignatures),
+		PriorityFee: (price*transferComputeUnitLimit + 999999) / 1000000,
+		RentFee:     rentFee,
+	}
+	return &transferTransaction{tx: tx, fee: fee, latestBlockhash: latestBlockhash}, nil
+}
+
+// tokenTransferInstructions 创建代币转账指令, 目标地址没有代币账户时先创建关联代币账户
+func (s *SwapService) tokenTransferInstructions(ctx context.Context, owner solana.PublicKey, tokenAddress string, to solana.PublicKey, amount uint64) ([]solana.Instruction, uint64, error) {
+	mint, err := solana.PublicKeyFromBase58(tokenAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mintAta, err := solanautil.GetAccountInfoJSONParsed(ctx, s.svcCtx.SolanaRpc, mint)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tokenProgramID := mintAta.Value.Owner
+	if tokenProgramID != solana.TokenProgramID && tokenProgramID != solana.Token2022ProgramID {
+		return nil, 0, fmt.Errorf("unsupported token program: %s", tokenProgramID)
+	}
+
+	v := gjson.GetBytes(mintAta.Value.Data.GetRawJSON(), "parsed.info.decimals")
+	if !v.Exists() {
+		return nil, 0, errors.New("token decimals not found")
+	}
+	decimals := uint8(v.Uint())
+
+	source, _, err := solanautil.FindAssociatedTokenAddress(owner, mint, tokenProgramID)
+	if err != nil {
+		return nil, 0, err
+	}
+	target, _, err := solanautil.FindAssociatedTokenAddress(to, mint, tokenProgramID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rentFee uint64
+	var instructions []solana.Instruction
+	_, err = s.svcCtx.SolanaRpc.GetAccountInfo(ctx, target)
+	if err != nil {
+		if !errors.Is(err, rpc.ErrNotFound) {
+			return nil, 0, err
+		}
+
+		size := uint64(tokenAccountSize)
+		if tokenProgramID == solana.Token2022ProgramID {
+			size = token2022AccountSize
+		}
+		rentFee, err = s.svcCtx.SolanaRpc.GetMinimumBalanceForRentExemption(ctx, size, rpc.CommitmentConfirmed)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// CreateIdempotent 指令
+		instructions = append(instructions, solana.NewInstruction(
+			solana.SPLAssociatedTokenAccountProgramID,
+			solana.AccountMetaSlice{
+				solana.Meta(owner).WRITE().SIGNER(),
+				solana.Meta(target).WRITE(),
+				solana.Meta(to),
+				solana.Meta(mint),
+				solana.Meta(solana.SystemProgramID),
+				solana.Meta(tokenProgramID),
+			},
+			[]byte{1},
+		))
+	}
+
+	// Token-2022 与 Token 程序的 TransferChecked 指令格式相同
+	transferChecked := token.NewTransferCheckedInstruction(amount, decimals, source, mint, target, owner, nil).Build()
+	data, err := transferChecked.Data()
+	if err != nil {
+		return nil, 0, err
+	}
+	instructions = append(instructions, solana.NewInstruction(tokenProgramID, transferChecked.Accounts(), data))
+
+	return instructions, rentFee, nil
+}
@@ -0,0 +1,161 @@
+package swap
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// fakeTransferRpc 测试用 RPC 服务, 返回构建转账交易需要的数据
+type fakeTransferRpc struct {
+	tokenProgram  solana.PublicKey
+	targetExists  bool
+	priorityFee   uint64
+	rentExemption map[uint64]uint64
+}
+
+func (f *fakeTransferRpc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Id     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params []any           `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rpcContext := map[string]any{"slot": 1}
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.Id}
+	switch req.Method {
+	case "getLatestBlockhash":
+		resp["result"] = map[string]any{"context": rpcContext, "value": map[string]any{
+			"blockhash": solana.Hash{1}.String(), "lastValidBlockHeight": 100,
+		}}
+	case "getRecentPrioritizationFees":
+		resp["result"] = []any{map[string]any{"slot": 1, "prioritizationFee": f.priorityFee}}
+	case "getMinimumBalanceForRentExemption":
+		resp["result"] = f.rentExemption[uint64(req.Params[0].(float64))]
+	case "getAccountInfo":
+		var value any
+		if req.Params[0] == solanautil.USDC {
+			value = map[string]any{
+				"data":       map[string]any{"parsed": map[string]any{"info": map[string]any{"decimals": 6}, "type": "mint"}, "program": "spl-token", "space": 82},
+				"executable": false,
+				"lamports":   1461600,
+				"owner":      f.tokenProgram.String(),
+				"rentEpoch":  0,
+			}
+		} else if f.targetExists {
+			value = map[string]any{
+				"data":       []string{"", "base64"},
+				"executable": false,
+				"lamports":   2039280,
+				"owner":      f.tokenProgram.String(),
+				"rentEpoch":  0,
+			}
+		}
+		resp["result"] = map[string]any{"context": rpcContext, "value": value}
+	default:
+		resp["error"] = map[string]any{"code": -32601, "message": "method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func TestBuildTransfer(t *testing.T) {
+	rentExemption := map[uint64]uint64{tokenAccountSize: 2039280, token2022AccountSize: 2074080}
+
+	tests := []struct {
+		name     string
+		token    string
+		amount   int64
+		rpc      *fakeTransferRpc
+		expected TransferFee
+		wantErr  bool
+	}{
+		{
+			name:     "转账 SOL",
+			token:    solanautil.WSOL,
+			amount:   1000000,
+			rpc:      &fakeTransferRpc{priorityFee: 1000},
+			expected: TransferFee{BaseFee: 5000, PriorityFee: 100},
+		},
+		{
+			name:     "优先费向上取整",
+			token:    solanautil.WSOL,
+			amount:   1000000,
+			rpc:      &fakeTransferRpc{priorityFee: 15},
+			expected: TransferFee{BaseFee: 5000, PriorityFee: 2},
+		},
+		{
+			name:     "目标地址已有代币账户",
+			token:    solanautil.USDC,
+			amount:   1000000,
+			rpc:      &fakeTransferRpc{tokenProgram: solana.TokenProgramID, targetExists: true, rentExemption: rentExemption},
+			expected: TransferFee{BaseFee: 5000},
+		},
+		{
+			name:     "为目标地址创建代币账户",
+			token:    solanautil.USDC,
+			amount:   1000000,
+			rpc:      &fakeTransferRpc{tokenProgram: solana.TokenProgramID, rentExemption: rentExemption},
+			expected: TransferFee{BaseFee: 5000, RentFee: 2039280},
+		},
+		{
+			name:     "为目标地址创建 Token-2022 代币账户",
+			token:    solanautil.USDC,
+			amount:   1000000,
+			rpc:      &fakeTransferRpc{tokenProgram: solana.Token2022ProgramID, rentExemption: rentExemption},
+			expected: TransferFee{BaseFee: 5000, RentFee: 2074080},
+		},
+		{
+			name:    "转账数量为零",
+			token:   solanautil.WSOL,
+			rpc:     &fakeTransferRpc{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.rpc)
+			defer server.Close()
+
+			s := &SwapService{
+				svcCtx:   &svc.ServiceContext{SolanaRpc: rpc.New(server.URL)},
+				settings: &ent.Settings{PriorityLevel: settings.PriorityLevelMedium},
+			}
+			destination := solana.NewWallet().PublicKey().String()
+
+			transfer, err := s.buildTransfer(context.Background(), solana.NewWallet(), tt.token, destination, big.NewInt(tt.amount))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("buildTransfer() 应该返回错误, 结果: %+v", transfer.fee)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildTransfer() 返回错误: %v", err)
+			}
+			if transfer.fee != tt.expected {
+				t.Errorf("buildTransfer() 费用 = %+v, 期望 %+v", transfer.fee, tt.expected)
+			}
+			if transfer.fee.Total() != tt.expected.BaseFee+tt.expected.PriorityFee+tt.expected.RentFee {
+				t.Errorf("Total() = %d, 期望 %d", transfer.fee.Total(), tt.expected.BaseFee+tt.expected.PriorityFee+tt.expected.RentFee)
+			}
+		})
+	}
+}
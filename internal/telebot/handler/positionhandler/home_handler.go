@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/fachebot/sol-grid-bot/internal/dexagg/okxweb3"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
 	"github.com/fachebot/sol-grid-bot/internal/telebot/handler/wallethandler"
@@ -34,13 +35,17 @@ func NewPositionHomeHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI)
 	return &PositionHomeHandler{botApi: botApi, svcCtx: svcCtx}
 }
 
-func (h PositionHomeHandler) FormatPath(page int) string {
-	return fmt.Sprintf("/position/%d", page)
+func (h PositionHomeHandler) FormatPath(account string, page int) string {
+	if account == "" {
+		return fmt.Sprintf("/position/%d", page)
+	}
+	return fmt.Sprintf("/position/%s/%d", account, page)
 }
 
 func (h *PositionHomeHandler) AddRouter(router *pathrouter.Router) {
 	router.HandleFunc("/position", h.handle)
 	router.HandleFunc("/position/{page:[0-9]+}", h.handle)
+	router.HandleFunc("/position/{account}/{page:[0-9]+}", h.handle)
 }
 
 func (h *PositionHomeHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
@@ -61,10 +66,24 @@ func (h *PositionHomeHandler) handle(ctx context.Context, vars map[string]string
 		return nil
 	}
 
+	// 获取用户钱包
+	wallets, err := h.svcCtx.WalletModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
 	w, err := wallethandler.GetUserWallet(ctx, h.svcCtx, userId)
 	if err != nil {
 		return err
 	}
+	if account, ok := vars["account"]; ok {
+		item, ok := lo.Find(wallets, func(item *ent.Wallet) bool {
+			return item.Account == account
+		})
+		if !ok {
+			return nil
+		}
+		w = item
+	}
 
 	// 获取代币余额
 	okxClient := okxweb3.NewClient(
@@ -117,9 +136,9 @@ func (h *PositionHomeHandler) handle(ctx context.Context, vars map[string]string
 			nextPage = 0
 		}
 		pageButtons = []tgbotapi.InlineKeyboardButton{
-			tgbotapi.NewInlineKeyboardButtonData("⬅️ 上一页", h.FormatPath(previousPage)),
-			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("%d/%d", page, totalPage), h.FormatPath(0)),
-			tgbotapi.NewInlineKeyboardButtonData("➡️ 下一页", h.FormatPath(nextPage)),
+			tgbotapi.NewInlineKeyboardButtonData("⬅️ 上一页", h.FormatPath(w.Account, previousPage)),
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("%d/%d", page, totalPage), h.FormatPath(w.Account, 0)),
+			tgbotapi.NewInlineKeyboardButtonData("➡️ 下一页", h.FormatPath(w.Account, nextPage)),
 		}
 	}
 
@@ -128,16 +147,35 @@ func (h *PositionHomeHandler) handle(ctx context.Context, vars map[string]string
 		rows = append(rows, pageButtons)
 	}
 	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData("🔄 刷新界面", h.FormatPath(1)),
+		tgbotapi.NewInlineKeyboardButtonData("🔄 刷新界面", h.FormatPath(w.Account, 1)),
 	))
 	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData("♻️ 代币清仓", SellAllHandler{}.FormatPath()),
+		tgbotapi.NewInlineKeyboardButtonData("♻️ 代币清仓", SellAllHandler{}.FormatPath(w.Account)),
 	))
+
+	// 切换钱包
+	if len(wallets) > 1 {
+		var switchRow []tgbotapi.InlineKeyboardButton
+		for _, item := range wallets {
+			if item.Account == w.Account {
+				continue
+			}
+
+			switchRow = append(switchRow, tgbotapi.NewInlineKeyboardButtonData("💳 "+wallethandler.WalletName(item), h.FormatPath(item.Account, 1)))
+			if len(switchRow) == 2 {
+				rows = append(rows, switchRow)
+				switchRow = nil
+			}
+		}
+		if len(switchRow) > 0 {
+			rows = append(rows, switchRow)
+		}
+	}
 	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
 		tgbotapi.NewInlineKeyboardButtonData("◀️ 返回主页", "/home"),
 	))
 
-	text := fmt.Sprintf("Solana 网格机器人 | 仓位列表\n\n%s", strings.Join(labels, "\n\n"))
+	text := fmt.Sprintf("Solana 网格机器人 | 仓位列表\n\n💳 %s: `%s`\n\n%s", wallethandler.WalletName(w), w.Account, strings.Join(labels, "\n\n"))
 	text = text + "\n\n⚠️ 清仓操作不可撤销，谨慎操作！\n⚠️ 重复清仓失败，与代币流动性有关"
 	markup := tgbotapi.NewInlineKeyboardMarkup(rows...)
 	_, err = utils.ReplyMessage(h.botApi, update, text, markup)
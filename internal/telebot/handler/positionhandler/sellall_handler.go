@@ -30,20 +30,40 @@ func NewSellAllHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *Sel
 	return &SellAllHandler{botApi: botApi, svcCtx: svcCtx}
 }
 
-func (h SellAllHandler) FormatPath() string {
-	return "/position/sellall"
+func (h SellAllHandler) FormatPath(account string) string {
+	if account == "" {
+		return "/position/sellall"
+	}
+	return fmt.Sprintf("/position/sellall/%s", account)
 }
 
 func (h *SellAllHandler) AddRouter(router *pathrouter.Router) {
 	router.HandleFunc("/position/sellall", h.handle)
+	router.HandleFunc("/position/sellall/{account}", h.handle)
 }
 
 func (h *SellAllHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	// 获取用户钱包
+	var err error
+	var w *ent.Wallet
+	account, ok := vars["account"]
+	if ok {
+		w, err = h.svcCtx.WalletModel.FindByUserIdAccount(ctx, userId, account)
+	} else {
+		w, err = wallethandler.GetUserWallet(ctx, h.svcCtx, userId)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
 
 		// 要求输入合约地址
-		text := "请输入需要清仓的代币合约地址:"
+		text := fmt.Sprintf("请输入需要清仓的代币合约地址:\n\n💳 钱包: %s", wallethandler.WalletName(w))
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -52,18 +72,12 @@ func (h *SellAllHandler) handle(ctx context.Context, vars map[string]string, use
 			logger.Debugf("[SellAllHandler] 发送消息失败, %v", err)
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(w.Account), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
 	}
 
-	// 获取用户钱包
-	w, err := wallethandler.GetUserWallet(ctx, h.svcCtx, userId)
-	if err != nil {
-		return err
-	}
-
 	if update.Message != nil {
 		chatId := update.Message.Chat.ID
 
@@ -82,7 +96,7 @@ func (h *SellAllHandler) handle(ctx context.Context, vars map[string]string, use
 		// 策略是否正在运行
 		s, err := h.svcCtx.StrategyModel.FindByUserIdToken(ctx, userId, token)
 		if err == nil {
-			if s.Status == strategy.StatusActive {
+			if s.Status == strategy.StatusActive && (s.Account == "" || s.Account == w.Account) {
 				utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 清仓前请手动停止正在运行的策略", 1)
 				return nil
 			}
@@ -110,18 +124,18 @@ func (h *SellAllHandler) handle(ctx context.Context, vars map[string]string, use
 			return nil
 		}
 
-		h.handleSellAll(ctx, userId, chatId, update.Message.Text, tokenmeta.Symbol, decimals, balance)
+		h.handleSellAll(ctx, userId, w.Account, chatId, update.Message.Text, tokenmeta.Symbol, decimals, balance)
 	}
 
 	return nil
 }
 
-func (h *SellAllHandler) handleSellAll(ctx context.Context, userId int64, chatId int64, token, symbol string, decimals uint8, amount *big.Int) {
+func (h *SellAllHandler) handleSellAll(ctx context.Context, userId int64, account string, chatId int64, token, symbol string, decimals uint8, amount *big.Int) {
 	uiAmount := solanautil.ParseUnits(amount, decimals)
 	utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("📊 代币持仓: %s 枚 | ⚡️ 清仓中...", uiAmount), 1)
 
 	// 获取报价
-	swapService := swap.NewSwapService(h.svcCtx, userId)
+	swapService := swap.NewSwapServiceWithAccount(h.svcCtx, userId, account)
 	tx, err := swapService.Quote(ctx, token, solanautil.USDC, amount, true)
 	if err != nil {
 		logger.Errorf("[SellAllHandler] 获取报价失败, in: %s, out: USDC, amount: %s, %v",
@@ -137,12 +151,16 @@ func (h *SellAllHandler) handleSellAll(ctx context.Context, userId int64, chatId
 	if err != nil {
 		logger.Errorf("[SellAllHandler] 清仓代币 - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
 			userId, token, uiAmount, uiOutAmount, hash, err)
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
-		return
-	}
 
-	logger.Infof("[SellAllHandler] 清仓代币 - 提交交易成功, user: %d, token: %s, totalAmount: %s, hash: %s",
-		userId, uiAmount, uiOutAmount, hash)
+		// 交易未广播时直接提示失败, 已经广播的交易仍可能上链, 保存订单等待确认
+		if hash == "" {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
+			return
+		}
+	} else {
+		logger.Infof("[SellAllHandler] 清仓代币 - 提交交易成功, user: %d, token: %s, totalAmount: %s, hash: %s",
+			userId, uiAmount, uiOutAmount, hash)
+	}
 
 	// 保存订单记录
 	orderArgs := ent.Order{
@@ -157,6 +175,8 @@ func (h *SellAllHandler) handleSellAll(ctx context.Context, userId int64, chatId
 		OutAmount:  uiOutAmount,
 		Status:     order.StatusPending,
 		TxHash:     hash,
+		JitoTip:    int64(h.svcCtx.TxSender.Tip(hash)),
+		Aggregator: tx.Aggregator(),
 	}
 
 	_, err = h.svcCtx.OrderModel.Save(ctx, orderArgs)
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fachebot/sol-grid-bot/internal/cache"
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
 	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/shopspring/decimal"
@@ -26,12 +29,23 @@ var (
 	SettingsOptionDexAggregator   SettingsOption = 5
 	SettingsOptionSellSlippageBps SettingsOption = 6
 	SettingsOptionExitSlippageBps SettingsOption = 7
+	SettingsOptionAggPriority     SettingsOption = 8
+	SettingsOptionJitoTip         SettingsOption = 9
+	SettingsOptionExitJitoTip     SettingsOption = 10
+	SettingsOptionSimulateTx      SettingsOption = 11
+	SettingsOptionExitSimulation  SettingsOption = 12
+)
+
+const (
+	minJitoTip = 1000      // 最小 Jito 小费(lamports)
+	maxJitoTip = 100000000 // 最大 Jito 小费(lamports)
 )
 
 func InitRoutes(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, router *pathrouter.Router) {
 	NewSettingsHomeHandler(svcCtx, botApi).AddRouter(router)
 	NewSetDexAggHandler(svcCtx, botApi).AddRouter(router)
 	NewSetPriorityLevelHandler(svcCtx, botApi).AddRouter(router)
+	NewSetTxSenderHandler(svcCtx, botApi).AddRouter(router)
 }
 
 type SettingsHomeHandler struct {
@@ -83,6 +97,16 @@ func (h *SettingsHomeHandler) handle(ctx context.Context, vars map[string]string
 		return h.handleSellSlippageBps(ctx, update, record)
 	case SettingsOptionExitSlippageBps:
 		return h.handleExitSlippageBps(ctx, update, record)
+	case SettingsOptionAggPriority:
+		return h.handleAggPriority(ctx, update, record)
+	case SettingsOptionJitoTip:
+		return h.handleJitoTip(ctx, update, record)
+	case SettingsOptionExitJitoTip:
+		return h.handleExitJitoTip(ctx, update, record)
+	case SettingsOptionSimulateTx:
+		return h.handleSimulateTx(ctx, update, record)
+	case SettingsOptionExitSimulation:
+		return h.handleExitSimulation(ctx, update, record)
 	}
 
 	return nil
@@ -425,3 +449,265 @@ func (h *SettingsHomeHandler) handleMaxLamports(ctx context.Context, update tgbo
 
 	return nil
 }
+
+func (h *SettingsHomeHandler) handleAggPriority(ctx context.Context, update tgbotapi.Update, record *ent.Settings) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写聚合器故障切换顺序, 报价或发送失败时按顺序切换, 用逗号分隔\n\n💵 例如: `jup,okx,relay`"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ParseMode = tgbotapi.ModeMarkdown
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[SettingsHomeHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(&SettingsOptionAggPriority), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入
+		priority, err := swap.ParseAggregatorPriority(strings.ToLower(update.Message.Text))
+		if err != nil {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效的聚合器, 可选值: jup/okx/relay", 1)
+			return nil
+		}
+
+		items := make([]string, 0, len(priority))
+		for _, item := range priority {
+			items = append(items, string(item))
+		}
+		aggregatorPriority := strings.Join(items, ",")
+		if aggregatorPriority == record.AggregatorPriority {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.SettingsModel.UpdateAggregatorPriority(ctx, record.ID, aggregatorPriority)
+		if err == nil {
+			record.AggregatorPriority = aggregatorPriority
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[SettingsHomeHandler] 更新配置[AggregatorPriority]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return displaySettingsMenu(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return displaySettingsMenu(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return displaySettingsMenu(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *SettingsHomeHandler) handleJitoTip(ctx context.Context, update tgbotapi.Update, record *ent.Settings) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写Jito 小费, 仅在发送方式为 jito 时生效\n\n💵 例如: 0.0001｜代表 0.0001 SOL , 单位是 SOL"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[SettingsHomeHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(&SettingsOptionJitoTip), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入小费
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效数字", 1)
+			return nil
+		}
+
+		lamports := d.Shift(solanautil.SOLDecimals).IntPart()
+		if lamports < minJitoTip {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 小费最小不能低于0.000001 SOL", 1)
+			return nil
+		} else if lamports > maxJitoTip {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 小费最大不能超过0.1 SOL", 1)
+			return nil
+		}
+
+		if record.JitoTip != nil && lamports == *record.JitoTip {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.SettingsModel.UpdateJitoTip(ctx, record.ID, lamports)
+		if err == nil {
+			record.JitoTip = &lamports
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[SettingsHomeHandler] 更新配置[JitoTip]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return displaySettingsMenu(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return displaySettingsMenu(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return displaySettingsMenu(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *SettingsHomeHandler) handleExitJitoTip(ctx context.Context, update tgbotapi.Update, record *ent.Settings) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写清仓交易的 Jito 小费, 仅在发送方式为 jito 时生效\n\n💵 例如: 0.0001｜代表 0.0001 SOL , 单位是 SOL"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[SettingsHomeHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(&SettingsOptionExitJitoTip), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入小费
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效数字", 1)
+			return nil
+		}
+
+		lamports := d.Shift(solanautil.SOLDecimals).IntPart()
+		if lamports < minJitoTip {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 小费最小不能低于0.000001 SOL", 1)
+			return nil
+		} else if lamports > maxJitoTip {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 小费最大不能超过0.1 SOL", 1)
+			return nil
+		}
+
+		if record.ExitJitoTip != nil && lamports == *record.ExitJitoTip {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.SettingsModel.UpdateExitJitoTip(ctx, record.ID, lamports)
+		if err == nil {
+			record.ExitJitoTip = &lamports
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[SettingsHomeHandler] 更新配置[ExitJitoTip]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return displaySettingsMenu(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return displaySettingsMenu(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return displaySettingsMenu(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *SettingsHomeHandler) handleSimulateTx(ctx context.Context, update tgbotapi.Update, record *ent.Settings) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.SettingsModel.UpdateSimulateTx(ctx, record.ID, !record.SimulateTx)
+	if err == nil {
+		record.SimulateTx = !record.SimulateTx
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[SettingsHomeHandler] 更新配置[SimulateTx]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return displaySettingsMenu(h.botApi, update, record)
+}
+
+func (h *SettingsHomeHandler) handleExitSimulation(ctx context.Context, update tgbotapi.Update, record *ent.Settings) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.SettingsModel.UpdateSkipExitSimulation(ctx, record.ID, !record.SkipExitSimulation)
+	if err == nil {
+		record.SkipExitSimulation = !record.SkipExitSimulation
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[SettingsHomeHandler] 更新配置[SkipExitSimulation]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return displaySettingsMenu(h.botApi, update, record)
+}
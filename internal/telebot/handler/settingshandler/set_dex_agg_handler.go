@@ -49,6 +49,9 @@ func (h *SetDexAggHandler) handle(ctx context.Context, vars map[string]string, u
 			tgbotapi.NewInlineKeyboardRow(
 				tgbotapi.NewInlineKeyboardButtonData("relay", h.FormatPath(settings.DexAggregatorRelay)),
 			),
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData("auto (最优报价)", h.FormatPath(settings.DexAggregatorAuto)),
+			),
 		)
 		_, err := utils.ReplyMessage(h.botApi, update, text, markup)
 		return err
@@ -0,0 +1,75 @@
+package settingshandler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type SetTxSenderHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetTxSenderHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *SetTxSenderHandler {
+	return &SetTxSenderHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h SetTxSenderHandler) FormatPath(txSender ...settings.TxSender) string {
+	if len(txSender) == 0 {
+		return "/settings/tx_sender"
+	}
+	return fmt.Sprintf("/settings/tx_sender/%s", txSender[0].String())
+}
+
+func (h *SetTxSenderHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/settings/tx_sender", h.handle)
+	router.HandleFunc("/settings/tx_sender/{value}", h.handle)
+}
+
+func (h *SetTxSenderHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	// 处理选项列表
+	value, ok := vars["value"]
+	if !ok {
+		text := getSettingsMenuText()
+		markup := tgbotapi.NewInlineKeyboardMarkup(
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData("rpc", h.FormatPath(settings.TxSenderRPC)),
+			),
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData("jito", h.FormatPath(settings.TxSenderJito)),
+			),
+		)
+		_, err := utils.ReplyMessage(h.botApi, update, text, markup)
+		return err
+	}
+
+	// 获取用户设置
+	record, err := getUserSettings(ctx, h.svcCtx, userId)
+	if err != nil {
+		logger.Errorf("[SetTxSenderHandler] 查询用户设置失败, userId: %d, %v", userId, err)
+		return err
+	}
+
+	// 更新发送方式
+	txSender := settings.TxSender(value)
+	if settings.TxSenderValidator(txSender) == nil {
+		err = h.svcCtx.SettingsModel.UpdateTxSender(ctx, record.ID, txSender)
+		if err != nil {
+			logger.Errorf("[SetTxSenderHandler] 更新 TxSender 配置失败, userId: %d, %v", userId, err)
+			return err
+		}
+
+		record.TxSender = txSender
+	}
+
+	displaySettingsMenu(h.botApi, update, record)
+	return nil
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/settings"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 func getSettingsMenuText() string {
@@ -20,6 +23,9 @@ func getSettingsMenuText() string {
 		"3️⃣ *优先级别:* 设置交易的优先级级别",
 		"4️⃣ *交易最大重试次数:* 交易失败后最大重试次数",
 		"5️⃣ *交易最大Lamports:* 交易中允许使用的最大Lamports数量",
+		"6️⃣ *故障切换顺序:* 聚合器报价或发送失败时依次切换的顺序",
+		"7️⃣ *发送方式:* 通过 RPC 或 Jito 交易包发送交易, Jito 需要支付小费",
+		"8️⃣ *交易模拟:* 发送前模拟执行交易, 模拟失败或输出不足时取消发送, 清仓交易可单独跳过模拟",
 	}
 
 	text := "Solana 网格机器人 | 用户配置"
@@ -45,6 +51,9 @@ func getUserSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int
 		MaxLamports:   c.MaxLamports,
 		PriorityLevel: settings.PriorityLevel(c.PriorityLevel),
 		DexAggregator: settings.DexAggregator(c.DexAggregator),
+		TxSender:      settings.TxSenderRPC,
+
+		SkipExitSimulation: true,
 	}
 	return svcCtx.SettingsModel.Save(ctx, args)
 }
@@ -61,14 +70,47 @@ func displaySettingsMenu(botApi *tgbotapi.BotAPI, update tgbotapi.Update, record
 		exitSlippageBps = float64(*record.ExitSlippageBps) / 10000 * 100
 	}
 
+	jitoTip := "默认"
+	if record.JitoTip != nil {
+		jitoTip = decimal.New(*record.JitoTip, -solanautil.SOLDecimals).String() + " SOL"
+	}
+
+	exitJitoTip := "默认"
+	if record.ExitJitoTip != nil {
+		exitJitoTip = decimal.New(*record.ExitJitoTip, -solanautil.SOLDecimals).String() + " SOL"
+	}
+
+	aggPriority := "默认"
+	if record.AggregatorPriority != "" {
+		aggPriority = strings.ReplaceAll(record.AggregatorPriority, ",", " > ")
+	}
+
 	markup := tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("聚合器: %s", record.DexAggregator), SetDexAggHandler{}.FormatPath()),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("故障切换顺序: %s", aggPriority), SettingsHomeHandler{}.FormatPath(&SettingsOptionAggPriority)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("优先级别: %s", record.PriorityLevel), SetPriorityLevelHandler{}.FormatPath()),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("发送方式: %s", record.TxSender), SetTxSenderHandler{}.FormatPath()),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("Jito小费: %s", jitoTip), SettingsHomeHandler{}.FormatPath(&SettingsOptionJitoTip)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("清仓小费: %s", exitJitoTip), SettingsHomeHandler{}.FormatPath(&SettingsOptionExitJitoTip)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.SimulateTx, "🟢 交易模拟打开").Else("🔴 交易模拟关闭"), SettingsHomeHandler{}.FormatPath(&SettingsOptionSimulateTx)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(!record.SkipExitSimulation, "🟢 清仓模拟打开").Else("🔴 清仓模拟关闭"), SettingsHomeHandler{}.FormatPath(&SettingsOptionExitSimulation)),
 		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
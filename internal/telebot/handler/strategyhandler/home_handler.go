@@ -22,6 +22,7 @@ func InitRoutes(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, router *pat
 	NewStrategyTradesHandler(svcCtx, botApi).AddRouter(router)
 	NewClosePositionyHandler(svcCtx, botApi).AddRouter(router)
 	NewQuickStartStrategyHandler(svcCtx, botApi).AddRouter(router)
+	NewRangeProposalHandler(svcCtx, botApi).AddRouter(router)
 }
 
 type StrategyHomeHandler struct {
@@ -35,8 +35,13 @@ func (h NewStrategyHandler) FormatPath() string {
 	return "/strategy/new"
 }
 
+func (h NewStrategyHandler) FormatTypePath(strategyType strategy.Type) string {
+	return fmt.Sprintf("/strategy/new/%s", strategyType)
+}
+
 func (h *NewStrategyHandler) AddRouter(router *pathrouter.Router) {
 	router.HandleFunc("/strategy/new", h.handle)
+	router.HandleFunc("/strategy/new/{type}", h.handle)
 }
 
 func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
@@ -45,17 +50,26 @@ func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string,
 		return err
 	}
 
+	strategyType := strategy.DefaultType
+	if value, ok := vars["type"]; ok {
+		strategyType = strategy.Type(value)
+		if strategy.TypeValidator(strategyType) != nil {
+			return nil
+		}
+	}
+	typeName := StrategyTypeName(strategyType)
+
 	// 要求输入合约地址
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		c := tgbotapi.NewMessage(chatId, "🔍 网格策略初始化中...\n\n请输入CA地址, 马上开启智能交易!")
+		c := tgbotapi.NewMessage(chatId, fmt.Sprintf("🔍 %s策略初始化中...\n\n请输入CA地址, 马上开启智能交易!", typeName))
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 		msg, err := h.botApi.Send(c)
 		if err != nil {
 			logger.Debugf("[NewStrategyHandler] 发送消息失败, %v", err)
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatTypePath(strategyType), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -87,7 +101,7 @@ func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string,
 			return nil
 		}
 
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("♻️ %s 正在初始化网格策略...", tokenAddress), 3)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("♻️ %s 正在初始化%s策略...", tokenAddress, typeName), 3)
 
 		// 查询代币信息
 		jupConf := h.svcCtx.Config.Jupiter
@@ -123,39 +137,91 @@ func (h *NewStrategyHandler) handle(ctx context.Context, vars map[string]string,
 			return nil
 		}
 
-		c := h.svcCtx.Config.DefaultGridSettings
-		args := ent.Strategy{
-			GUID:                   guid.String(),
-			UserId:                 userId,
-			Token:                  tokenAddress,
-			Symbol:                 strings.TrimRight(tokenMeta.Data.Symbol, "\u0000"),
-			MartinFactor:           1,
-			TakeProfitRatio:        c.TakeProfitRatio,
-			UpperPriceBound:        decimal.Zero,
-			LowerPriceBound:        decimal.Zero,
-			InitialOrderSize:       c.OrderSize,
-			LastKlineVolume:        &c.LastKlineVolume,
-			FiveKlineVolume:        &c.FiveKlineVolume,
-			MaxGridLimit:           &c.MaxGridLimit,
-			StopLossExit:           &c.StopLossExit,
-			TakeProfitExit:         &c.TakeProfitExit,
-			GlobalTakeProfitRatio:  &c.GlobalTakeProfitRatio,
-			DropOn:                 c.DropOn,
-			CandlesToCheck:         c.CandlesToCheck,
-			DropThreshold:          &c.DropThreshold,
-			EnableAutoBuy:          true,
-			EnableAutoSell:         true,
-			EnableAutoExit:         c.EnableAutoExit,
-			EnablePushNotification: true,
-			Status:                 strategy.StatusInactive,
+		// 获取默认钱包
+		w, err := h.svcCtx.WalletModel.FindDefaultByUserId(ctx, userId)
+		if err != nil {
+			logger.Errorf("[NewStrategyHandler] 查询用户钱包失败, userId: %d, %v", userId, err)
+			return err
+		}
+
+		var args ent.Strategy
+		symbol := strings.TrimRight(tokenMeta.Data.Symbol, "\u0000")
+		if strategyType == strategy.TypeDca {
+			c := h.svcCtx.Config.DefaultDcaSettings
+			args = ent.Strategy{
+				GUID:                   guid.String(),
+				UserId:                 userId,
+				Account:                w.Account,
+				Token:                  tokenAddress,
+				Symbol:                 symbol,
+				Type:                   strategy.TypeDca,
+				MartinFactor:           1,
+				TakeProfitRatio:        decimal.Zero,
+				UpperPriceBound:        decimal.Zero,
+				LowerPriceBound:        decimal.Zero,
+				InitialOrderSize:       c.OrderSize,
+				DcaInterval:            c.Interval,
+				DcaDropRatio:           &c.DropRatio,
+				DcaMaxOrders:           c.MaxOrders,
+				DcaSellInterval:        c.SellInterval,
+				DcaSellProfitRatio:     &c.SellProfitRatio,
+				EnableAutoBuy:          true,
+				EnableAutoSell:         true,
+				EnablePushNotification: true,
+				Status:                 strategy.StatusInactive,
+			}
+		} else {
+			c := h.svcCtx.Config.DefaultGridSettings
+			args = ent.Strategy{
+				GUID:                   guid.String(),
+				UserId:                 userId,
+				Account:                w.Account,
+				Token:                  tokenAddress,
+				Symbol:                 symbol,
+				MartinFactor:           c.MartinFactor,
+				TakeProfitRatio:        c.TakeProfitRatio,
+				UpperPriceBound:        decimal.Zero,
+				LowerPriceBound:        decimal.Zero,
+				InitialOrderSize:       c.OrderSize,
+				LastKlineVolume:        &c.LastKlineVolume,
+				FiveKlineVolume:        &c.FiveKlineVolume,
+				MaxGridLimit:           &c.MaxGridLimit,
+				StopLossExit:           &c.StopLossExit,
+				TakeProfitExit:         &c.TakeProfitExit,
+				GlobalTakeProfitRatio:  &c.GlobalTakeProfitRatio,
+				DropOn:                 c.DropOn,
+				CandlesToCheck:         c.CandlesToCheck,
+				DropThreshold:          &c.DropThreshold,
+				TrailingOn:             c.TrailingOn,
+				TrailingCandles:        c.TrailingCandles,
+				TrailingMaxShifts:      c.TrailingMaxShifts,
+				EnableAutoBuy:          true,
+				EnableAutoSell:         true,
+				EnableAutoExit:         c.EnableAutoExit,
+				EnablePushNotification: true,
+				Status:                 strategy.StatusInactive,
+			}
+		}
+
+		// 建议价格区间
+		proposalUpdate := update
+		if update.Message.ReplyToMessage != nil {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				proposalUpdate = tgbotapi.Update{Message: route.Context}
+			}
 		}
+		if strategyType == strategy.TypeGrid && ProposeStrategyRange(ctx, h.svcCtx, h.botApi, proposalUpdate, args) {
+			return nil
+		}
+
 		record, err = h.svcCtx.StrategyModel.Save(ctx, args)
 		if err != nil {
 			logger.Errorf("[NewStrategyHandler] 保存策略失败, %v", err)
 			return err
 		}
 
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("✅ %s 网格策略初始化完成", tokenAddress), 3)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("✅ %s %s策略初始化完成", tokenAddress, typeName), 3)
 
 		// 更新用户界面
 		if update.Message.ReplyToMessage == nil {
@@ -66,14 +66,22 @@ func (h *QuickStartStrategyHandler) handle(ctx context.Context, vars map[string]
 		return nil
 	}
 
+	// 获取默认钱包
+	w, err := h.svcCtx.WalletModel.FindDefaultByUserId(ctx, userId)
+	if err != nil {
+		logger.Errorf("[QuickStartStrategyHandler] 查询用户钱包失败, userId: %d, %v", userId, err)
+		return err
+	}
+
 	// 保存策略信息
 	c := h.svcCtx.Config.QuickStartSettings
 	args := ent.Strategy{
 		GUID:                   guid.String(),
 		UserId:                 userId,
+		Account:                w.Account,
 		Token:                  tokenAddress,
 		Symbol:                 strings.TrimRight(tokenMeta.Data.Symbol, "\u0000"),
-		MartinFactor:           1,
+		MartinFactor:           c.MartinFactor,
 		TakeProfitRatio:        c.TakeProfitRatio,
 		UpperPriceBound:        c.UpperPriceBound,
 		LowerPriceBound:        c.LowerPriceBound,
@@ -85,12 +93,21 @@ func (h *QuickStartStrategyHandler) handle(ctx context.Context, vars map[string]
 		DropOn:                 c.DropOn,
 		CandlesToCheck:         c.CandlesToCheck,
 		DropThreshold:          &c.DropThreshold,
+		TrailingOn:             c.TrailingOn,
+		TrailingCandles:        c.TrailingCandles,
+		TrailingMaxShifts:      c.TrailingMaxShifts,
 		EnableAutoBuy:          true,
 		EnableAutoSell:         true,
 		EnableAutoExit:         c.EnableAutoExit,
 		EnablePushNotification: true,
 		Status:                 strategy.StatusInactive,
 	}
+
+	// 建议价格区间
+	if ProposeStrategyRange(ctx, h.svcCtx, h.botApi, update, args) {
+		return nil
+	}
+
 	record, err = h.svcCtx.StrategyModel.Save(ctx, args)
 	if err != nil {
 		logger.Errorf("[QuickStartStrategyHandler] 保存策略失败, %v", err)
@@ -0,0 +1,296 @@
+package strategyhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	gridstrategy "github.com/fachebot/sol-grid-bot/internal/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/format"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/shopspring/decimal"
+)
+
+type ProposalOption int
+
+var (
+	ProposalOptionAccept          ProposalOption = 1
+	ProposalOptionUpperPriceBound ProposalOption = 2
+	ProposalOptionLowerPriceBound ProposalOption = 3
+	ProposalOptionTakeProfitRatio ProposalOption = 4
+	ProposalOptionCancel          ProposalOption = 5
+)
+
+type RangeProposalHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewRangeProposalHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *RangeProposalHandler {
+	return &RangeProposalHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h RangeProposalHandler) FormatPath(guid string, option *ProposalOption) string {
+	if option == nil {
+		return fmt.Sprintf("/strategy/proposal/%s", guid)
+	}
+	return fmt.Sprintf("/strategy/proposal/%s/%d", guid, *option)
+}
+
+func (h *RangeProposalHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/strategy/proposal/{uuid}", h.handle)
+	router.HandleFunc("/strategy/proposal/{uuid}/{option}", h.handle)
+}
+
+func (h *RangeProposalHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	guid, ok := vars["uuid"]
+	if !ok {
+		return nil
+	}
+
+	chatId, _ := utils.GetChatId(&update)
+	pending, ok := h.svcCtx.PendingCache.Get(guid)
+	if !ok || pending.Strategy.UserId != userId {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 建议参数已过期, 请重新创建策略", 3)
+		return nil
+	}
+
+	option, ok := vars["option"]
+	if !ok {
+		return DisplayRangeProposal(h.botApi, update, pending)
+	}
+
+	optionValue, err := strconv.Atoi(option)
+	if err != nil {
+		return DisplayRangeProposal(h.botApi, update, pending)
+	}
+
+	switch ProposalOption(optionValue) {
+	case ProposalOptionAccept:
+		return h.handleAccept(ctx, userId, update, pending)
+	case ProposalOptionUpperPriceBound:
+		return h.handleUpperPriceBound(update, pending)
+	case ProposalOptionLowerPriceBound:
+		return h.handleLowerPriceBound(update, pending)
+	case ProposalOptionTakeProfitRatio:
+		return h.handleTakeProfitRatio(update, pending)
+	case ProposalOptionCancel:
+		h.svcCtx.PendingCache.Delete(guid)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 已取消创建策略", 1)
+		return DisplayStrategyList(ctx, h.svcCtx, h.botApi, userId, update, 1)
+	}
+
+	return nil
+}
+
+func (h *RangeProposalHandler) handleAccept(ctx context.Context, userId int64, update tgbotapi.Update, pending cache.PendingStrategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	// 是否重复创建
+	chatId := update.CallbackQuery.Message.Chat.ID
+	args := pending.Strategy
+	record, err := h.svcCtx.StrategyModel.FindByUserIdToken(ctx, userId, args.Token)
+	if !ent.IsNotFound(err) {
+		h.svcCtx.PendingCache.Delete(args.GUID)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("❌ %s 策略已存在", args.Token), 3)
+		return DisplayStrategyDetails(ctx, h.svcCtx, h.botApi, userId, update, record)
+	}
+
+	// 保存策略信息
+	record, err = h.svcCtx.StrategyModel.Save(ctx, args)
+	if err != nil {
+		logger.Errorf("[RangeProposalHandler] 保存策略失败, %v", err)
+		return err
+	}
+	h.svcCtx.PendingCache.Delete(args.GUID)
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, fmt.Sprintf("✅ %s 网格策略初始化完成", args.Token), 3)
+
+	return DisplayStrategyDetails(ctx, h.svcCtx, h.botApi, userId, update, record)
+}
+
+func (h *RangeProposalHandler) handleUpperPriceBound(update tgbotapi.Update, pending cache.PendingStrategy) error {
+	text := "🌳 填写网格最高价格（单位: USDC）\n\n💵 例: 100 → 代表100 USDC"
+	return h.handleInput(update, pending, ProposalOptionUpperPriceBound, text, func(pending *cache.PendingStrategy, d decimal.Decimal) string {
+		if d.LessThanOrEqual(pending.Strategy.LowerPriceBound) {
+			return "⚠️ 网格最高价格必须大于最低价格"
+		}
+		pending.Strategy.UpperPriceBound = d
+		return ""
+	})
+}
+
+func (h *RangeProposalHandler) handleLowerPriceBound(update tgbotapi.Update, pending cache.PendingStrategy) error {
+	text := "🌳 填写网格最低价格（单位: USDC）\n\n💵 例: 100 → 代表100 USDC"
+	return h.handleInput(update, pending, ProposalOptionLowerPriceBound, text, func(pending *cache.PendingStrategy, d decimal.Decimal) string {
+		if d.GreaterThanOrEqual(pending.Strategy.UpperPriceBound) {
+			return "⚠️ 网格最低价格必须小于最高价格"
+		}
+		pending.Strategy.LowerPriceBound = d
+		return ""
+	})
+}
+
+func (h *RangeProposalHandler) handleTakeProfitRatio(update tgbotapi.Update, pending cache.PendingStrategy) error {
+	text := "🌳 填写网格止盈百分比\n\n💵 例如: 3.5｜代表 3.5% , 单位是 %"
+	return h.handleInput(update, pending, ProposalOptionTakeProfitRatio, text, func(pending *cache.PendingStrategy, d decimal.Decimal) string {
+		pending.Strategy.TakeProfitRatio = d
+		return ""
+	})
+}
+
+func (h *RangeProposalHandler) handleInput(update tgbotapi.Update, pending cache.PendingStrategy, option ProposalOption, prompt string, apply func(pending *cache.PendingStrategy, d decimal.Decimal) string) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		c := tgbotapi.NewMessage(chatId, prompt)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[RangeProposalHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(pending.Strategy.GUID, &option), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入数值
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效数值", 1)
+			return nil
+		}
+		if text := apply(&pending, d); text != "" {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+		h.svcCtx.PendingCache.Set(pending.Strategy.GUID, pending)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayRangeProposal(h.botApi, update, pending)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayRangeProposal(h.botApi, tgbotapi.Update{Message: route.Context}, pending)
+			}
+			return DisplayRangeProposal(h.botApi, update, pending)
+		}
+	}
+
+	return nil
+}
+
+// ProposeStrategyRange 根据K线波动率生成建议参数, 等待用户确认后再保存策略
+func ProposeStrategyRange(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, update tgbotapi.Update, args ent.Strategy) bool {
+	c := svcCtx.Config.AutoRange
+	if !c.Enable {
+		return false
+	}
+
+	ohlcs, err := FetchTokenCandles(ctx, svcCtx, args.Token, time.Now(), c.Period, c.Candles)
+	if err != nil {
+		logger.Warnf("[ProposeStrategyRange] 获取 ohlcs 数据失败, token: %s, %v", args.Token, err)
+		return false
+	}
+
+	proposal, err := gridstrategy.ProposeRange(ohlcs, c, args.TakeProfitRatio)
+	if err != nil {
+		logger.Warnf("[ProposeStrategyRange] 计算建议区间失败, token: %s, method: %s, %v", args.Token, c.Method, err)
+		return false
+	}
+
+	args.LowerPriceBound = proposal.LowerPriceBound
+	args.UpperPriceBound = proposal.UpperPriceBound
+	args.TakeProfitRatio = proposal.TakeProfitRatio
+	pending := cache.PendingStrategy{
+		Strategy: args,
+		Method:   proposal.Method,
+		Price:    proposal.Price,
+		Atr:      proposal.Atr,
+	}
+	svcCtx.PendingCache.Set(args.GUID, pending)
+
+	if err = DisplayRangeProposal(botApi, update, pending); err != nil {
+		logger.Debugf("[ProposeStrategyRange] 显示建议区间失败, %v", err)
+	}
+	return true
+}
+
+func DisplayRangeProposal(botApi *tgbotapi.BotAPI, update tgbotapi.Update, pending cache.PendingStrategy) error {
+	args := pending.Strategy
+	methods := map[string]string{"atr": "ATR波动率", "percentile": "高低价分位数", "percent": "当前价格百分比"}
+
+	// 计算网格数量
+	gridCount := 0
+	gridList, err := gridstrategy.GenerateGridList(&args)
+	if err == nil {
+		gridCount = len(gridList)
+	}
+	maxGridLimit := 0
+	if args.MaxGridLimit != nil {
+		maxGridLimit = *args.MaxGridLimit
+	}
+	totalCapital := utils.CalculateGridTotalCapital(args.InitialOrderSize, args.MartinFactor, gridCount, maxGridLimit)
+
+	text := fmt.Sprintf("Solana 网格机器人 | *%s* 建议参数\n\n`%s`\n\n", strings.TrimRight(args.Symbol, "\u0000"), args.Token)
+	text = text + fmt.Sprintf("📊 计算方式: *%s*\n", methods[pending.Method])
+	text = text + fmt.Sprintf("💥 当前价格: *$%s*\n", format.Price(pending.Price, 5))
+	if pending.Atr.GreaterThan(decimal.Zero) {
+		volatility := pending.Atr.Div(pending.Price).Mul(decimal.NewFromInt(100))
+		text = text + fmt.Sprintf("🌊 ATR波动: *$%s (%s%%)*\n", format.Price(pending.Atr, 5), volatility.Truncate(2))
+	}
+	text = text + fmt.Sprintf("📈 价格区间: *$%s ~ $%s*\n", args.LowerPriceBound, args.UpperPriceBound)
+	text = text + fmt.Sprintf("🔄 网格详情: *%d格 (%s%% 止盈)*\n", gridCount, args.TakeProfitRatio)
+	text = text + fmt.Sprintf("💰 所需资金: *%s 𝗨𝗦𝗗𝗖*\n", totalCapital.Truncate(2))
+	text = text + "\n`「确认后保存策略, 也可以先修改建议参数」`"
+
+	h := RangeProposalHandler{}
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("⬆️ 价格上限 %v", args.UpperPriceBound), h.FormatPath(args.GUID, &ProposalOptionUpperPriceBound)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("⬇️ 价格下限 %v", args.LowerPriceBound), h.FormatPath(args.GUID, &ProposalOptionLowerPriceBound)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("🟰 止盈 %s%%", args.TakeProfitRatio), h.FormatPath(args.GUID, &ProposalOptionTakeProfitRatio)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("✅ 确认创建", h.FormatPath(args.GUID, &ProposalOptionAccept)),
+			tgbotapi.NewInlineKeyboardButtonData("❌ 取消", h.FormatPath(args.GUID, &ProposalOptionCancel)),
+		),
+	)
+	_, err = utils.ReplyMessage(botApi, update, text, markup)
+	if err != nil {
+		logger.Debugf("[DisplayRangeProposal] 生成建议参数UI失败, %v", err)
+	}
+	return nil
+}
@@ -2,13 +2,17 @@ package strategyhandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/fachebot/sol-grid-bot/internal/cache"
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
+	gridstrategy "github.com/fachebot/sol-grid-bot/internal/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
 	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
@@ -39,6 +43,21 @@ var (
 	SettingsOptionDropThreshold          SettingsOption = 17
 	SettingsOptionStopLossExit           SettingsOption = 18
 	SettingsOptionGlobalTakeProfitRatio  SettingsOption = 19
+	SettingsOptionPaperTrading           SettingsOption = 20
+	SettingsOptionMartinFactor           SettingsOption = 21
+	SettingsOptionGridMode               SettingsOption = 22
+	SettingsOptionGridCount              SettingsOption = 23
+	SettingsOptionGridStep               SettingsOption = 24
+	SettingsOptionTrailingOn             SettingsOption = 25
+	SettingsOptionTrailingCandles        SettingsOption = 26
+	SettingsOptionTrailingMaxShifts      SettingsOption = 27
+	SettingsOptionBuyConditions          SettingsOption = 28
+	SettingsOptionDcaInterval            SettingsOption = 29
+	SettingsOptionDcaDropRatio           SettingsOption = 30
+	SettingsOptionDcaMaxOrders           SettingsOption = 31
+	SettingsOptionDcaSellInterval        SettingsOption = 32
+	SettingsOptionDcaSellProfitRatio     SettingsOption = 33
+	SettingsOptionWallet                 SettingsOption = 34
 )
 
 type StrategySettingsHandler struct {
@@ -130,6 +149,36 @@ func (h *StrategySettingsHandler) handle(ctx context.Context, vars map[string]st
 		return h.handleStopLossExit(ctx, update, record)
 	case SettingsOptionGlobalTakeProfitRatio:
 		return h.handleGlobalTakeProfitRatio(ctx, update, record)
+	case SettingsOptionPaperTrading:
+		return h.handlePaperTrading(ctx, update, record)
+	case SettingsOptionMartinFactor:
+		return h.handleMartinFactor(ctx, update, record)
+	case SettingsOptionGridMode:
+		return h.handleGridMode(ctx, update, record)
+	case SettingsOptionGridCount:
+		return h.handleGridCount(ctx, update, record)
+	case SettingsOptionGridStep:
+		return h.handleGridStep(ctx, update, record)
+	case SettingsOptionTrailingOn:
+		return h.handleTrailingOn(ctx, update, record)
+	case SettingsOptionTrailingCandles:
+		return h.handleTrailingCandles(ctx, update, record)
+	case SettingsOptionTrailingMaxShifts:
+		return h.handleTrailingMaxShifts(ctx, update, record)
+	case SettingsOptionBuyConditions:
+		return h.handleBuyConditions(ctx, update, record)
+	case SettingsOptionDcaInterval:
+		return h.handleDcaInterval(ctx, update, record)
+	case SettingsOptionDcaDropRatio:
+		return h.handleDcaDropRatio(ctx, update, record)
+	case SettingsOptionDcaMaxOrders:
+		return h.handleDcaMaxOrders(ctx, update, record)
+	case SettingsOptionDcaSellInterval:
+		return h.handleDcaSellInterval(ctx, update, record)
+	case SettingsOptionDcaSellProfitRatio:
+		return h.handleDcaSellProfitRatio(ctx, update, record)
+	case SettingsOptionWallet:
+		return h.handleWallet(ctx, update, record)
 	}
 
 	return nil
@@ -215,6 +264,97 @@ func (h *StrategySettingsHandler) handleEnablePushNotification(ctx context.Conte
 	return DisplayStrategSettings(h.botApi, update, record)
 }
 
+func (h *StrategySettingsHandler) handlePaperTrading(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 请先停止策略, 再切换模拟交易", 1)
+		return nil
+	}
+
+	// 切换后无法卖出原交易模式下买入的网格
+	grids, err := h.svcCtx.GridModel.FindByStrategyId(ctx, record.GUID)
+	if err != nil {
+		logger.Errorf("[StrategySettingsHandler] 查询网格列表失败, strategy: %s, %v", record.GUID, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+		return nil
+	}
+	if len(grids) > 0 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略持有网格, 请先清仓, 再切换模拟交易", 1)
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err = h.svcCtx.StrategyModel.UpdatePaperTrading(ctx, record.ID, !record.PaperTrading)
+	if err == nil {
+		record.PaperTrading = !record.PaperTrading
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[PaperTrading]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettings(h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleWallet(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 请先停止策略, 再切换钱包", 1)
+		return nil
+	}
+
+	grids, err := h.svcCtx.GridModel.FindByStrategyId(ctx, record.GUID)
+	if err != nil {
+		logger.Errorf("[StrategySettingsHandler] 查询网格列表失败, strategy: %s, %v", record.GUID, err)
+		return nil
+	}
+	if len(grids) > 0 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略存在持仓, 请先清仓再切换钱包", 1)
+		return nil
+	}
+
+	wallets, err := h.svcCtx.WalletModel.FindAllByUserId(ctx, record.UserId)
+	if err != nil {
+		logger.Errorf("[StrategySettingsHandler] 查询用户钱包失败, userId: %d, %v", record.UserId, err)
+		return nil
+	}
+	if len(wallets) < 2 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 没有其他钱包可以切换, 请先在钱包管理中新建钱包", 1)
+		return nil
+	}
+
+	// 依次切换到下一个钱包
+	account := wallets[0].Account
+	for idx, w := range wallets {
+		if w.Account == record.Account {
+			account = wallets[(idx+1)%len(wallets)].Account
+			break
+		}
+	}
+
+	text := "✅ 配置修改成功"
+	err = h.svcCtx.StrategyModel.UpdateAccount(ctx, record.ID, account)
+	if err == nil {
+		record.Account = account
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[Account]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettings(h.botApi, update, record)
+}
+
 func (h *StrategySettingsHandler) handleEnableDynamicStopLoss(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	if update.CallbackQuery == nil {
 		return nil
@@ -347,12 +487,849 @@ func (h *StrategySettingsHandler) handleMaxGridLimit(ctx context.Context, update
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateMaxGridLimit(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateMaxGridLimit(ctx, record.ID, d)
+		if err == nil {
+			record.MaxGridLimit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[MaxGridLimit]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleMartinFactor(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写马丁倍数\n\n💵 例如: 1.2｜代表网格顶部以下每下降一格, 买入金额乘以 1.2\n\n⚠️ 填写 1 表示每格金额相同, 单格金额最多为首格的 %d 倍", utils.MaxMartinMultiple)
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionMartinFactor), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入倍数
+		d, err := strconv.ParseFloat(update.Message.Text, 64)
+		if err != nil || d < 1 {
+			text := "⚠️ 请输入有效马丁倍数, 不能小于1"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if d == record.MartinFactor {
+			return nil
+		}
+
+		// 检查底部网格金额
+		gridList, err := gridstrategy.GenerateGridList(record)
+		if err == nil && len(gridList) > 1 && math.Pow(d, float64(len(gridList)-1)) > utils.MaxMartinMultiple {
+			maxFactor := math.Pow(utils.MaxMartinMultiple, 1/float64(len(gridList)-1))
+			text := fmt.Sprintf("⚠️ 当前网格数量为 %d, 马丁倍数不能超过 %s, 底部网格金额最多为首格的 %d 倍",
+				len(gridList), decimal.NewFromFloat(maxFactor).Truncate(4), utils.MaxMartinMultiple)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateMartinFactor(ctx, record.ID, d)
+		if err == nil {
+			record.MartinFactor = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[MartinFactor]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleGridMode(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	gridMode := strategy.GridModeArithmetic
+	if record.GridMode == strategy.GridModeArithmetic {
+		gridMode = strategy.GridModeGeometric
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateGridMode(ctx, record.ID, gridMode)
+	if err == nil {
+		record.GridMode = gridMode
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[GridMode]失败, %v", err)
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettings(h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleGridCount(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写等差网格数量\n\n💵 例如: 20｜代表在价格区间内等距划分 20 格\n\n⚠️ 设置网格数量后, 网格间隔自动计算"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGridCount), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入数量
+		d, err := strconv.Atoi(update.Message.Text)
+		if err == nil && d <= 0 {
+			err = errors.New("grid count must be positive")
+		}
+		if err != nil {
+			text := "⚠️ 请输入有效网格数量"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+		if d > utils.MaxArithmeticGridLevels {
+			text := fmt.Sprintf("⚠️ 网格数量不能超过 %d", utils.MaxArithmeticGridLevels)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if d == record.GridCount {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateGridCount(ctx, record.ID, d)
+		if err == nil {
+			record.GridCount = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[GridCount]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleGridStep(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写等差网格间隔（单位: USDC）\n\n💵 例如: 0.001｜代表每格价格相差 0.001 USDC\n\n⚠️ 设置网格间隔后, 网格数量设置将被清除"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGridStep), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入间隔
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err == nil && d.LessThanOrEqual(decimal.Zero) {
+			err = errors.New("grid step must be positive")
+		}
+		if err != nil {
+			text := "⚠️ 请输入有效网格间隔"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		// 检查网格数量上限
+		if record.UpperPriceBound.GreaterThan(record.LowerPriceBound) && record.LowerPriceBound.GreaterThan(decimal.Zero) {
+			_, err = utils.GenerateArithmeticGrid(record.LowerPriceBound, record.UpperPriceBound, d)
+			if errors.Is(err, utils.ErrTooManyGridLevels) {
+				text := fmt.Sprintf("⚠️ 网格间隔过小, 网格数量不能超过 %d", utils.MaxArithmeticGridLevels)
+				utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+				return nil
+			}
+		}
+
+		if record.GridCount == 0 && record.GridStep != nil && d.Equal(*record.GridStep) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateGridStep(ctx, record.ID, d)
+		if err == nil {
+			record.GridStep = &d
+			record.GridCount = 0
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[GridStep]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTakeProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写网格止盈间隔%\n\n💵 例如: 10｜代表 10% , 单位是 %"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitRatio), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			text := "⚠️ 请输入有效止盈间隔"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if d.Equal(record.TakeProfitRatio) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTakeProfitRatio(ctx, record.ID, d)
+		if err == nil {
+			record.TakeProfitRatio = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitRatio]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写网格最高价格（单位: USDC）\n\n💵 例: 100 → 代表100 USDC"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
+			return nil
+		} else if d.LessThanOrEqual(record.LowerPriceBound) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最高价格必须大于最低价格", 1)
+			return nil
+		}
+
+		if d.Equal(record.UpperPriceBound) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateUpperPriceBound(ctx, record.ID, d)
+		if err == nil {
+			record.UpperPriceBound = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperPriceBound]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	chatId, _ := utils.GetChatId(&update)
+	if record.Status == strategy.StatusActive {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+		return nil
+	}
+
+	// 步骤1
+	if update.CallbackQuery != nil {
+		text := "🌳 填写网格最低价格（单位: USDC）\n\n💵 例: 100 → 代表100 USDC"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLowerPriceBound), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThanOrEqual(decimal.Zero) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
+			return nil
+		} else if d.GreaterThanOrEqual(record.UpperPriceBound) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最低价格必须小于最高价格", 1)
+			return nil
+		}
+
+		if d.Equal(record.LowerPriceBound) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateLowerPriceBound(ctx, record.ID, d)
+		if err == nil {
+			record.LowerPriceBound = d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[LowerPriceBound]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写近期的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLastKlineVolume), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效交易量数值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.LastKlineVolume == nil && d.IsZero()) || (record.LastKlineVolume != nil && d.Equal(*record.LastKlineVolume)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateLastKlineVolume(ctx, record.ID, d)
+		if err == nil {
+			record.LastKlineVolume = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[LastKlineVolume]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写近期5分钟的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionFiveKlineVolume), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效交易量数值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.FiveKlineVolume == nil && d.IsZero()) || (record.FiveKlineVolume != nil && d.Equal(*record.FiveKlineVolume)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateFiveKlineVolume(ctx, record.ID, d)
+		if err == nil {
+			record.FiveKlineVolume = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[FiveKlineVolume]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写离场目标价格, 到达此价格将自动清仓并停止策略"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperBoundExit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效离场目标价格"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.UpperBoundExit == nil && d.IsZero()) || (record.UpperBoundExit != nil && d.Equal(*record.UpperBoundExit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateUpperBoundExit(ctx, record.ID, d)
+		if err == nil {
+			record.UpperBoundExit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperBoundExit]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.botApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.botApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写止损金额阈值, 如果亏损金额达到阈值, 将自动清仓并停止策略"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionStopLossExit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效止损金额阈值"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.StopLossExit == nil && d.IsZero()) || (record.StopLossExit != nil && d.Equal(*record.StopLossExit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateStopLossExit(ctx, record.ID, d)
+		if err == nil {
+			record.StopLossExit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[UpdateStopLossExit]失败, %v", err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage == nil {
+			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
+		} else {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayStrategSettings(h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+			}
+			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
+		}
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写盈利目标金额, 如果盈利达到盈利目标, 将自动清仓并停止策略"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitExit), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		// 检查输入金额
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效盈利目标金额"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return nil
+		}
+
+		if (record.TakeProfitExit == nil && d.IsZero()) || (record.TakeProfitExit != nil && d.Equal(*record.TakeProfitExit)) {
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTakeProfitExit(ctx, record.ID, d)
 		if err == nil {
-			record.MaxGridLimit = &d
+			record.TakeProfitExit = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[MaxGridLimit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitExit]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -371,17 +1348,31 @@ func (h *StrategySettingsHandler) handleMaxGridLimit(ctx context.Context, update
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleTakeProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
-	chatId, _ := utils.GetChatId(&update)
-	if record.Status == strategy.StatusActive {
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
+func (h *StrategySettingsHandler) handleDropOn(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
 		return nil
 	}
 
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateDropOn(ctx, record.ID, !record.DropOn)
+	if err == nil {
+		record.DropOn = !record.DropOn
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[DropOn]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettings(h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写网格止盈间隔%\n\n💵 例如: 10｜代表 10% , 单位是 %"
+		text := "🌳 填写防瀑布K线根数, 如果达到下跌阈值则自动关闭策略并清仓"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -391,7 +1382,7 @@ func (h *StrategySettingsHandler) handleTakeProfitRatio(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitRatio), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionCandlesToCheck), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -407,25 +1398,25 @@ func (h *StrategySettingsHandler) handleTakeProfitRatio(ctx context.Context, upd
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
 		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThanOrEqual(decimal.Zero) {
-			text := "⚠️ 请输入有效止盈间隔"
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			text := "⚠️ 请输入有效K线根数"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d.Equal(record.TakeProfitRatio) {
+		if d == record.CandlesToCheck {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateTakeProfitRatio(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateCandlesToCheck(ctx, record.ID, d)
 		if err == nil {
-			record.TakeProfitRatio = d
+			record.CandlesToCheck = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitRatio]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[CandlesToCheck]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -444,17 +1435,11 @@ func (h *StrategySettingsHandler) handleTakeProfitRatio(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
-	chatId, _ := utils.GetChatId(&update)
-	if record.Status == strategy.StatusActive {
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
-		return nil
-	}
-
+func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写网格最高价格（单位: USDC）\n\n💵 例: 100 → 代表100 USDC"
+		text := "🌳 填写防瀑布下跌阈值%, 如果达到下跌阈值则自动关闭策略并清仓\n\n💵 例如: 10｜代表 10% , 单位是 %"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -464,7 +1449,7 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDropThreshold), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -481,26 +1466,24 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 
 		// 检查输入金额
 		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThanOrEqual(decimal.Zero) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
-			return nil
-		} else if d.LessThanOrEqual(record.LowerPriceBound) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最高价格必须大于最低价格", 1)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效防瀑布下跌阈值%"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d.Equal(record.UpperPriceBound) {
+		if (record.DropThreshold == nil && d.IsZero()) || (record.DropThreshold != nil && d.Equal(*record.DropThreshold)) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateUpperPriceBound(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateDropThreshold(ctx, record.ID, d)
 		if err == nil {
-			record.UpperPriceBound = d
+			record.DropThreshold = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperPriceBound]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DropThreshold]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -519,16 +1502,11 @@ func (h *StrategySettingsHandler) handleUpperPriceBound(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
-	chatId, _ := utils.GetChatId(&update)
-	if record.Status == strategy.StatusActive {
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 策略开启后, 只允许修改单笔投入金额", 1)
-		return nil
-	}
-
+func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
-		text := "🌳 填写网格最低价格（单位: USDC）\n\n💵 例: 100 → 代表100 USDC"
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := "🌳 填写全局止盈%, 如果整体盈利率达到此值则清仓并关闭策略, 0 表示不受限制\n\n💵 例如: 1｜代表 1% , 单位是 %"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -538,7 +1516,7 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLowerPriceBound), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGlobalTakeProfitRatio), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -546,6 +1524,7 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 
 	// 步骤2
 	if update.Message != nil {
+		chatId := update.Message.Chat.ID
 		deleteMessages := []int{update.Message.MessageID}
 		if update.Message.ReplyToMessage != nil {
 			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
@@ -554,49 +1533,71 @@ func (h *StrategySettingsHandler) handleLowerPriceBound(ctx context.Context, upd
 
 		// 检查输入金额
 		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThanOrEqual(decimal.Zero) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效金额", 1)
-			return nil
-		} else if d.GreaterThanOrEqual(record.UpperPriceBound) {
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 网格最低价格必须小于最高价格", 1)
+		if err != nil || d.LessThan(decimal.Zero) {
+			text := "⚠️ 请输入有效全局止盈%"
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d.Equal(record.LowerPriceBound) {
+		d = d.Div(decimal.NewFromInt(100))
+		if (record.GlobalTakeProfitRatio == nil && d.IsZero()) || (record.GlobalTakeProfitRatio != nil && d.Equal(*record.GlobalTakeProfitRatio)) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateLowerPriceBound(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateGlobalTakeProfitRatio(ctx, record.ID, d)
 		if err == nil {
-			record.LowerPriceBound = d
+			record.GlobalTakeProfitRatio = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[LowerPriceBound]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[GlobalTakeProfitRatio]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
 		// 更新用户界面
 		if update.Message.ReplyToMessage == nil {
-			return DisplayStrategSettings(h.botApi, update, record)
+			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
 		} else {
 			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
 			if ok && route.Context != nil {
-				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
+				return DisplayStrategSettings(h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
 			}
-			return DisplayStrategSettings(h.botApi, update, record)
+			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
 		}
 	}
 
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleTrailingOn(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateTrailingOn(ctx, record.ID, !record.TrailingOn)
+	if err == nil {
+		record.TrailingOn = !record.TrailingOn
+		if record.TrailingOn {
+			text = "✅ 配置修改成功, 网格跟随只在价格突破上限时上移区间, 跌破下限时不会下移"
+		}
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingOn]失败, %v", err)
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+
+	return DisplayStrategSettings(h.botApi, update, record)
+}
+
+func (h *StrategySettingsHandler) handleTrailingCandles(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写近期的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		text := "🔁 填写网格跟随K线根数, 价格连续多根K线收盘高于价格上限时自动上移网格区间"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -606,7 +1607,7 @@ func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionLastKlineVolume), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTrailingCandles), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -621,26 +1622,26 @@ func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, upd
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效交易量数值"
+		// 检查输入根数
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			text := "⚠️ 请输入有效K线根数"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.LastKlineVolume == nil && d.IsZero()) || (record.LastKlineVolume != nil && d.Equal(*record.LastKlineVolume)) {
+		if d == record.TrailingCandles {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateLastKlineVolume(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateTrailingCandles(ctx, record.ID, d)
 		if err == nil {
-			record.LastKlineVolume = &d
+			record.TrailingCandles = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[LastKlineVolume]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingCandles]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -659,11 +1660,11 @@ func (h *StrategySettingsHandler) handleLastKlineVolume(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleTrailingMaxShifts(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写近期5分钟的最小交易量, 如果交易量小于此值则不会买入, 0 表示不受限制"
+		text := "🔁 填写网格区间最多移动次数, 填写 0 表示不限制"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -673,7 +1674,7 @@ func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, upd
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionFiveKlineVolume), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTrailingMaxShifts), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -688,26 +1689,26 @@ func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, upd
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效交易量数值"
+		// 检查输入次数
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			text := "⚠️ 请输入有效移动次数"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.FiveKlineVolume == nil && d.IsZero()) || (record.FiveKlineVolume != nil && d.Equal(*record.FiveKlineVolume)) {
+		if d == record.TrailingMaxShifts {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateFiveKlineVolume(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateTrailingMaxShifts(ctx, record.ID, d)
 		if err == nil {
-			record.FiveKlineVolume = &d
+			record.TrailingMaxShifts = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[FiveKlineVolume]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingMaxShifts]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -726,11 +1727,13 @@ func (h *StrategySettingsHandler) handleFiveKlineVolume(ctx context.Context, upd
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleBuyConditions(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写离场目标价格, 到达此价格将自动清仓并停止策略"
+		text := "📊 填写买入指标条件, 满足所有条件时才会执行网格买入, 多个条件使用 && 分隔, 填写 0 表示不限制\n\n" +
+			"💵 例如: RSI(14) < 35 && CLOSE > EMA(50)\n\n" +
+			"支持指标: CLOSE, OPEN, HIGH, LOW, VWAP, RSI(n), SMA(n), EMA(n), ATR(n), BB_UPPER(n,k), BB_MIDDLE(n,k), BB_LOWER(n,k), MACD(f,s,sig), MACD_SIGNAL(f,s,sig), MACD_HIST(f,s,sig)"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -740,7 +1743,7 @@ func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, upda
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionUpperBoundExit), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionBuyConditions), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -755,26 +1758,29 @@ func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, upda
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效离场目标价格"
-			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
-			return nil
+		// 检查输入条件
+		buyConditions := ""
+		if strings.TrimSpace(update.Message.Text) != "0" {
+			conditions, err := gridstrategy.ParseBuyConditions(update.Message.Text)
+			if err != nil {
+				utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⚠️ 请输入有效指标条件", 1)
+				return nil
+			}
+			buyConditions = gridstrategy.FormatBuyConditions(conditions)
 		}
 
-		if (record.UpperBoundExit == nil && d.IsZero()) || (record.UpperBoundExit != nil && d.Equal(*record.UpperBoundExit)) {
+		if buyConditions == record.BuyConditions {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateUpperBoundExit(ctx, record.ID, d)
+		err := h.svcCtx.StrategyModel.UpdateBuyConditions(ctx, record.ID, buyConditions)
 		if err == nil {
-			record.UpperBoundExit = &d
+			record.BuyConditions = buyConditions
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[UpperBoundExit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[BuyConditions]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -793,11 +1799,11 @@ func (h *StrategySettingsHandler) handleUpperBoundExit(ctx context.Context, upda
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleDcaInterval(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写止损金额阈值, 如果亏损金额达到阈值, 将自动清仓并停止策略"
+		text := "⏱ 填写定投间隔(分钟), 每隔指定时间买入一次\n\n💵 例如: 60｜代表每小时买入一次, 0 表示不按时间买入"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -807,7 +1813,7 @@ func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionStopLossExit), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDcaInterval), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -822,49 +1828,49 @@ func (h *StrategySettingsHandler) handleStopLossExit(ctx context.Context, update
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效止损金额阈值"
+		// 检查输入数值
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			text := "⚠️ 请输入有效定投间隔"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.StopLossExit == nil && d.IsZero()) || (record.StopLossExit != nil && d.Equal(*record.StopLossExit)) {
+		if d == record.DcaInterval {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateStopLossExit(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateDcaInterval(ctx, record.ID, d)
 		if err == nil {
-			record.StopLossExit = &d
+			record.DcaInterval = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[UpdateStopLossExit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DcaInterval]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
 		// 更新用户界面
 		if update.Message.ReplyToMessage == nil {
-			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettings(h.botApi, update, record)
 		} else {
 			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
 			if ok && route.Context != nil {
-				return DisplayStrategSettings(h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
 			}
-			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettings(h.botApi, update, record)
 		}
 	}
 
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleDcaDropRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写盈利目标金额, 如果盈利达到盈利目标, 将自动清仓并停止策略"
+		text := "📉 填写下跌买入比例%, 价格较上次买入下跌达到该比例时再次买入\n\n💵 例如: 5｜代表 5% , 单位是 %, 0 表示不按跌幅买入"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -874,7 +1880,7 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionTakeProfitExit), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDcaDropRatio), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -889,26 +1895,26 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入比例
 		d, err := decimal.NewFromString(update.Message.Text)
 		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效盈利目标金额"
+			text := "⚠️ 请输入有效下跌买入比例%"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.TakeProfitExit == nil && d.IsZero()) || (record.TakeProfitExit != nil && d.Equal(*record.TakeProfitExit)) {
+		if (record.DcaDropRatio == nil && d.IsZero()) || (record.DcaDropRatio != nil && d.Equal(*record.DcaDropRatio)) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateTakeProfitExit(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateDcaDropRatio(ctx, record.ID, d)
 		if err == nil {
-			record.TakeProfitExit = &d
+			record.DcaDropRatio = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[TakeProfitExit]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DcaDropRatio]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -927,31 +1933,11 @@ func (h *StrategySettingsHandler) handleTakeProfitExit(ctx context.Context, upda
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleDropOn(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
-	if update.CallbackQuery == nil {
-		return nil
-	}
-
-	text := "✅ 配置修改成功"
-	err := h.svcCtx.StrategyModel.UpdateDropOn(ctx, record.ID, !record.DropOn)
-	if err == nil {
-		record.DropOn = !record.DropOn
-	} else {
-		text = "❌ 配置修改失败, 请稍后重试"
-		logger.Errorf("[StrategySettingsHandler] 更新配置[DropOn]失败, %v", err)
-	}
-
-	chatId := update.CallbackQuery.Message.Chat.ID
-	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
-
-	return DisplayStrategSettings(h.botApi, update, record)
-}
-
-func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleDcaMaxOrders(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写防瀑布K线根数, 如果达到下跌阈值则自动关闭策略并清仓"
+		text := "♾️ 填写最多持仓笔数, 达到后暂停买入, 0 表示不限制"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -961,7 +1947,7 @@ func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, upda
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionCandlesToCheck), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDcaMaxOrders), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -976,26 +1962,26 @@ func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, upda
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入数值
 		d, err := strconv.Atoi(update.Message.Text)
 		if err != nil || d < 0 {
-			text := "⚠️ 请输入有效K线根数"
+			text := "⚠️ 请输入有效持仓笔数"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if d == record.CandlesToCheck {
+		if d == record.DcaMaxOrders {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateCandlesToCheck(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateDcaMaxOrders(ctx, record.ID, d)
 		if err == nil {
-			record.CandlesToCheck = d
+			record.DcaMaxOrders = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[CandlesToCheck]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DcaMaxOrders]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -1014,11 +2000,11 @@ func (h *StrategySettingsHandler) handleCandlesToCheck(ctx context.Context, upda
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleDcaSellInterval(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写防瀑布下跌阈值%, 如果达到下跌阈值则自动关闭策略并清仓\n\n💵 例如: 10｜代表 10% , 单位是 %"
+		text := "⏱ 填写分批卖出间隔(分钟), 每隔指定时间卖出一笔成本最低的持仓\n\n💵 例如: 1440｜代表每天卖出一笔, 0 表示不卖出"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -1028,7 +2014,7 @@ func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, updat
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDropThreshold), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDcaSellInterval), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -1043,26 +2029,26 @@ func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, updat
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
-		d, err := decimal.NewFromString(update.Message.Text)
-		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效防瀑布下跌阈值%"
+		// 检查输入数值
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			text := "⚠️ 请输入有效卖出间隔"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		if (record.DropThreshold == nil && d.IsZero()) || (record.DropThreshold != nil && d.Equal(*record.DropThreshold)) {
+		if d == record.DcaSellInterval {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateDropThreshold(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateDcaSellInterval(ctx, record.ID, d)
 		if err == nil {
-			record.DropThreshold = &d
+			record.DcaSellInterval = d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[DropThreshold]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DcaSellInterval]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
@@ -1081,11 +2067,11 @@ func (h *StrategySettingsHandler) handleDropThreshold(ctx context.Context, updat
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleDcaSellProfitRatio(ctx context.Context, update tgbotapi.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.CallbackQuery != nil {
 		chatId := update.CallbackQuery.Message.Chat.ID
-		text := "🌳 填写全局止盈%, 如果整体盈利率达到此值则清仓并关闭策略, 0 表示不受限制\n\n💵 例如: 1｜代表 1% , 单位是 %"
+		text := "🟰 填写分批卖出最低盈利%, 持仓盈利达到该比例才会卖出\n\n💵 例如: 10｜代表 10% , 单位是 %"
 		c := tgbotapi.NewMessage(chatId, text)
 		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
 
@@ -1095,7 +2081,7 @@ func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Contex
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionGlobalTakeProfitRatio), Context: update.CallbackQuery.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &SettingsOptionDcaSellProfitRatio), Context: update.CallbackQuery.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
 
 		return nil
@@ -1110,39 +2096,38 @@ func (h *StrategySettingsHandler) handleGlobalTakeProfitRatio(ctx context.Contex
 		}
 		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
 
-		// 检查输入金额
+		// 检查输入比例
 		d, err := decimal.NewFromString(update.Message.Text)
 		if err != nil || d.LessThan(decimal.Zero) {
-			text := "⚠️ 请输入有效全局止盈%"
+			text := "⚠️ 请输入有效卖出盈利比例%"
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 			return nil
 		}
 
-		d = d.Div(decimal.NewFromInt(100))
-		if (record.GlobalTakeProfitRatio == nil && d.IsZero()) || (record.GlobalTakeProfitRatio != nil && d.Equal(*record.GlobalTakeProfitRatio)) {
+		if (record.DcaSellProfitRatio == nil && d.IsZero()) || (record.DcaSellProfitRatio != nil && d.Equal(*record.DcaSellProfitRatio)) {
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateGlobalTakeProfitRatio(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateDcaSellProfitRatio(ctx, record.ID, d)
 		if err == nil {
-			record.GlobalTakeProfitRatio = &d
+			record.DcaSellProfitRatio = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[GlobalTakeProfitRatio]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[DcaSellProfitRatio]失败, %v", err)
 		}
 		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
 
 		// 更新用户界面
 		if update.Message.ReplyToMessage == nil {
-			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettings(h.botApi, update, record)
 		} else {
 			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
 			if ok && route.Context != nil {
-				return DisplayStrategSettings(h.svcCtx.BotApi, tgbotapi.Update{Message: route.Context}, record)
+				return DisplayStrategSettings(h.botApi, tgbotapi.Update{Message: route.Context}, record)
 			}
-			return DisplayStrategSettings(h.svcCtx.BotApi, update, record)
+			return DisplayStrategSettings(h.botApi, update, record)
 		}
 	}
 
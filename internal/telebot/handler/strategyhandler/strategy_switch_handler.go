@@ -2,6 +2,7 @@ package strategyhandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fachebot/sol-grid-bot/internal/engine"
@@ -116,18 +117,11 @@ func (h *StrategySwitchHandler) handleStartStrategy(ctx context.Context, userId
 		return nil
 	}
 
-	if record.LowerPriceBound.LessThanOrEqual(decimal.Zero) {
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格价格下限必须大于0", 1)
-		return nil
-	}
-
-	if record.UpperPriceBound.LessThanOrEqual(decimal.Zero) {
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格价格上限必须大于0", 1)
-		return nil
-	}
-
-	if record.UpperPriceBound.LessThanOrEqual(record.LowerPriceBound) {
-		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格价格上限必须大于价格下限", 1)
+	if record.Type == strategy.TypeDca {
+		if !h.checkDcaSettings(chatId, record) {
+			return nil
+		}
+	} else if !h.checkGridSettings(chatId, record) {
 		return nil
 	}
 
@@ -154,6 +148,16 @@ func (h *StrategySwitchHandler) handleStartStrategy(ctx context.Context, userId
 			return err
 		}
 
+		err = model.NewStrategyModel(tx.Strategy).UpdateTrailingShifts(ctx, record.ID, 0)
+		if err != nil {
+			return err
+		}
+
+		err = model.NewStrategyModel(tx.Strategy).ClearDcaState(ctx, record.ID)
+		if err != nil {
+			return err
+		}
+
 		return model.NewStrategyModel(tx.Strategy).UpdateStatusByGuid(ctx, record.GUID, strategy.StatusActive)
 	})
 	if err != nil {
@@ -163,7 +167,7 @@ func (h *StrategySwitchHandler) handleStartStrategy(ctx context.Context, userId
 
 	record.Status = strategy.StatusActive
 
-	s := gridstrategy.NewGridStrategy(h.svcCtx, record)
+	s := gridstrategy.NewStrategy(h.svcCtx, record)
 	err = h.svcCtx.Engine.StartStrategy([]engine.Strategy{s})
 	if err != nil {
 		logger.Errorf("[StrategySwitchHandler] 开启策略失败, id: %s, %v", record.GUID, err)
@@ -178,6 +182,47 @@ func (h *StrategySwitchHandler) handleStartStrategy(ctx context.Context, userId
 	return DisplayStrategyDetails(ctx, h.svcCtx, h.botApi, userId, update, record)
 }
 
+func (h *StrategySwitchHandler) checkGridSettings(chatId int64, record *ent.Strategy) bool {
+	if record.LowerPriceBound.LessThanOrEqual(decimal.Zero) {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格价格下限必须大于0", 1)
+		return false
+	}
+
+	if record.UpperPriceBound.LessThanOrEqual(decimal.Zero) {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格价格上限必须大于0", 1)
+		return false
+	}
+
+	if record.UpperPriceBound.LessThanOrEqual(record.LowerPriceBound) {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 网格价格上限必须大于价格下限", 1)
+		return false
+	}
+
+	if record.GridMode == strategy.GridModeArithmetic {
+		if _, err := gridstrategy.CalculateGridStep(record); err != nil {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 请设置等差网格数量或网格间隔", 1)
+			return false
+		}
+
+		if _, err := gridstrategy.GenerateGridList(record); errors.Is(err, utils.ErrTooManyGridLevels) {
+			text := fmt.Sprintf("❌ 开启策略失败, 网格数量不能超过 %d, 请调大网格间隔", utils.MaxArithmeticGridLevels)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 1)
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h *StrategySwitchHandler) checkDcaSettings(chatId int64, record *ent.Strategy) bool {
+	if record.DcaInterval <= 0 && (record.DcaDropRatio == nil || record.DcaDropRatio.LessThanOrEqual(decimal.Zero)) {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 开启策略失败, 请设置定投间隔或下跌买入比例", 1)
+		return false
+	}
+
+	return true
+}
+
 func (h *StrategySwitchHandler) handleStopStrategy(ctx context.Context, userId int64, update tgbotapi.Update, record *ent.Strategy) error {
 	chatId, ok := utils.GetChatId(&update)
 	if !ok {
@@ -112,6 +112,7 @@ func (h *StrategyTradesHandler) handle(ctx context.Context, vars map[string]stri
 	}
 
 	// 生成交易记录
+	paper := false
 	items := make([]string, 0)
 	for _, item := range orders {
 		var status string
@@ -125,23 +126,33 @@ func (h *StrategyTradesHandler) handle(ctx context.Context, vars map[string]stri
 		}
 		finalPrice := format.Price(item.FinalPrice, 5)
 
+		// 模拟订单没有链上交易
+		link := fmt.Sprintf("[>>](https://solscan.io/tx/%s)", item.TxHash)
+		if item.Paper {
+			paper = true
+			link = "📝"
+		}
+
 		if item.Type == order.TypeBuy {
 			if item.GridNumber != nil {
-				items = append(items, fmt.Sprintf("*%s* 🟢 买入`#%d` %sU, 价格 %s %s [>>](https://solscan.io/tx/%s)",
-					utils.FormaDate(item.CreateTime), *item.GridNumber, item.InAmount.Truncate(2), finalPrice, status, item.TxHash))
+				items = append(items, fmt.Sprintf("*%s* 🟢 买入`#%d` %sU, 价格 %s %s %s",
+					utils.FormaDate(item.CreateTime), *item.GridNumber, item.InAmount.Truncate(2), finalPrice, status, link))
 			}
 		} else if item.Type == order.TypeSell {
 			if item.GridNumber == nil {
-				items = append(items, fmt.Sprintf("*%s* 🔴 清仓 %sU, 价格 %s %s [>>](https://solscan.io/tx/%s)",
-					utils.FormaDate(item.CreateTime), item.OutAmount.Truncate(2), finalPrice, status, item.TxHash))
+				items = append(items, fmt.Sprintf("*%s* 🔴 清仓 %sU, 价格 %s %s %s",
+					utils.FormaDate(item.CreateTime), item.OutAmount.Truncate(2), finalPrice, status, link))
 			} else {
-				items = append(items, fmt.Sprintf("*%s* 🔴 卖出`#%d` %sU, 价格 %s %s [>>](https://solscan.io/tx/%s)",
-					utils.FormaDate(item.CreateTime), *item.GridNumber, item.OutAmount.Truncate(2), finalPrice, status, item.TxHash))
+				items = append(items, fmt.Sprintf("*%s* 🔴 卖出`#%d` %sU, 价格 %s %s %s",
+					utils.FormaDate(item.CreateTime), *item.GridNumber, item.OutAmount.Truncate(2), finalPrice, status, link))
 			}
 		}
 	}
 	text := fmt.Sprintf("Solana 网格机器人 | *%s* 交易记录\n\n", strings.TrimRight(record.Symbol, "\u0000"))
 	text = text + strings.Join(items, "\n\n")
+	if paper {
+		text = text + "\n\n📝 模拟交易, 未上链"
+	}
 
 	var rows [][]tgbotapi.InlineKeyboardButton
 	if len(pageButtons) > 0 {
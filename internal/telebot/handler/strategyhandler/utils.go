@@ -15,6 +15,7 @@ import (
 	"github.com/fachebot/sol-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/model"
+	gridstrategy "github.com/fachebot/sol-grid-bot/internal/strategy"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
 	"github.com/fachebot/sol-grid-bot/internal/swap"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
@@ -39,14 +40,15 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 		uiTotalQuantity = uiTotalQuantity.Add(item.Quantity)
 	}
 
-	w, err := svcCtx.WalletModel.FindByUserId(ctx, userId)
+	w, err := gridstrategy.GetStrategyWallet(ctx, svcCtx, record)
 	if err != nil {
-		logger.Errorf("[ClosePosition] 查询用户钱包失败, userId: %d, %v", userId, err)
+		logger.Errorf("[ClosePosition] 查询策略钱包失败, userId: %d, %v", userId, err)
 		return
 	}
 
 	// 获取代币余额
-	tokenBalance, decimals, err := solanautil.GetTokenBalance(ctx, svcCtx.SolanaRpc, record.Token, w.Account)
+	executor := gridstrategy.NewExecutor(svcCtx, record)
+	tokenBalance, decimals, err := executor.GetTokenBalance(ctx, record.Token, w.Account)
 	if err != nil {
 		logger.Debugf("[ClosePosition] 获取代币余额失败, token: %s, %v", record.Token, err)
 		utils.SendMessageAndDelayDeletion(botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
@@ -68,8 +70,7 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 
 	// 获取报价
 	amount := solanautil.FormatUnits(uiTotalQuantity, decimals)
-	swapService := swap.NewSwapService(svcCtx, userId)
-	tx, err := swapService.Quote(ctx, record.Token, solanautil.USDC, amount, true)
+	tx, err := executor.Quote(ctx, userId, w.Account, record.Token, solanautil.USDC, amount, true)
 	if err != nil {
 		logger.Errorf("[ClosePosition] 获取报价失败, in: %s, out: USDC, amount: %s, %v",
 			record.Token, uiTotalQuantity, err)
@@ -84,14 +85,19 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 	if err != nil {
 		logger.Errorf("[ClosePosition] 清仓代币 - 发送交易失败, user: %d, inToken: %s, inputAmount: %s, outAmount: %s, hash: %s, %v",
 			userId, record.Token, uiTotalQuantity, uiOutAmount, hash, err)
-		utils.SendMessageAndDelayDeletion(botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
-		return
-	}
 
-	logger.Infof("[ClosePosition] 清仓代币 - 提交交易成功, user: %d, strategy: %s, totalAmount: %s, hash: %s",
-		userId, record.GUID, uiTotalQuantity, hash)
+		// 交易未广播时直接提示失败, 已经广播的交易仍可能上链, 保存订单等待确认
+		if hash == "" {
+			utils.SendMessageAndDelayDeletion(botApi, chatId, "❌ 清仓失败, 请手动清仓", 1)
+			return
+		}
+	} else {
+		logger.Infof("[ClosePosition] 清仓代币 - 提交交易成功, user: %d, strategy: %s, totalAmount: %s, hash: %s",
+			userId, record.GUID, uiTotalQuantity, hash)
+	}
 
 	// 保存订单记录
+	_, paper := tx.(*swap.PaperSwapTransaction)
 	orderArgs := ent.Order{
 		Account:     tx.Signer(),
 		Token:       record.Token,
@@ -105,6 +111,9 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbo
 		OutAmount:   uiOutAmount,
 		Status:      order.StatusPending,
 		TxHash:      hash,
+		JitoTip:     int64(svcCtx.TxSender.Tip(hash)),
+		Paper:       paper,
+		Aggregator:  tx.Aggregator(),
 	}
 
 	err = utils.Tx(ctx, svcCtx.DbClient, func(tx *ent.Tx) error {
@@ -137,12 +146,37 @@ func FetchTokenCandles(ctx context.Context, svcCtx *svc.ServiceContext, token st
 	return svcCtx.JupagClient.FetchTokenCandles(ctx, token, to, period, limit)
 }
 
+// StrategyTypeName 策略类型名称
+func StrategyTypeName(strategyType strategy.Type) string {
+	if strategyType == strategy.TypeDca {
+		return "定投"
+	}
+	return "网格"
+}
+
+func formatMinutes(minutes int) string {
+	if minutes <= 0 {
+		return "-"
+	}
+	if minutes%1440 == 0 {
+		return fmt.Sprintf("%d天", minutes/1440)
+	}
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%d小时", minutes/60)
+	}
+	return fmt.Sprintf("%d分钟", minutes)
+}
+
 func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) string {
+	if record.Type == strategy.TypeDca {
+		return getDcaStrategyDetailsText(ctx, svcCtx, record)
+	}
+
 	// 生成网格列表
-	gridPrices, err := utils.GenerateGrid(record.LowerPriceBound, record.UpperPriceBound, record.TakeProfitRatio.Div(decimal.NewFromInt(100)))
+	gridPrices, err := gridstrategy.GenerateGridList(record)
 	if err != nil {
-		logger.Debugf("[GetStrategyDetailsText] 生成网格列表失败, low: %v, up: %v, takeProfitRatio: %v, %v",
-			record.LowerPriceBound, record.UpperPriceBound, record.TakeProfitRatio, err)
+		logger.Debugf("[GetStrategyDetailsText] 生成网格列表失败, mode: %s, low: %v, up: %v, takeProfitRatio: %v, %v",
+			record.GridMode, record.LowerPriceBound, record.UpperPriceBound, record.TakeProfitRatio, err)
 	}
 
 	// 获取网格数据
@@ -170,9 +204,9 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 	}
 
 	// 查询已实现利润
-	var reallzedProfit decimal.Decimal
+	var reallzedProfit, netProfit decimal.Decimal
 	if record.FirstOrderId != nil {
-		reallzedProfit, err = svcCtx.OrderModel.TotalProfit(ctx, record.GUID, *record.FirstOrderId)
+		reallzedProfit, netProfit, err = svcCtx.OrderModel.TotalProfit(ctx, record.GUID, *record.FirstOrderId)
 		if err != nil {
 			logger.Warnf("[GetStrategyDetailsText] 获取已实现盈亏失败, strategy: %s, %v", record.GUID, err)
 		}
@@ -212,14 +246,43 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 		dropText = fmt.Sprintf("📉 最近%d分钟最大跌幅: %s%%\n", record.CandlesToCheck, drop.Truncate(2))
 	}
 
+	// 计算所需资金
+	maxGridLimit := 0
+	if record.MaxGridLimit != nil {
+		maxGridLimit = *record.MaxGridLimit
+	}
+	totalCapital := utils.CalculateGridTotalCapital(record.InitialOrderSize, record.MartinFactor, len(gridPrices), maxGridLimit)
+
 	// 生成网格详情
 	text := fmt.Sprintf("Solana 网格机器人 | *%s* 策略详情", strings.TrimRight(record.Symbol, "\u0000"))
+	if gridstrategy.IsPaperTrading(svcCtx, record) {
+		text = text + " 📝 *模拟交易*"
+	}
 	text = text + fmt.Sprintf("\n\n[Jup](https://jup.ag/tokens/%s) | [GMGN](https://gmgn.ai/sol/token/%s) | [DEX Scanner](https://dexscreener.com/solana/%s)", record.Token, record.Token, record.Token)
 	text = text + fmt.Sprintf("\n\n📈 价格区间: *$%s ~ $%s*\n", record.LowerPriceBound.String(), record.UpperPriceBound.String())
 	text = text + fmt.Sprintf("⚙️ 单格投入: *%s 𝗨𝗦𝗗𝗖*\n", record.InitialOrderSize.String())
-	text = text + fmt.Sprintf("🔄 网格详情: *%d格 (%s%% 止盈)*\n", len(gridPrices), record.TakeProfitRatio.String())
+	if record.MartinFactor > 1 {
+		text = text + fmt.Sprintf("✖️ 马丁倍数: *%v*\n", record.MartinFactor)
+	}
+	text = text + fmt.Sprintf("💰 所需资金: *%s 𝗨𝗦𝗗𝗖*\n", totalCapital.Truncate(2))
+	if record.GridMode == strategy.GridModeArithmetic {
+		step, _ := gridstrategy.CalculateGridStep(record)
+		text = text + fmt.Sprintf("🔄 网格详情: *%d格 (等差, 间隔 %s)*\n", len(gridPrices), format.Price(step, 5))
+	} else {
+		text = text + fmt.Sprintf("🔄 网格详情: *%d格 (%s%% 止盈)*\n", len(gridPrices), record.TakeProfitRatio.String())
+	}
+	if record.TrailingOn {
+		maxShifts := "不限"
+		if record.TrailingMaxShifts > 0 {
+			maxShifts = strconv.Itoa(record.TrailingMaxShifts)
+		}
+		text = text + fmt.Sprintf("🔁 网格跟随(仅上移): *已移动 %d/%s 次*\n", record.TrailingShifts, maxShifts)
+	}
+	if record.BuyConditions != "" {
+		text = text + fmt.Sprintf("📊 买入条件: `%s`\n", record.BuyConditions)
+	}
 	text = text + fmt.Sprintf("💵 总利润: %s\n", reallzedProfit.Add(unreallzed).Truncate(2))
-	text = text + fmt.Sprintf("✅ 已实现利润: %s\n", reallzedProfit.Truncate(2))
+	text = text + fmt.Sprintf("✅ 已实现利润: %s (扣除费用后: %s)\n", reallzedProfit.Truncate(2), netProfit.Truncate(2))
 	text = text + fmt.Sprintf("❓ 未实现利润: %s\n", unreallzed.Truncate(2))
 	text = text + fmt.Sprintf("💰 最近交易量: %s\n", humanize.Comma(lastKlineVolume.IntPart()))
 	text = text + fmt.Sprintf("💰 最近5分钟交易量: %s\n", humanize.Comma(fiveKlineVolume.IntPart()))
@@ -257,6 +320,10 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 		}
 
 		item := fmt.Sprintf("➖\\[ *%d* ] %s %v", idx, format.Price(gridPrice, 5), status)
+		if record.MartinFactor > 1 {
+			orderSize := utils.CalculateGridOrderSize(record.InitialOrderSize, record.MartinFactor, len(gridPrices), idx)
+			item += fmt.Sprintf(" `%sU`", orderSize.Truncate(2))
+		}
 		if idx == 0 {
 			item += " *(网格底部)*"
 		}
@@ -349,6 +416,110 @@ func GetStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, rec
 	return text
 }
 
+func getDcaStrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) string {
+	// 获取持仓数据
+	gridRecords, err := svcCtx.GridModel.FindByStrategyId(ctx, record.GUID)
+	if err != nil {
+		logger.Debugf("[GetStrategyDetailsText] 查找持仓列表失败, strategy: %v, %v", record.GUID, err)
+	}
+	slices.SortFunc(gridRecords, func(a, b *ent.Grid) int { return b.GridNumber - a.GridNumber })
+
+	// 获取当前价格
+	var currentPrice decimal.Decimal
+	ohlcs, err := FetchTokenCandles(ctx, svcCtx, record.Token, time.Now(), "1m", 1)
+	if err != nil {
+		logger.Warnf("[GetStrategyDetailsText] 获取 ohlcs 数据失败, token: %s, %v", record.Token, err)
+	}
+
+	lastUpdateTime := time.Now()
+	if len(ohlcs) > 0 {
+		currentPrice = ohlcs[len(ohlcs)-1].Close
+		lastUpdateTime = ohlcs[len(ohlcs)-1].Time
+	}
+
+	// 查询已实现利润
+	var reallzedProfit, netProfit decimal.Decimal
+	if record.FirstOrderId != nil {
+		reallzedProfit, netProfit, err = svcCtx.OrderModel.TotalProfit(ctx, record.GUID, *record.FirstOrderId)
+		if err != nil {
+			logger.Warnf("[GetStrategyDetailsText] 获取已实现盈亏失败, strategy: %s, %v", record.GUID, err)
+		}
+	}
+
+	// 计算持仓成本
+	var totalAmount, totalQuantity, unreallzed decimal.Decimal
+	for _, item := range gridRecords {
+		if item.Status != grid.StatusBought {
+			continue
+		}
+		totalAmount = totalAmount.Add(item.Amount)
+		totalQuantity = totalQuantity.Add(item.Quantity)
+		unreallzed = unreallzed.Add(item.Quantity.Mul(currentPrice).Sub(item.Amount))
+	}
+	averagePrice := decimal.Zero
+	if totalQuantity.GreaterThan(decimal.Zero) {
+		averagePrice = totalAmount.Div(totalQuantity)
+	}
+
+	// 生成策略详情
+	text := fmt.Sprintf("Solana 网格机器人 | *%s* 定投策略详情", strings.TrimRight(record.Symbol, "\u0000"))
+	if gridstrategy.IsPaperTrading(svcCtx, record) {
+		text = text + " 📝 *模拟交易*"
+	}
+	text = text + fmt.Sprintf("\n\n[Jup](https://jup.ag/tokens/%s) | [GMGN](https://gmgn.ai/sol/token/%s) | [DEX Scanner](https://dexscreener.com/solana/%s)", record.Token, record.Token, record.Token)
+	text = text + fmt.Sprintf("\n\n⚙️ 单笔投入: *%s 𝗨𝗦𝗗𝗖*\n", record.InitialOrderSize.String())
+	text = text + fmt.Sprintf("⏱ 定投间隔: *%s*\n", formatMinutes(record.DcaInterval))
+	if record.DcaDropRatio != nil && record.DcaDropRatio.GreaterThan(decimal.Zero) {
+		text = text + fmt.Sprintf("📉 下跌买入: *%s%%*\n", record.DcaDropRatio.String())
+	}
+	if record.DcaMaxOrders > 0 {
+		text = text + fmt.Sprintf("♾️ 最多持仓: *%d笔*\n", record.DcaMaxOrders)
+	}
+	if record.DcaSellInterval > 0 {
+		sellProfitRatio := decimal.Zero
+		if record.DcaSellProfitRatio != nil {
+			sellProfitRatio = *record.DcaSellProfitRatio
+		}
+		text = text + fmt.Sprintf("💸 分批卖出: *每%s (盈利 ≥ %s%%)*\n", formatMinutes(record.DcaSellInterval), sellProfitRatio.String())
+	}
+	if record.BuyConditions != "" {
+		text = text + fmt.Sprintf("📊 买入条件: `%s`\n", record.BuyConditions)
+	}
+	text = text + fmt.Sprintf("💰 持仓成本: *%s 𝗨𝗦𝗗𝗖*\n", totalAmount.Truncate(2))
+	text = text + fmt.Sprintf("📈 持仓均价: *%s*\n", format.Price(averagePrice, 5))
+	text = text + fmt.Sprintf("💵 总利润: %s\n", reallzedProfit.Add(unreallzed).Truncate(2))
+	text = text + fmt.Sprintf("✅ 已实现利润: %s (扣除费用后: %s)\n", reallzedProfit.Truncate(2), netProfit.Truncate(2))
+	text = text + fmt.Sprintf("❓ 未实现利润: %s\n", unreallzed.Truncate(2))
+	if record.DcaLastBuyTime != nil && record.DcaLastBuyPrice != nil {
+		text = text + fmt.Sprintf("🕒 上次买入: %s (%s)\n", utils.FormaTime(*record.DcaLastBuyTime), format.Price(*record.DcaLastBuyPrice, 5))
+	}
+	text = text + "\n🟡 买入中 │ 🟢 已买入 | 🔴 卖出中\n\n"
+
+	// 生成持仓标签
+	const maxItems = 10
+	lotLabels := []string{fmt.Sprintf("➖[💵] *当前价格*: $*%s*", format.Price(currentPrice, 5))}
+	for idx, item := range gridRecords {
+		if idx >= maxItems {
+			lotLabels = append(lotLabels, fmt.Sprintf("➖   ... (省略%d笔持仓)", len(gridRecords)-maxItems))
+			break
+		}
+
+		status := "🟢"
+		switch item.Status {
+		case grid.StatusBuying:
+			status = "🟡"
+		case grid.StatusSelling:
+			status = "🔴"
+		}
+		lotLabels = append(lotLabels, fmt.Sprintf("➖\\[ *%d* ] %s %v `%sU`", item.GridNumber, format.Price(item.FinalPrice, 5), status, item.Amount.Truncate(2)))
+	}
+
+	text = text + strings.Join(lotLabels, "\n")
+	text = text + fmt.Sprintf("\n\n🕒 更新时间: [%s]\n\n⚠️ 重要提示:\n▸ *停止策略会清空之前的持仓记录!*", utils.FormaTime(lastUpdateTime))
+
+	return text
+}
+
 func DisplayStrategyList(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, userId int64, update tgbotapi.Update, page int) error {
 	if page < 1 {
 		return nil
@@ -387,6 +558,10 @@ func DisplayStrategyList(ctx context.Context, svcCtx *svc.ServiceContext, botApi
 		}
 		text := fmt.Sprintf("%s %s | 单笔: %vU | 止盈: %v%%",
 			status, strings.TrimRight(item.Symbol, "\u0000"), item.InitialOrderSize.String(), item.TakeProfitRatio.String())
+		if item.Type == strategy.TypeDca {
+			text = fmt.Sprintf("%s %s | 定投 | 单笔: %vU | 间隔: %s",
+				status, strings.TrimRight(item.Symbol, "\u0000"), item.InitialOrderSize.String(), formatMinutes(item.DcaInterval))
+		}
 		strategyButtons = append(strategyButtons, []tgbotapi.InlineKeyboardButton{
 			tgbotapi.NewInlineKeyboardButtonData(text, StrategyDetailsHandler{}.FormatPath(item.GUID)),
 		})
@@ -420,6 +595,9 @@ func DisplayStrategyList(ctx context.Context, svcCtx *svc.ServiceContext, botApi
 		tgbotapi.NewInlineKeyboardButtonData("◀️ 返回", "/home"),
 		tgbotapi.NewInlineKeyboardButtonData("➕ 新建策略", NewStrategyHandler{}.FormatPath()),
 	))
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("➕ 新建定投策略", NewStrategyHandler{}.FormatTypePath(strategy.TypeDca)),
+	))
 	markup := tgbotapi.NewInlineKeyboardMarkup(rows...)
 
 	text := "Solana 网格机器人 | 我的策略\n\n⏳ 7x24小时自动化交易\n🔥 市场震荡行情的最佳解决方案\n\n*[核心优势]*\n✓ 突破传统低买高卖模式\n✓ 震荡行情中收益最大化\n\n*[适用场景]*\n🔸 横盘震荡行情\n🔸 主流币/稳定币交易对"
@@ -466,6 +644,10 @@ func DisplayStrategyDetails(ctx context.Context, svcCtx *svc.ServiceContext, bot
 }
 
 func DisplayStrategSettings(botApi *tgbotapi.BotAPI, update tgbotapi.Update, record *ent.Strategy) error {
+	if record.Type == strategy.TypeDca {
+		return displayDcaStrategySettings(botApi, update, record)
+	}
+
 	lastKlineVolume, fiveKlineVolume := "-", "-"
 	if record.LastKlineVolume != nil && !record.LastKlineVolume.IsZero() {
 		lastKlineVolume = humanize.Comma(record.LastKlineVolume.IntPart())
@@ -504,12 +686,48 @@ func DisplayStrategSettings(botApi *tgbotapi.BotAPI, update tgbotapi.Update, rec
 		dropThreshold = fmt.Sprintf("%v%%", record.DropThreshold.Truncate(2))
 	}
 
+	gridCount := "-"
+	if record.GridCount > 0 {
+		gridCount = strconv.Itoa(record.GridCount)
+	}
+
+	gridStep := "-"
+	if record.GridStep != nil && record.GridStep.GreaterThan(decimal.Zero) {
+		gridStep = record.GridStep.String()
+	}
+
+	trailingCandles := "-"
+	if record.TrailingCandles > 0 {
+		trailingCandles = strconv.Itoa(record.TrailingCandles)
+	}
+
+	trailingMaxShifts := "-"
+	if record.TrailingMaxShifts > 0 {
+		trailingMaxShifts = strconv.Itoa(record.TrailingMaxShifts)
+	}
+
+	buyConditions := "-"
+	if record.BuyConditions != "" {
+		buyConditions = record.BuyConditions
+	}
+
 	globalTakeProfitRatio := "-"
 	if record.GlobalTakeProfitRatio != nil && !record.GlobalTakeProfitRatio.IsZero() {
 		globalTakeProfitRatio = "+" + record.GlobalTakeProfitRatio.Mul(decimal.NewFromInt(100)).Truncate(2).String() + "%"
 	}
 
 	h := StrategySettingsHandler{}
+	gridModeRow := tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			lo.If(record.GridMode == strategy.GridModeArithmetic, "📐 等差网格").Else("📐 等比网格"), h.FormatPath(record.GUID, &SettingsOptionGridMode)),
+	)
+	if record.GridMode == strategy.GridModeArithmetic {
+		gridModeRow = append(gridModeRow,
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("数量 %s", gridCount), h.FormatPath(record.GUID, &SettingsOptionGridCount)),
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("间隔 %s", gridStep), h.FormatPath(record.GUID, &SettingsOptionGridStep)),
+		)
+	}
+
 	text := "Solana 网格机器人 | *%s* 编辑策略\n\n`%s`\n\n`「调整设置, 优化您的交易体验」`"
 	text = fmt.Sprintf(text, strings.TrimRight(record.Symbol, "\u0000"), record.Token)
 	markup := tgbotapi.NewInlineKeyboardMarkup(
@@ -538,6 +756,8 @@ func DisplayStrategSettings(botApi *tgbotapi.BotAPI, update tgbotapi.Update, rec
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("离场目标价格: %v", upperBoundExit), h.FormatPath(record.GUID, &SettingsOptionUpperBoundExit)),
 		),
+		gridModeRow,
+		walletRow(record),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("🟰 止盈 %s%%", record.TakeProfitRatio), h.FormatPath(record.GUID, &SettingsOptionTakeProfitRatio)),
@@ -550,13 +770,28 @@ func DisplayStrategSettings(botApi *tgbotapi.BotAPI, update tgbotapi.Update, rec
 			tgbotapi.NewInlineKeyboardButtonData(
 				lo.If(record.DynamicStopLoss, "🟢 动态止损打开").Else("🔴 动态止损关闭"), h.FormatPath(record.GUID, &SettingsOptionDynamicStopLoss)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("✖️ 马丁倍数 %v", record.MartinFactor), h.FormatPath(record.GUID, &SettingsOptionMartinFactor)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(lo.If(record.DropOn, "🟢 防瀑布打开").Else("🔴 防瀑布关闭"), h.FormatPath(record.GUID, &SettingsOptionDropOn)),
+			tgbotapi.NewInlineKeyboardButtonData(lo.If(record.PaperTrading, "🟢 模拟交易打开").Else("🔴 模拟交易关闭"), h.FormatPath(record.GUID, &SettingsOptionPaperTrading)),
 		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("K线根数: %s", candlesToCheck), h.FormatPath(record.GUID, &SettingsOptionCandlesToCheck)),
 			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("跌幅阈值: %s", dropThreshold), h.FormatPath(record.GUID, &SettingsOptionDropThreshold)),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("📊 买入条件: %s", buyConditions), h.FormatPath(record.GUID, &SettingsOptionBuyConditions)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(lo.If(record.TrailingOn, "🟢 网格跟随打开").Else("🔴 网格跟随关闭"), h.FormatPath(record.GUID, &SettingsOptionTrailingOn)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("跟随K线: %s", trailingCandles), h.FormatPath(record.GUID, &SettingsOptionTrailingCandles)),
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("最多移动: %s", trailingMaxShifts), h.FormatPath(record.GUID, &SettingsOptionTrailingMaxShifts)),
+		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				fmt.Sprintf("⬆️ 价格上限 %v", record.UpperPriceBound), h.FormatPath(record.GUID, &SettingsOptionUpperPriceBound)),
@@ -584,3 +819,108 @@ func DisplayStrategSettings(botApi *tgbotapi.BotAPI, update tgbotapi.Update, rec
 	}
 	return nil
 }
+
+func walletRow(record *ent.Strategy) []tgbotapi.InlineKeyboardButton {
+	account := "默认钱包"
+	if record.Account != "" {
+		account = format.ShortAddress(record.Account)
+	}
+	return tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("💳 钱包: %s", account), StrategySettingsHandler{}.FormatPath(record.GUID, &SettingsOptionWallet)),
+	)
+}
+
+func displayDcaStrategySettings(botApi *tgbotapi.BotAPI, update tgbotapi.Update, record *ent.Strategy) error {
+	dcaDropRatio := "-"
+	if record.DcaDropRatio != nil && record.DcaDropRatio.GreaterThan(decimal.Zero) {
+		dcaDropRatio = fmt.Sprintf("%v%%", record.DcaDropRatio.Truncate(2))
+	}
+
+	dcaMaxOrders := "-"
+	if record.DcaMaxOrders > 0 {
+		dcaMaxOrders = strconv.Itoa(record.DcaMaxOrders)
+	}
+
+	dcaSellProfitRatio := "-"
+	if record.DcaSellProfitRatio != nil && record.DcaSellProfitRatio.GreaterThan(decimal.Zero) {
+		dcaSellProfitRatio = fmt.Sprintf("%v%%", record.DcaSellProfitRatio.Truncate(2))
+	}
+
+	buyConditions := "-"
+	if record.BuyConditions != "" {
+		buyConditions = record.BuyConditions
+	}
+
+	stopLossExit := "-"
+	if record.StopLossExit != nil && !record.StopLossExit.IsZero() {
+		stopLossExit = "-" + record.StopLossExit.Truncate(2).String() + "U"
+	}
+
+	takeProfitExit := "-"
+	if record.TakeProfitExit != nil && !record.TakeProfitExit.IsZero() {
+		takeProfitExit = "+" + record.TakeProfitExit.Truncate(2).String() + "U"
+	}
+
+	upperBoundExit := "-"
+	if record.UpperBoundExit != nil && record.UpperBoundExit.GreaterThan(decimal.Zero) {
+		upperBoundExit = record.UpperBoundExit.String()
+	}
+
+	h := StrategySettingsHandler{}
+	text := "Solana 网格机器人 | *%s* 编辑定投策略\n\n`%s`\n\n`「调整设置, 优化您的交易体验」`"
+	text = fmt.Sprintf(text, strings.TrimRight(record.Symbol, "\u0000"), record.Token)
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.EnableAutoBuy, "🟢 自动买入打开").Else("🔴 自动买入关闭"), h.FormatPath(record.GUID, &SettingsOptionEnableAutoBuy)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.EnableAutoSell, "🟢 分批卖出打开").Else("🔴 分批卖出关闭"), h.FormatPath(record.GUID, &SettingsOptionEnableAutoSell)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.EnablePushNotification, "🟢 消息推送打开").Else("🔴 消息推送关闭"), h.FormatPath(record.GUID, &SettingsOptionEnablePushNotification)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				lo.If(record.PaperTrading, "🟢 模拟交易打开").Else("🔴 模拟交易关闭"), h.FormatPath(record.GUID, &SettingsOptionPaperTrading)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("🟰 每笔 %vU", record.InitialOrderSize), h.FormatPath(record.GUID, &SettingsOptionOrderSize)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("♾️ 最多持仓 %s", dcaMaxOrders), h.FormatPath(record.GUID, &SettingsOptionDcaMaxOrders)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("⏱ 定投间隔 %s", formatMinutes(record.DcaInterval)), h.FormatPath(record.GUID, &SettingsOptionDcaInterval)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("📉 下跌买入 %s", dcaDropRatio), h.FormatPath(record.GUID, &SettingsOptionDcaDropRatio)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("⏱ 卖出间隔 %s", formatMinutes(record.DcaSellInterval)), h.FormatPath(record.GUID, &SettingsOptionDcaSellInterval)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("🟰 卖出盈利 %s", dcaSellProfitRatio), h.FormatPath(record.GUID, &SettingsOptionDcaSellProfitRatio)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("止盈金额 %s", takeProfitExit), h.FormatPath(record.GUID, &SettingsOptionTakeProfitExit)),
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf("止损金额 %s", stopLossExit), h.FormatPath(record.GUID, &SettingsOptionStopLossExit)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("离场目标价格: %v", upperBoundExit), h.FormatPath(record.GUID, &SettingsOptionUpperBoundExit)),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("📊 买入条件: %s", buyConditions), h.FormatPath(record.GUID, &SettingsOptionBuyConditions)),
+		),
+		walletRow(record),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("◀️ 返回上级", StrategyDetailsHandler{}.FormatPath(record.GUID)),
+			tgbotapi.NewInlineKeyboardButtonData("⏪ 返回主页", "/home"),
+		),
+	)
+	_, err := utils.ReplyMessage(botApi, update, text, markup)
+	if err != nil {
+		logger.Debugf("[DisplayStrategyDetails] 生成策略设置UI失败, %v", err)
+	}
+	return nil
+}
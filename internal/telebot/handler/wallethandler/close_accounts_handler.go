@@ -0,0 +1,70 @@
+package wallethandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fachebot/sol-grid-bot/internal/job"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type CloseAccountsHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewCloseAccountsHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *CloseAccountsHandler {
+	return &CloseAccountsHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h CloseAccountsHandler) FormatPath(account string) string {
+	return fmt.Sprintf("/wallet/closeaccounts/%s", account)
+}
+
+func (h *CloseAccountsHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/closeaccounts/{account}", h.Handle)
+}
+
+func (h *CloseAccountsHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	account, ok := vars["account"]
+	if !ok || update.CallbackQuery == nil {
+		return nil
+	}
+
+	w, err := h.svcCtx.WalletModel.FindByAccount(ctx, account)
+	if err != nil {
+		logger.Errorf("[CloseAccountsHandler] 根据账户查找钱包失败, account: %s, %v", account, err)
+		return nil
+	}
+	if w.UserId != userId {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⏳ 正在关闭空代币账户, 请稍候...", 3)
+
+	report, err := job.NewAccountCloser(h.svcCtx).Close(ctx, w)
+	if err != nil {
+		if errors.Is(err, job.ErrCloseAccountsRunning) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⏳ 正在关闭空代币账户, 请稍后再试", 3)
+			return nil
+		}
+
+		logger.Errorf("[CloseAccountsHandler] 关闭空代币账户失败, account: %s, %v", account, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 关闭空代币账户失败, 请稍后再试", 3)
+		return nil
+	}
+
+	_, err = utils.SendMessage(h.botApi, chatId, report.String())
+	if err != nil {
+		logger.Debugf("[CloseAccountsHandler] 发送消息失败, %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package wallethandler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/model"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type DefaultWalletHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewDefaultWalletHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *DefaultWalletHandler {
+	return &DefaultWalletHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h DefaultWalletHandler) FormatPath(account string) string {
+	return fmt.Sprintf("/wallet/default/%s", account)
+}
+
+func (h *DefaultWalletHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/default/{account}", h.Handle)
+}
+
+func (h *DefaultWalletHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	account, ok := vars["account"]
+	if !ok || update.CallbackQuery == nil {
+		return nil
+	}
+
+	w, err := h.svcCtx.WalletModel.FindByAccount(ctx, account)
+	if err != nil {
+		logger.Errorf("[DefaultWalletHandler] 根据账户查找钱包失败, account: %s, %v", account, err)
+		return nil
+	}
+	if w.UserId != userId {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	err = utils.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+		return model.NewWalletModel(tx.Wallet).SetDefault(ctx, userId, account)
+	})
+	if err != nil {
+		logger.Errorf("[DefaultWalletHandler] 设置默认钱包失败, account: %s, %v", account, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+		return nil
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 已设为默认钱包, 新建策略将默认使用该钱包", 1)
+
+	err = DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, update, account)
+	if err != nil {
+		logger.Debugf("[DefaultWalletHandler] 显示钱包菜单失败, %v", err)
+	}
+
+	return nil
+}
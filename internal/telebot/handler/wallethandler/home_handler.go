@@ -2,6 +2,7 @@ package wallethandler
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/fachebot/sol-grid-bot/internal/logger"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
@@ -13,6 +14,14 @@ import (
 func InitRoutes(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, router *pathrouter.Router) {
 	NewWalletHomeHandler(svcCtx, botApi).AddRouter(router)
 	NewKeyExportHandler(svcCtx, botApi).AddRouter(router)
+	NewReconcileHandler(svcCtx, botApi).AddRouter(router)
+	NewCloseAccountsHandler(svcCtx, botApi).AddRouter(router)
+	NewNewWalletHandler(svcCtx, botApi).AddRouter(router)
+	NewImportWalletHandler(svcCtx, botApi).AddRouter(router)
+	NewRenameWalletHandler(svcCtx, botApi).AddRouter(router)
+	NewDefaultWalletHandler(svcCtx, botApi).AddRouter(router)
+	NewWithdrawHandler(svcCtx, botApi).AddRouter(router)
+	NewWithdrawAddressHandler(svcCtx, botApi).AddRouter(router)
 }
 
 type WalletHomeHandler struct {
@@ -24,16 +33,20 @@ func NewWalletHomeHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *
 	return &WalletHomeHandler{botApi: botApi, svcCtx: svcCtx}
 }
 
-func (h WalletHomeHandler) FormatPath() string {
-	return "/wallet"
+func (h WalletHomeHandler) FormatPath(account string) string {
+	if account == "" {
+		return "/wallet"
+	}
+	return fmt.Sprintf("/wallet/view/%s", account)
 }
 
 func (h *WalletHomeHandler) AddRouter(router *pathrouter.Router) {
 	router.HandleFunc("/wallet", h.Handle)
+	router.HandleFunc("/wallet/view/{account}", h.Handle)
 }
 
 func (h *WalletHomeHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
-	err := DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, update)
+	err := DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, update, vars["account"])
 	if err != nil {
 		logger.Debugf("[WalletHomeHandler] 处理主页失败, %v", err)
 	}
@@ -0,0 +1,99 @@
+package wallethandler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type ImportWalletHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewImportWalletHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *ImportWalletHandler {
+	return &ImportWalletHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h ImportWalletHandler) FormatPath() string {
+	return "/wallet/import"
+}
+
+func (h *ImportWalletHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/import", h.Handle)
+}
+
+func (h *ImportWalletHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+
+		// 要求输入私钥
+		text := "📥 请输入需要导入的钱包私钥\n\n💡 支持 base58 格式或 JSON 字节数组格式, 私钥消息会在收到后立即删除"
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[ImportWalletHandler] 发送消息失败, %v", err)
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+
+		// 立即删除包含私钥的消息
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		privateKey, err := solanautil.ParsePrivateKey(update.Message.Text)
+		if err != nil {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 私钥格式错误, 请检查后再试", 1)
+			return nil
+		}
+
+		w, err := ImportUserWallet(ctx, h.svcCtx, userId, privateKey)
+		if err != nil {
+			switch {
+			case errors.Is(err, ErrWalletExists):
+				utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 该钱包已经导入, 请勿重复导入", 1)
+			case errors.Is(err, ErrTooManyWallets):
+				utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 钱包数量已达上限", 1)
+			default:
+				logger.Errorf("[ImportWalletHandler] 导入钱包失败, userId: %d, account: %s, %v", userId, privateKey.PublicKey(), err)
+				utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+			}
+			return nil
+		}
+
+		logger.Infof("[ImportWalletHandler] 导入钱包成功, userId: %d, account: %s", userId, w.Account)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 钱包导入成功", 1)
+
+		// 显示钱包地址和余额
+		if update.Message.ReplyToMessage != nil {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, tgbotapi.Update{Message: route.Context}, w.Account)
+			}
+		}
+
+		return DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, update, w.Account)
+	}
+
+	return nil
+}
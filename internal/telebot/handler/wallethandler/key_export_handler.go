@@ -116,7 +116,7 @@ func (h *KeyExportHandler) Handle(ctx context.Context, vars map[string]string, u
 		}
 
 		// 解密真正私钥
-		pk, err := h.svcCtx.HashEncoder.Decryption(w.PrivateKey)
+		pk, err := h.svcCtx.KeyCipher.Decryption(w.PrivateKey)
 		if err != nil {
 			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 解密私钥失败, 请联系客服", 1)
 			return nil
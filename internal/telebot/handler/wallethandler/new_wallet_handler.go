@@ -0,0 +1,58 @@
+package wallethandler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type NewWalletHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewNewWalletHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *NewWalletHandler {
+	return &NewWalletHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h NewWalletHandler) FormatPath() string {
+	return "/wallet/new"
+}
+
+func (h *NewWalletHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/new", h.Handle)
+}
+
+func (h *NewWalletHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	w, err := CreateUserWallet(ctx, h.svcCtx, userId)
+	if err != nil {
+		if errors.Is(err, ErrTooManyWallets) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 钱包数量已达上限", 1)
+			return nil
+		}
+
+		logger.Errorf("[NewWalletHandler] 创建钱包失败, userId: %d, %v", userId, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 创建钱包失败, 请稍后再试", 1)
+		return nil
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 钱包创建成功", 1)
+
+	err = DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, update, w.Account)
+	if err != nil {
+		logger.Debugf("[NewWalletHandler] 显示钱包菜单失败, %v", err)
+	}
+
+	return nil
+}
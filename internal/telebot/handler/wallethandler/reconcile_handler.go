@@ -0,0 +1,70 @@
+package wallethandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fachebot/sol-grid-bot/internal/job"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type ReconcileHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewReconcileHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *ReconcileHandler {
+	return &ReconcileHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h ReconcileHandler) FormatPath(account string) string {
+	return fmt.Sprintf("/wallet/reconcile/%s", account)
+}
+
+func (h *ReconcileHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/reconcile/{account}", h.Handle)
+}
+
+func (h *ReconcileHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	account, ok := vars["account"]
+	if !ok || update.CallbackQuery == nil {
+		return nil
+	}
+
+	w, err := h.svcCtx.WalletModel.FindByAccount(ctx, account)
+	if err != nil {
+		logger.Errorf("[ReconcileHandler] 根据账户查找钱包失败, account: %s, %v", account, err)
+		return nil
+	}
+	if w.UserId != userId {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⏳ 正在对账, 请稍候...", 3)
+
+	report, err := job.NewReconciler(h.svcCtx).Reconcile(ctx, w)
+	if err != nil {
+		if errors.Is(err, job.ErrReconcileRunning) {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⏳ 对账正在进行中, 请稍后再试", 3)
+			return nil
+		}
+
+		logger.Errorf("[ReconcileHandler] 对账失败, account: %s, %v", account, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 对账失败, 请稍后再试", 3)
+		return nil
+	}
+
+	_, err = utils.SendMessage(h.botApi, chatId, report.String())
+	if err != nil {
+		logger.Debugf("[ReconcileHandler] 发送消息失败, %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package wallethandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+type RenameWalletHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewRenameWalletHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *RenameWalletHandler {
+	return &RenameWalletHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h RenameWalletHandler) FormatPath(account string) string {
+	return fmt.Sprintf("/wallet/rename/%s", account)
+}
+
+func (h *RenameWalletHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/rename/{account}", h.Handle)
+}
+
+func (h *RenameWalletHandler) Handle(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	account, ok := vars["account"]
+	if !ok {
+		return nil
+	}
+
+	w, err := h.svcCtx.WalletModel.FindByAccount(ctx, account)
+	if err != nil {
+		logger.Errorf("[RenameWalletHandler] 根据账户查找钱包失败, account: %s, %v", account, err)
+		return nil
+	}
+	if w.UserId != userId {
+		return nil
+	}
+
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+		text := fmt.Sprintf("✏️ 请输入钱包 %s 的新名称, 最多16个字符", WalletName(w))
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[RenameWalletHandler] 发送消息失败, %v", err)
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(account), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	if update.Message != nil {
+		chatId := update.Message.Chat.ID
+
+		deleteMessages := []int{update.Message.MessageID}
+		if update.Message.ReplyToMessage != nil {
+			deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		}
+		utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+		name := strings.TrimSpace(update.Message.Text)
+		if name == "" || utf8.RuneCountInString(name) > 16 {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 钱包名称长度在1~16个字符之间", 1)
+			return nil
+		}
+
+		err = h.svcCtx.WalletModel.UpdateName(ctx, account, name)
+		if err != nil {
+			logger.Errorf("[RenameWalletHandler] 更新钱包名称失败, account: %s, name: %s, %v", account, name, err)
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+			return nil
+		}
+
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 钱包名称修改成功", 1)
+
+		// 更新用户界面
+		if update.Message.ReplyToMessage != nil {
+			route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+			if ok && route.Context != nil {
+				return DisplayWalletMenu(ctx, h.svcCtx, h.botApi, userId, tgbotapi.Update{Message: route.Context}, account)
+			}
+		}
+	}
+
+	return nil
+}
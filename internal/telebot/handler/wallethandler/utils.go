@@ -2,52 +2,112 @@ package wallethandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/fachebot/sol-grid-bot/internal/ent"
 	"github.com/fachebot/sol-grid-bot/internal/svc"
 	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/format"
 	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
 
 	"github.com/gagliardetto/solana-go"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
+// 每个用户最多创建的钱包数量
+const maxUserWallets = 10
+
+var (
+	ErrTooManyWallets = errors.New("too many wallets")
+	ErrWalletExists   = errors.New("wallet already exists")
+)
+
+// GetUserWallet 获取用户默认钱包, 用户没有钱包时自动创建
 func GetUserWallet(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) (*ent.Wallet, error) {
-	w, err := svcCtx.WalletModel.FindByUserId(ctx, userId)
+	w, err := svcCtx.WalletModel.FindDefaultByUserId(ctx, userId)
 	if err != nil {
 		if !ent.IsNotFound(err) {
 			return nil, err
 		}
+		return CreateUserWallet(ctx, svcCtx, userId)
+	}
 
-		privateKey := solana.NewWallet().PrivateKey
-		pk, err := svcCtx.HashEncoder.Encryption(privateKey.String())
-		if err != nil {
-			return nil, err
-		}
+	return w, nil
+}
 
-		args := ent.Wallet{
-			UserId:     userId,
-			Account:    privateKey.PublicKey().String(),
-			PrivateKey: pk,
-		}
-		w, err = svcCtx.WalletModel.Save(ctx, args)
-		if err != nil {
-			return nil, err
-		}
+// CreateUserWallet 为用户生成新钱包, 第一个钱包设为默认钱包
+func CreateUserWallet(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) (*ent.Wallet, error) {
+	return saveUserWallet(ctx, svcCtx, userId, solana.NewWallet().PrivateKey)
+}
+
+// ImportUserWallet 为用户导入已有私钥, 第一个钱包设为默认钱包
+func ImportUserWallet(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, privateKey solana.PrivateKey) (*ent.Wallet, error) {
+	_, err := svcCtx.WalletModel.FindByAccount(ctx, privateKey.PublicKey().String())
+	if err == nil {
+		return nil, ErrWalletExists
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
 	}
 
-	return w, nil
+	return saveUserWallet(ctx, svcCtx, userId, privateKey)
 }
 
-func DisplayWalletMenu(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, userId int64, update tgbotapi.Update) error {
+func saveUserWallet(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, privateKey solana.PrivateKey) (*ent.Wallet, error) {
+	count, err := svcCtx.WalletModel.CountByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxUserWallets {
+		return nil, ErrTooManyWallets
+	}
+
+	pk, err := svcCtx.KeyCipher.Encryption(privateKey.String())
+	if err != nil {
+		return nil, err
+	}
+
+	args := ent.Wallet{
+		UserId:     userId,
+		Account:    privateKey.PublicKey().String(),
+		PrivateKey: pk,
+		Name:       fmt.Sprintf("钱包%d", count+1),
+		IsDefault:  count == 0,
+	}
+	return svcCtx.WalletModel.Save(ctx, args)
+}
+
+// WalletName 钱包显示名称, 未命名时显示缩写地址
+func WalletName(w *ent.Wallet) string {
+	if w.Name != "" {
+		return w.Name
+	}
+	return format.ShortAddress(w.Account)
+}
+
+// DisplayWalletMenu 显示钱包管理菜单, account 为空时显示默认钱包
+func DisplayWalletMenu(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, userId int64, update tgbotapi.Update, account string) error {
 	// 确保生成账户
-	w, err := GetUserWallet(ctx, svcCtx, userId)
+	defaultWallet, err := GetUserWallet(ctx, svcCtx, userId)
+	if err != nil {
+		return err
+	}
+
+	wallets, err := svcCtx.WalletModel.FindAllByUserId(ctx, userId)
 	if err != nil {
 		return err
 	}
 
+	w := defaultWallet
+	for _, item := range wallets {
+		if item.Account == account {
+			w = item
+		}
+	}
+	isDefault := w.Account == defaultWallet.Account
+
 	// 查询账户余额
 	balance, err := solanautil.GetBalance(ctx, svcCtx.SolanaRpc, w.Account)
 	if err != nil {
@@ -61,18 +121,59 @@ func DisplayWalletMenu(ctx context.Context, svcCtx *svc.ServiceContext, botApi *
 	}
 
 	// 回复钱包菜单
-	markup := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("◀️ 返回", "/home"),
-			tgbotapi.NewInlineKeyboardButtonData("刷新余额", WalletHomeHandler{}.FormatPath()),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("⚠️ 导出钱包私钥", KeyExportHandler{}.FormatPath(w.Account)),
-		),
+	var rows [][]tgbotapi.InlineKeyboardButton
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("◀️ 返回", "/home"),
+		tgbotapi.NewInlineKeyboardButtonData("刷新余额", WalletHomeHandler{}.FormatPath(w.Account)),
+	))
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("🔍 对账", ReconcileHandler{}.FormatPath(w.Account)),
+		tgbotapi.NewInlineKeyboardButtonData("⚠️ 导出钱包私钥", KeyExportHandler{}.FormatPath(w.Account)),
+	))
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("💸 提现", WithdrawHandler{}.FormatPath(w.Account)),
+		tgbotapi.NewInlineKeyboardButtonData("🧹 回收租金", CloseAccountsHandler{}.FormatPath(w.Account)),
+	))
+
+	manageRow := tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("✏️ 重命名", RenameWalletHandler{}.FormatPath(w.Account)),
 	)
-	text := fmt.Sprintf("Solana 网格机器人 | 钱包管理\n\n💳 我的钱包:\n`%s`\n\n💰    SOL余额: `%s`\n💰 USDC余额: `%s`",
-		w.Account, solanautil.ParseSOL(balance).Truncate(5), solanautil.ParseUnits(usdcBalance, decimals).Truncate(5))
+	if !isDefault {
+		manageRow = append(manageRow, tgbotapi.NewInlineKeyboardButtonData("⭐ 设为默认", DefaultWalletHandler{}.FormatPath(w.Account)))
+	}
+	rows = append(rows, manageRow)
+
+	// 切换钱包
+	var switchRow []tgbotapi.InlineKeyboardButton
+	for _, item := range wallets {
+		if item.Account == w.Account {
+			continue
+		}
+
+		switchRow = append(switchRow, tgbotapi.NewInlineKeyboardButtonData("💳 "+WalletName(item), WalletHomeHandler{}.FormatPath(item.Account)))
+		if len(switchRow) == 2 {
+			rows = append(rows, switchRow)
+			switchRow = nil
+		}
+	}
+	if len(switchRow) > 0 {
+		rows = append(rows, switchRow)
+	}
+
+	if len(wallets) < maxUserWallets {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("➕ 新建钱包", NewWalletHandler{}.FormatPath()),
+			tgbotapi.NewInlineKeyboardButtonData("📥 导入钱包", ImportWalletHandler{}.FormatPath()),
+		))
+	}
+
+	name := WalletName(w)
+	if isDefault {
+		name = name + " (默认)"
+	}
+	text := fmt.Sprintf("Solana 网格机器人 | 钱包管理\n\n💳 %s:\n`%s`\n\n💰    SOL余额: `%s`\n💰 USDC余额: `%s`",
+		name, w.Account, solanautil.ParseSOL(balance).Truncate(5), solanautil.ParseUnits(usdcBalance, decimals).Truncate(5))
 	text = text + fmt.Sprintf("\n\n[OKX](https://web3.okx.com/zh-hant/portfolio/%s/analysis?chainIndex=501) | [GMGN](https://gmgn.ai/sol/address/%s) | [Solscan](https://solscan.io/account/%s)", w.Account, w.Account, w.Account)
-	_, err = utils.ReplyMessage(botApi, update, text, markup)
+	_, err = utils.ReplyMessage(botApi, update, text, tgbotapi.NewInlineKeyboardMarkup(rows...))
 	return err
 }
@@ -0,0 +1,253 @@
+package wallethandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// 每个用户最多添加的提现地址数量
+const maxWithdrawAddresses = 20
+
+type WithdrawAddressHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewWithdrawAddressHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *WithdrawAddressHandler {
+	return &WithdrawAddressHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h WithdrawAddressHandler) FormatPath(action string) string {
+	if action == "" {
+		return "/wallet/withdraw/addresses"
+	}
+	return "/wallet/withdraw/addresses/" + action
+}
+
+func (h *WithdrawAddressHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/withdraw/addresses", h.handleList)
+	router.HandleFunc("/wallet/withdraw/addresses/add", h.handleAdd)
+	router.HandleFunc("/wallet/withdraw/addresses/delete/{id:[0-9]+}", h.handleDelete)
+}
+
+func (h *WithdrawAddressHandler) handleList(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	err := h.displayAddressList(ctx, userId, update)
+	if err != nil {
+		logger.Debugf("[WithdrawAddressHandler] 显示提现地址列表失败, %v", err)
+	}
+
+	return nil
+}
+
+func (h *WithdrawAddressHandler) handleAdd(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.CallbackQuery != nil {
+		chatId := update.CallbackQuery.Message.Chat.ID
+
+		// 要求输入地址和备注
+		text := "请输入提现地址和备注, 用空格分隔, 备注可省略:\n\n例如: `地址 交易所`"
+		if cooldown := h.svcCtx.Config.Withdraw.AddressCooldown; cooldown > 0 {
+			text = text + fmt.Sprintf("\n\n⏳ 新添加的地址需要等待 %d 分钟后才能提现", cooldown)
+		}
+		c := tgbotapi.NewMessage(chatId, text)
+		c.ParseMode = tgbotapi.ModeMarkdown
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[WithdrawAddressHandler] 发送消息失败, %v", err)
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath("add"), Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	if update.Message == nil {
+		return nil
+	}
+
+	chatId := update.Message.Chat.ID
+	var contextMessage *tgbotapi.Message
+	deleteMessages := []int{update.Message.MessageID}
+	if update.Message.ReplyToMessage != nil {
+		deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+		route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+		if ok {
+			contextMessage = route.Context
+		}
+	}
+	utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+
+	// 解析地址和备注
+	fields := strings.Fields(update.Message.Text)
+	if len(fields) == 0 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 请输入提现地址", 1)
+		return nil
+	}
+	address := fields[0]
+	name := strings.Join(fields[1:], " ")
+	if utf8.RuneCountInString(name) > 16 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 地址备注最多16个字符", 1)
+		return nil
+	}
+
+	if err := h.checkAddress(ctx, address); err != nil {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ "+err.Error(), 3)
+		return nil
+	}
+
+	count, err := h.svcCtx.AddressModel.CountByUserId(ctx, userId)
+	if err != nil {
+		logger.Errorf("[WithdrawAddressHandler] 查询提现地址数量失败, userId: %d, %v", userId, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+		return nil
+	}
+	if count >= maxWithdrawAddresses {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 提现地址数量已达上限", 1)
+		return nil
+	}
+
+	exists, err := h.svcCtx.AddressModel.ExistsByUserIdAddress(ctx, userId, address)
+	if err != nil {
+		logger.Errorf("[WithdrawAddressHandler] 查询提现地址失败, userId: %d, address: %s, %v", userId, address, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+		return nil
+	}
+	if exists {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 该地址已在白名单中", 1)
+		return nil
+	}
+
+	args := ent.WithdrawAddress{UserId: userId, Address: address, Name: name}
+	_, err = h.svcCtx.AddressModel.Save(ctx, args)
+	if err != nil {
+		logger.Errorf("[WithdrawAddressHandler] 保存提现地址失败, userId: %d, address: %s, %v", userId, address, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+		return nil
+	}
+
+	logger.Infof("[WithdrawAddressHandler] 添加提现地址, userId: %d, address: %s, name: %s", userId, address, name)
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 提现地址添加成功", 1)
+
+	// 更新用户界面
+	pending, ok := h.svcCtx.WithdrawCache.Get(userId)
+	if !ok || contextMessage == nil {
+		return nil
+	}
+	w, err := h.svcCtx.WalletModel.FindByUserIdAccount(ctx, userId, pending.Account)
+	if err != nil {
+		return nil
+	}
+	return displayWithdrawMenu(ctx, h.svcCtx, h.botApi, userId, tgbotapi.Update{Message: contextMessage}, w)
+}
+
+func (h *WithdrawAddressHandler) handleDelete(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	_, err = h.svcCtx.AddressModel.DeleteByUserIdID(ctx, userId, id)
+	if err != nil {
+		logger.Errorf("[WithdrawAddressHandler] 删除提现地址失败, userId: %d, id: %d, %v", userId, id, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 服务器内部错误, 请稍后再试", 1)
+		return nil
+	}
+
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, "✅ 提现地址已删除", 1)
+
+	err = h.displayAddressList(ctx, userId, update)
+	if err != nil {
+		logger.Debugf("[WithdrawAddressHandler] 显示提现地址列表失败, %v", err)
+	}
+
+	return nil
+}
+
+// checkAddress 检查提现地址, 不允许填写代币账户地址
+func (h *WithdrawAddressHandler) checkAddress(ctx context.Context, address string) error {
+	pubkey, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return errors.New("地址格式错误, 请检查后再试")
+	}
+
+	info, err := h.svcCtx.SolanaRpc.GetAccountInfo(ctx, pubkey)
+	if err != nil {
+		if errors.Is(err, rpc.ErrNotFound) {
+			return nil
+		}
+		logger.Warnf("[WithdrawAddressHandler] 查询账户信息失败, address: %s, %v", address, err)
+		return errors.New("查询地址信息失败, 请稍后再试")
+	}
+
+	owner := info.Value.Owner
+	if owner == solana.TokenProgramID || owner == solana.Token2022ProgramID {
+		return errors.New("这是代币账户地址, 请填写钱包地址")
+	}
+	return nil
+}
+
+func (h *WithdrawAddressHandler) displayAddressList(ctx context.Context, userId int64, update tgbotapi.Update) error {
+	addresses, err := h.svcCtx.AddressModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	var labels []string
+	var rows [][]tgbotapi.InlineKeyboardButton
+	for idx, address := range addresses {
+		label := fmt.Sprintf("%d. %s\n`%s`", idx+1, AddressName(address), address.Address)
+		if remaining := addressCooldown(h.svcCtx, address); remaining > 0 {
+			label = label + fmt.Sprintf(" ⏳ %d 分钟后可用", int(remaining.Minutes())+1)
+		}
+		labels = append(labels, label)
+
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("🗑 删除 "+AddressName(address), h.FormatPath(fmt.Sprintf("delete/%d", address.ID))),
+		))
+	}
+
+	back := WalletHomeHandler{}.FormatPath("")
+	if pending, ok := h.svcCtx.WithdrawCache.Get(userId); ok {
+		back = WithdrawHandler{}.FormatPath(pending.Account)
+	}
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("➕ 添加地址", h.FormatPath("add")),
+		tgbotapi.NewInlineKeyboardButtonData("◀️ 返回", back),
+	))
+
+	text := "Solana 网格机器人 | 提现地址白名单\n\n"
+	if len(labels) == 0 {
+		text = text + "暂无提现地址"
+	} else {
+		text = text + strings.Join(labels, "\n\n")
+	}
+
+	_, err = utils.ReplyMessage(h.botApi, update, text, tgbotapi.NewInlineKeyboardMarkup(rows...))
+	return err
+}
@@ -0,0 +1,582 @@
+package wallethandler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/cache"
+	"github.com/fachebot/sol-grid-bot/internal/ent"
+	"github.com/fachebot/sol-grid-bot/internal/ent/grid"
+	"github.com/fachebot/sol-grid-bot/internal/ent/withdrawal"
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	gridstrategy "github.com/fachebot/sol-grid-bot/internal/strategy"
+	"github.com/fachebot/sol-grid-bot/internal/svc"
+	"github.com/fachebot/sol-grid-bot/internal/swap"
+	"github.com/fachebot/sol-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/sol-grid-bot/internal/utils"
+	"github.com/fachebot/sol-grid-bot/internal/utils/format"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/shopspring/decimal"
+)
+
+type WithdrawHandler struct {
+	botApi *tgbotapi.BotAPI
+	svcCtx *svc.ServiceContext
+}
+
+func NewWithdrawHandler(svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI) *WithdrawHandler {
+	return &WithdrawHandler{botApi: botApi, svcCtx: svcCtx}
+}
+
+func (h WithdrawHandler) FormatPath(account string) string {
+	return fmt.Sprintf("/wallet/withdraw/%s", account)
+}
+
+func (h *WithdrawHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/wallet/withdraw/{account:[1-9A-HJ-NP-Za-km-z]{32,44}}", h.handleHome)
+	router.HandleFunc("/wallet/withdraw/address/{id:[0-9]+}", h.handleAddress)
+	router.HandleFunc("/wallet/withdraw/token/{token}", h.handleToken)
+	router.HandleFunc("/wallet/withdraw/amount", h.handleAmount)
+	router.HandleFunc("/wallet/withdraw/confirm", h.handleConfirm)
+}
+
+func (h *WithdrawHandler) handleHome(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	account, ok := vars["account"]
+	if !ok {
+		return nil
+	}
+
+	w, err := h.svcCtx.WalletModel.FindByUserIdAccount(ctx, userId, account)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Errorf("[WithdrawHandler] 查询用户钱包失败, userId: %d, account: %s, %v", userId, account, err)
+		}
+		return nil
+	}
+
+	// 重新开始填写提现请求
+	h.svcCtx.WithdrawCache.Set(userId, cache.PendingWithdraw{Account: w.Account})
+
+	err = displayWithdrawMenu(ctx, h.svcCtx, h.botApi, userId, update, w)
+	if err != nil {
+		logger.Debugf("[WithdrawHandler] 显示提现菜单失败, %v", err)
+	}
+
+	return nil
+}
+
+func (h *WithdrawHandler) handleAddress(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.CallbackQuery == nil {
+		return nil
+	}
+
+	chatId := update.CallbackQuery.Message.Chat.ID
+	pending, ok := h.svcCtx.WithdrawCache.Get(userId)
+	if !ok {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 操作已过期, 请重新进入提现页面", 1)
+		return nil
+	}
+
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil
+	}
+
+	address, err := h.svcCtx.AddressModel.FindByUserIdID(ctx, userId, id)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Errorf("[WithdrawHandler] 查询提现地址失败, userId: %d, id: %d, %v", userId, id, err)
+		}
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 提现地址不存在", 1)
+		return nil
+	}
+
+	// 新地址冷却期内不能提现
+	if remaining := addressCooldown(h.svcCtx, address); remaining > 0 {
+		text := fmt.Sprintf("⏳ 新添加的地址需要等待 %d 分钟后才能提现", int(remaining.Minutes())+1)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 3)
+		return nil
+	}
+
+	pending.AddressId = address.ID
+	pending.AddressName = AddressName(address)
+	pending.Destination = address.Address
+	pending.Token = ""
+	pending.Amount = nil
+	h.svcCtx.WithdrawCache.Set(userId, pending)
+
+	return displayWithdrawTokenMenu(h.botApi, update, pending)
+}
+
+func (h *WithdrawHandler) handleToken(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	chatId, ok := utils.GetChatId(&update)
+	if !ok {
+		return nil
+	}
+
+	pending, ok := h.svcCtx.WithdrawCache.Get(userId)
+	if !ok || pending.Destination == "" {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 操作已过期, 请重新进入提现页面", 1)
+		return nil
+	}
+
+	if update.CallbackQuery != nil {
+		switch vars["token"] {
+		case "sol":
+			pending.Token, pending.Symbol, pending.Decimals = solanautil.WSOL, "SOL", solanautil.SOLDecimals
+		case "usdc":
+			pending.Token, pending.Symbol, pending.Decimals = solanautil.USDC, "USDC", solanautil.USDCDecimals
+		default:
+			// 要求输入代币合约地址
+			c := tgbotapi.NewMessage(chatId, "请输入需要提现的代币合约地址:")
+			c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+			msg, err := h.botApi.Send(c)
+			if err != nil {
+				logger.Debugf("[WithdrawHandler] 发送消息失败, %v", err)
+			}
+
+			route := cache.RouteInfo{Path: "/wallet/withdraw/token/other", Context: update.CallbackQuery.Message}
+			h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+			return nil
+		}
+
+		h.svcCtx.WithdrawCache.Set(userId, pending)
+		h.promptAmount(chatId, update.CallbackQuery.Message, pending)
+		return nil
+	}
+
+	if update.Message != nil {
+		contextMessage := h.contextMessage(chatId, update)
+		h.deleteReplyMessages(chatId, update)
+
+		token := strings.TrimSpace(update.Message.Text)
+		if _, err := solana.PublicKeyFromBase58(token); err != nil {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 代币合约地址格式错误, 请检查后再试", 1)
+			return nil
+		}
+
+		tokenmeta, err := h.svcCtx.TokenMetaCache.GetTokenMeta(ctx, token)
+		if err != nil {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 查询代币信息失败, 请检查后再试", 1)
+			return nil
+		}
+
+		pending.Token, pending.Symbol, pending.Decimals = token, tokenmeta.Symbol, tokenmeta.Decimals
+		h.svcCtx.WithdrawCache.Set(userId, pending)
+		h.promptAmount(chatId, contextMessage, pending)
+	}
+
+	return nil
+}
+
+func (h *WithdrawHandler) handleAmount(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	if update.Message == nil {
+		return nil
+	}
+
+	chatId := update.Message.Chat.ID
+	contextMessage := h.contextMessage(chatId, update)
+	h.deleteReplyMessages(chatId, update)
+
+	pending, ok := h.svcCtx.WithdrawCache.Get(userId)
+	if !ok || pending.Destination == "" || pending.Token == "" {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 操作已过期, 请重新进入提现页面", 1)
+		return nil
+	}
+
+	uiAmount, err := decimal.NewFromString(strings.TrimSpace(update.Message.Text))
+	if err != nil || uiAmount.LessThanOrEqual(decimal.Zero) {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 请输入有效的数字", 1)
+		return nil
+	}
+	amount := solanautil.FormatUnits(uiAmount, pending.Decimals)
+	if amount.Sign() <= 0 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 提现数量过小", 1)
+		return nil
+	}
+
+	// 估算交易费用
+	swapService := swap.NewSwapServiceWithAccount(h.svcCtx, userId, pending.Account)
+	fee, err := swapService.EstimateTransfer(ctx, pending.Token, pending.Destination, amount)
+	if err != nil {
+		logger.Errorf("[WithdrawHandler] 估算提现费用失败, account: %s, token: %s, destination: %s, %v",
+			pending.Account, pending.Token, pending.Destination, err)
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 估算交易费用失败, 请稍后再试", 1)
+		return nil
+	}
+
+	// 检查钱包余额
+	if text, ok := h.checkBalance(ctx, pending, amount, fee); !ok {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 3)
+		return nil
+	}
+
+	pending.Amount = amount
+	h.svcCtx.WithdrawCache.Set(userId, pending)
+
+	if contextMessage != nil {
+		update = tgbotapi.Update{Message: contextMessage}
+	}
+	return displayWithdrawConfirm(h.botApi, update, pending, fee)
+}
+
+func (h *WithdrawHandler) handleConfirm(ctx context.Context, vars map[string]string, userId int64, update tgbotapi.Update) error {
+	chatId, ok := utils.GetChatId(&update)
+	if !ok {
+		return nil
+	}
+
+	pending, ok := h.svcCtx.WithdrawCache.Get(userId)
+	if !ok || pending.Amount == nil {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 操作已过期, 请重新进入提现页面", 1)
+		return nil
+	}
+
+	w, err := h.svcCtx.WalletModel.FindByUserIdAccount(ctx, userId, pending.Account)
+	if err != nil {
+		logger.Errorf("[WithdrawHandler] 查询用户钱包失败, userId: %d, account: %s, %v", userId, pending.Account, err)
+		return nil
+	}
+
+	if update.CallbackQuery != nil {
+		if len(w.Password) == 0 {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "🔐 您未设置过密码, 请先在「⚠️ 导出钱包私钥」中设置密码", 3)
+			return nil
+		}
+
+		// 要求输入密码
+		c := tgbotapi.NewMessage(chatId, "🔑 请输入密码确认提现...")
+		c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+		msg, err := h.botApi.Send(c)
+		if err != nil {
+			logger.Debugf("[WithdrawHandler] 发送消息失败, %v", err)
+		}
+
+		route := cache.RouteInfo{Path: "/wallet/withdraw/confirm", Context: update.CallbackQuery.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+
+		return nil
+	}
+
+	if update.Message == nil {
+		return nil
+	}
+
+	contextMessage := h.contextMessage(chatId, update)
+	h.deleteReplyMessages(chatId, update)
+
+	// 验证用户密码
+	if update.Message.Text != w.Password {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 密码错误, 请检查后再试", 1)
+		return nil
+	}
+
+	// 再次检查提现地址
+	address, err := h.svcCtx.AddressModel.FindByUserIdID(ctx, userId, pending.AddressId)
+	if err != nil || address.Address != pending.Destination {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 提现地址不存在", 1)
+		return nil
+	}
+	if addressCooldown(h.svcCtx, address) > 0 {
+		utils.SendMessageAndDelayDeletion(h.botApi, chatId, "⏳ 新添加的地址冷却中, 请稍后再试", 1)
+		return nil
+	}
+
+	// 发送交易
+	h.svcCtx.WithdrawCache.Delete(userId)
+	uiAmount := solanautil.ParseUnits(pending.Amount, pending.Decimals)
+	swapService := swap.NewSwapServiceWithAccount(h.svcCtx, userId, pending.Account)
+	hash, fee, err := swapService.Transfer(ctx, pending.Token, pending.Destination, pending.Amount)
+	if err != nil {
+		logger.Errorf("[WithdrawHandler] 提现 - 发送交易失败, user: %d, account: %s, token: %s, amount: %s, destination: %s, hash: %s, %v",
+			userId, pending.Account, pending.Token, uiAmount, pending.Destination, hash, err)
+
+		// 交易未广播时直接提示失败, 已经广播的交易仍可能上链, 保存记录等待确认
+		if hash == "" {
+			utils.SendMessageAndDelayDeletion(h.botApi, chatId, "❌ 提现失败, 请稍后再试", 3)
+			return nil
+		}
+	} else {
+		logger.Infof("[WithdrawHandler] 提现 - 提交交易成功, user: %d, account: %s, token: %s, amount: %s, destination: %s, hash: %s",
+			userId, pending.Account, pending.Token, uiAmount, pending.Destination, hash)
+	}
+
+	// 保存提现记录
+	args := ent.Withdrawal{
+		UserId:      userId,
+		Account:     pending.Account,
+		Token:       pending.Token,
+		Symbol:      pending.Symbol,
+		Destination: pending.Destination,
+		Amount:      uiAmount,
+		Status:      withdrawal.StatusPending,
+		TxHash:      hash,
+		BaseFee:     int64(fee.BaseFee),
+		PriorityFee: int64(fee.PriorityFee),
+		RentFee:     int64(fee.RentFee),
+	}
+	_, err = h.svcCtx.WithdrawalModel.Save(ctx, args)
+	if err != nil {
+		logger.Errorf("[WithdrawHandler] 保存提现记录失败, withdrawal: %+v, %v", args, err)
+	}
+
+	text := fmt.Sprintf("⏳ 提现 %s %s 交易已提交, 等待链上确认 [>>](https://solscan.io/tx/%s)", uiAmount, pending.Symbol, hash)
+	utils.SendMessageAndDelayDeletion(h.botApi, chatId, text, 5)
+
+	if contextMessage != nil {
+		update = tgbotapi.Update{Message: contextMessage}
+	}
+	return displayWithdrawMenu(ctx, h.svcCtx, h.botApi, userId, update, w)
+}
+
+// checkBalance 检查提现数量和交易费用是否超过钱包余额
+func (h *WithdrawHandler) checkBalance(ctx context.Context, pending cache.PendingWithdraw, amount *big.Int, fee swap.TransferFee) (string, bool) {
+	solBalance, err := solanautil.GetBalance(ctx, h.svcCtx.SolanaRpc, pending.Account)
+	if err != nil {
+		return "❌ 查询钱包余额失败, 请稍后再试", false
+	}
+
+	need := new(big.Int).SetUint64(fee.Total())
+	if pending.Token == solanautil.WSOL {
+		need.Add(need, amount)
+	} else {
+		tokenBalance, _, err := solanautil.GetTokenBalance(ctx, h.svcCtx.SolanaRpc, pending.Token, pending.Account)
+		if err != nil {
+			return "❌ 查询代币余额失败, 请稍后再试", false
+		}
+		if tokenBalance.Cmp(amount) < 0 {
+			return fmt.Sprintf("❌ %s 余额不足, 当前余额: %s", pending.Symbol, solanautil.ParseUnits(tokenBalance, pending.Decimals)), false
+		}
+
+		// 扣除策略占用的余额
+		locked, err := h.lockedAmount(ctx, pending)
+		if err != nil {
+			logger.Errorf("[WithdrawHandler] 查询策略占用余额失败, account: %s, token: %s, %v", pending.Account, pending.Token, err)
+			return "❌ 查询策略持仓失败, 请稍后再试", false
+		}
+		if new(big.Int).Sub(tokenBalance, locked).Cmp(amount) < 0 {
+			return fmt.Sprintf("❌ %s 可提现余额不足, 当前余额: %s, 策略占用: %s, 请先停止相关策略", pending.Symbol,
+				solanautil.ParseUnits(tokenBalance, pending.Decimals), solanautil.ParseUnits(locked, pending.Decimals)), false
+		}
+	}
+
+	if solBalance.Cmp(need) < 0 {
+		return fmt.Sprintf("❌ SOL 余额不足以支付提现数量和交易费用, 需要: %s SOL", solanautil.ParseSOL(need)), false
+	}
+
+	// 剩余余额不能低于账户租金
+	remaining := new(big.Int).Sub(solBalance, need)
+	if remaining.Sign() > 0 {
+		rentExempt, err := h.svcCtx.SolanaRpc.GetMinimumBalanceForRentExemption(ctx, 0, rpc.CommitmentConfirmed)
+		if err == nil && remaining.Cmp(new(big.Int).SetUint64(rentExempt)) < 0 {
+			return "❌ 提现后剩余的 SOL 不足以支付账户租金, 请调整提现数量", false
+		}
+	}
+
+	return "", true
+}
+
+// lockedAmount 查询策略占用的代币数量, 包括真实交易网格的持仓和未确认买入交易占用的 USDC
+func (h *WithdrawHandler) lockedAmount(ctx context.Context, pending cache.PendingWithdraw) (*big.Int, error) {
+	if pending.Token == solanautil.USDC {
+		return swap.ReservedUsdc(h.svcCtx, pending.Account), nil
+	}
+
+	grids, err := h.svcCtx.GridModel.FindByAccount(ctx, pending.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := decimal.Zero
+	paper := make(map[string]bool)
+	for _, item := range grids {
+		if item.Token != pending.Token || (item.Status != grid.StatusBought && item.Status != grid.StatusSelling) {
+			continue
+		}
+
+		// 模拟交易的网格不占用钱包余额
+		isPaper, ok := paper[item.StrategyId]
+		if !ok {
+			record, err := h.svcCtx.StrategyModel.FindByGUID(ctx, item.StrategyId)
+			if err != nil && !ent.IsNotFound(err) {
+				return nil, err
+			}
+			isPaper = record != nil && gridstrategy.IsPaperTrading(h.svcCtx, record)
+			paper[item.StrategyId] = isPaper
+		}
+		if !isPaper {
+			quantity = quantity.Add(item.Quantity)
+		}
+	}
+	return solanautil.FormatUnits(quantity, pending.Decimals), nil
+}
+
+func (h *WithdrawHandler) promptAmount(chatId int64, contextMessage *tgbotapi.Message, pending cache.PendingWithdraw) {
+	text := fmt.Sprintf("请输入提现的 %s 数量:", pending.Symbol)
+	c := tgbotapi.NewMessage(chatId, text)
+	c.ReplyMarkup = tgbotapi.ForceReply{ForceReply: true}
+
+	msg, err := h.botApi.Send(c)
+	if err != nil {
+		logger.Debugf("[WithdrawHandler] 发送消息失败, %v", err)
+	}
+
+	route := cache.RouteInfo{Path: "/wallet/withdraw/amount", Context: contextMessage}
+	h.svcCtx.MessageCache.SetRoute(chatId, msg.MessageID, route)
+}
+
+// contextMessage 获取回复消息对应的菜单消息
+func (h *WithdrawHandler) contextMessage(chatId int64, update tgbotapi.Update) *tgbotapi.Message {
+	if update.Message.ReplyToMessage == nil {
+		return nil
+	}
+
+	route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyToMessage.MessageID)
+	if !ok {
+		return nil
+	}
+	return route.Context
+}
+
+func (h *WithdrawHandler) deleteReplyMessages(chatId int64, update tgbotapi.Update) {
+	deleteMessages := []int{update.Message.MessageID}
+	if update.Message.ReplyToMessage != nil {
+		deleteMessages = append(deleteMessages, update.Message.ReplyToMessage.MessageID)
+	}
+	utils.DeleteMessages(h.botApi, chatId, deleteMessages, 0)
+}
+
+// AddressName 提现地址显示名称, 未命名时显示缩写地址
+func AddressName(address *ent.WithdrawAddress) string {
+	if address.Name != "" {
+		return address.Name
+	}
+	return format.ShortAddress(address.Address)
+}
+
+// addressCooldown 新添加的提现地址剩余冷却时间
+func addressCooldown(svcCtx *svc.ServiceContext, address *ent.WithdrawAddress) time.Duration {
+	cooldown := time.Duration(svcCtx.Config.Withdraw.AddressCooldown) * time.Minute
+	return max(time.Until(address.CreateTime.Add(cooldown)), 0)
+}
+
+func displayWithdrawMenu(ctx context.Context, svcCtx *svc.ServiceContext, botApi *tgbotapi.BotAPI, userId int64, update tgbotapi.Update, w *ent.Wallet) error {
+	addresses, err := svcCtx.AddressModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	records, err := svcCtx.WithdrawalModel.FindRecentByUserId(ctx, userId, 5)
+	if err != nil {
+		return err
+	}
+
+	// 查询账户余额
+	balance, err := solanautil.GetBalance(ctx, svcCtx.SolanaRpc, w.Account)
+	if err != nil {
+		balance = big.NewInt(0)
+	}
+
+	// 查询USDC余额
+	usdcBalance, decimals, err := solanautil.GetTokenBalance(ctx, svcCtx.SolanaRpc, solanautil.USDC, w.Account)
+	if err != nil {
+		usdcBalance = big.NewInt(0)
+	}
+
+	var rows [][]tgbotapi.InlineKeyboardButton
+	for _, address := range addresses {
+		label := "📮 " + AddressName(address)
+		if addressCooldown(svcCtx, address) > 0 {
+			label = label + " (冷却中)"
+		}
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(label, fmt.Sprintf("/wallet/withdraw/address/%d", address.ID)),
+		))
+	}
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("➕ 添加地址", WithdrawAddressHandler{}.FormatPath("add")),
+		tgbotapi.NewInlineKeyboardButtonData("🗑 管理地址", WithdrawAddressHandler{}.FormatPath("")),
+	))
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("◀️ 返回", WalletHomeHandler{}.FormatPath(w.Account)),
+		tgbotapi.NewInlineKeyboardButtonData("🔄 刷新", WithdrawHandler{}.FormatPath(w.Account)),
+	))
+
+	text := fmt.Sprintf("Solana 网格机器人 | 提现\n\n💳 %s:\n`%s`\n\n💰    SOL余额: `%s`\n💰 USDC余额: `%s`",
+		WalletName(w), w.Account, solanautil.ParseSOL(balance).Truncate(5), solanautil.ParseUnits(usdcBalance, decimals).Truncate(5))
+	if len(addresses) == 0 {
+		text = text + "\n\n📮 只能提现到白名单地址, 请先添加提现地址"
+	} else {
+		text = text + "\n\n📮 请选择提现地址"
+	}
+
+	if len(records) > 0 {
+		var labels []string
+		for _, item := range records {
+			status := "⏳"
+			switch item.Status {
+			case withdrawal.StatusClosed:
+				status = "✅"
+			case withdrawal.StatusRejected:
+				status = "❌"
+			}
+			labels = append(labels, fmt.Sprintf("%s %s %s → `%s` [>>](https://solscan.io/tx/%s)",
+				status, item.Amount, item.Symbol, format.ShortAddress(item.Destination), item.TxHash))
+		}
+		text = text + "\n\n🧾 最近提现:\n" + strings.Join(labels, "\n")
+	}
+
+	_, err = utils.ReplyMessage(botApi, update, text, tgbotapi.NewInlineKeyboardMarkup(rows...))
+	return err
+}
+
+func displayWithdrawTokenMenu(botApi *tgbotapi.BotAPI, update tgbotapi.Update, pending cache.PendingWithdraw) error {
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("SOL", "/wallet/withdraw/token/sol"),
+			tgbotapi.NewInlineKeyboardButtonData("USDC", "/wallet/withdraw/token/usdc"),
+			tgbotapi.NewInlineKeyboardButtonData("其他代币", "/wallet/withdraw/token/other"),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("◀️ 返回", WithdrawHandler{}.FormatPath(pending.Account)),
+		),
+	)
+
+	text := fmt.Sprintf("Solana 网格机器人 | 提现\n\n💳 从: `%s`\n📮 到: %s\n`%s`\n\n🪙 请选择提现的代币",
+		pending.Account, pending.AddressName, pending.Destination)
+	_, err := utils.ReplyMessage(botApi, update, text, markup)
+	return err
+}
+
+func displayWithdrawConfirm(botApi *tgbotapi.BotAPI, update tgbotapi.Update, pending cache.PendingWithdraw, fee swap.TransferFee) error {
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("✅ 确认提现", "/wallet/withdraw/confirm"),
+			tgbotapi.NewInlineKeyboardButtonData("❌ 取消", WithdrawHandler{}.FormatPath(pending.Account)),
+		),
+	)
+
+	networkFee := new(big.Int).SetUint64(fee.BaseFee + fee.PriorityFee)
+	text := fmt.Sprintf("Solana 网格机器人 | 确认提现\n\n💳 从: `%s`\n📮 到: %s\n`%s`\n\n🪙 代币: %s\n💰 数量: `%s`\n⛽ 网络费用: `%s` SOL",
+		pending.Account, pending.AddressName, pending.Destination, pending.Symbol,
+		solanautil.ParseUnits(pending.Amount, pending.Decimals), solanautil.ParseSOL(networkFee))
+	if fee.RentFee > 0 {
+		rentFee := new(big.Int).SetUint64(fee.RentFee)
+		text = text + fmt.Sprintf("\n🏦 创建代币账户租金: `%s` SOL", solanautil.ParseSOL(rentFee))
+	}
+	text = text + "\n\n⚠️ 请仔细核对提现地址和数量, 交易上链后无法撤销"
+
+	_, err := utils.ReplyMessage(botApi, update, text, markup)
+	return err
+}
@@ -115,8 +115,8 @@ func (s *TeleBot) handleHome(userId int64, update tgbotapi.Update) error {
 			tgbotapi.NewInlineKeyboardButtonData("⚙️ 设置", "/settings"),
 		),
 	)
-	text := fmt.Sprintf("Solana 网格机器人 | 盈利如春雨, 润物无声, 渐丰收! \n\n💳 我的钱包:\n`%s`\n\n💰    SOL余额: `%s`\n💰 USDC余额: `%s`",
-		w.Account, solanautil.ParseSOL(balance).Truncate(5), solanautil.ParseUnits(usdcBalance, decimals).Truncate(5))
+	text := fmt.Sprintf("Solana 网格机器人 | 盈利如春雨, 润物无声, 渐丰收! \n\n💳 %s (默认):\n`%s`\n\n💰    SOL余额: `%s`\n💰 USDC余额: `%s`",
+		wallethandler.WalletName(w), w.Account, solanautil.ParseSOL(balance).Truncate(5), solanautil.ParseUnits(usdcBalance, decimals).Truncate(5))
 	text = text + fmt.Sprintf("\n\n[OKX](https://web3.okx.com/zh-hant/portfolio/%s/analysis?chainIndex=501) | [GMGN](https://gmgn.ai/sol/address/%s) | [Solscan](https://solscan.io/account/%s)", w.Account, w.Account, w.Account)
 	_, err = utils.ReplyMessage(s.botApi, update, text, markup)
 	if err != nil {
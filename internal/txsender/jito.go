@@ -0,0 +1,104 @@
+package txsender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+// Jito 小费账户
+var jitoTipAccounts = []string{
+	"96gYZGLnJYVFmbjzopPSU6QiEV5fGqZNyN9nmNhvrZU5",
+	"HFqU5x63VTqvQss8hp11i4wVV8bD44PvwucfZ2bU7gRe",
+	"Cw8CFyM9FkoMi7K7Crf6HNQqf4uEMzpKw6QNghXLvLkY",
+	"ADaUMid9yfUytqMBgopwjb2DTLSokTSzL1zt6iGPaS49",
+	"DfXygSm4jCyNCybVYYK6DwvWqjKee8pbDmJGcLWNDXjh",
+	"ADuUkR4vqLUMWXxW9gh6D6L8pMSawimctcNZ5pGwDcEt",
+	"DttWaMuVvTiduZRnguLF7jNxTgiMBZ1hyAumKUiL2KRL",
+	"3AVi9Tg9Uo68tJfuvoKvqKNWKkC5wPdSSdeBnizKZ6jT",
+}
+
+type jsonRpcRequest struct {
+	Jsonrpc string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type jsonRpcResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// newTipTransaction 创建 Jito 小费转账交易, 与兑换交易使用相同的区块哈希
+func newTipTransaction(wallet *solana.Wallet, lamports uint64, blockhash solana.Hash) (*solana.Transaction, error) {
+	tipAccount := solana.MustPublicKeyFromBase58(jitoTipAccounts[rand.IntN(len(jitoTipAccounts))])
+	instruction := system.NewTransferInstruction(lamports, wallet.PublicKey(), tipAccount).Build()
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{instruction},
+		blockhash,
+		solana.TransactionPayer(wallet.PublicKey()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(wallet.PublicKey()) {
+			return &wallet.PrivateKey
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// sendBundle 通过 Jito Block Engine 发送交易包
+func (s *TxSender) sendBundle(ctx context.Context, txs []*solana.Transaction) (string, error) {
+	encoded := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		data, err := tx.ToBase64()
+		if err != nil {
+			return "", err
+		}
+		encoded = append(encoded, data)
+	}
+
+	httpClient := new(http.Client)
+	if s.transportProxy != nil {
+		httpClient.Transport = s.transportProxy
+	}
+
+	var response jsonRpcResponse
+	req := jsonRpcRequest{
+		Jsonrpc: "2.0",
+		ID:      1,
+		Method:  "sendBundle",
+		Params:  []any{encoded, map[string]string{"encoding": "base64"}},
+	}
+	err := requests.URL(fmt.Sprintf("%s/api/v1/bundles", s.jitoUrl)).
+		Client(httpClient).
+		BodyJSON(&req).
+		ToJSON(&response).
+		Fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if response.Error != nil {
+		return "", errors.New(response.Error.Message)
+	}
+	return response.Result, nil
+}
@@ -0,0 +1,345 @@
+package txsender
+
+// 交易发送器
+// 在区块哈希过期前持续重新广播已签名交易, 过期后给出明确的 expired 状态
+// 设置 Jito 小费时通过 Jito Block Engine 以交易包方式发送
+// 设置模拟参数时先模拟执行, 模拟失败或输出不足时不发送交易
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fachebot/sol-grid-bot/internal/logger"
+	"github.com/fachebot/sol-grid-bot/internal/utils/solanautil"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+const (
+	rebroadcastInterval = 2 * time.Second  // 重新广播间隔
+	retentionDuration   = 10 * time.Minute // 终态交易保留时间
+)
+
+type TxState int
+
+const (
+	TxStateUnknown   TxState = iota // 未被发送器跟踪
+	TxStatePending                  // 等待上链, 持续重新广播
+	TxStateConfirmed                // 已上链(成功或失败)
+	TxStateExpired                  // 区块哈希已过期且未上链
+)
+
+func (state TxState) String() string {
+	switch state {
+	case TxStatePending:
+		return "pending"
+	case TxStateConfirmed:
+		return "confirmed"
+	case TxStateExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// SendOptions 交易发送参数
+type SendOptions struct {
+	MinContextSlot       uint64
+	LastValidBlockHeight uint64
+	MaxRetries           uint
+	Wallet               *solana.Wallet // 签名小费交易的钱包
+	JitoTip              uint64         // Jito 小费(lamports), 为 0 时通过 RPC 发送
+	Simulation           *Simulation    // 发送前模拟执行, 为空时跳过
+}
+
+// Simulation 交易模拟参数
+type Simulation struct {
+	OutputMint   solana.PublicKey // 输出代币
+	MinOutAmount uint64           // 最少输出数量
+}
+
+type trackedTx struct {
+	tx                   *solana.Transaction
+	tipTx                *solana.Transaction
+	tip                  uint64
+	signature            solana.Signature
+	lastValidBlockHeight uint64
+	state                TxState
+	updatedAt            time.Time
+}
+
+type TxSender struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	stopChan       chan struct{}
+	solanaRpc      *rpc.Client
+	jitoUrl        string
+	transportProxy *http.Transport
+	mutex          sync.Mutex
+	txs            map[string]*trackedTx
+}
+
+func NewTxSender(solanaRpc *rpc.Client, jitoUrl string, transportProxy *http.Transport) *TxSender {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TxSender{
+		ctx:            ctx,
+		cancel:         cancel,
+		solanaRpc:      solanaRpc,
+		jitoUrl:        jitoUrl,
+		transportProxy: transportProxy,
+		txs:            make(map[string]*trackedTx),
+	}
+}
+
+func (s *TxSender) Stop() {
+	if s.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[TxSender] 准备停止服务")
+
+	s.cancel()
+
+	<-s.stopChan
+	close(s.stopChan)
+	s.stopChan = nil
+
+	logger.Infof("[TxSender] 服务已经停止")
+}
+
+func (s *TxSender) Start() {
+	if s.stopChan != nil {
+		return
+	}
+
+	s.stopChan = make(chan struct{})
+	logger.Infof("[TxSender] 开始运行服务")
+	go s.run()
+}
+
+// Send 广播已签名交易, 发送成功后在区块哈希过期前持续重新广播
+// 广播请求失败时交易仍可能已经上链, 此时同样返回交易哈希并继续跟踪, 只有广播前失败时返回空哈希
+func (s *TxSender) Send(ctx context.Context, tx *solana.Transaction, opts SendOptions) (string, error) {
+	signature := tx.Signatures[0]
+	if opts.Simulation != nil && opts.Wallet != nil {
+		if err := s.simulate(ctx, tx, opts.Wallet.PublicKey(), opts.Simulation); err != nil {
+			return "", err
+		}
+	}
+
+	item := &trackedTx{
+		tx:                   tx,
+		signature:            signature,
+		lastValidBlockHeight: opts.LastValidBlockHeight,
+		state:                TxStatePending,
+	}
+
+	var err error
+	if opts.JitoTip > 0 && opts.Wallet != nil {
+		// 创建小费交易
+		tipTx, tipErr := newTipTransaction(opts.Wallet, opts.JitoTip, tx.Message.RecentBlockhash)
+		if tipErr != nil {
+			return "", tipErr
+		}
+		item.tipTx = tipTx
+		item.tip = opts.JitoTip
+
+		// 发送交易包
+		var bundleId string
+		bundleId, err = s.sendBundle(ctx, []*solana.Transaction{tx, tipTx})
+		if err == nil {
+			logger.Debugf("[TxSender] 发送交易包成功, hash: %s, bundleId: %s, tip: %d", signature, bundleId, opts.JitoTip)
+		}
+	} else {
+		_, err = s.solanaRpc.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{
+			MaxRetries:          &opts.MaxRetries,
+			MinContextSlot:      &opts.MinContextSlot,
+			SkipPreflight:       true,
+			PreflightCommitment: rpc.CommitmentProcessed,
+		})
+	}
+
+	s.mutex.Lock()
+	item.updatedAt = time.Now()
+	s.txs[signature.String()] = item
+	s.mutex.Unlock()
+
+	return signature.String(), err
+}
+
+// simulate 模拟执行交易, 校验输出代币数量不低于最少输出
+func (s *TxSender) simulate(ctx context.Context, tx *solana.Transaction, owner solana.PublicKey, simulation *Simulation) error {
+	outAmount, err := solanautil.SimulateSwap(ctx, s.solanaRpc, tx, owner, simulation.OutputMint)
+	if err != nil {
+		return err
+	}
+
+	if outAmount.Sign() < 0 || !outAmount.IsUint64() || outAmount.Uint64() < simulation.MinOutAmount {
+		return &solanautil.SimulationError{
+			Err: fmt.Sprintf("insufficient output amount, expected: %d, simulated: %s", simulation.MinOutAmount, outAmount),
+		}
+	}
+
+	logger.Debugf("[TxSender] 模拟交易成功, hash: %s, outputMint: %s, outAmount: %s", tx.Signatures[0], simulation.OutputMint, outAmount)
+	return nil
+}
+
+// State 查询交易状态, 未被跟踪(例如程序重启)时返回 TxStateUnknown
+func (s *TxSender) State(hash string) TxState {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	item, ok := s.txs[hash]
+	if !ok {
+		return TxStateUnknown
+	}
+	return item.state
+}
+
+// Tip 查询交易支付的 Jito 小费(lamports), 未被跟踪时返回 0
+func (s *TxSender) Tip(hash string) uint64 {
+	if s == nil {
+		return 0
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	item, ok := s.txs[hash]
+	if !ok {
+		return 0
+	}
+	return item.tip
+}
+
+// Abandon 停止跟踪并不再重新广播交易, 调用方放弃等待交易结果时使用
+func (s *TxSender) Abandon(hash string) {
+	if s == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.txs, hash)
+}
+
+func (s *TxSender) run() {
+	ticker := time.NewTicker(rebroadcastInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.handleRebroadcast()
+		case <-s.ctx.Done():
+			s.stopChan <- struct{}{}
+			return
+		}
+	}
+}
+
+func (s *TxSender) pendingTxs() []*trackedTx {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	list := make([]*trackedTx, 0)
+	for hash, item := range s.txs {
+		if item.state == TxStatePending {
+			list = append(list, item)
+		} else if now.Sub(item.updatedAt) > retentionDuration {
+			delete(s.txs, hash)
+		}
+	}
+	return list
+}
+
+func (s *TxSender) setState(item *trackedTx, state TxState) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	item.state = state
+	item.updatedAt = time.Now()
+}
+
+func (s *TxSender) handleRebroadcast() {
+	list := s.pendingTxs()
+	if len(list) == 0 {
+		return
+	}
+
+	// 获取区块高度
+	blockHeight, err := s.solanaRpc.GetBlockHeight(s.ctx, rpc.CommitmentConfirmed)
+	if err != nil {
+		logger.Warnf("[TxSender] 获取区块高度失败, %v", err)
+		return
+	}
+
+	// 查询交易状态
+	signatures := make([]solana.Signature, 0, len(list))
+	for _, item := range list {
+		signatures = append(signatures, item.signature)
+	}
+	statuses, err := s.solanaRpc.GetSignatureStatuses(s.ctx, false, signatures...)
+	if err != nil {
+		logger.Warnf("[TxSender] 查询交易状态失败, %v", err)
+		return
+	}
+
+	for idx, item := range list {
+		if idx < len(statuses.Value) && isLanded(statuses.Value[idx]) {
+			s.setState(item, TxStateConfirmed)
+			continue
+		}
+
+		// 区块哈希过期, 查询完整历史确认交易未上链
+		if blockHeight > item.lastValidBlockHeight {
+			s.handleExpired(item)
+			continue
+		}
+
+		// 重新广播
+		if item.tipTx != nil {
+			_, err = s.sendBundle(s.ctx, []*solana.Transaction{item.tx, item.tipTx})
+		} else {
+			maxRetries := uint(0)
+			_, err = s.solanaRpc.SendTransactionWithOpts(s.ctx, item.tx, rpc.TransactionOpts{
+				MaxRetries:          &maxRetries,
+				SkipPreflight:       true,
+				PreflightCommitment: rpc.CommitmentProcessed,
+			})
+		}
+		if err != nil {
+			logger.Debugf("[TxSender] 重新广播交易失败, hash: %s, %v", item.signature, err)
+		}
+	}
+}
+
+func (s *TxSender) handleExpired(item *trackedTx) {
+	statuses, err := s.solanaRpc.GetSignatureStatuses(s.ctx, true, item.signature)
+	if err != nil {
+		logger.Warnf("[TxSender] 查询交易状态失败, hash: %s, %v", item.signature, err)
+		return
+	}
+
+	if len(statuses.Value) > 0 && isLanded(statuses.Value[0]) {
+		s.setState(item, TxStateConfirmed)
+		return
+	}
+
+	s.setState(item, TxStateExpired)
+	logger.Infof("[TxSender] 交易区块哈希已过期, hash: %s, lastValidBlockHeight: %d", item.signature, item.lastValidBlockHeight)
+}
+
+func isLanded(status *rpc.SignatureStatusesResult) bool {
+	if status == nil {
+		return false
+	}
+	return status.ConfirmationStatus == rpc.ConfirmationStatusConfirmed ||
+		status.ConfirmationStatus == rpc.ConfirmationStatusFinalized
+}
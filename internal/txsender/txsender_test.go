@@ -0,0 +1,208 @@
+package txsender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// fakeRpc 测试用 JSON-RPC 服务
+type fakeRpc struct {
+	mutex       sync.Mutex
+	sendErr     string // sendTransaction 返回的错误, 为空时返回成功
+	sendCount   int
+	blockHeight uint64
+	status      string // 最近状态缓存中的确认状态, 为空时查询不到
+	history     string // 完整历史中的确认状态, 为空时查询不到
+}
+
+func (f *fakeRpc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Id     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.Id}
+	switch req.Method {
+	case "sendTransaction":
+		f.sendCount++
+		if f.sendErr != "" {
+			resp["error"] = map[string]any{"code": -32000, "message": f.sendErr}
+		} else {
+			resp["result"] = solana.Signature{}.String()
+		}
+	case "getBlockHeight":
+		resp["result"] = f.blockHeight
+	case "getSignatureStatuses":
+		status := f.status
+		if strings.Contains(string(req.Params), `"searchTransactionHistory":true`) {
+			status = f.history
+		}
+		var value any
+		if status != "" {
+			value = map[string]any{"slot": 1, "confirmations": nil, "err": nil, "confirmationStatus": status}
+		}
+		resp["result"] = map[string]any{"context": map[string]any{"slot": 1}, "value": []any{value}}
+	default:
+		resp["error"] = map[string]any{"code": -32601, "message": "method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (f *fakeRpc) sent() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.sendCount
+}
+
+func newTestTxSender(t *testing.T, f *fakeRpc) *TxSender {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	s := NewTxSender(rpc.New(server.URL), "", nil)
+	t.Cleanup(s.cancel)
+	return s
+}
+
+func newTestTransaction(t *testing.T) *solana.Transaction {
+	wallet := solana.NewWallet()
+	instruction := system.NewTransferInstruction(1, wallet.PublicKey(), solana.NewWallet().PublicKey()).Build()
+	tx, err := solana.NewTransaction([]solana.Instruction{instruction}, solana.Hash{}, solana.TransactionPayer(wallet.PublicKey()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(wallet.PublicKey()) {
+			return &wallet.PrivateKey
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name    string
+		sendErr string
+	}{
+		{name: "广播成功"},
+		{name: "广播请求失败", sendErr: "request timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestTxSender(t, &fakeRpc{sendErr: tt.sendErr})
+			tx := newTestTransaction(t)
+
+			hash, err := s.Send(context.Background(), tx, SendOptions{LastValidBlockHeight: 100})
+			if tt.sendErr != "" && err == nil {
+				t.Fatal("Send() 应该返回错误")
+			}
+			if tt.sendErr == "" && err != nil {
+				t.Fatalf("Send() 返回错误: %v", err)
+			}
+
+			// 已经广播的交易可能上链, 必须返回哈希并继续跟踪, 调用方才不会重新报价重复成交
+			if hash != tx.Signatures[0].String() {
+				t.Fatalf("Send() = %q, 期望 %s", hash, tx.Signatures[0])
+			}
+			if state := s.State(hash); state != TxStatePending {
+				t.Errorf("State() = %s, 期望 %s", state, TxStatePending)
+			}
+		})
+	}
+}
+
+func TestHandleRebroadcast(t *testing.T) {
+	tests := []struct {
+		name        string
+		blockHeight uint64
+		status      string
+		history     string
+		expected    TxState
+		rebroadcast bool
+	}{
+		{name: "未上链继续广播", blockHeight: 50, expected: TxStatePending, rebroadcast: true},
+		{name: "已确认", blockHeight: 50, status: "confirmed", expected: TxStateConfirmed},
+		{name: "已最终确认", blockHeight: 150, status: "finalized", expected: TxStateConfirmed},
+		{name: "仅处理未确认", blockHeight: 50, status: "processed", expected: TxStatePending, rebroadcast: true},
+		{name: "区块哈希过期", blockHeight: 150, expected: TxStateExpired},
+		{name: "过期但已上链", blockHeight: 150, history: "finalized", expected: TxStateConfirmed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeRpc{}
+			s := newTestTxSender(t, f)
+
+			hash, err := s.Send(context.Background(), newTestTransaction(t), SendOptions{LastValidBlockHeight: 100})
+			if err != nil {
+				t.Fatalf("Send() 返回错误: %v", err)
+			}
+
+			f.mutex.Lock()
+			f.sendCount = 0
+			f.blockHeight = tt.blockHeight
+			f.status = tt.status
+			f.history = tt.history
+			f.mutex.Unlock()
+
+			s.handleRebroadcast()
+
+			if state := s.State(hash); state != tt.expected {
+				t.Errorf("State() = %s, 期望 %s", state, tt.expected)
+			}
+			if rebroadcast := f.sent() > 0; rebroadcast != tt.rebroadcast {
+				t.Errorf("重新广播 = %v, 期望 %v", rebroadcast, tt.rebroadcast)
+			}
+		})
+	}
+}
+
+func TestAbandon(t *testing.T) {
+	f := &fakeRpc{}
+	s := newTestTxSender(t, f)
+
+	hash, err := s.Send(context.Background(), newTestTransaction(t), SendOptions{LastValidBlockHeight: 100})
+	if err != nil {
+		t.Fatalf("Send() 返回错误: %v", err)
+	}
+
+	f.mutex.Lock()
+	f.sendCount = 0
+	f.blockHeight = 50
+	f.mutex.Unlock()
+
+	// 放弃后不再跟踪, 也不再重新广播
+	s.Abandon(hash)
+	s.handleRebroadcast()
+
+	if state := s.State(hash); state != TxStateUnknown {
+		t.Errorf("State() = %s, 期望 %s", state, TxStateUnknown)
+	}
+	if f.sent() > 0 {
+		t.Error("放弃的交易不应重新广播")
+	}
+}
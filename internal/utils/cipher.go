@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	keyCipherPrefix  = "v1$"
+	keyCipherSaltLen = 16
+	keyCipherKeyLen  = 32
+
+	// Argon2id 参数
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+)
+
+var ErrInvalidCipherText = errors.New("invalid cipher text")
+
+// KeyCipher 使用主密码经 Argon2id 派生的密钥以 AES-GCM 加密私钥
+//
+// 密文格式为 v1$<盐>$<随机数+密文>, 盐和密文使用 base64 编码, 每个盐派生的密钥会被缓存
+type KeyCipher struct {
+	passphrase []byte
+	salt       []byte
+	mutex      sync.Mutex
+	keys       map[string][]byte
+}
+
+func NewKeyCipher(passphrase string) (*KeyCipher, error) {
+	if passphrase == "" {
+		return nil, errors.New("empty passphrase")
+	}
+
+	salt := make([]byte, keyCipherSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return &KeyCipher{
+		passphrase: []byte(passphrase),
+		salt:       salt,
+		keys:       make(map[string][]byte),
+	}, nil
+}
+
+// IsKeyCipherText 判断是否为 KeyCipher 加密的密文
+func IsKeyCipherText(message string) bool {
+	return strings.HasPrefix(message, keyCipherPrefix)
+}
+
+func (c *KeyCipher) Encryption(message string) (string, error) {
+	aead, err := c.aead(c.salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(message), nil)
+	return keyCipherPrefix + base64.RawStdEncoding.EncodeToString(c.salt) + "$" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *KeyCipher) Decryption(message string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(message, keyCipherPrefix), "$")
+	if !IsKeyCipherText(message) || len(parts) != 2 {
+		return "", ErrInvalidCipherText
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[0])
+	if err != nil || len(salt) != keyCipherSaltLen {
+		return "", ErrInvalidCipherText
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidCipherText
+	}
+
+	aead, err := c.aead(salt)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCipherText
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func (c *KeyCipher) aead(salt []byte) (cipher.AEAD, error) {
+	c.mutex.Lock()
+	key, ok := c.keys[string(salt)]
+	if !ok {
+		key = argon2.IDKey(c.passphrase, salt, argon2Time, argon2Memory, argon2Threads, keyCipherKeyLen)
+		c.keys[string(salt)] = key
+	}
+	c.mutex.Unlock()
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
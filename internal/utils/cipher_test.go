@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestKeyCipher(t *testing.T) {
+	const message = "4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw9Ve8f3gKHNRzn7Jhd3kFUdWWNsz6BoxQ9iP1PKTUqXHmA"
+
+	c, err := NewKeyCipher("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := c.Encryption(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsKeyCipherText(encrypted) {
+		t.Fatalf("密文缺少版本前缀: %s", encrypted)
+	}
+	if len(encrypted) > 200 {
+		t.Fatalf("密文长度超过字段限制: %d", len(encrypted))
+	}
+
+	// 不同实例使用相同主密码可以解密
+	other, err := NewKeyCipher("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := other.Decryption(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != message {
+		t.Fatalf("解密结果不一致: %s", decrypted)
+	}
+
+	// 主密码错误时解密失败
+	wrong, err := NewKeyCipher("wrong")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = wrong.Decryption(encrypted); err == nil {
+		t.Fatal("主密码错误时应解密失败")
+	}
+
+	// 密文被篡改时解密失败
+	tampered := encrypted[:len(encrypted)-2] + "AA"
+	if tampered == encrypted {
+		tampered = encrypted[:len(encrypted)-2] + "BB"
+	}
+	if _, err = c.Decryption(tampered); err == nil {
+		t.Fatal("密文被篡改时应解密失败")
+	}
+
+	if _, err = c.Decryption("not-encrypted"); err != ErrInvalidCipherText {
+		t.Fatalf("非密文应返回 ErrInvalidCipherText: %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+package format
+
+// ShortAddress 缩写地址, 保留首尾各4个字符
+func ShortAddress(address string) string {
+	if len(address) <= 10 {
+		return address
+	}
+	return address[:4] + "..." + address[len(address)-4:]
+}
@@ -2,11 +2,20 @@ package utils
 
 import (
 	"errors"
+	"math"
 	"slices"
 
 	"github.com/shopspring/decimal"
 )
 
+// MaxArithmeticGridLevels 等差网格最大格数, 避免间隔过小时生成过多网格
+const MaxArithmeticGridLevels = 500
+
+// MaxMartinMultiple 马丁加仓后单格买入金额最多为首格的倍数, 避免网格数量较多时底部网格金额指数膨胀
+const MaxMartinMultiple = 100
+
+var ErrTooManyGridLevels = errors.New("too many grid levels")
+
 func GenerateGrid(lowerPriceBound, upperPriceBound, takeProfitRatio decimal.Decimal) ([]decimal.Decimal, error) {
 	if lowerPriceBound.LessThanOrEqual(decimal.Zero) {
 		return nil, errors.New("lower price bound must be positive")
@@ -28,6 +37,76 @@ func GenerateGrid(lowerPriceBound, upperPriceBound, takeProfitRatio decimal.Deci
 	return result, nil
 }
 
+func GenerateArithmeticGrid(lowerPriceBound, upperPriceBound, priceStep decimal.Decimal) ([]decimal.Decimal, error) {
+	if lowerPriceBound.LessThanOrEqual(decimal.Zero) {
+		return nil, errors.New("lower price bound must be positive")
+	}
+	if upperPriceBound.LessThanOrEqual(lowerPriceBound) {
+		return nil, errors.New("upper price bound must be greater than lower price bound")
+	}
+	if priceStep.LessThanOrEqual(decimal.Zero) {
+		return nil, errors.New("price step must be positive")
+	}
+	if priceStep.Mul(decimal.NewFromInt(MaxArithmeticGridLevels)).LessThan(upperPriceBound.Sub(lowerPriceBound)) {
+		return nil, ErrTooManyGridLevels
+	}
+
+	result := make([]decimal.Decimal, 0)
+	for idx := int64(0); ; idx++ {
+		grid := lowerPriceBound.Add(priceStep.Mul(decimal.NewFromInt(idx)))
+		if grid.GreaterThanOrEqual(upperPriceBound) {
+			break
+		}
+		result = append(result, grid)
+	}
+	return result, nil
+}
+
+// CalculateArithmeticStep 按网格数量计算等差网格的价格间隔, 向上取整避免多生成一格
+func CalculateArithmeticStep(lowerPriceBound, upperPriceBound decimal.Decimal, gridCount int) (decimal.Decimal, error) {
+	if gridCount <= 0 {
+		return decimal.Zero, errors.New("grid count must be positive")
+	}
+	if gridCount > MaxArithmeticGridLevels {
+		return decimal.Zero, ErrTooManyGridLevels
+	}
+	if upperPriceBound.LessThanOrEqual(lowerPriceBound) {
+		return decimal.Zero, errors.New("upper price bound must be greater than lower price bound")
+	}
+
+	priceRange := upperPriceBound.Sub(lowerPriceBound)
+	return priceRange.DivRound(decimal.NewFromInt(int64(gridCount)), 24).RoundUp(20), nil
+}
+
+// CalculateGridOrderSize 计算网格买入金额, 网格顶部以下每下降一格金额乘以 martinFactor, 最多为首格的 MaxMartinMultiple 倍
+func CalculateGridOrderSize(initialOrderSize decimal.Decimal, martinFactor float64, gridCount, gridNumber int) decimal.Decimal {
+	levels := gridCount - 1 - gridNumber
+	if martinFactor <= 1 || levels <= 0 {
+		return initialOrderSize
+	}
+
+	if math.Pow(martinFactor, float64(levels)) >= MaxMartinMultiple {
+		return initialOrderSize.Mul(decimal.NewFromInt(MaxMartinMultiple))
+	}
+
+	factor := decimal.NewFromFloat(martinFactor).Pow(decimal.NewFromInt(int64(levels)))
+	return initialOrderSize.Mul(factor).Round(6)
+}
+
+// CalculateGridTotalCapital 计算买满网格所需资金, maxGridLimit 大于0时只计算最底部的 maxGridLimit 个网格
+func CalculateGridTotalCapital(initialOrderSize decimal.Decimal, martinFactor float64, gridCount, maxGridLimit int) decimal.Decimal {
+	count := gridCount
+	if maxGridLimit > 0 && maxGridLimit < gridCount {
+		count = maxGridLimit
+	}
+
+	total := decimal.Zero
+	for gridNumber := range count {
+		total = total.Add(CalculateGridOrderSize(initialOrderSize, martinFactor, gridCount, gridNumber))
+	}
+	return total
+}
+
 func CalculateGridPosition(gridList []decimal.Decimal, price decimal.Decimal) (int, bool) {
 	if len(gridList) == 0 {
 		return 0, false
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGenerateArithmeticGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		lower    string
+		upper    string
+		step     string
+		expected []string
+		wantErr  bool
+		tooMany  bool
+	}{
+		{name: "整除区间", lower: "1", upper: "2", step: "0.25", expected: []string{"1", "1.25", "1.5", "1.75"}},
+		{name: "不整除区间", lower: "1", upper: "2", step: "0.3", expected: []string{"1", "1.3", "1.6", "1.9"}},
+		{name: "间隔大于区间", lower: "1", upper: "2", step: "5", expected: []string{"1"}},
+		{name: "刚好达到上限格数", lower: "1", upper: "2", step: "0.002", expected: nil},
+		{name: "超过上限格数", lower: "1", upper: "2", step: "0.0019", wantErr: true, tooMany: true},
+		{name: "极小间隔", lower: "1", upper: "1000000", step: "0.000000000000000001", wantErr: true, tooMany: true},
+		{name: "下限为零", lower: "0", upper: "2", step: "0.1", wantErr: true},
+		{name: "上限不大于下限", lower: "2", upper: "2", step: "0.1", wantErr: true},
+		{name: "间隔为零", lower: "1", upper: "2", step: "0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateArithmeticGrid(decimal.RequireFromString(tt.lower), decimal.RequireFromString(tt.upper), decimal.RequireFromString(tt.step))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GenerateArithmeticGrid() 应该返回错误, 结果: %v", got)
+				}
+				if tt.tooMany && !errors.Is(err, ErrTooManyGridLevels) {
+					t.Fatalf("GenerateArithmeticGrid() 返回错误 %v, 期望 %v", err, ErrTooManyGridLevels)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateArithmeticGrid() 返回错误: %v", err)
+			}
+
+			if tt.expected == nil {
+				if len(got) != MaxArithmeticGridLevels {
+					t.Fatalf("GenerateArithmeticGrid() 生成 %d 格, 期望 %d 格", len(got), MaxArithmeticGridLevels)
+				}
+				return
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("GenerateArithmeticGrid() = %v, 期望 %v", got, tt.expected)
+			}
+			for idx := range got {
+				if !got[idx].Equal(decimal.RequireFromString(tt.expected[idx])) {
+					t.Errorf("GenerateArithmeticGrid()[%d] = %s, 期望 %s", idx, got[idx], tt.expected[idx])
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateArithmeticStep(t *testing.T) {
+	tests := []struct {
+		name      string
+		lower     string
+		upper     string
+		gridCount int
+		expected  string
+		wantErr   bool
+	}{
+		{name: "整除区间", lower: "1", upper: "2", gridCount: 4, expected: "0.25"},
+		{name: "不整除向上取整", lower: "1", upper: "2", gridCount: 3, expected: "0.33333333333333333334"},
+		{name: "最大格数", lower: "1", upper: "2", gridCount: MaxArithmeticGridLevels, expected: "0.002"},
+		{name: "超过最大格数", lower: "1", upper: "2", gridCount: MaxArithmeticGridLevels + 1, wantErr: true},
+		{name: "格数为零", lower: "1", upper: "2", gridCount: 0, wantErr: true},
+		{name: "上限不大于下限", lower: "2", upper: "1", gridCount: 10, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper := decimal.RequireFromString(tt.lower), decimal.RequireFromString(tt.upper)
+			got, err := CalculateArithmeticStep(lower, upper, tt.gridCount)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("CalculateArithmeticStep() 应该返回错误, 结果: %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CalculateArithmeticStep() 返回错误: %v", err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("CalculateArithmeticStep() = %s, 期望 %s", got, tt.expected)
+			}
+
+			// 按计算出的间隔生成的格数不能超过设置的数量
+			grid, err := GenerateArithmeticGrid(lower, upper, got)
+			if err != nil {
+				t.Fatalf("GenerateArithmeticGrid() 返回错误: %v", err)
+			}
+			if len(grid) != tt.gridCount {
+				t.Errorf("生成 %d 格, 期望 %d 格", len(grid), tt.gridCount)
+			}
+		})
+	}
+}
+
+func TestCalculateGridOrderSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		martinFactor float64
+		gridCount    int
+		gridNumber   int
+		expected     string
+	}{
+		{name: "顶部网格", martinFactor: 1.5, gridCount: 5, gridNumber: 4, expected: "10"},
+		{name: "向下一格", martinFactor: 1.5, gridCount: 5, gridNumber: 3, expected: "15"},
+		{name: "底部网格", martinFactor: 1.5, gridCount: 5, gridNumber: 0, expected: "50.625"},
+		{name: "保留6位小数", martinFactor: 1.1, gridCount: 10, gridNumber: 0, expected: "23.579477"},
+		{name: "倍数为1", martinFactor: 1, gridCount: 5, gridNumber: 0, expected: "10"},
+		{name: "倍数小于1", martinFactor: 0.5, gridCount: 5, gridNumber: 0, expected: "10"},
+		{name: "编号超出网格", martinFactor: 1.5, gridCount: 5, gridNumber: 8, expected: "10"},
+		{name: "超过最大倍数", martinFactor: 2, gridCount: 10, gridNumber: 0, expected: "1000"},
+		{name: "网格数量过多", martinFactor: 1.5, gridCount: MaxArithmeticGridLevels, gridNumber: 0, expected: "1000"},
+	}
+
+	initialOrderSize := decimal.NewFromInt(10)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateGridOrderSize(initialOrderSize, tt.martinFactor, tt.gridCount, tt.gridNumber)
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("CalculateGridOrderSize(%v, %d, %d) = %s, 期望 %s", tt.martinFactor, tt.gridCount, tt.gridNumber, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateGridTotalCapital(t *testing.T) {
+	tests := []struct {
+		name         string
+		martinFactor float64
+		gridCount    int
+		maxGridLimit int
+		expected     string
+	}{
+		{name: "没有马丁倍数", martinFactor: 1, gridCount: 4, expected: "40"},
+		{name: "马丁倍数", martinFactor: 1.5, gridCount: 5, expected: "131.875"},
+		{name: "只计算底部网格", martinFactor: 1.5, gridCount: 5, maxGridLimit: 2, expected: "84.375"},
+		{name: "限制超过网格数量", martinFactor: 1.5, gridCount: 5, maxGridLimit: 10, expected: "131.875"},
+		{name: "没有网格", martinFactor: 1.5, gridCount: 0, expected: "0"},
+		{name: "底部网格达到最大倍数", martinFactor: 2, gridCount: 9, expected: "3270"},
+	}
+
+	initialOrderSize := decimal.NewFromInt(10)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateGridTotalCapital(initialOrderSize, tt.martinFactor, tt.gridCount, tt.maxGridLimit)
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("CalculateGridTotalCapital(%v, %d, %d) = %s, 期望 %s", tt.martinFactor, tt.gridCount, tt.maxGridLimit, got, tt.expected)
+			}
+		})
+	}
+}
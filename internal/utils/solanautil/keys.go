@@ -0,0 +1,53 @@
+package solanautil
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+var ErrInvalidPrivateKey = errors.New("invalid private key")
+
+// ParsePrivateKey 解析 base58 编码或 JSON 字节数组格式(solana-keygen 导出格式)的私钥
+func ParsePrivateKey(text string) (solana.PrivateKey, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	if strings.HasPrefix(text, "[") {
+		var values []int
+		if err := json.Unmarshal([]byte(text), &values); err != nil {
+			return nil, ErrInvalidPrivateKey
+		}
+		if len(values) != ed25519.PrivateKeySize {
+			return nil, ErrInvalidPrivateKey
+		}
+
+		data := make([]byte, len(values))
+		for idx, v := range values {
+			if v < 0 || v > 255 {
+				return nil, ErrInvalidPrivateKey
+			}
+			data[idx] = byte(v)
+		}
+		text = solana.PrivateKey(data).String()
+	}
+
+	w, err := solana.WalletFromPrivateKeyBase58(text)
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	// 公钥部分必须与种子推导结果一致
+	expected := ed25519.NewKeyFromSeed(w.PrivateKey[:ed25519.SeedSize])
+	if !bytes.Equal(expected, w.PrivateKey) {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	return w.PrivateKey, nil
+}
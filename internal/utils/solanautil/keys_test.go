@@ -0,0 +1,53 @@
+package solanautil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+func TestParsePrivateKey(t *testing.T) {
+	privateKey := solana.NewWallet().PrivateKey
+
+	pk, err := ParsePrivateKey(" " + privateKey.String() + "\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pk.PublicKey() != privateKey.PublicKey() {
+		t.Fatalf("base58 私钥解析结果不一致: %s", pk.PublicKey())
+	}
+
+	values := make([]int, len(privateKey))
+	for idx, b := range privateKey {
+		values[idx] = int(b)
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err = ParsePrivateKey(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pk.PublicKey() != privateKey.PublicKey() {
+		t.Fatalf("字节数组私钥解析结果不一致: %s", pk.PublicKey())
+	}
+
+	// 公钥部分被篡改
+	tampered := make([]byte, len(privateKey))
+	copy(tampered, privateKey)
+	tampered[63] ^= 0xff
+	invalid := []string{
+		"",
+		"not-a-key",
+		"[1,2,3]",
+		solana.PrivateKey(tampered).String(),
+		privateKey.PublicKey().String(),
+	}
+	for _, text := range invalid {
+		if _, err = ParsePrivateKey(text); err != ErrInvalidPrivateKey {
+			t.Fatalf("无效私钥应返回 ErrInvalidPrivateKey: %q, %v", text, err)
+		}
+	}
+}
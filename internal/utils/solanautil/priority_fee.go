@@ -0,0 +1,170 @@
+package solanautil
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"slices"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+const (
+	defaultComputeUnitLimit  = 200000  // 未设置计算单元上限时每条指令的默认值
+	maxComputeUnitLimit      = 1400000 // 交易计算单元上限
+	maxPrioritizationAccount = 128     // getRecentPrioritizationFees 最多支持的账户数量
+
+	computeUnitLimitInstruction = 2 // SetComputeUnitLimit 指令类型
+	computeUnitPriceInstruction = 3 // SetComputeUnitPrice 指令类型
+)
+
+// PriorityFeePercentile 优先级别对应的近期优先费百分位
+func PriorityFeePercentile(level string) int {
+	switch level {
+	case "medium":
+		return 50
+	case "high":
+		return 75
+	case "veryHigh":
+		return 90
+	default:
+		return 0
+	}
+}
+
+// EstimatePriorityFee 查询可写账户的近期优先费, 返回指定百分位的计算单元价格(micro-lamports)
+func EstimatePriorityFee(ctx context.Context, solanaRpc *rpc.Client, accounts solana.PublicKeySlice, percentile int) (uint64, error) {
+	if percentile <= 0 {
+		return 0, nil
+	}
+	if len(accounts) > maxPrioritizationAccount {
+		accounts = accounts[:maxPrioritizationAccount]
+	}
+
+	fees, err := solanaRpc.GetRecentPrioritizationFees(ctx, accounts)
+	if err != nil {
+		return 0, err
+	}
+	if len(fees) == 0 {
+		return 0, nil
+	}
+
+	values := make([]uint64, 0, len(fees))
+	for _, item := range fees {
+		values = append(values, item.PrioritizationFee)
+	}
+	slices.Sort(values)
+
+	return values[(len(values)-1)*percentile/100], nil
+}
+
+// SetPriorityFee 根据交易可写账户估算优先费并改写 SetComputeUnitPrice 指令, 优先费总额不超过 maxLamports
+// 交易中没有 SetComputeUnitPrice 指令时不做修改, 返回设置的计算单元价格(micro-lamports)
+func SetPriorityFee(ctx context.Context, solanaRpc *rpc.Client, tx *solana.Transaction, tables map[solana.PublicKey]solana.PublicKeySlice, level string, maxLamports uint64) (uint64, error) {
+	msg := &tx.Message
+	budget := parseComputeBudget(msg)
+	if budget.priceIdx < 0 {
+		return 0, nil
+	}
+
+	// 估算优先费
+	price, err := EstimatePriorityFee(ctx, solanaRpc, writableAccounts(msg, tables), PriorityFeePercentile(level))
+	if err != nil {
+		return 0, err
+	}
+	if maxLamports > 0 && budget.unitLimit > 0 {
+		price = min(price, maxLamports*1000000/budget.unitLimit)
+	}
+
+	data := make([]byte, 9)
+	data[0] = computeUnitPriceInstruction
+	binary.LittleEndian.PutUint64(data[1:], price)
+	msg.Instructions[budget.priceIdx].Data = data
+
+	return price, nil
+}
+
+// computeBudget 交易的计算单元设置
+type computeBudget struct {
+	unitLimit uint64 // 计算单元上限, 未设置时按指令数量计算默认值
+	unitPrice uint64 // 计算单元价格(micro-lamports)
+	priceIdx  int    // SetComputeUnitPrice 指令位置, 没有时为 -1
+}
+
+// parseComputeBudget 读取交易中的计算单元指令
+func parseComputeBudget(msg *solana.Message) computeBudget {
+	program := -1
+	for idx, key := range msg.AccountKeys {
+		if key.Equals(solana.ComputeBudget) {
+			program = idx
+			break
+		}
+	}
+
+	budget := computeBudget{priceIdx: -1}
+	instructions := 0
+	for idx, inst := range msg.Instructions {
+		if int(inst.ProgramIDIndex) != program || len(inst.Data) == 0 {
+			instructions++
+			continue
+		}
+
+		switch inst.Data[0] {
+		case computeUnitLimitInstruction:
+			if len(inst.Data) >= 5 {
+				budget.unitLimit = uint64(binary.LittleEndian.Uint32(inst.Data[1:5]))
+			}
+		case computeUnitPriceInstruction:
+			if len(inst.Data) >= 9 {
+				budget.priceIdx = idx
+				budget.unitPrice = binary.LittleEndian.Uint64(inst.Data[1:9])
+			}
+		}
+	}
+	if budget.unitLimit == 0 {
+		budget.unitLimit = min(uint64(instructions)*defaultComputeUnitLimit, maxComputeUnitLimit)
+	}
+	return budget
+}
+
+// transactionFee 按签名数量和计算单元设置计算交易费用(lamports)
+func transactionFee(msg *solana.Message) uint64 {
+	budget := parseComputeBudget(msg)
+	priorityFee := new(big.Int).Mul(new(big.Int).SetUint64(budget.unitLimit), new(big.Int).SetUint64(budget.unitPrice))
+	priorityFee.Add(priorityFee, big.NewInt(999999))
+	priorityFee.Div(priorityFee, big.NewInt(1000000))
+	return uint64(msg.Header.NumRequiredSignatures)*lamportsPerSignature + priorityFee.Uint64()
+}
+
+// writableAccounts 获取交易的可写账户, 包括地址查找表中的可写账户
+func writableAccounts(msg *solana.Message, tables map[solana.PublicKey]solana.PublicKeySlice) solana.PublicKeySlice {
+	h := msg.Header
+	numSigned := int(h.NumRequiredSignatures)
+	numStatic := len(msg.AccountKeys)
+
+	accounts := make(solana.PublicKeySlice, 0, numStatic)
+	for idx, key := range msg.AccountKeys {
+		if idx < numSigned {
+			if idx < numSigned-int(h.NumReadonlySignedAccounts) {
+				accounts = append(accounts, key)
+			}
+		} else if idx < numStatic-int(h.NumReadonlyUnsignedAccounts) {
+			accounts = append(accounts, key)
+		}
+	}
+
+	for _, lookup := range msg.AddressTableLookups {
+		table, ok := tables[lookup.AccountKey]
+		if !ok {
+			continue
+		}
+		for _, idx := range lookup.WritableIndexes {
+			if int(idx) < len(table) {
+				accounts = append(accounts, table[idx])
+			}
+		}
+	}
+
+	return accounts
+}
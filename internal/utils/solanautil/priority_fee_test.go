@@ -0,0 +1,120 @@
+package solanautil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func newTestTransaction(t *testing.T, unitLimit uint32, unitPrice uint64) *solana.Transaction {
+	payer := solana.NewWallet().PublicKey()
+	instructions := make([]solana.Instruction, 0)
+	if unitLimit > 0 {
+		instructions = append(instructions, computebudget.NewSetComputeUnitLimitInstruction(unitLimit).Build())
+	}
+	if unitPrice > 0 {
+		instructions = append(instructions, computebudget.NewSetComputeUnitPriceInstruction(unitPrice).Build())
+	}
+	instructions = append(instructions, system.NewTransferInstruction(1, payer, solana.NewWallet().PublicKey()).Build())
+
+	tx, err := solana.NewTransaction(instructions, solana.Hash{}, solana.TransactionPayer(payer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestTransactionFee(t *testing.T) {
+	tests := []struct {
+		name      string
+		unitLimit uint32
+		unitPrice uint64
+		expected  uint64
+	}{
+		{name: "没有优先费", expected: 5000},
+		{name: "设置计算单元上限和价格", unitLimit: 200000, unitPrice: 1000, expected: 5200},
+		{name: "未设置上限按指令数量计算", unitPrice: 10, expected: 5002},
+		{name: "优先费向上取整", unitLimit: 100000, unitPrice: 3, expected: 5001},
+		{name: "只设置上限", unitLimit: 300000, expected: 5000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := transactionFee(&newTestTransaction(t, tt.unitLimit, tt.unitPrice).Message)
+			if got != tt.expected {
+				t.Errorf("transactionFee() = %d, 期望 %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// newPrioritizationFeeRpc 测试用 RPC 服务, getRecentPrioritizationFees 返回指定的优先费
+func newPrioritizationFeeRpc(t *testing.T, fees []uint64) *rpc.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		result := make([]map[string]any, 0, len(fees))
+		for idx, fee := range fees {
+			result = append(result, map[string]any{"slot": idx + 1, "prioritizationFee": fee})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.Id, "result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	return rpc.New(server.URL)
+}
+
+func TestSetPriorityFee(t *testing.T) {
+	// 乱序的近期优先费
+	fees := []uint64{700, 100, 1000, 300, 500, 900, 200, 800, 400, 600}
+
+	tests := []struct {
+		name        string
+		level       string
+		unitPrice   uint64
+		maxLamports uint64
+		expected    uint64
+	}{
+		{name: "中等优先级取中位数", level: "medium", unitPrice: 1, expected: 500},
+		{name: "高优先级", level: "high", unitPrice: 1, expected: 700},
+		{name: "极高优先级", level: "veryHigh", unitPrice: 1, expected: 900},
+		{name: "未知优先级", level: "none", unitPrice: 1, expected: 0},
+		{name: "优先费不超过上限", level: "veryHigh", unitPrice: 1, maxLamports: 50, expected: 250},
+		{name: "上限高于估算值", level: "veryHigh", unitPrice: 1, maxLamports: 1000, expected: 900},
+		{name: "没有计算单元价格指令", level: "veryHigh", expected: 0},
+	}
+
+	solanaRpc := newPrioritizationFeeRpc(t, fees)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := newTestTransaction(t, 200000, tt.unitPrice)
+
+			got, err := SetPriorityFee(context.Background(), solanaRpc, tx, nil, tt.level, tt.maxLamports)
+			if err != nil {
+				t.Fatalf("SetPriorityFee() 返回错误: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("SetPriorityFee() = %d, 期望 %d", got, tt.expected)
+			}
+
+			// 交易中的计算单元价格已被改写
+			if budget := parseComputeBudget(&tx.Message); budget.priceIdx >= 0 && budget.unitPrice != tt.expected {
+				t.Errorf("计算单元价格 = %d, 期望 %d", budget.unitPrice, tt.expected)
+			}
+		})
+	}
+}
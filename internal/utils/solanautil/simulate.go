@@ -0,0 +1,114 @@
+package solanautil
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// SimulationError 交易模拟执行失败
+type SimulationError struct {
+	Err  string
+	Logs []string
+}
+
+func (e *SimulationError) Error() string {
+	return "Simulation Error: " + e.Err
+}
+
+func IsSimulationError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *SimulationError
+	return errors.As(err, &e)
+}
+
+// SimulateSwap 模拟执行兑换交易, 返回输出代币的余额变化
+// 输出 SOL 时余额变化已扣除交易费用, 这里加回交易费用, 临时 WSOL 账户的租金在关闭时已经返还
+func SimulateSwap(ctx context.Context, solanaRpc *rpc.Client, tx *solana.Transaction, owner, outputMint solana.PublicKey) (*big.Int, error) {
+	// 输出代币账户
+	account := owner
+	if !outputMint.Equals(solana.MustPublicKeyFromBase58(WSOL)) {
+		mintAta, err := GetAccountInfoJSONParsed(ctx, solanaRpc, outputMint)
+		if err != nil {
+			return nil, err
+		}
+
+		account, _, err = FindAssociatedTokenAddress(owner, outputMint, mintAta.Value.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 模拟前余额
+	pre, err := simulationBalance(ctx, solanaRpc, account, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// 模拟执行
+	result, err := solanaRpc.SimulateTransactionWithOpts(ctx, tx, &rpc.SimulateTransactionOpts{
+		Commitment: rpc.CommitmentConfirmed,
+		Accounts: &rpc.SimulateTransactionAccountsOpts{
+			Encoding:  solana.EncodingBase64,
+			Addresses: []solana.PublicKey{account},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Value == nil {
+		return nil, fmt.Errorf("empty simulation result")
+	}
+	if result.Value.Err != nil {
+		data, _ := json.Marshal(result.Value.Err)
+		return nil, &SimulationError{Err: string(data), Logs: result.Value.Logs}
+	}
+
+	// 模拟后余额
+	post := big.NewInt(0)
+	if len(result.Value.Accounts) > 0 && result.Value.Accounts[0] != nil {
+		acc := result.Value.Accounts[0]
+		if account.Equals(owner) {
+			post.SetUint64(acc.Lamports)
+			post.Add(post, new(big.Int).SetUint64(transactionFee(&tx.Message)))
+		} else if acc.Data != nil && len(acc.Data.GetBinary()) >= 72 {
+			data := acc.Data.GetBinary()
+			post.SetUint64(binary.LittleEndian.Uint64(data[64:72]))
+		}
+	}
+
+	return post.Sub(post, pre), nil
+}
+
+func simulationBalance(ctx context.Context, solanaRpc *rpc.Client, account, owner solana.PublicKey) (*big.Int, error) {
+	if account.Equals(owner) {
+		balance, err := solanaRpc.GetBalance(ctx, owner, rpc.CommitmentConfirmed)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(balance.Value), nil
+	}
+
+	balance, err := solanaRpc.GetTokenAccountBalance(ctx, account, rpc.CommitmentConfirmed)
+	if err != nil {
+		if strings.Contains(err.Error(), "could not find account") {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+
+	amount, ok := new(big.Int).SetString(balance.Value.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid token amount: %s", balance.Value.Amount)
+	}
+	return amount, nil
+}
@@ -24,6 +24,10 @@ var ErrTxNotFound = errors.New("tx not found")
 const (
 	USDC         = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
 	USDCDecimals = 6
+	SOLDecimals  = 9
+	WSOL         = "So11111111111111111111111111111111111111112"
+
+	lamportsPerSignature = 5000
 )
 
 type ProgramError struct {
@@ -40,6 +44,14 @@ type TokenBalanceChange struct {
 	Change decimal.Decimal
 }
 
+// TransactionFee 交易费用, 单位 lamports
+type TransactionFee struct {
+	BaseFee      int64 // 基础费用
+	PriorityFee  int64 // 优先费
+	ComputeUnits int64 // 消耗的计算单元
+	RentFee      int64 // 新建账户租金, 关闭账户返还租金时为负数
+}
+
 func IsProgramError(err error) bool {
 	if err == nil {
 		return false
@@ -133,6 +145,62 @@ func GetSignatureStatuses(ctx context.Context, solanaRpc *rpc.Client, txHash str
 	return status, nil
 }
 
+// GetConfirmedSignatures 批量查询交易签名状态, 返回已经确认(成功或失败)的交易哈希
+func GetConfirmedSignatures(ctx context.Context, solanaRpc *rpc.Client, hashes []string) (map[string]bool, error) {
+	sigs := make([]solana.Signature, 0, len(hashes))
+	for _, hash := range hashes {
+		sig, err := solana.SignatureFromBase58(hash)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	// 每次最多查询256个签名
+	confirmed := make(map[string]bool)
+	for _, chunk := range lo.Chunk(sigs, 256) {
+		result, err := solanaRpc.GetSignatureStatuses(ctx, true, chunk...)
+		if err != nil {
+			return nil, err
+		}
+
+		for idx, status := range result.Value {
+			if status == nil || idx >= len(chunk) {
+				continue
+			}
+			if status.ConfirmationStatus == rpc.ConfirmationStatusConfirmed ||
+				status.ConfirmationStatus == rpc.ConfirmationStatusFinalized {
+				confirmed[chunk[idx].String()] = true
+			}
+		}
+	}
+	return confirmed, nil
+}
+
+// GetRecentSignatures 查询账户最近执行成功的交易签名, 按时间倒序
+func GetRecentSignatures(ctx context.Context, solanaRpc *rpc.Client, ownerAddress string, limit int) ([]string, error) {
+	owner, err := solana.PublicKeyFromBase58(ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := solanaRpc.GetSignaturesForAddressWithOpts(ctx, owner, &rpc.GetSignaturesForAddressOpts{
+		Limit:      &limit,
+		Commitment: rpc.CommitmentConfirmed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(result))
+	for _, item := range result {
+		if item.Err == nil {
+			hashes = append(hashes, item.Signature.String())
+		}
+	}
+	return hashes, nil
+}
+
 func GetTokenMint(ctx context.Context, solanaRpc *rpc.Client, tokenAddress string) (*token.Mint, error) {
 	account, err := solana.PublicKeyFromBase58(tokenAddress)
 	if err != nil {
@@ -152,6 +220,43 @@ func GetTokenMint(ctx context.Context, solanaRpc *rpc.Client, tokenAddress strin
 	return mint, nil
 }
 
+// MintRisk 代币铸造账户的权限和扩展信息
+type MintRisk struct {
+	MintAuthority   bool     // 是否可以增发
+	FreezeAuthority bool     // 是否可以冻结持有人账户
+	Extensions      []string // Token-2022 扩展
+}
+
+// GetMintRisk 查询代币的增发权限、冻结权限和 Token-2022 扩展
+func GetMintRisk(ctx context.Context, solanaRpc *rpc.Client, tokenAddress string) (MintRisk, error) {
+	mint, err := GetTokenMint(ctx, solanaRpc, tokenAddress)
+	if err != nil {
+		return MintRisk{}, err
+	}
+
+	risk := MintRisk{
+		MintAuthority:   mint.MintAuthority != nil,
+		FreezeAuthority: mint.FreezeAuthority != nil,
+	}
+
+	mintAta, err := GetAccountInfoJSONParsed(ctx, solanaRpc, solana.MustPublicKeyFromBase58(tokenAddress))
+	if err != nil {
+		return MintRisk{}, err
+	}
+	if mintAta.Value.Owner != solana.Token2022ProgramID {
+		return risk, nil
+	}
+
+	var tokenData TokenData
+	if err = json.Unmarshal(mintAta.Value.Data.GetRawJSON(), &tokenData); err != nil {
+		return MintRisk{}, err
+	}
+	for _, ext := range tokenData.Parsed.Info.Extensions {
+		risk.Extensions = append(risk.Extensions, ext.Extension)
+	}
+	return risk, nil
+}
+
 func GetAccountInfoJSONParsed(ctx context.Context, solanaRpc *rpc.Client, account solana.PublicKey) (out *rpc.GetAccountInfoResult, err error) {
 	return solanaRpc.GetAccountInfoWithOpts(
 		ctx,
@@ -354,3 +459,101 @@ func GetTokenBalanceChanges(ctx context.Context, solanaRpc *rpc.Client, hash, ow
 
 	return changes, nil
 }
+
+// GetTransactionFee 从交易详情读取交易费用, 交易执行失败时同样收取费用
+func GetTransactionFee(ctx context.Context, solanaRpc *rpc.Client, hash, ownerAddress string) (TransactionFee, error) {
+	txSig, err := solana.SignatureFromBase58(hash)
+	if err != nil {
+		return TransactionFee{}, err
+	}
+
+	owner, err := solana.PublicKeyFromBase58(ownerAddress)
+	if err != nil {
+		return TransactionFee{}, err
+	}
+
+	maxSupportedTransactionVersion := uint64(0)
+	result, err := solanaRpc.GetTransaction(
+		ctx,
+		txSig,
+		&rpc.GetTransactionOpts{
+			Encoding:                       solana.EncodingBase64,
+			MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
+			Commitment:                     rpc.CommitmentConfirmed,
+		},
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return TransactionFee{}, ErrTxNotFound
+		}
+		return TransactionFee{}, err
+	}
+	if result.Meta == nil {
+		return TransactionFee{}, ErrTxNotFound
+	}
+
+	tx, err := result.Transaction.GetTransaction()
+	if err != nil {
+		return TransactionFee{}, err
+	}
+
+	// 基础费用按签名数量计算, 其余为优先费
+	meta := result.Meta
+	baseFee := min(meta.Fee, uint64(len(tx.Signatures))*lamportsPerSignature)
+	fee := TransactionFee{
+		BaseFee:     int64(baseFee),
+		PriorityFee: int64(meta.Fee - baseFee),
+	}
+	if meta.ComputeUnitsConsumed != nil {
+		fee.ComputeUnits = int64(*meta.ComputeUnitsConsumed)
+	}
+
+	// 新建账户的租金由手续费支付者承担
+	if len(tx.Message.AccountKeys) > 0 && tx.Message.AccountKeys[0].Equals(owner) {
+		fee.RentFee = transactionRentFee(meta)
+	}
+
+	return fee, nil
+}
+
+// transactionRentFee 按交易前后代币账户的变化计算租金, 关闭账户返还的租金为负数
+// 不能直接使用 SOL 余额变化, 其中包含兑换或转账的 SOL 数量
+func transactionRentFee(meta *rpc.TransactionMeta) int64 {
+	preAccounts := make(map[uint16]rpc.TokenBalance)
+	for _, balance := range meta.PreTokenBalances {
+		preAccounts[balance.AccountIndex] = balance
+	}
+	postAccounts := make(map[uint16]rpc.TokenBalance)
+	for _, balance := range meta.PostTokenBalances {
+		postAccounts[balance.AccountIndex] = balance
+	}
+
+	var rentFee int64
+	for idx, balance := range postAccounts {
+		if _, ok := preAccounts[idx]; !ok {
+			rentFee += tokenAccountRent(meta.PostBalances, balance)
+		}
+	}
+	for idx, balance := range preAccounts {
+		if _, ok := postAccounts[idx]; !ok {
+			rentFee -= tokenAccountRent(meta.PreBalances, balance)
+		}
+	}
+	return rentFee
+}
+
+// tokenAccountRent 代币账户中的租金, WSOL 账户扣除包装的 SOL 数量
+func tokenAccountRent(balances []uint64, balance rpc.TokenBalance) int64 {
+	if int(balance.AccountIndex) >= len(balances) {
+		return 0
+	}
+
+	lamports := int64(balances[balance.AccountIndex])
+	if balance.Mint.String() == WSOL && balance.UiTokenAmount != nil {
+		amount, ok := new(big.Int).SetString(balance.UiTokenAmount.Amount, 10)
+		if ok && amount.IsInt64() {
+			lamports -= amount.Int64()
+		}
+	}
+	return max(lamports, 0)
+}
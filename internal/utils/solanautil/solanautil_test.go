@@ -0,0 +1,82 @@
+package solanautil
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransactionRentFee(t *testing.T) {
+	const rent = 2039280
+	usdc := solana.MustPublicKeyFromBase58(USDC)
+	wsol := solana.MustPublicKeyFromBase58(WSOL)
+	token := solana.NewWallet().PublicKey()
+
+	tokenBalance := func(idx uint16, mint solana.PublicKey, amount string) rpc.TokenBalance {
+		return rpc.TokenBalance{AccountIndex: idx, Mint: mint, UiTokenAmount: &rpc.UiTokenAmount{Amount: amount}}
+	}
+
+	tests := []struct {
+		name     string
+		meta     *rpc.TransactionMeta
+		expected int64
+	}{
+		{
+			name: "兑换获得 SOL",
+			meta: &rpc.TransactionMeta{
+				Fee:               5000,
+				PreBalances:       []uint64{1_000_000_000, rent},
+				PostBalances:      []uint64{1_499_995_000, rent},
+				PreTokenBalances:  []rpc.TokenBalance{tokenBalance(1, usdc, "100000000")},
+				PostTokenBalances: []rpc.TokenBalance{tokenBalance(1, usdc, "20000000")},
+			},
+			expected: 0,
+		},
+		{
+			name: "买入时新建代币账户",
+			meta: &rpc.TransactionMeta{
+				PreBalances:       []uint64{1_000_000_000, rent, 0},
+				PostBalances:      []uint64{997_955_720, rent, rent},
+				PreTokenBalances:  []rpc.TokenBalance{tokenBalance(1, usdc, "100000000")},
+				PostTokenBalances: []rpc.TokenBalance{tokenBalance(1, usdc, "90000000"), tokenBalance(2, token, "1000")},
+			},
+			expected: rent,
+		},
+		{
+			name: "新建 WSOL 账户不计包装数量",
+			meta: &rpc.TransactionMeta{
+				PreBalances:       []uint64{1_000_000_000, 0},
+				PostBalances:      []uint64{497_955_720, rent + 500_000_000},
+				PostTokenBalances: []rpc.TokenBalance{tokenBalance(1, wsol, "500000000")},
+			},
+			expected: rent,
+		},
+		{
+			name: "关闭代币账户返还租金",
+			meta: &rpc.TransactionMeta{
+				PreBalances:      []uint64{1_000_000_000, rent},
+				PostBalances:     []uint64{1_002_034_280, 0},
+				PreTokenBalances: []rpc.TokenBalance{tokenBalance(1, token, "0")},
+			},
+			expected: -rent,
+		},
+		{
+			name: "没有代币账户变化",
+			meta: &rpc.TransactionMeta{
+				PreBalances:  []uint64{1_000_000_000, 0},
+				PostBalances: []uint64{899_995_000, 100_000_000},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := transactionRentFee(tt.meta)
+			if got != tt.expected {
+				t.Errorf("transactionRentFee() = %d, 期望 %d", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package solanautil
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/tidwall/gjson"
+)
+
+// TokenAccount 钱包持有的代币账户
+type TokenAccount struct {
+	Address   solana.PublicKey
+	Mint      string
+	ProgramID solana.PublicKey
+	Lamports  uint64 // 账户租金
+}
+
+// GetEmptyTokenAccounts 查询钱包中余额为零且可以关闭的代币账户, 包括 Token 和 Token-2022 程序
+func GetEmptyTokenAccounts(ctx context.Context, solanaRpc *rpc.Client, ownerAddress string) ([]TokenAccount, error) {
+	owner, err := solana.PublicKeyFromBase58(ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]TokenAccount, 0)
+	for _, programID := range []solana.PublicKey{solana.TokenProgramID, solana.Token2022ProgramID} {
+		result, err := solanaRpc.GetTokenAccountsByOwner(ctx, owner,
+			&rpc.GetTokenAccountsConfig{ProgramId: programID.ToPointer()},
+			&rpc.GetTokenAccountsOpts{Encoding: solana.EncodingJSONParsed, Commitment: rpc.CommitmentConfirmed},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Value {
+			if item.Account.Data == nil {
+				continue
+			}
+
+			info := gjson.GetBytes(item.Account.Data.GetRawJSON(), "parsed.info")
+			if info.Get("tokenAmount.amount").String() != "0" {
+				continue
+			}
+
+			// 冻结账户无法关闭
+			if info.Get("state").String() == "frozen" {
+				continue
+			}
+
+			// Token-2022 账户存在未提取的转账手续费时无法关闭
+			withheld := false
+			for _, ext := range info.Get("extensions").Array() {
+				if ext.Get("extension").String() == "transferFeeAmount" && ext.Get("state.withheldAmount").Uint() > 0 {
+					withheld = true
+				}
+			}
+			if withheld {
+				continue
+			}
+
+			accounts = append(accounts, TokenAccount{
+				Address:   item.Pubkey,
+				Mint:      info.Get("mint").String(),
+				ProgramID: programID,
+				Lamports:  item.Account.Lamports,
+			})
+		}
+	}
+
+	return accounts, nil
+}
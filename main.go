@@ -5,6 +5,7 @@ import (
 	"flag"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 
 	"github.com/fachebot/sol-grid-bot/internal/config"
@@ -37,7 +38,7 @@ func startAllStrategy(svcCtx *svc.ServiceContext, strategyEngine *engine.Strateg
 
 		strategyList := make([]engine.Strategy, 0)
 		for _, item := range data {
-			s := strategy.NewGridStrategy(svcCtx, item)
+			s := strategy.NewStrategy(svcCtx, item)
 			strategyList = append(strategyList, s)
 		}
 
@@ -52,6 +53,7 @@ func startAllStrategy(svcCtx *svc.ServiceContext, strategyEngine *engine.Strateg
 
 func main() {
 	flag.Parse()
+	logger.SetLogFile(filepath.Join("logs", "gridbot.log"))
 
 	// 读取配置文件
 	c, err := config.LoadFromFile(*configFile)
@@ -115,10 +117,29 @@ func main() {
 	// 创建服务上下文
 	svcCtx := svc.NewServiceContext(c, strategyEngine)
 
+	// 全局模拟交易无法卖出真实持仓
+	if err = strategy.CheckPaperTrading(context.TODO(), svcCtx); err != nil {
+		logger.Fatalf("开启模拟交易失败, %s", err)
+	}
+
+	// 运行交易发送器
+	svcCtx.TxSender.Start()
+
+	// 对账网格和订单
+	job.NewReconciler(svcCtx).ReconcileAll(context.TODO())
+
 	// 运行订单Keeper
 	orderKeeper := job.NewOrderKeeper(svcCtx)
 	orderKeeper.Start()
 
+	// 运行 SOL 余额监控
+	gasMonitor := job.NewGasMonitor(svcCtx)
+	gasMonitor.Start()
+
+	// 运行提现Keeper
+	withdrawKeeper := job.NewWithdrawKeeper(svcCtx)
+	withdrawKeeper.Start()
+
 	// 运行机器人服务
 	botService, err := telebot.NewTeleBot(svcCtx)
 	if err != nil {
@@ -138,7 +159,10 @@ func main() {
 	strategyEngine.Stop()
 	klineManager.Stop()
 	quotationSubscriber.Stop()
+	gasMonitor.Stop()
+	withdrawKeeper.Stop()
 	orderKeeper.Stop()
+	svcCtx.TxSender.Stop()
 
 	svcCtx.Close()
 	logger.Infof("服务已停止")